@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tricorn/internal/logger"
+)
+
+// ApprovalChore sends bridge out of transfers, which exceeded limits and were approved by operator.
+//
+// architecture: Chore
+type ApprovalChore struct {
+	log logger.Logger
+
+	service  *Service
+	interval time.Duration
+}
+
+// NewApprovalChore instantiates ApprovalChore.
+func NewApprovalChore(log logger.Logger, service *Service, interval time.Duration) *ApprovalChore {
+	return &ApprovalChore{
+		log:      log,
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run executes approved transfers every interval until context is cancelled.
+func (chore *ApprovalChore) Run(ctx context.Context) error {
+	ticker := time.NewTicker(chore.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		executed, err := chore.service.ExecuteApprovedTransfers(ctx)
+		if err != nil {
+			chore.log.Error("couldn't execute approved transfers", Error.Wrap(err))
+		}
+
+		if executed > 0 {
+			chore.log.Debug(fmt.Sprintf("%d approved transfers executed", executed))
+		}
+	}
+}
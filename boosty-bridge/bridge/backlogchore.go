@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tricorn/internal/logger"
+)
+
+// BacklogChore sends bridge out of transfers, which were deferred while their networks were paused.
+//
+// architecture: Chore
+type BacklogChore struct {
+	log logger.Logger
+
+	service  *Service
+	interval time.Duration
+}
+
+// NewBacklogChore instantiates BacklogChore.
+func NewBacklogChore(log logger.Logger, service *Service, interval time.Duration) *BacklogChore {
+	return &BacklogChore{
+		log:      log,
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run sends deferred transfers every interval until context is cancelled.
+func (chore *BacklogChore) Run(ctx context.Context) error {
+	ticker := time.NewTicker(chore.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		processed, err := chore.service.ProcessBacklog(ctx)
+		if err != nil {
+			chore.log.Error("couldn't process paused transfers backlog", Error.Wrap(err))
+		}
+
+		if processed > 0 {
+			chore.log.Debug(fmt.Sprintf("%d deferred transfers sent", processed))
+		}
+	}
+}
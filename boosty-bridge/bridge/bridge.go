@@ -7,9 +7,16 @@ import (
 
 	"github.com/google/uuid"
 
+	"tricorn/bridge/dryrun"
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/limits"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/reconciliation"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/transactions"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/chains"
 	"tricorn/currencyrates"
 	"tricorn/signer"
@@ -18,6 +25,9 @@ import (
 // authenticationMsg defines message which client signs to authenticate.
 const authenticationMsg = "Bridge Authentication Proof"
 
+// maxHistoryLimit defines maximal amount of transfers in page of history, it is used if requested limit is zero as well.
+const maxHistoryLimit = 100
+
 var (
 	// ErrNoNetworkBlock indicates that network block does not exist.
 	ErrNoNetworkBlock = errors.New("network block does not exist")
@@ -31,6 +41,8 @@ var (
 	ErrNoToken = errors.New("token does not exist")
 	// ErrNoTransaction indicates that transaction does not exist.
 	ErrNoTransaction = errors.New("transaction does not exist")
+	// ErrNoUnmatchedEvent indicates that unmatched event does not exist.
+	ErrNoUnmatchedEvent = errors.New("unmatched event does not exist")
 	// ErrTransactionAlreadyExists indicates that the transaction already exists.
 	ErrTransactionAlreadyExists = errors.New("transaction already exists")
 	// ErrNotConnectedNetwork indicates that network is not connected.
@@ -57,6 +69,14 @@ type Connector interface {
 	BridgeInSignature(context.Context, BridgeInSignatureRequest) (BridgeInSignatureResponse, error)
 	// CancelSignature returns signature for user to return funds.
 	CancelSignature(context.Context, chains.CancelSignatureRequest) (chains.CancelSignatureResponse, error)
+	// TokenSupply returns balance of the bridge contract and total supply of the token.
+	TokenSupply(ctx context.Context, token []byte) (chains.TokenSupply, error)
+	// SetPaused pauses or unpauses bridge contract and returns hash of the sent transaction.
+	SetPaused(ctx context.Context, paused bool) ([]byte, error)
+	// EventRange returns historical bridge events of the bounded range of blocks.
+	EventRange(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error)
+	// TransactionStatus returns status of the transaction on chain.
+	TransactionStatus(ctx context.Context, txHash []byte) (chains.TxStatus, error)
 
 	// AddEventSubscriber adds subscriber to event publisher.
 	AddEventSubscriber() EventSubscriber
@@ -76,14 +96,21 @@ type CurrencyRates interface {
 type Signer interface {
 	// Sign signs data for specific network.
 	Sign(ctx context.Context, networkType networks.Type, data []byte, dataType signer.Type) ([]byte, error)
+	// SignWithKey signs data for specific network with the transaction key of the specified public key.
+	SignWithKey(ctx context.Context, networkType networks.Type, publicKey networks.PublicKey, data []byte) ([]byte, error)
 	// PublicKey returns public key for specific network.
 	PublicKey(ctx context.Context, networkID networks.Type) (networks.PublicKey, error)
+	// PublicKeys returns public keys of all transaction keys for specific network.
+	PublicKeys(ctx context.Context, networkType networks.Type) ([]networks.PublicKey, error)
 }
 
 // DB provides access to all databases and database related functionality.
 //
 // architecture: Master Database.
 type DB interface {
+	// Networks provides access to network definitions db.
+	Networks() networks.Definitions
+
 	// NetworkBlocks provides access to network blocks db.
 	NetworkBlocks() networks.NetworkBlocks
 
@@ -96,9 +123,59 @@ type DB interface {
 	// TokenTransfers provides access to token transfers db.
 	TokenTransfers() transfers.TokenTransfers
 
+	// TransferStatusChanges provides notifications about changes of token transfers.
+	TransferStatusChanges() transfers.StatusChanges
+
+	// TransferStatusHistory provides access to the recorded status transitions of token transfers.
+	TransferStatusHistory() transfers.StatusHistory
+
+	// WebhookEndpoints provides access to webhook endpoints db.
+	WebhookEndpoints() webhooks.Endpoints
+
+	// WebhookOutbox provides access to webhook deliveries db.
+	WebhookOutbox() webhooks.Outbox
+
+	// DryRunDecisions provides access to decisions of the bridge in dry run mode db.
+	DryRunDecisions() dryrun.Decisions
+
+	// DryRunOutcomes provides access to outcomes of the events in the live bridge to compare dry run with.
+	DryRunOutcomes() dryrun.Outcomes
+
+	// ReconciliationLedger provides access to token transfers in the form required for reconciliation.
+	ReconciliationLedger() reconciliation.Ledger
+
+	// ReconciliationBaselines provides access to baselines of bridge contract balances db.
+	ReconciliationBaselines() reconciliation.Baselines
+
+	// LimitCounters provides access to rolling volume counters of transfer limits db.
+	LimitCounters() limits.Counters
+
+	// TransferApprovals provides access to the queue of transfers which wait for manual approval db.
+	TransferApprovals() limits.Approvals
+
+	// Pauses provides access to pauses of the bridge and networks db.
+	Pauses() pause.Pauses
+
+	// PausedTransfers provides access to transfers, bridge out of which was deferred by pause, db.
+	PausedTransfers() pause.Backlog
+	// OutboundAttempts provides access to the attempts of bridge out of transfers db.
+	OutboundAttempts() transfers.OutboundAttempts
+
+	// Denylist provides access to addresses denied by operators db.
+	Denylist() screening.Denylist
+
+	// ScreeningHolds provides access to the audit of transfers held by screening db.
+	ScreeningHolds() screening.Holds
+
+	// AuditLog provides access to the append-only audit log of operator interventions db.
+	AuditLog() interventions.AuditLog
+
 	// Tokens provides access to tokens db.
 	Tokens() Tokens
 
+	// UnmatchedEvents provides access to unmatched events db.
+	UnmatchedEvents() transfers.UnmatchedEvents
+
 	// Transactions provides access to transactions db.
 	Transactions() transactions.DB
 
@@ -13,12 +13,24 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 
 	"tricorn/bridge"
 	"tricorn/bridge/database/dbtesting"
+	"tricorn/bridge/dryrun"
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/limits"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/reconciliation"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/transactions"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+	"tricorn/chains"
+	"tricorn/communication/mockcommunication"
+	"tricorn/internal/logger/zaplog"
+	"tricorn/pkg/codec"
 )
 
 func TestNetworkBlocksDB(t *testing.T) {
@@ -62,6 +74,41 @@ func TestNetworkBlocksDB(t *testing.T) {
 	})
 }
 
+func TestNetworksDB(t *testing.T) {
+	definition := networks.Definition{
+		ID:      12,
+		Name:    "ARBITRUM",
+		Type:    networks.TypeEVM,
+		ChainID: 42161,
+		Codec:   codec.NameEVM,
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		repository := db.Networks()
+
+		t.Run("Empty List", func(t *testing.T) {
+			definitions, err := repository.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, definitions)
+		})
+
+		t.Run("Upsert", func(t *testing.T) {
+			err := repository.Upsert(ctx, definition)
+			require.NoError(t, err)
+
+			definition.IsTestnet = true
+			err = repository.Upsert(ctx, definition)
+			require.NoError(t, err)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			definitions, err := repository.List(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, []networks.Definition{definition}, definitions)
+		})
+	})
+}
+
 func TestNetworkNoncesDB(t *testing.T) {
 	networkNonce := networks.NetworkNonce{
 		NetworkID: networks.IDCasper,
@@ -134,7 +181,7 @@ func TestNetworkNoncesDB(t *testing.T) {
 }
 
 func TestNetworkTokensDB(t *testing.T) {
-	casperContractAddress, err := networks.StringToBytes(networks.IDCasper, "0xF035b4f54B4A5Dd154cD378ce9d77a12A2c97764")
+	casperContractAddress, err := codec.Casper.ParseContract("hash-f035b4f54b4a5dd154cd378ce9d77a12a2c97764f035b4f54b4a5dd154cd378c")
 	require.NoError(t, err)
 
 	networkTokenCasper := networks.NetworkToken{
@@ -144,7 +191,7 @@ func TestNetworkTokensDB(t *testing.T) {
 		Decimals:        18,
 	}
 
-	ethContractAddress, err := networks.StringToBytes(networks.IDEth, "0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69")
+	ethContractAddress, err := codec.EVM.ParseContract("0x0e26df2baafbc976a104ee3cbcf1b467ff1b7a69")
 	require.NoError(t, err)
 
 	networkTokenEth := networks.NetworkToken{
@@ -205,6 +252,80 @@ func TestNetworkTokensDB(t *testing.T) {
 	})
 }
 
+func TestLegacyCasperAddressesMigration(t *testing.T) {
+	accountHash, err := codec.Casper.ParseAddress("account-hash-f035b4f54b4a5dd154cd378ce9d77a12a2c97764f035b4f54b4a5dd154cd378c")
+	require.NoError(t, err)
+
+	contractHash, err := codec.Casper.ParseContract("hash-0e26df2baafbc976a104ee3cbcf1b467ff1b7a690e26df2baafbc976a104ee3c")
+	require.NoError(t, err)
+
+	publicKey, err := codec.Casper.ParsePublicKey("01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
+	require.NoError(t, err)
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		// casper addresses used to be stored with the tag of the key: 00 for account hash and 01 for contract hash.
+		legacyTransfer := transfers.TokenTransfer{
+			ID:                 1,
+			TokenID:            1,
+			Amount:             *big.NewInt(1),
+			Status:             transfers.StatusWaiting,
+			SenderNetworkID:    int64(networks.IDCasper),
+			SenderAddress:      append([]byte{0}, accountHash...),
+			RecipientNetworkID: int64(networks.IDEth),
+			RecipientAddress:   make([]byte, 20),
+		}
+		publicKeyTransfer := legacyTransfer
+		publicKeyTransfer.ID = 2
+		publicKeyTransfer.SenderAddress = publicKey
+
+		require.NoError(t, db.TokenTransfers().Create(ctx, legacyTransfer))
+		require.NoError(t, db.TokenTransfers().Create(ctx, publicKeyTransfer))
+		require.NoError(t, db.NetworkTokens().Create(ctx, networks.NetworkToken{
+			NetworkID:       networks.IDCasper,
+			TokenID:         1,
+			ContractAddress: append([]byte{1}, contractHash...),
+			Decimals:        18,
+		}))
+
+		// casper network, which is known only from the networks table, is selected by type.
+		customCasper := networks.Definition{ID: 100, Name: "CASPER-CUSTOM", Type: networks.TypeCasper, Codec: codec.NameCasper}
+		require.NoError(t, db.Networks().Upsert(ctx, customCasper))
+		require.NoError(t, db.NetworkTokens().Create(ctx, networks.NetworkToken{
+			NetworkID:       customCasper.ID,
+			TokenID:         1,
+			ContractAddress: append([]byte{1}, contractHash...),
+			Decimals:        18,
+		}))
+
+		// migration is applied once, so it does not run on the next startup.
+		require.NoError(t, db.CreateSchema(ctx))
+
+		tokenTransfer, err := db.TokenTransfers().Get(ctx, legacyTransfer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, legacyTransfer.SenderAddress, tokenTransfer.SenderAddress)
+
+		require.NoError(t, dbtesting.Exec(ctx, db, "DELETE FROM schema_migrations"))
+		require.NoError(t, db.CreateSchema(ctx))
+
+		tokenTransfer, err = db.TokenTransfers().Get(ctx, legacyTransfer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, accountHash, tokenTransfer.SenderAddress)
+
+		// tagged ed25519 public key has the same length, but it is not tagged as account hash.
+		tokenTransfer, err = db.TokenTransfers().Get(ctx, publicKeyTransfer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, publicKey, tokenTransfer.SenderAddress)
+
+		token, err := db.NetworkTokens().Get(ctx, networks.IDCasper, 1)
+		require.NoError(t, err)
+		assert.Equal(t, contractHash, token.ContractAddress)
+
+		token, err = db.NetworkTokens().Get(ctx, customCasper.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, contractHash, token.ContractAddress)
+	})
+}
+
 func TestTokenTransfersDB(t *testing.T) {
 	senderAddress, err := hex.DecodeString("4zXwdbUDWo1S5AP2CEfv4zAPRds5PQUG1dyqLLvib2xu")
 	require.Error(t, err)
@@ -218,11 +339,18 @@ func TestTokenTransfersDB(t *testing.T) {
 		OutboundTx:         0,
 		TokenID:            1,
 		Amount:             *new(big.Int).SetInt64(1),
-		Status:             "waiting",
+		Status:             transfers.StatusWaiting,
 		SenderNetworkID:    1,
 		SenderAddress:      senderAddress,
 		RecipientNetworkID: 2,
 		RecipientAddress:   recipientAddress,
+		CreatedAt:          time.Now().UTC().Truncate(time.Microsecond),
+	}
+	fundsIn := transfers.StatusTransition{
+		From:      transfers.StatusWaiting,
+		To:        transfers.StatusConfirming,
+		Cause:     transfers.CauseFundsIn,
+		ChangedAt: time.Now().UTC(),
 	}
 
 	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
@@ -236,7 +364,7 @@ func TestTokenTransfersDB(t *testing.T) {
 
 		t.Run("Negative Update", func(t *testing.T) {
 			tokenTransfer.OutboundTx = 2
-			err := repository.Update(ctx, tokenTransfer)
+			err := repository.Update(ctx, tokenTransfer, fundsIn)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, bridge.ErrNoTokenTransfer))
 		})
@@ -266,9 +394,42 @@ func TestTokenTransfersDB(t *testing.T) {
 		})
 
 		t.Run("Update", func(t *testing.T) {
-			tokenTransfer.Status = "finished"
-			err := repository.Update(ctx, tokenTransfer)
+			tokenTransfer.Status = transfers.StatusConfirming
+			err := repository.Update(ctx, tokenTransfer, fundsIn)
+			require.NoError(t, err)
+
+			tokenTransferFromDB, err := repository.Get(ctx, tokenTransfer.ID)
+			require.NoError(t, err)
+			assert.Equal(t, transfers.StatusConfirming, tokenTransferFromDB.Status)
+		})
+
+		t.Run("Negative Update stale status", func(t *testing.T) {
+			err := repository.Update(ctx, tokenTransfer, fundsIn)
+			require.Error(t, err)
+			require.True(t, transfers.ErrIllegalTransition.Has(err))
+		})
+
+		t.Run("Negative Update illegal transition", func(t *testing.T) {
+			err := repository.Update(ctx, tokenTransfer, transfers.StatusTransition{
+				From:      transfers.StatusConfirming,
+				To:        transfers.StatusWaiting,
+				Cause:     transfers.CauseFundsIn,
+				ChangedAt: time.Now().UTC(),
+			})
+			require.Error(t, err)
+			require.True(t, transfers.ErrIllegalTransition.Has(err))
+		})
+
+		t.Run("Status history", func(t *testing.T) {
+			timeline, err := db.TransferStatusHistory().List(ctx, transfers.ID(tokenTransfer.ID))
 			require.NoError(t, err)
+			require.Len(t, timeline, 2)
+			assert.Equal(t, transfers.Status(""), timeline[0].From)
+			assert.Equal(t, transfers.StatusWaiting, timeline[0].To)
+			assert.Equal(t, transfers.CauseCreated, timeline[0].Cause)
+			assert.Equal(t, transfers.StatusWaiting, timeline[1].From)
+			assert.Equal(t, transfers.StatusConfirming, timeline[1].To)
+			assert.Equal(t, transfers.CauseFundsIn, timeline[1].Cause)
 		})
 	})
 
@@ -321,6 +482,7 @@ func TestTokenTransfersDB(t *testing.T) {
 		SenderAddress:      senderAddress,
 		RecipientNetworkID: int64(transaction2.NetworkID),
 		RecipientAddress:   recipientAddress,
+		CreatedAt:          time.Now().UTC().Truncate(time.Microsecond),
 	}
 	tokenTransfer2 := transfers.TokenTransfer{
 		ID:                 2,
@@ -332,6 +494,20 @@ func TestTokenTransfersDB(t *testing.T) {
 		SenderAddress:      senderAddress,
 		RecipientNetworkID: int64(transaction4.NetworkID),
 		RecipientAddress:   recipientAddress,
+		// created at the same time, so transfers are ordered by id.
+		CreatedAt: tokenTransfer1.CreatedAt,
+	}
+	// created before the others, but has the greatest id.
+	tokenTransfer3 := transfers.TokenTransfer{
+		ID:                 3,
+		TokenID:            token.ID,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusCancelled,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      senderAddress,
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   recipientAddress,
+		CreatedAt:          tokenTransfer1.CreatedAt.Add(-time.Minute),
 	}
 
 	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
@@ -405,182 +581,1591 @@ func TestTokenTransfersDB(t *testing.T) {
 			require.NoError(t, err)
 			assert.EqualValues(t, 2, amount)
 		})
-	})
-}
 
-func TestTokensDB(t *testing.T) {
-	token1 := bridge.Token{
-		ID:        1,
-		ShortName: "TEST",
-		LongName:  "TEST TOKEN",
-	}
-	token2 := bridge.Token{
-		ID:        2,
-		ShortName: "TESTONIUM",
-		LongName:  "TESTONIUM TOKEN",
-	}
+		t.Run("ListByUserAfter", func(t *testing.T) {
+			query := transfers.HistoryQuery{
+				NetworkID:   networks.IDCasper,
+				UserAddress: senderAddress,
+				Sort:        transfers.SortNewest,
+				Limit:       1,
+			}
 
-	casperContractAddress, err := networks.StringToBytes(networks.IDCasper, "0xF035b4f54B4A5Dd154cD378ce9d77a12A2c97764")
-	require.NoError(t, err)
-	eth1ContractAddress, err := networks.StringToBytes(networks.IDEth, "0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69")
-	require.NoError(t, err)
-	solana2ContractAddress, err := networks.StringToBytes(networks.IDSolana, "7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
-	require.NoError(t, err)
-	eth2ContractAddress, err := networks.StringToBytes(networks.IDEth, "0x5b4f54B4A5Dd1546a104EE3cbcf1B467ff1b7a69")
-	require.NoError(t, err)
+			list, err := tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer2}, list)
 
-	networkTokenCasper1 := networks.NetworkToken{
-		NetworkID:       networks.IDCasper,
-		TokenID:         token1.ID,
-		ContractAddress: casperContractAddress,
-		Decimals:        18,
-	}
-	networkTokenEth1 := networks.NetworkToken{
-		NetworkID:       networks.IDEth,
-		TokenID:         token1.ID,
-		ContractAddress: eth1ContractAddress,
-		Decimals:        18,
-	}
-	networkTokenSolana2 := networks.NetworkToken{
-		NetworkID:       networks.IDSolana,
-		TokenID:         token2.ID,
-		ContractAddress: solana2ContractAddress,
-		Decimals:        16,
-	}
-	networkTokenEth2 := networks.NetworkToken{
-		NetworkID:       networks.IDEth,
-		TokenID:         token2.ID,
-		ContractAddress: eth2ContractAddress,
-		Decimals:        16,
-	}
+			query.After = transfers.NewCursor(tokenTransfer2, query.Sort, query.Filter)
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer1}, list)
 
-	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
-		repository := db.Tokens()
+			query.After = transfers.NewCursor(tokenTransfer1, query.Sort, query.Filter)
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Empty(t, list)
 
-		t.Run("Negative Get", func(t *testing.T) {
-			_, err := repository.Get(ctx, 0)
-			require.Error(t, err)
-			require.True(t, errors.Is(err, bridge.ErrNoToken))
+			query.Sort = transfers.SortOldest
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer2}, list)
 		})
 
-		t.Run("Negative Update", func(t *testing.T) {
-			token1.ShortName = "test"
-			err := repository.Update(ctx, token1)
-			require.Error(t, err)
-			require.True(t, errors.Is(err, bridge.ErrNoToken))
-		})
+		t.Run("ListByUserAfter with filter", func(t *testing.T) {
+			query := transfers.HistoryQuery{
+				NetworkID:   networks.IDCasper,
+				UserAddress: senderAddress,
+				Sort:        transfers.SortOldest,
+				Limit:       10,
+			}
 
-		t.Run("Create", func(t *testing.T) {
-			err := repository.Create(ctx, token1)
+			query.Filter = transfers.HistoryFilter{Statuses: []transfers.Status{transfers.StatusFinished}, TokenIDs: []int64{token.ID}}
+			list, err := tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer1}, list)
+
+			query.Filter = transfers.HistoryFilter{RecipientNetworks: []networks.ID{networks.IDEth}}
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
 			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer2}, list)
+
+			query.Filter = transfers.HistoryFilter{SenderNetworks: []networks.ID{networks.IDEth}}
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Empty(t, list)
+
+			query.Filter = transfers.HistoryFilter{CreatedFrom: time.Now().Add(-time.Hour), CreatedTo: time.Now().Add(time.Hour)}
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer1, tokenTransfer2}, list)
+
+			query.Filter = transfers.HistoryFilter{CreatedFrom: time.Now().Add(time.Hour)}
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Empty(t, list)
 		})
 
-		t.Run("Get", func(t *testing.T) {
-			tokenFromDB, err := repository.Get(ctx, token1.ID)
+		t.Run("ListByUserAfter as recipient", func(t *testing.T) {
+			list, err := tokenTransfersRepository.ListByUserAfter(ctx, transfers.HistoryQuery{
+				NetworkID:   networks.ID(tokenTransfer1.RecipientNetworkID),
+				UserAddress: recipientAddress,
+				Sort:        transfers.SortNewest,
+				Limit:       10,
+			})
 			require.NoError(t, err)
-			assert.Equal(t, token1.ID, tokenFromDB.ID)
-			assert.Equal(t, token1.ShortName, tokenFromDB.ShortName)
-			assert.Equal(t, token1.LongName, tokenFromDB.LongName)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer1}, list)
 		})
 
-		t.Run("Update", func(t *testing.T) {
-			token1.ShortName = "test2"
-			err := repository.Update(ctx, token1)
+		t.Run("ListByUserAfter sorted by creation time", func(t *testing.T) {
+			err := tokenTransfersRepository.Create(ctx, tokenTransfer3)
 			require.NoError(t, err)
+
+			query := transfers.HistoryQuery{
+				NetworkID:   networks.IDCasper,
+				UserAddress: senderAddress,
+				Sort:        transfers.SortOldest,
+				Limit:       10,
+			}
+			list, err := tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer3, tokenTransfer1, tokenTransfer2}, list)
+
+			query.Sort, query.Limit = transfers.SortNewest, 2
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer2, tokenTransfer1}, list)
+
+			query.After = transfers.NewCursor(tokenTransfer1, query.Sort, query.Filter)
+			list, err = tokenTransfersRepository.ListByUserAfter(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.TokenTransfer{tokenTransfer3}, list)
 		})
 	})
+}
+
+func TestTokenTransfersNonceReservation(t *testing.T) {
+	networkNonce := networks.NetworkNonce{
+		NetworkID: networks.IDCasper,
+		Nonce:     7,
+	}
+	tokenTransfer := transfers.TokenTransfer{
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusWaiting,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
+	}
 
 	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
-		tokensRepository := db.Tokens()
-		networkTokensRepository := db.NetworkTokens()
+		repository := db.TokenTransfers()
+		deadline := time.Now().UTC().Add(time.Hour)
+		sign := func(nonce int64) (time.Time, error) {
+			return deadline, nil
+		}
 
-		t.Run("Empty List", func(t *testing.T) {
-			list, err := tokensRepository.List(ctx, networks.IDEth)
+		t.Run("Negative CreateWithNonce", func(t *testing.T) {
+			_, err := repository.CreateWithNonce(ctx, tokenTransfer, sign)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, bridge.ErrNoNetworkNonce))
+		})
+
+		t.Run("CreateWithNonce", func(t *testing.T) {
+			err := db.Nonces().Create(ctx, networkNonce)
 			require.NoError(t, err)
-			assert.Empty(t, list)
 
-			list, err = tokensRepository.List(ctx, networks.IDSolana)
+			created, err := repository.CreateWithNonce(ctx, tokenTransfer, sign)
 			require.NoError(t, err)
-			assert.Empty(t, list)
+			assert.EqualValues(t, networkNonce.Nonce, created.Nonce)
+
+			fromDB, err := repository.Get(ctx, created.ID)
+			require.NoError(t, err)
+			assert.EqualValues(t, networkNonce.Nonce, fromDB.Nonce)
+			assert.WithinDuration(t, deadline, fromDB.Deadline, time.Millisecond)
+
+			nonce, err := db.Nonces().Get(ctx, networkNonce.NetworkID)
+			require.NoError(t, err)
+			assert.EqualValues(t, networkNonce.Nonce+1, nonce)
 		})
 
-		t.Run("Create", func(t *testing.T) {
-			err := tokensRepository.Create(ctx, token1)
+		t.Run("Failed sign releases nonce", func(t *testing.T) {
+			_, err := repository.CreateWithNonce(ctx, tokenTransfer, func(nonce int64) (time.Time, error) {
+				return time.Time{}, errors.New("connector is unavailable")
+			})
+			require.Error(t, err)
+
+			nonce, err := db.Nonces().Get(ctx, networkNonce.NetworkID)
 			require.NoError(t, err)
+			assert.EqualValues(t, networkNonce.Nonce+1, nonce)
+		})
 
-			err = tokensRepository.Create(ctx, token2)
+		t.Run("Concurrent CreateWithNonce", func(t *testing.T) {
+			const requests = 10
+			nonces := make(chan int64, requests)
+			var group errgroup.Group
+			for i := 0; i < requests; i++ {
+				group.Go(func() error {
+					created, err := repository.CreateWithNonce(ctx, tokenTransfer, sign)
+					nonces <- created.Nonce
+					return err
+				})
+			}
+			require.NoError(t, group.Wait())
+			close(nonces)
+
+			seen := make(map[int64]bool)
+			for nonce := range nonces {
+				assert.False(t, seen[nonce], "nonce %d reserved twice", nonce)
+				seen[nonce] = true
+			}
+		})
+
+		t.Run("CancelWithNonce", func(t *testing.T) {
+			created, err := repository.CreateWithNonce(ctx, tokenTransfer, sign)
 			require.NoError(t, err)
 
-			err = networkTokensRepository.Create(ctx, networkTokenCasper1)
+			transition, err := created.MoveTo(transfers.StatusCancelled, transfers.CauseCancelSignature)
 			require.NoError(t, err)
 
-			err = networkTokensRepository.Create(ctx, networkTokenEth1)
+			_, err = repository.CancelWithNonce(ctx, created, networks.IDCasper, transition, func(nonce int64) error {
+				return errors.New("connector is unavailable")
+			})
+			require.Error(t, err)
+
+			fromDB, err := repository.Get(ctx, created.ID)
 			require.NoError(t, err)
+			assert.Equal(t, transfers.StatusWaiting, fromDB.Status)
 
-			err = networkTokensRepository.Create(ctx, networkTokenSolana2)
+			next, err := db.Nonces().Get(ctx, networkNonce.NetworkID)
 			require.NoError(t, err)
 
-			err = networkTokensRepository.Create(ctx, networkTokenEth2)
+			nonce, err := repository.CancelWithNonce(ctx, created, networks.IDCasper, transition, func(nonce int64) error {
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, next, nonce)
+
+			fromDB, err = repository.Get(ctx, created.ID)
+			require.NoError(t, err)
+			assert.Equal(t, transfers.StatusCancelled, fromDB.Status)
+			assert.Equal(t, created.Nonce, fromDB.Nonce)
+
+			// cancelled transfer is not cancelled once more, so no nonce is reserved.
+			_, err = repository.CancelWithNonce(ctx, created, networks.IDCasper, transition, func(nonce int64) error {
+				return nil
+			})
+			require.Error(t, err)
+			assert.True(t, transfers.ErrIllegalTransition.Has(err))
+
+			nonce, err = db.Nonces().Get(ctx, networkNonce.NetworkID)
 			require.NoError(t, err)
+			assert.Equal(t, next+1, nonce)
 		})
 
-		t.Run("List", func(t *testing.T) {
-			list, err := tokensRepository.List(ctx, networks.IDEth)
+		t.Run("Expire", func(t *testing.T) {
+			expired, err := repository.Expire(ctx, time.Now().UTC(), 0)
 			require.NoError(t, err)
-			assert.Len(t, list, 2)
-			assert.EqualValues(t, []bridge.Token{token1, token2}, list)
+			assert.EqualValues(t, 0, expired)
 
-			list, err = tokensRepository.List(ctx, networks.IDSolana)
+			// funds in sent before the deadline may be indexed later, so transfers are not expired within grace.
+			expired, err = repository.Expire(ctx, deadline.Add(time.Second), time.Minute)
 			require.NoError(t, err)
-			assert.Len(t, list, 1)
-			assert.EqualValues(t, token2, list[0])
+			assert.EqualValues(t, 0, expired)
+
+			expired, err = repository.Expire(ctx, deadline.Add(time.Second), 0)
+			require.NoError(t, err)
+			assert.EqualValues(t, 11, expired)
+
+			fromDB, err := repository.Get(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, transfers.StatusExpired, fromDB.Status)
 		})
 	})
 }
 
-func TestTransactionsDB(t *testing.T) {
-	transaction := transactions.Transaction{
-		ID:          1,
-		NetworkID:   networks.IDCasper,
-		TxHash:      []byte{},
-		Sender:      []byte{},
-		BlockNumber: 1,
-		SeenAt:      time.Now(),
+func TestTokenTransfersBinding(t *testing.T) {
+	networkNonce := networks.NetworkNonce{
+		NetworkID: networks.IDCasper,
+		Nonce:     3,
+	}
+	tokenTransfer := transfers.TokenTransfer{
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusWaiting,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
 	}
 
 	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
-		repository := db.Transactions()
+		repository := db.TokenTransfers()
+		sign := func(nonce int64) (time.Time, error) {
+			return time.Now().UTC().Add(time.Hour), nil
+		}
 
-		t.Run("Negative Get", func(t *testing.T) {
-			_, err := repository.Get(ctx, 0)
+		t.Run("Negative GetByNonce", func(t *testing.T) {
+			_, err := repository.GetByNonce(ctx, networks.IDCasper, networkNonce.Nonce)
 			require.Error(t, err)
-			require.True(t, errors.Is(err, bridge.ErrNoTransaction))
+			require.True(t, errors.Is(err, bridge.ErrNoTokenTransfer))
 		})
 
-		t.Run("Negative Exists", func(t *testing.T) {
-			err := repository.Exists(ctx, transaction.NetworkID, transaction.TxHash)
+		t.Run("Negative GetByTriggeringTx", func(t *testing.T) {
+			_, err := repository.GetByTriggeringTx(ctx, 1)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, bridge.ErrNoTokenTransfer))
+		})
+
+		t.Run("Identical transfers are bound by nonce", func(t *testing.T) {
+			err := db.Nonces().Create(ctx, networkNonce)
+			require.NoError(t, err)
+
+			first, err := repository.CreateWithNonce(ctx, tokenTransfer, sign)
+			require.NoError(t, err)
+			second, err := repository.CreateWithNonce(ctx, tokenTransfer, sign)
 			require.NoError(t, err)
+
+			fromDB, err := repository.GetByNonce(ctx, networks.IDCasper, first.Nonce)
+			require.NoError(t, err)
+			assert.Equal(t, first.ID, fromDB.ID)
+
+			fromDB, err = repository.GetByNonce(ctx, networks.IDCasper, second.Nonce)
+			require.NoError(t, err)
+			assert.Equal(t, second.ID, fromDB.ID)
+
+			_, err = repository.GetByNonce(ctx, networks.IDEth, first.Nonce)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, bridge.ErrNoTokenTransfer))
 		})
 
-		t.Run("Create", func(t *testing.T) {
-			_, err := repository.Create(ctx, transaction)
+		t.Run("GetByTriggeringTx", func(t *testing.T) {
+			second, err := repository.GetByNonce(ctx, networks.IDCasper, networkNonce.Nonce+1)
+			require.NoError(t, err)
+
+			transition, err := second.MoveTo(transfers.StatusConfirming, transfers.CauseFundsIn)
+			require.NoError(t, err)
+			second.TriggeringTx = 2
+			err = repository.Update(ctx, second, transition)
 			require.NoError(t, err)
+
+			fromDB, err := repository.GetByTriggeringTx(ctx, 2)
+			require.NoError(t, err)
+			assert.Equal(t, second.ID, fromDB.ID)
+			assert.Equal(t, transfers.StatusConfirming, fromDB.Status)
 		})
+	})
+}
 
-		t.Run("Positive Exists", func(t *testing.T) {
-			err := repository.Exists(ctx, transaction.NetworkID, transaction.TxHash)
-			require.Error(t, err)
-			assert.True(t, errors.Is(err, bridge.ErrTransactionAlreadyExists))
+func TestTransferStatusChangesDB(t *testing.T) {
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusWaiting,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		changes := make(chan transfers.ID, 1)
+		var group errgroup.Group
+		group.Go(func() error {
+			return db.TransferStatusChanges().Listen(ctx, changes)
 		})
 
-		t.Run("Get", func(t *testing.T) {
-			transactionFromDB, err := repository.Get(ctx, transaction.ID)
+		// listener subscribes asynchronously, so transfers are created until notification is received.
+		received := false
+		for i := 0; i < 50 && !received; i++ {
+			err := db.TokenTransfers().Create(ctx, tokenTransfer)
 			require.NoError(t, err)
-			assert.Equal(t, transaction.NetworkID, transactionFromDB.NetworkID)
-			assert.Equal(t, transaction.TxHash, transactionFromDB.TxHash)
+
+			select {
+			case id := <-changes:
+				received = id != 0
+			case <-time.After(100 * time.Millisecond):
+			}
+		}
+		require.True(t, received)
+
+		transition, err := tokenTransfer.MoveTo(transfers.StatusConfirming, transfers.CauseFundsIn)
+		require.NoError(t, err)
+		err = db.TokenTransfers().Update(ctx, tokenTransfer, transition)
+		require.NoError(t, err)
+
+		// notifications of the transfers created after the first notified one precede the status change.
+		received = false
+		for !received {
+			select {
+			case id := <-changes:
+				received = id == transfers.ID(tokenTransfer.ID)
+			case <-time.After(5 * time.Second):
+				t.Fatal("status change is not notified")
+			}
+		}
+
+		cancel()
+		require.NoError(t, group.Wait())
+	})
+}
+
+func TestWebhooksDB(t *testing.T) {
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusConfirming,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
+	}
+
+	endpoint := webhooks.Endpoint{
+		Owner:     "integrator",
+		URL:       "https://example.com/webhooks",
+		Secret:    "secret",
+		Statuses:  []transfers.Status{},
+		CreatedAt: time.Now().UTC(),
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		endpoints := db.WebhookEndpoints()
+		outbox := db.WebhookOutbox()
+
+		t.Run("Negative Get", func(t *testing.T) {
+			_, err := endpoints.Get(ctx, 1)
+			require.Error(t, err)
+			require.True(t, webhooks.ErrEndpointNotFound.Has(err))
+		})
+
+		t.Run("Create", func(t *testing.T) {
+			var err error
+			endpoint.ID, err = endpoints.Create(ctx, endpoint)
+			require.NoError(t, err)
+
+			finishedOnly := endpoint
+			finishedOnly.Statuses = []transfers.Status{transfers.StatusFinished}
+			_, err = endpoints.Create(ctx, finishedOnly)
+			require.NoError(t, err)
+
+			list, err := endpoints.List(ctx, endpoint.Owner)
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+			assert.Equal(t, endpoint.URL, list[0].URL)
+			assert.Equal(t, []transfers.Status{transfers.StatusFinished}, list[1].Statuses)
+		})
+
+		t.Run("Status changes are enqueued", func(t *testing.T) {
+			err := db.TokenTransfers().Create(ctx, tokenTransfer)
+			require.NoError(t, err)
+
+			transition, err := tokenTransfer.MoveTo(transfers.StatusFinished, transfers.CauseFundsOut)
+			require.NoError(t, err)
+			tokenTransfer.OutboundTx = 2
+			err = db.TokenTransfers().Update(ctx, tokenTransfer, transition)
+			require.NoError(t, err)
+
+			deliveries, err := outbox.List(ctx, endpoint.ID, "", 10)
+			require.NoError(t, err)
+			require.Len(t, deliveries, 2)
+			assert.Equal(t, transfers.StatusFinished, deliveries[0].Status)
+			assert.Equal(t, transfers.StatusConfirming, deliveries[0].PreviousStatus)
+			assert.Equal(t, transfers.StatusConfirming, deliveries[1].Status)
+			assert.Equal(t, transfers.Status(""), deliveries[1].PreviousStatus)
+
+			// transfer is stored as it was at the status change.
+			require.NotNil(t, deliveries[0].Transfer)
+			assert.Equal(t, transfers.StatusFinished, deliveries[0].Transfer.TokenTransfer.Status)
+			assert.Equal(t, "1", deliveries[0].Transfer.TokenTransfer.Amount.String())
+			assert.Equal(t, tokenTransfer.SenderAddress, deliveries[0].Transfer.TokenTransfer.SenderAddress)
+			assert.Equal(t, tokenTransfer.RecipientAddress, deliveries[0].Transfer.TokenTransfer.RecipientAddress)
+			assert.Nil(t, deliveries[0].Transfer.OutboundTx)
+			require.NotNil(t, deliveries[1].Transfer)
+			assert.Equal(t, transfers.StatusConfirming, deliveries[1].Transfer.TokenTransfer.Status)
+
+			deliveries, err = outbox.List(ctx, endpoint.ID+1, "", 10)
+			require.NoError(t, err)
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, transfers.StatusFinished, deliveries[0].Status)
+		})
+
+		t.Run("Claim keeps order of transfer events", func(t *testing.T) {
+			now := time.Now().UTC()
+			claimed, err := outbox.Claim(ctx, now, now.Add(time.Minute), 10)
+			require.NoError(t, err)
+			require.Len(t, claimed, 2)
+			assert.Equal(t, transfers.StatusConfirming, claimed[0].Status)
+			assert.Equal(t, endpoint.ID+1, claimed[1].EndpointID)
+
+			claimed, err = outbox.Claim(ctx, now, now.Add(time.Minute), 10)
+			require.NoError(t, err)
+			assert.Empty(t, claimed)
+		})
+
+		t.Run("Dead and Replay", func(t *testing.T) {
+			deliveries, err := outbox.List(ctx, endpoint.ID, webhooks.DeliveryStatePending, 10)
+			require.NoError(t, err)
+			require.Len(t, deliveries, 2)
+			first := deliveries[1]
+
+			err = outbox.MarkDead(ctx, first.ID, "endpoint responded with 500")
+			require.NoError(t, err)
+
+			dead, err := outbox.List(ctx, endpoint.ID, webhooks.DeliveryStateDead, 10)
+			require.NoError(t, err)
+			require.Len(t, dead, 1)
+			assert.EqualValues(t, 1, dead[0].Attempts)
+			assert.Equal(t, "endpoint responded with 500", dead[0].LastError)
+
+			replayed, err := outbox.Replay(ctx, endpoint.ID, 0, time.Now().UTC())
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, replayed)
+
+			err = outbox.MarkDelivered(ctx, first.ID, time.Now().UTC())
+			require.NoError(t, err)
+
+			delivered, err := outbox.List(ctx, endpoint.ID, webhooks.DeliveryStateDelivered, 10)
+			require.NoError(t, err)
+			require.Len(t, delivered, 1)
+			assert.NotNil(t, delivered[0].DeliveredAt)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			err := endpoints.Delete(ctx, "other", endpoint.ID)
+			require.True(t, webhooks.ErrEndpointNotFound.Has(err))
+
+			err = endpoints.Delete(ctx, endpoint.Owner, endpoint.ID)
+			require.NoError(t, err)
+
+			deliveries, err := outbox.List(ctx, endpoint.ID, "", 10)
+			require.NoError(t, err)
+			assert.Empty(t, deliveries)
+		})
+	})
+}
+
+func TestUnmatchedEventsDB(t *testing.T) {
+	event := transfers.UnmatchedEvent{
+		Kind:      transfers.EventKindFundsOut,
+		NetworkID: networks.IDEth,
+		TxHash:    []byte{1, 2, 3},
+		Reference: 42,
+		Amount:    *new(big.Int).SetInt64(100),
+		Reason:    "no transfer with such triggering transaction",
+		CreatedAt: time.Now().UTC(),
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		repository := db.UnmatchedEvents()
+
+		t.Run("Negative Resolve", func(t *testing.T) {
+			err := repository.Resolve(ctx, 1, time.Now().UTC())
+			require.Error(t, err)
+			require.True(t, errors.Is(err, bridge.ErrNoUnmatchedEvent))
+		})
+
+		t.Run("Create and List", func(t *testing.T) {
+			err := repository.Create(ctx, event)
+			require.NoError(t, err)
+
+			events, err := repository.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.Kind, events[0].Kind)
+			assert.Equal(t, event.NetworkID, events[0].NetworkID)
+			assert.Equal(t, event.TxHash, events[0].TxHash)
+			assert.Equal(t, event.Reference, events[0].Reference)
+			assert.Equal(t, event.Amount.String(), events[0].Amount.String())
+			assert.Equal(t, event.Reason, events[0].Reason)
+		})
+
+		t.Run("Resolve", func(t *testing.T) {
+			err := repository.Resolve(ctx, 1, time.Now().UTC())
+			require.NoError(t, err)
+
+			events, err := repository.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, events)
+		})
+	})
+}
+
+func TestTokensDB(t *testing.T) {
+	token1 := bridge.Token{
+		ID:        1,
+		ShortName: "TEST",
+		LongName:  "TEST TOKEN",
+	}
+	token2 := bridge.Token{
+		ID:        2,
+		ShortName: "TESTONIUM",
+		LongName:  "TESTONIUM TOKEN",
+	}
+
+	casperContractAddress, err := codec.Casper.ParseContract("hash-f035b4f54b4a5dd154cd378ce9d77a12a2c97764f035b4f54b4a5dd154cd378c")
+	require.NoError(t, err)
+	eth1ContractAddress, err := codec.EVM.ParseContract("0x0e26df2baafbc976a104ee3cbcf1b467ff1b7a69")
+	require.NoError(t, err)
+	solana2ContractAddress, err := codec.Solana.ParseContract("7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	require.NoError(t, err)
+	eth2ContractAddress, err := codec.EVM.ParseContract("0x5b4f54b4a5dd1546a104ee3cbcf1b467ff1b7a69")
+	require.NoError(t, err)
+
+	networkTokenCasper1 := networks.NetworkToken{
+		NetworkID:       networks.IDCasper,
+		TokenID:         token1.ID,
+		ContractAddress: casperContractAddress,
+		Decimals:        18,
+	}
+	networkTokenEth1 := networks.NetworkToken{
+		NetworkID:       networks.IDEth,
+		TokenID:         token1.ID,
+		ContractAddress: eth1ContractAddress,
+		Decimals:        18,
+	}
+	networkTokenSolana2 := networks.NetworkToken{
+		NetworkID:       networks.IDSolana,
+		TokenID:         token2.ID,
+		ContractAddress: solana2ContractAddress,
+		Decimals:        16,
+	}
+	networkTokenEth2 := networks.NetworkToken{
+		NetworkID:       networks.IDEth,
+		TokenID:         token2.ID,
+		ContractAddress: eth2ContractAddress,
+		Decimals:        16,
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		repository := db.Tokens()
+
+		t.Run("Negative Get", func(t *testing.T) {
+			_, err := repository.Get(ctx, 0)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, bridge.ErrNoToken))
+		})
+
+		t.Run("Negative Update", func(t *testing.T) {
+			token1.ShortName = "test"
+			err := repository.Update(ctx, token1)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, bridge.ErrNoToken))
+		})
+
+		t.Run("Create", func(t *testing.T) {
+			err := repository.Create(ctx, token1)
+			require.NoError(t, err)
+		})
+
+		t.Run("Get", func(t *testing.T) {
+			tokenFromDB, err := repository.Get(ctx, token1.ID)
+			require.NoError(t, err)
+			assert.Equal(t, token1.ID, tokenFromDB.ID)
+			assert.Equal(t, token1.ShortName, tokenFromDB.ShortName)
+			assert.Equal(t, token1.LongName, tokenFromDB.LongName)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			token1.ShortName = "test2"
+			err := repository.Update(ctx, token1)
+			require.NoError(t, err)
+		})
+	})
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		tokensRepository := db.Tokens()
+		networkTokensRepository := db.NetworkTokens()
+
+		t.Run("Empty List", func(t *testing.T) {
+			list, err := tokensRepository.List(ctx, networks.IDEth)
+			require.NoError(t, err)
+			assert.Empty(t, list)
+
+			list, err = tokensRepository.List(ctx, networks.IDSolana)
+			require.NoError(t, err)
+			assert.Empty(t, list)
+		})
+
+		t.Run("Create", func(t *testing.T) {
+			err := tokensRepository.Create(ctx, token1)
+			require.NoError(t, err)
+
+			err = tokensRepository.Create(ctx, token2)
+			require.NoError(t, err)
+
+			err = networkTokensRepository.Create(ctx, networkTokenCasper1)
+			require.NoError(t, err)
+
+			err = networkTokensRepository.Create(ctx, networkTokenEth1)
+			require.NoError(t, err)
+
+			err = networkTokensRepository.Create(ctx, networkTokenSolana2)
+			require.NoError(t, err)
+
+			err = networkTokensRepository.Create(ctx, networkTokenEth2)
+			require.NoError(t, err)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			list, err := tokensRepository.List(ctx, networks.IDEth)
+			require.NoError(t, err)
+			assert.Len(t, list, 2)
+			assert.EqualValues(t, []bridge.Token{token1, token2}, list)
+
+			list, err = tokensRepository.List(ctx, networks.IDSolana)
+			require.NoError(t, err)
+			assert.Len(t, list, 1)
+			assert.EqualValues(t, token2, list[0])
+		})
+	})
+}
+
+func TestTransactionsDB(t *testing.T) {
+	transaction := transactions.Transaction{
+		ID:          1,
+		NetworkID:   networks.IDCasper,
+		TxHash:      []byte{},
+		Sender:      []byte{},
+		BlockNumber: 1,
+		SeenAt:      time.Now(),
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		repository := db.Transactions()
+
+		t.Run("Negative Get", func(t *testing.T) {
+			_, err := repository.Get(ctx, 0)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, bridge.ErrNoTransaction))
+		})
+
+		t.Run("Negative Exists", func(t *testing.T) {
+			err := repository.Exists(ctx, transaction.NetworkID, transaction.TxHash, transaction.LogIndex)
+			require.NoError(t, err)
+		})
+
+		t.Run("Create", func(t *testing.T) {
+			_, err := repository.Create(ctx, transaction)
+			require.NoError(t, err)
+		})
+
+		t.Run("Positive Exists", func(t *testing.T) {
+			err := repository.Exists(ctx, transaction.NetworkID, transaction.TxHash, transaction.LogIndex)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, bridge.ErrTransactionAlreadyExists))
+		})
+
+		t.Run("Get", func(t *testing.T) {
+			transactionFromDB, err := repository.Get(ctx, transaction.ID)
+			require.NoError(t, err)
+			assert.Equal(t, transaction.NetworkID, transactionFromDB.NetworkID)
+			assert.Equal(t, transaction.TxHash, transactionFromDB.TxHash)
 			assert.Equal(t, transaction.Sender, transactionFromDB.Sender)
 			assert.Equal(t, transaction.BlockNumber, transactionFromDB.BlockNumber)
+			assert.Equal(t, transaction.LogIndex, transactionFromDB.LogIndex)
 			assert.NotEmpty(t, transaction.SeenAt)
 		})
+
+		t.Run("Negative Create duplicate event", func(t *testing.T) {
+			_, err := repository.Create(ctx, transaction)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, bridge.ErrTransactionAlreadyExists))
+		})
+
+		t.Run("Several events in one transaction", func(t *testing.T) {
+			secondEvent := transaction
+			secondEvent.LogIndex = 1
+
+			err := repository.Exists(ctx, secondEvent.NetworkID, secondEvent.TxHash, secondEvent.LogIndex)
+			require.NoError(t, err)
+
+			id, err := repository.Create(ctx, secondEvent)
+			require.NoError(t, err)
+			assert.NotEqual(t, transaction.ID, id)
+
+			transactionFromDB, err := repository.Get(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, transaction.TxHash, transactionFromDB.TxHash)
+			assert.EqualValues(t, 1, transactionFromDB.LogIndex)
+
+			err = repository.Exists(ctx, secondEvent.NetworkID, secondEvent.TxHash, secondEvent.LogIndex)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, bridge.ErrTransactionAlreadyExists))
+		})
+
+		t.Run("GetByEvent", func(t *testing.T) {
+			transactionFromDB, err := repository.GetByEvent(ctx, transaction.NetworkID, transaction.TxHash, 1)
+			require.NoError(t, err)
+			assert.NotEqual(t, transaction.ID, transactionFromDB.ID)
+			assert.Equal(t, transaction.BlockNumber, transactionFromDB.BlockNumber)
+			assert.EqualValues(t, 1, transactionFromDB.LogIndex)
+
+			_, err = repository.GetByEvent(ctx, transaction.NetworkID, transaction.TxHash, 2)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, bridge.ErrNoTransaction))
+		})
+	})
+}
+
+func TestReconciliationDB(t *testing.T) {
+	transfer := func(id int64, senderNetworkID, recipientNetworkID networks.ID, status transfers.Status, amount int64) transfers.TokenTransfer {
+		return transfers.TokenTransfer{
+			ID:                 id,
+			TokenID:            1,
+			Amount:             *big.NewInt(amount),
+			Status:             status,
+			SenderNetworkID:    int64(senderNetworkID),
+			SenderAddress:      []byte{1, 2, 3},
+			RecipientNetworkID: int64(recipientNetworkID),
+			RecipientAddress:   []byte{4, 5, 6},
+		}
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		ledger := db.ReconciliationLedger()
+		baselines := db.ReconciliationBaselines()
+
+		triggeringTx, err := db.Transactions().Create(ctx, transactions.Transaction{
+			NetworkID:   networks.IDCasper,
+			TxHash:      []byte{1},
+			Sender:      []byte{},
+			BlockNumber: 1,
+			SeenAt:      time.Now().UTC().Add(-2 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		finished := transfer(1, networks.IDCasper, networks.IDEth, transfers.StatusFinished, 10)
+		finished.OutboundTx = triggeringTx
+		confirming := transfer(3, networks.IDCasper, networks.IDEth, transfers.StatusConfirming, 7)
+		confirming.TriggeringTx = triggeringTx
+
+		tokenTransfers := []transfers.TokenTransfer{
+			finished,
+			transfer(2, networks.IDCasper, networks.IDEth, transfers.StatusFinished, 5),
+			confirming,
+			transfer(4, networks.IDEth, networks.IDCasper, transfers.StatusWaiting, 3),
+		}
+		for _, tokenTransfer := range tokenTransfers {
+			require.NoError(t, db.TokenTransfers().Create(ctx, tokenTransfer))
+		}
+
+		t.Run("RouteTotals", func(t *testing.T) {
+			routes, err := ledger.RouteTotals(ctx)
+			require.NoError(t, err)
+			require.Len(t, routes, 3)
+
+			assert.Equal(t, networks.IDCasper, routes[0].SenderNetworkID)
+			assert.Equal(t, transfers.StatusConfirming, routes[0].Status)
+			assert.EqualValues(t, 1, routes[0].Count)
+			assert.Equal(t, "7", routes[0].Amount.String())
+
+			assert.Equal(t, transfers.StatusFinished, routes[1].Status)
+			assert.EqualValues(t, 2, routes[1].Count)
+			assert.Equal(t, "15", routes[1].Amount.String())
+
+			assert.Equal(t, networks.IDEth, routes[2].SenderNetworkID)
+			assert.Equal(t, transfers.StatusWaiting, routes[2].Status)
+		})
+
+		t.Run("FinishedWithoutOutbound", func(t *testing.T) {
+			anomalies, err := ledger.FinishedWithoutOutbound(ctx)
+			require.NoError(t, err)
+			require.Len(t, anomalies, 1)
+			assert.Equal(t, transfers.ID(2), anomalies[0].TransferID)
+			assert.Equal(t, reconciliation.AnomalyFinishedWithoutOutbound, anomalies[0].Kind)
+			assert.Equal(t, "5", anomalies[0].Amount.String())
+		})
+
+		t.Run("ConfirmingSince", func(t *testing.T) {
+			anomalies, err := ledger.ConfirmingSince(ctx, time.Now().UTC().Add(-3*time.Hour))
+			require.NoError(t, err)
+			assert.Empty(t, anomalies)
+
+			anomalies, err = ledger.ConfirmingSince(ctx, time.Now().UTC().Add(-time.Hour))
+			require.NoError(t, err)
+			require.Len(t, anomalies, 1)
+			assert.Equal(t, transfers.ID(3), anomalies[0].TransferID)
+			assert.Equal(t, reconciliation.AnomalyConfirmingOverdue, anomalies[0].Kind)
+		})
+
+		t.Run("Baselines", func(t *testing.T) {
+			holding := reconciliation.Holding{NetworkID: networks.IDEth, TokenID: 1}
+
+			_, err := baselines.Get(ctx, holding)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, reconciliation.ErrNoBaseline))
+
+			err = baselines.Create(ctx, holding, big.NewInt(1030))
+			require.NoError(t, err)
+
+			// existing baseline is kept.
+			err = baselines.Create(ctx, holding, big.NewInt(1))
+			require.NoError(t, err)
+
+			baseline, err := baselines.Get(ctx, holding)
+			require.NoError(t, err)
+			assert.Equal(t, "1030", baseline.String())
+		})
+	})
+}
+
+func TestLimitsDB(t *testing.T) {
+	now := time.Now().UTC()
+	usage := limits.Usage{
+		TokenID:            1,
+		SenderNetworkID:    networks.IDCasper,
+		RecipientNetworkID: networks.IDEth,
+		SenderAddress:      []byte{1, 2, 3},
+		Amount:             big.NewInt(100),
+		At:                 now,
+	}
+
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusConfirming,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		counters := db.LimitCounters()
+		approvals := db.TransferApprovals()
+
+		t.Run("Counters", func(t *testing.T) {
+			err := counters.Add(ctx, usage)
+			require.NoError(t, err)
+
+			// transfers of the same bucket are summed up.
+			err = counters.Add(ctx, usage)
+			require.NoError(t, err)
+
+			otherAddress := usage
+			otherAddress.SenderAddress = []byte{7}
+			otherAddress.Amount = big.NewInt(50)
+			err = counters.Add(ctx, otherAddress)
+			require.NoError(t, err)
+
+			otherRoute := usage
+			otherRoute.RecipientNetworkID = networks.IDPolygon
+			otherRoute.At = now.Add(-2 * time.Hour)
+			err = counters.Add(ctx, otherRoute)
+			require.NoError(t, err)
+
+			sum, err := counters.Sum(ctx, limits.Scope{TokenID: 1}, now.Add(-3*time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, "350", sum.String())
+
+			sum, err = counters.Sum(ctx, limits.Scope{TokenID: 1}, now.Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, "250", sum.String())
+
+			sum, err = counters.Sum(ctx, limits.Scope{
+				TokenID:           1,
+				SenderNetworks:    []networks.ID{networks.IDCasper},
+				RecipientNetworks: []networks.ID{networks.IDEth},
+				SenderAddress:     usage.SenderAddress,
+			}, now.Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, "200", sum.String())
+
+			sum, err = counters.Sum(ctx, limits.Scope{TokenID: 2}, now.Add(-3*time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, "0", sum.String())
+
+			err = counters.DeleteBefore(ctx, now.Add(-time.Hour))
+			require.NoError(t, err)
+
+			sum, err = counters.Sum(ctx, limits.Scope{TokenID: 1}, now.Add(-3*time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, "250", sum.String())
+		})
+
+		t.Run("Negative Get approval", func(t *testing.T) {
+			_, err := approvals.Get(ctx, 1)
+			require.Error(t, err)
+			require.True(t, limits.ErrApprovalNotFound.Has(err))
+		})
+
+		t.Run("Approvals", func(t *testing.T) {
+			err := db.TokenTransfers().Create(ctx, tokenTransfer)
+			require.NoError(t, err)
+
+			approval := limits.Approval{
+				TransferID: 1,
+				Reason:     "amount exceeds max transfer",
+				Status:     limits.ApprovalPending,
+				CreatedAt:  now,
+			}
+			err = approvals.Create(ctx, approval)
+			require.NoError(t, err)
+
+			// already enqueued transfer is kept as is.
+			duplicate := approval
+			duplicate.Reason = "other reason"
+			err = approvals.Create(ctx, duplicate)
+			require.NoError(t, err)
+
+			approvalFromDB, err := approvals.Get(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, approval.Reason, approvalFromDB.Reason)
+			assert.Equal(t, limits.ApprovalPending, approvalFromDB.Status)
+			assert.Empty(t, approvalFromDB.Operator)
+			assert.True(t, approvalFromDB.DecidedAt.IsZero())
+
+			pending, err := approvals.List(ctx, limits.ApprovalPending)
+			require.NoError(t, err)
+			assert.Len(t, pending, 1)
+
+			approved, err := approvals.List(ctx, limits.ApprovalApproved)
+			require.NoError(t, err)
+			assert.Empty(t, approved)
+
+			err = approvals.Decide(ctx, limits.Decision{TransferID: 1, From: limits.ApprovalPending, To: limits.ApprovalApproved, Operator: "operator", DecidedAt: now})
+			require.NoError(t, err)
+
+			// approval is decided only once.
+			err = approvals.Decide(ctx, limits.Decision{TransferID: 1, From: limits.ApprovalPending, To: limits.ApprovalRejected, Operator: "other", DecidedAt: now})
+			require.Error(t, err)
+			assert.True(t, limits.ErrApprovalNotFound.Has(err))
+
+			// operator of the decision is kept when approval is executed.
+			err = approvals.Decide(ctx, limits.Decision{TransferID: 1, From: limits.ApprovalApproved, To: limits.ApprovalExecuted, DecidedAt: now})
+			require.NoError(t, err)
+
+			approvalFromDB, err = approvals.Get(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, limits.ApprovalExecuted, approvalFromDB.Status)
+			assert.Equal(t, "operator", approvalFromDB.Operator)
+			assert.False(t, approvalFromDB.DecidedAt.IsZero())
+
+			all, err := approvals.List(ctx, "")
+			require.NoError(t, err)
+			assert.Len(t, all, 1)
+		})
+	})
+}
+
+func TestPausesDB(t *testing.T) {
+	now := time.Now().UTC()
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusConfirming,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		pauses := db.Pauses()
+		backlog := db.PausedTransfers()
+
+		t.Run("Negative Resume", func(t *testing.T) {
+			err := pauses.Resume(ctx, networks.NameCasper)
+			require.Error(t, err)
+			assert.True(t, pause.ErrNotPaused.Has(err))
+		})
+
+		t.Run("Pauses", func(t *testing.T) {
+			err := pauses.Pause(ctx, pause.Pause{Reason: "incident", Operator: "operator", PausedAt: now})
+			require.NoError(t, err)
+
+			err = pauses.Pause(ctx, pause.Pause{Network: networks.NameCasper, Reason: "upgrade", Operator: "operator", PausedAt: now})
+			require.NoError(t, err)
+
+			// pause of the same network is replaced.
+			err = pauses.Pause(ctx, pause.Pause{Network: networks.NameCasper, Reason: "node outage", Operator: "other", PausedAt: now})
+			require.NoError(t, err)
+
+			list, err := pauses.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+
+			networkPause, paused := pause.Find(list, networks.NameCasper)
+			require.True(t, paused)
+			assert.Empty(t, networkPause.Network)
+
+			err = pauses.Resume(ctx, "")
+			require.NoError(t, err)
+
+			list, err = pauses.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			assert.Equal(t, networks.NameCasper, list[0].Network)
+			assert.Equal(t, "node outage", list[0].Reason)
+			assert.Equal(t, "other", list[0].Operator)
+
+			err = pauses.Resume(ctx, networks.NameCasper)
+			require.NoError(t, err)
+
+			list, err = pauses.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, list)
+		})
+
+		t.Run("Backlog", func(t *testing.T) {
+			err := db.TokenTransfers().Create(ctx, tokenTransfer)
+			require.NoError(t, err)
+
+			err = backlog.Add(ctx, 1, now)
+			require.NoError(t, err)
+
+			// already deferred transfer is kept as is.
+			err = backlog.Add(ctx, 1, now.Add(time.Minute))
+			require.NoError(t, err)
+
+			transferIDs, err := backlog.List(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, []transfers.ID{1}, transferIDs)
+
+			removed, err := backlog.Remove(ctx, 1)
+			require.NoError(t, err)
+			assert.True(t, removed)
+
+			removed, err = backlog.Remove(ctx, 1)
+			require.NoError(t, err)
+			assert.False(t, removed)
+
+			transferIDs, err = backlog.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, transferIDs)
+		})
+	})
+}
+
+func TestOutboundAttemptsDB(t *testing.T) {
+	now := time.Now().UTC()
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusConfirming,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		attempts := db.OutboundAttempts()
+
+		err := db.TokenTransfers().Create(ctx, tokenTransfer)
+		require.NoError(t, err)
+
+		first, err := attempts.Create(ctx, transfers.OutboundAttempt{TransferID: 1, NetworkID: networks.IDEth, AttemptedAt: now})
+		require.NoError(t, err)
+
+		second, err := attempts.Create(ctx, transfers.OutboundAttempt{TransferID: 1, NetworkID: networks.IDEth, AttemptedAt: now})
+		require.NoError(t, err)
+
+		err = attempts.SetTxHash(ctx, second, []byte{7, 8, 9})
+		require.NoError(t, err)
+
+		err = attempts.SetTxHash(ctx, second+1, []byte{7, 8, 9})
+		require.Error(t, err)
+
+		list, err := attempts.List(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, first, list[0].ID)
+		assert.True(t, list[0].IsUnknown())
+		assert.Equal(t, second, list[1].ID)
+		assert.Equal(t, []byte{7, 8, 9}, list[1].TxHash)
+		assert.False(t, list[1].IsUnknown())
+
+		list, err = attempts.List(ctx, 2)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
+
+func TestBridgeOutCountedOnce(t *testing.T) {
+	now := time.Now().UTC()
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(100),
+		Status:             transfers.StatusConfirming,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      make([]byte, codec.CasperHashLength),
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   make([]byte, 20),
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		limiter, err := limits.NewLimiter([]limits.Rule{{TokenID: 1, WindowInSeconds: 3600, MaxVolume: big.NewInt(1000)}}, db.LimitCounters())
+		require.NoError(t, err)
+
+		communication := mockcommunication.New()
+		service := bridge.New(
+			zaplog.NewLog(),
+			communication.Signer(),
+			db.Nonces(),
+			db.NetworkTokens(),
+			db.Tokens(),
+			db.Transactions(),
+			db.TokenTransfers(),
+			db.NetworkBlocks(),
+			db.UnmatchedEvents(),
+			bridge.NewTransferWatcher(zaplog.NewLog(), db.TransferStatusChanges()),
+			db.WebhookEndpoints(),
+			db.WebhookOutbox(),
+			limiter,
+			db.TransferApprovals(),
+			db.Pauses(),
+			db.PausedTransfers(),
+			screening.NewDenylistScreener(db.Denylist()),
+			db.ScreeningHolds(),
+			db.AuditLog(),
+			db.TransferStatusHistory(),
+			db.OutboundAttempts(),
+			db.DryRunDecisions(),
+			false,
+		)
+
+		err = db.NetworkTokens().Create(ctx, networks.NetworkToken{NetworkID: networks.IDEth, TokenID: 1, ContractAddress: make([]byte, 20), Decimals: 18})
+		require.NoError(t, err)
+
+		err = db.TokenTransfers().Create(ctx, tokenTransfer)
+		require.NoError(t, err)
+
+		err = db.TransferApprovals().Create(ctx, limits.Approval{TransferID: 1, Reason: "volume", Status: limits.ApprovalPending, CreatedAt: now})
+		require.NoError(t, err)
+
+		err = db.TransferApprovals().Decide(ctx, limits.Decision{TransferID: 1, From: limits.ApprovalPending, To: limits.ApprovalApproved, DecidedAt: now})
+		require.NoError(t, err)
+
+		volume := func() string {
+			sum, err := db.LimitCounters().Sum(ctx, limits.Scope{TokenID: 1}, now.Add(-time.Hour))
+			require.NoError(t, err)
+			return sum.String()
+		}
+
+		// bridge out fails before it is attempted, since connector is not connected yet.
+		executed, err := service.ExecuteApprovedTransfers(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, executed)
+		assert.Equal(t, "0", volume())
+
+		approval, err := db.TransferApprovals().Get(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, limits.ApprovalApproved, approval.Status)
+
+		connector := communication.Connector(ctx).(*mockcommunication.ConnectorMock)
+		connector.SetBridgeOut(func(ctx context.Context, req chains.TokenOutRequest) (chains.TokenOutResponse, error) {
+			return chains.TokenOutResponse{}, errors.New("connection reset")
+		})
+		service.AddConnector(ctx, networks.NameEth, connector)
+
+		// outcome of the attempt is unknown, so transfer stays counted and is left for operator.
+		executed, err = service.ExecuteApprovedTransfers(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, executed)
+		assert.Equal(t, "100", volume())
+
+		approval, err = db.TransferApprovals().Get(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, limits.ApprovalExecuted, approval.Status)
+
+		connector.SetBridgeOut(func(ctx context.Context, req chains.TokenOutRequest) (chains.TokenOutResponse, error) {
+			return chains.TokenOutResponse{Txhash: []byte{1, 2, 3}}, nil
+		})
+		_, err = service.Intervene(ctx, interventions.Request{
+			TransferID: 1,
+			Kind:       interventions.KindRetry,
+			Operator:   "operator",
+			Reason:     "attempt was not broadcast",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "100", volume())
+
+		// broadcast transaction is pending, so transfer is not sent once more.
+		connector.SetTransactionStatus(func(ctx context.Context, txHash []byte) (chains.TxStatus, error) {
+			return chains.TxStatusPending, nil
+		})
+		_, err = service.Intervene(ctx, interventions.Request{
+			TransferID: 1,
+			Kind:       interventions.KindRetry,
+			Operator:   "operator",
+			Reason:     "transaction is not mined",
+		})
+		require.Error(t, err)
+		assert.True(t, interventions.ErrNotAllowed.Has(err))
+		assert.Equal(t, "100", volume())
+	})
+}
+
+func TestScreeningDB(t *testing.T) {
+	now := time.Now().UTC()
+	subject := screening.Subject{NetworkID: networks.IDEth, Address: []byte{1, 2, 3}}
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusHeld,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{4, 5, 6},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   subject.Address,
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		denylist := db.Denylist()
+		holds := db.ScreeningHolds()
+
+		t.Run("Negative Get", func(t *testing.T) {
+			_, err := denylist.Get(ctx, subject)
+			require.Error(t, err)
+			assert.True(t, screening.ErrNotDenied.Has(err))
+
+			err = denylist.Remove(ctx, subject)
+			require.Error(t, err)
+			assert.True(t, screening.ErrNotDenied.Has(err))
+		})
+
+		t.Run("Denylist", func(t *testing.T) {
+			err := denylist.Add(ctx, screening.Entry{Subject: subject, Reason: "sanctioned", Operator: "operator", CreatedAt: now})
+			require.NoError(t, err)
+
+			// entry of the same address is replaced.
+			err = denylist.Add(ctx, screening.Entry{Subject: subject, Reason: "fraud", Operator: "other", CreatedAt: now})
+			require.NoError(t, err)
+
+			entry, err := denylist.Get(ctx, subject)
+			require.NoError(t, err)
+			assert.Equal(t, subject, entry.Subject)
+			assert.Equal(t, "fraud", entry.Reason)
+			assert.Equal(t, "other", entry.Operator)
+
+			// address is denied only in its network.
+			_, err = denylist.Get(ctx, screening.Subject{NetworkID: networks.IDPolygon, Address: subject.Address})
+			require.Error(t, err)
+			assert.True(t, screening.ErrNotDenied.Has(err))
+
+			entries, err := denylist.List(ctx)
+			require.NoError(t, err)
+			assert.Len(t, entries, 1)
+
+			err = denylist.Remove(ctx, subject)
+			require.NoError(t, err)
+
+			entries, err = denylist.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+
+		t.Run("Holds", func(t *testing.T) {
+			err := db.TokenTransfers().Create(ctx, tokenTransfer)
+			require.NoError(t, err)
+
+			hold := screening.Hold{
+				TransferID: 1,
+				NetworkID:  subject.NetworkID,
+				Address:    subject.Address,
+				Provider:   screening.DenylistProvider,
+				Reason:     "sanctioned",
+				CreatedAt:  now,
+			}
+			err = holds.Create(ctx, hold)
+			require.NoError(t, err)
+
+			// already held transfer is kept as is.
+			duplicate := hold
+			duplicate.Reason = "other reason"
+			err = holds.Create(ctx, duplicate)
+			require.NoError(t, err)
+
+			list, err := holds.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			assert.Equal(t, hold.Reason, list[0].Reason)
+			assert.Equal(t, hold.Address, list[0].Address)
+			assert.Equal(t, screening.DenylistProvider, list[0].Provider)
+
+			transferFromDB, err := db.TokenTransfers().Get(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, transfers.StatusHeld, transferFromDB.Status)
+		})
+	})
+}
+
+func TestAuditLogDB(t *testing.T) {
+	now := time.Now().UTC()
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 1,
+		TokenID:            1,
+		Amount:             *new(big.Int).SetInt64(1),
+		Status:             transfers.StatusConfirming,
+		SenderNetworkID:    int64(networks.IDCasper),
+		SenderAddress:      []byte{1, 2, 3},
+		RecipientNetworkID: int64(networks.IDEth),
+		RecipientAddress:   []byte{4, 5, 6},
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		auditLog := db.AuditLog()
+
+		t.Run("Empty List", func(t *testing.T) {
+			entries, err := auditLog.List(ctx, 1)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+
+		t.Run("Append", func(t *testing.T) {
+			err := db.TokenTransfers().Create(ctx, tokenTransfer)
+			require.NoError(t, err)
+
+			state := interventions.StateOf(tokenTransfer)
+			id, err := auditLog.Append(ctx, interventions.Entry{
+				TransferID: 1,
+				Kind:       interventions.KindAnnotate,
+				Operator:   "operator",
+				Reason:     "support ticket",
+				Change:     "recipient asked about delay",
+				Before:     state,
+				After:      state,
+				CreatedAt:  now,
+			})
+			require.NoError(t, err)
+			assert.NotZero(t, id)
+		})
+
+		t.Run("Apply", func(t *testing.T) {
+			entry := interventions.Entry{
+				TransferID: 1,
+				Kind:       interventions.KindAttachOutboundTx,
+				Operator:   "operator",
+				Reason:     "sent manually",
+				Change:     "outbound transaction is attached",
+				Before:     interventions.State{Status: transfers.StatusConfirming},
+				After:      interventions.State{Status: transfers.StatusFinished, OutboundTx: 7},
+				CreatedAt:  now,
+			}
+			_, err := auditLog.Apply(ctx, entry)
+			require.NoError(t, err)
+
+			transferFromDB, err := db.TokenTransfers().Get(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, transfers.StatusFinished, transferFromDB.Status)
+			assert.EqualValues(t, 7, transferFromDB.OutboundTx)
+
+			// transfer is not in the before state anymore, so neither transfer nor audit log is changed.
+			_, err = auditLog.Apply(ctx, entry)
+			require.Error(t, err)
+			assert.True(t, interventions.ErrNotAllowed.Has(err))
+
+			entries, err := auditLog.List(ctx, 1)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+			assert.Equal(t, interventions.KindAnnotate, entries[0].Kind)
+			assert.Equal(t, entry.Kind, entries[1].Kind)
+			assert.Equal(t, entry.Operator, entries[1].Operator)
+			assert.Equal(t, entry.Reason, entries[1].Reason)
+			assert.Equal(t, entry.Before, entries[1].Before)
+			assert.Equal(t, entry.After, entries[1].After)
+			assert.WithinDuration(t, now, entries[1].CreatedAt, time.Second)
+		})
+
+		t.Run("Apply release", func(t *testing.T) {
+			heldTransfer := tokenTransfer
+			heldTransfer.ID, heldTransfer.Status = 2, transfers.StatusHeld
+			err := db.TokenTransfers().Create(ctx, heldTransfer)
+			require.NoError(t, err)
+
+			_, err = auditLog.Apply(ctx, interventions.Entry{
+				TransferID: 2,
+				Kind:       interventions.KindRelease,
+				Operator:   "operator",
+				Reason:     "false positive",
+				Change:     "status is changed from HELD to CONFIRMING",
+				Before:     interventions.State{Status: transfers.StatusHeld},
+				After:      interventions.State{Status: transfers.StatusConfirming},
+				CreatedAt:  now,
+			})
+			require.NoError(t, err)
+
+			history, err := db.TransferStatusHistory().List(ctx, 2)
+			require.NoError(t, err)
+			require.NotEmpty(t, history)
+			last := history[len(history)-1]
+			assert.Equal(t, transfers.StatusHeld, last.From)
+			assert.Equal(t, transfers.StatusConfirming, last.To)
+			assert.Equal(t, transfers.CauseRelease, last.Cause)
+		})
+	})
+}
+
+func TestDryRunDB(t *testing.T) {
+	now := time.Now().UTC()
+	matched := dryrun.Decision{
+		Event:      dryrun.Event{NetworkID: networks.IDCasper, TxHash: []byte{1, 2, 3}, LogIndex: 1, BlockNumber: 10},
+		TransferID: 1,
+		Action:     dryrun.ActionBridgeOut,
+		BridgeOut: dryrun.BridgeOut{
+			RecipientNetworkID: networks.IDEth,
+			RecipientAddress:   []byte{7, 8, 9},
+			Amount:             big.NewInt(100),
+			Token:              []byte{10, 11},
+		},
+		DecidedAt: now,
+	}
+	unmatched := dryrun.Decision{
+		Event:     dryrun.Event{NetworkID: networks.IDCasper, TxHash: []byte{4, 5, 6}, LogIndex: 2, BlockNumber: 11},
+		Action:    dryrun.ActionUnmatched,
+		Reason:    "no transfer with such nonce",
+		DecidedAt: now,
+	}
+
+	dbtesting.Run(t, func(ctx context.Context, t *testing.T, db bridge.DB) {
+		decisions := db.DryRunDecisions()
+		outcomes := db.DryRunOutcomes()
+
+		t.Run("Decisions", func(t *testing.T) {
+			err := decisions.Create(ctx, matched)
+			require.NoError(t, err)
+
+			err = decisions.Create(ctx, unmatched)
+			require.NoError(t, err)
+
+			// decision on already recorded event is ignored.
+			duplicate := matched
+			duplicate.Action = dryrun.ActionHold
+			err = decisions.Create(ctx, duplicate)
+			require.NoError(t, err)
+
+			list, err := decisions.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+			assert.Equal(t, matched.Event, list[0].Event)
+			assert.Equal(t, matched.TransferID, list[0].TransferID)
+			assert.Equal(t, matched.Action, list[0].Action)
+			assert.Equal(t, matched.BridgeOut, list[0].BridgeOut)
+			assert.WithinDuration(t, now, list[0].DecidedAt, time.Second)
+			assert.Equal(t, unmatched.Action, list[1].Action)
+			assert.Equal(t, unmatched.Reason, list[1].Reason)
+			assert.Nil(t, list[1].BridgeOut.Amount)
+		})
+
+		t.Run("Outcomes", func(t *testing.T) {
+			// live bridge has not processed events yet.
+			outcome, err := outcomes.Get(ctx, matched.Event)
+			require.NoError(t, err)
+			assert.Empty(t, outcome.Action)
+
+			for i, decision := range []dryrun.Decision{matched, unmatched} {
+				_, err = db.Transactions().Create(ctx, transactions.Transaction{
+					NetworkID:   decision.Event.NetworkID,
+					TxHash:      decision.Event.TxHash,
+					Sender:      []byte{1},
+					BlockNumber: int64(decision.Event.BlockNumber),
+					SeenAt:      now,
+					LogIndex:    int64(decision.Event.LogIndex),
+				})
+				require.NoError(t, err, i)
+			}
+
+			outcome, err = outcomes.Get(ctx, unmatched.Event)
+			require.NoError(t, err)
+			assert.Equal(t, dryrun.ActionUnmatched, outcome.Action)
+
+			transaction, err := db.Transactions().GetByEvent(ctx, matched.Event.NetworkID, matched.Event.TxHash, int64(matched.Event.LogIndex))
+			require.NoError(t, err)
+
+			err = db.TokenTransfers().Create(ctx, transfers.TokenTransfer{
+				TriggeringTx:       transaction.ID,
+				TokenID:            1,
+				Amount:             *big.NewInt(100),
+				Status:             transfers.StatusConfirming,
+				SenderNetworkID:    int64(networks.IDCasper),
+				SenderAddress:      []byte{4, 5, 6},
+				RecipientNetworkID: int64(networks.IDEth),
+				RecipientAddress:   []byte{7, 8, 9},
+			})
+			require.NoError(t, err)
+
+			outcome, err = outcomes.Get(ctx, matched.Event)
+			require.NoError(t, err)
+			assert.Equal(t, dryrun.ActionBridgeOut, outcome.Action)
+			assert.Equal(t, matched.TransferID, outcome.TransferID)
+			assert.Empty(t, dryrun.Compare(matched, outcome).Divergence)
+
+			err = db.ScreeningHolds().Create(ctx, screening.Hold{
+				TransferID: 1,
+				NetworkID:  networks.IDEth,
+				Address:    []byte{7, 8, 9},
+				Provider:   screening.DenylistProvider,
+				Reason:     "sanctioned",
+				CreatedAt:  now,
+			})
+			require.NoError(t, err)
+
+			outcome, err = outcomes.Get(ctx, matched.Event)
+			require.NoError(t, err)
+			assert.Equal(t, dryrun.ActionHold, outcome.Action)
+		})
+
+		t.Run("Report", func(t *testing.T) {
+			report, err := dryrun.NewReport(ctx, decisions, outcomes)
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.Decisions)
+			assert.Equal(t, 1, report.Agreed)
+			assert.Equal(t, 0, report.Pending)
+			require.Len(t, report.Divergences, 1)
+			assert.Equal(t, dryrun.ActionHold, report.Divergences[0].Live.Action)
+		})
 	})
 }
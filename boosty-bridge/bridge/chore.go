@@ -43,19 +43,20 @@ func (chore *chore) eventsReading(ctx context.Context, networkName networks.Name
 	subscriber := connector.AddEventSubscriber()
 
 	group.Go(func() error {
-		fromBlock, err := chore.networkBlocks.Get(ctx, networks.NetworkNameToID[networkName])
-		if err != nil && !errors.Is(err, ErrNoNetworkBlock) {
+		networkID, ok := networks.IDByName(networkName)
+		if !ok {
+			err := fmt.Errorf("no network with such name %v", networkName)
 			chore.log.Error("", Error.Wrap(err))
 			return nil
 		}
-		if errors.Is(err, ErrNoNetworkBlock) {
-			networkID, ok := networks.NetworkNameToID[networkName]
-			if !ok {
-				err = fmt.Errorf("no network with such name %v", networkName)
-				chore.log.Error("", Error.Wrap(err))
-				return nil
-			}
 
+		fromBlock, err := chore.networkBlocks.Get(ctx, networkID)
+		if err != nil && !errors.Is(err, ErrNoNetworkBlock) {
+			chore.log.Error("", Error.Wrap(err))
+			return nil
+		}
+		// bridge in dry run mode follows events from the last block seen by the live bridge, but never moves it.
+		if errors.Is(err, ErrNoNetworkBlock) && !chore.service.dryRun {
 			err = chore.service.networkBlocks.Create(ctx, networks.NetworkBlock{
 				NetworkID:     networkID,
 				LastSeenBlock: 0,
@@ -102,7 +103,11 @@ func (chore *chore) receiveEvents(ctx context.Context, subscriber EventSubscribe
 				return status.Error(codes.Internal, Error.Wrap(err).Error())
 			}
 
-			networkID, ok := networks.NetworkNameToID[networkName]
+			if chore.service.dryRun {
+				continue
+			}
+
+			networkID, ok := networks.IDByName(networkName)
 			if !ok {
 				err := Error.New("network %v is not connected", networkName)
 				chore.log.Error("", err)
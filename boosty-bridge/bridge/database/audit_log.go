@@ -0,0 +1,149 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/transfers"
+)
+
+// ensures that auditLogDB implements interventions.AuditLog.
+var _ interventions.AuditLog = (*auditLogDB)(nil)
+
+// ErrAuditLog indicates that there was an error in the database.
+var ErrAuditLog = errs.Class("audit log repository")
+
+// auditLogDB provides access to the append-only audit log of operator interventions.
+//
+// architecture: Database
+type auditLogDB struct {
+	conn *sql.DB
+}
+
+// Append appends entry, which does not change state of the transfer, to the audit log and returns its id.
+func (auditLogDB *auditLogDB) Append(ctx context.Context, entry interventions.Entry) (interventions.EntryID, error) {
+	id, err := appendAuditEntry(ctx, auditLogDB.conn, entry)
+	return id, ErrAuditLog.Wrap(err)
+}
+
+// Apply changes state of the transfer from the entry before state to its after state, records status transition and
+// appends entry to the audit log in one database transaction. ErrNotAllowed is returned if status transition is not
+// allowed or transfer is not in the before state anymore.
+func (auditLogDB *auditLogDB) Apply(ctx context.Context, entry interventions.Entry) (_ interventions.EntryID, err error) {
+	transition := transfers.StatusTransition{
+		From:      entry.Before.Status,
+		To:        entry.After.Status,
+		Cause:     entry.Kind.Cause(),
+		ChangedAt: entry.CreatedAt,
+	}
+	if err = transition.Validate(); err != nil {
+		return 0, interventions.ErrNotAllowed.Wrap(err)
+	}
+
+	tx, err := auditLogDB.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, ErrAuditLog.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrAuditLog.Wrap(tx.Rollback()))
+		}
+	}()
+
+	query := `UPDATE token_transfers SET status = $1, outbound_tx = $2
+        WHERE id = $3 AND status = $4 AND COALESCE(outbound_tx, 0) = $5`
+	result, err := tx.ExecContext(ctx, query, entry.After.Status, entry.After.OutboundTx, entry.TransferID,
+		entry.Before.Status, entry.Before.OutboundTx)
+	if err != nil {
+		return 0, ErrAuditLog.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, ErrAuditLog.Wrap(err)
+	}
+	if rowsAffected == 0 {
+		return 0, interventions.ErrNotAllowed.New("transfer %d is not %s anymore", entry.TransferID, entry.Before.Status)
+	}
+
+	if err = recordStatusTransition(ctx, tx, entry.TransferID, transition); err != nil {
+		return 0, ErrAuditLog.Wrap(err)
+	}
+
+	id, err := appendAuditEntry(ctx, tx, entry)
+	if err != nil {
+		return 0, ErrAuditLog.Wrap(err)
+	}
+
+	return id, ErrAuditLog.Wrap(tx.Commit())
+}
+
+// List returns audit log of the transfer from the oldest entry to the newest one.
+func (auditLogDB *auditLogDB) List(ctx context.Context, transferID transfers.ID) (_ []interventions.Entry, err error) {
+	query := `SELECT id, transfer_id, kind, operator, reason, change, state_before, state_after, created_at FROM audit_log
+        WHERE transfer_id = $1
+        ORDER BY id`
+
+	rows, err := auditLogDB.conn.QueryContext(ctx, query, transferID)
+	if err != nil {
+		return nil, ErrAuditLog.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	entries := make([]interventions.Entry, 0)
+	for rows.Next() {
+		var (
+			entry         interventions.Entry
+			before, after []byte
+		)
+		err = rows.Scan(&entry.ID, &entry.TransferID, &entry.Kind, &entry.Operator, &entry.Reason, &entry.Change,
+			&before, &after, &entry.CreatedAt)
+		if err != nil {
+			return nil, ErrAuditLog.Wrap(err)
+		}
+
+		if err = json.Unmarshal(before, &entry.Before); err != nil {
+			return nil, ErrAuditLog.Wrap(err)
+		}
+		if err = json.Unmarshal(after, &entry.After); err != nil {
+			return nil, ErrAuditLog.Wrap(err)
+		}
+
+		entry.CreatedAt = entry.CreatedAt.UTC()
+		entries = append(entries, entry)
+	}
+
+	return entries, ErrAuditLog.Wrap(rows.Err())
+}
+
+// appendAuditEntry inserts entry to the audit log through connection or transaction.
+func appendAuditEntry(ctx context.Context, conn interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, entry interventions.Entry) (interventions.EntryID, error) {
+	before, err := json.Marshal(entry.Before)
+	if err != nil {
+		return 0, err
+	}
+
+	after, err := json.Marshal(entry.After)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `INSERT INTO audit_log(transfer_id, kind, operator, reason, change, state_before, state_after, created_at)
+        VALUES($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+
+	var id interventions.EntryID
+	err = conn.QueryRowContext(ctx, query, entry.TransferID, entry.Kind, entry.Operator, entry.Reason, entry.Change,
+		before, after, entry.CreatedAt).Scan(&id)
+	return id, err
+}
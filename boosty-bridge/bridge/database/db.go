@@ -7,13 +7,20 @@ import (
 	"context"
 	"database/sql"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/zeebo/errs"
 
 	"tricorn/bridge"
+	"tricorn/bridge/dryrun"
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/limits"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/reconciliation"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/transactions"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 )
 
 // ensures that database implements bridge.DB.
@@ -29,7 +36,8 @@ var (
 //
 // architecture: Master Database
 type database struct {
-	conn *sql.DB
+	conn        *sql.DB
+	databaseURL string
 }
 
 // New returns bridge.DB postgresql implementation.
@@ -39,10 +47,12 @@ func New(databaseURL string) (bridge.DB, error) {
 		return nil, Error.Wrap(err)
 	}
 
-	return &database{conn: conn}, nil
+	return &database{conn: conn, databaseURL: databaseURL}, nil
 }
 
-// CreateSchema create schema for all tables and databases.
+// CreateSchema create schema for all tables and databases. Creation time of transfers, which were created before it
+// was recorded, is backfilled from their triggering transactions. Casper account and contract hashes, which used to be
+// stored with the tag of the key, are migrated to the untagged form once.
 func (db *database) CreateSchema(ctx context.Context) error {
 	createTableQuery :=
 		`CREATE TABLE IF NOT EXISTS network_blocks (
@@ -70,8 +80,75 @@ func (db *database) CreateSchema(ctx context.Context) error {
             sender_network_id    INTEGER               NOT NULL,
             sender_address       BYTEA                 NOT NULL,
             recipient_network_id INTEGER               NOT NULL,
-            recipient_address    BYTEA                 NOT NULL
+            recipient_address    BYTEA                 NOT NULL,
+            nonce                BIGINT,
+            deadline             TIMESTAMP WITH TIME ZONE,
+            created_at           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
         );
+        ALTER TABLE token_transfers ADD COLUMN IF NOT EXISTS nonce BIGINT;
+        ALTER TABLE token_transfers ADD COLUMN IF NOT EXISTS deadline TIMESTAMP WITH TIME ZONE;
+        DO $$
+        BEGIN
+            IF NOT EXISTS (SELECT 1 FROM information_schema.columns
+                WHERE table_schema = current_schema() AND table_name = 'token_transfers' AND column_name = 'created_at') THEN
+                ALTER TABLE token_transfers ADD COLUMN created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
+                UPDATE token_transfers SET created_at = transactions.seen_at FROM transactions
+                    WHERE token_transfers.triggering_tx = transactions.id;
+            END IF;
+        END;
+        $$;
+        CREATE INDEX IF NOT EXISTS token_transfers_status_deadline_idx ON token_transfers(status, deadline);
+        CREATE UNIQUE INDEX IF NOT EXISTS token_transfers_sender_network_id_nonce_idx ON token_transfers(sender_network_id, nonce);
+        CREATE INDEX IF NOT EXISTS token_transfers_triggering_tx_idx ON token_transfers(triggering_tx);
+        CREATE INDEX IF NOT EXISTS token_transfers_sender_id_idx ON token_transfers(sender_network_id, sender_address, id);
+        CREATE INDEX IF NOT EXISTS token_transfers_recipient_id_idx ON token_transfers(recipient_network_id, recipient_address, id);
+        CREATE INDEX IF NOT EXISTS token_transfers_sender_created_at_idx ON token_transfers(sender_network_id, sender_address, created_at, id);
+        CREATE INDEX IF NOT EXISTS token_transfers_recipient_created_at_idx ON token_transfers(recipient_network_id, recipient_address, created_at, id);
+        CREATE OR REPLACE FUNCTION notify_token_transfer_status() RETURNS TRIGGER AS $$
+        BEGIN
+            IF TG_OP = 'INSERT' OR NEW.status IS DISTINCT FROM OLD.status THEN
+                PERFORM pg_notify('token_transfer_status', NEW.id::TEXT);
+            END IF;
+            RETURN NEW;
+        END;
+        $$ LANGUAGE plpgsql;
+        DROP TRIGGER IF EXISTS token_transfers_status_notify ON token_transfers;
+        CREATE TRIGGER token_transfers_status_notify AFTER INSERT OR UPDATE OF status ON token_transfers
+            FOR EACH ROW EXECUTE PROCEDURE notify_token_transfer_status();
+        CREATE OR REPLACE FUNCTION enqueue_token_transfer_webhooks() RETURNS TRIGGER AS $$
+        DECLARE
+            old_status VARCHAR := '';
+            snapshot   JSONB;
+        BEGIN
+            IF TG_OP = 'UPDATE' THEN
+                IF NEW.status IS NOT DISTINCT FROM OLD.status THEN
+                    RETURN NEW;
+                END IF;
+                old_status := OLD.status;
+            END IF;
+            snapshot := jsonb_build_object(
+                'tokenId', NEW.token_id,
+                'amount', encode(NEW.amount, 'hex'),
+                'status', NEW.status,
+                'senderNetworkId', NEW.sender_network_id,
+                'senderAddress', encode(NEW.sender_address, 'hex'),
+                'recipientNetworkId', NEW.recipient_network_id,
+                'recipientAddress', encode(NEW.recipient_address, 'hex'),
+                'createdAt', NEW.created_at,
+                'triggeringTx', (SELECT jsonb_build_object('networkId', network_id, 'txHash', encode(tx_hash, 'hex'), 'seenAt', seen_at)
+                    FROM transactions WHERE id = NEW.triggering_tx),
+                'outboundTx', (SELECT jsonb_build_object('networkId', network_id, 'txHash', encode(tx_hash, 'hex'), 'seenAt', seen_at)
+                    FROM transactions WHERE id = NEW.outbound_tx)
+            );
+            INSERT INTO webhook_outbox(endpoint_id, transfer_id, status, previous_status, transfer)
+                SELECT id, NEW.id, NEW.status, old_status, snapshot FROM webhook_endpoints
+                WHERE cardinality(statuses) = 0 OR NEW.status = ANY(statuses);
+            RETURN NEW;
+        END;
+        $$ LANGUAGE plpgsql;
+        DROP TRIGGER IF EXISTS token_transfers_webhooks_enqueue ON token_transfers;
+        CREATE TRIGGER token_transfers_webhooks_enqueue AFTER INSERT OR UPDATE OF status ON token_transfers
+            FOR EACH ROW EXECUTE PROCEDURE enqueue_token_transfer_webhooks();
         CREATE TABLE IF NOT EXISTS tokens (
             id         SERIAL  PRIMARY KEY NOT NULL,
             short_name VARCHAR             NOT NULL,
@@ -83,11 +160,236 @@ func (db *database) CreateSchema(ctx context.Context) error {
             tx_hash      BYTEA                    NOT NULL,
             sender       BYTEA                    NOT NULL,
             block_number INTEGER                  NOT NULL,
-            seen_at      TIMESTAMP WITH TIME ZONE NOT NULL
+            seen_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+            log_index    BIGINT                   NOT NULL DEFAULT 0
+        );
+        ALTER TABLE transactions ADD COLUMN IF NOT EXISTS log_index BIGINT NOT NULL DEFAULT 0;
+        CREATE UNIQUE INDEX IF NOT EXISTS transactions_network_id_tx_hash_log_index_idx ON transactions(network_id, tx_hash, log_index);
+        CREATE TABLE IF NOT EXISTS unmatched_events (
+            id          BIGSERIAL PRIMARY KEY    NOT NULL,
+            kind        VARCHAR                  NOT NULL,
+            network_id  INTEGER                  NOT NULL,
+            tx_hash     BYTEA                    NOT NULL,
+            reference   BIGINT                   NOT NULL,
+            amount      BYTEA                    NOT NULL,
+            reason      VARCHAR                  NOT NULL,
+            created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+            resolved_at TIMESTAMP WITH TIME ZONE
+        );
+        CREATE TABLE IF NOT EXISTS networks (
+            id         INTEGER PRIMARY KEY NOT NULL,
+            name       VARCHAR UNIQUE      NOT NULL,
+            type       VARCHAR             NOT NULL,
+            is_testnet BOOLEAN             NOT NULL,
+            chain_id   BIGINT              NOT NULL DEFAULT 0,
+            codec      VARCHAR             NOT NULL DEFAULT ''
+        );
+        CREATE TABLE IF NOT EXISTS webhook_endpoints (
+            id         BIGSERIAL PRIMARY KEY    NOT NULL,
+            owner      VARCHAR                  NOT NULL,
+            url        VARCHAR                  NOT NULL,
+            secret     VARCHAR                  NOT NULL,
+            statuses   VARCHAR[]                NOT NULL DEFAULT '{}',
+            created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+        );
+        CREATE INDEX IF NOT EXISTS webhook_endpoints_owner_idx ON webhook_endpoints(owner);
+        CREATE TABLE IF NOT EXISTS webhook_outbox (
+            id              BIGSERIAL PRIMARY KEY    NOT NULL,
+            endpoint_id     BIGINT                   NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+            transfer_id     BIGINT                   NOT NULL,
+            status          VARCHAR                  NOT NULL,
+            previous_status VARCHAR                  NOT NULL DEFAULT '',
+            state           VARCHAR                  NOT NULL DEFAULT 'PENDING',
+            attempts        INTEGER                  NOT NULL DEFAULT 0,
+            next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+            last_error      VARCHAR                  NOT NULL DEFAULT '',
+            created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+            delivered_at    TIMESTAMP WITH TIME ZONE,
+            transfer        JSONB
+        );
+        ALTER TABLE webhook_outbox ADD COLUMN IF NOT EXISTS transfer JSONB;
+        CREATE INDEX IF NOT EXISTS webhook_outbox_state_next_attempt_at_idx ON webhook_outbox(state, next_attempt_at);
+        CREATE INDEX IF NOT EXISTS webhook_outbox_endpoint_id_transfer_id_idx ON webhook_outbox(endpoint_id, transfer_id, id);
+        CREATE INDEX IF NOT EXISTS webhook_outbox_endpoint_id_idx ON webhook_outbox(endpoint_id, id);
+        CREATE TABLE IF NOT EXISTS reconciliation_baselines (
+            network_id INTEGER                  NOT NULL,
+            token_id   INTEGER                  NOT NULL,
+            amount     NUMERIC(78, 0)           NOT NULL,
+            created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+            PRIMARY KEY(network_id, token_id)
+        );
+        CREATE TABLE IF NOT EXISTS limit_counters (
+            token_id             INTEGER                  NOT NULL,
+            sender_network_id    INTEGER                  NOT NULL,
+            recipient_network_id INTEGER                  NOT NULL,
+            sender_address       BYTEA                    NOT NULL,
+            bucket               TIMESTAMP WITH TIME ZONE NOT NULL,
+            amount               NUMERIC(78, 0)           NOT NULL,
+            PRIMARY KEY(token_id, sender_network_id, recipient_network_id, sender_address, bucket)
+        );
+        CREATE INDEX IF NOT EXISTS limit_counters_bucket_idx ON limit_counters(bucket);
+        CREATE TABLE IF NOT EXISTS transfer_approvals (
+            transfer_id BIGINT PRIMARY KEY       NOT NULL REFERENCES token_transfers(id) ON DELETE CASCADE,
+            reason      VARCHAR                  NOT NULL,
+            status      VARCHAR                  NOT NULL,
+            operator    VARCHAR,
+            created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+            decided_at  TIMESTAMP WITH TIME ZONE
+        );
+        CREATE INDEX IF NOT EXISTS transfer_approvals_status_idx ON transfer_approvals(status, created_at);
+        CREATE TABLE IF NOT EXISTS bridge_pauses (
+            network   VARCHAR PRIMARY KEY      NOT NULL,
+            reason    VARCHAR                  NOT NULL,
+            operator  VARCHAR                  NOT NULL,
+            paused_at TIMESTAMP WITH TIME ZONE NOT NULL
+        );
+        CREATE TABLE IF NOT EXISTS paused_transfers (
+            transfer_id BIGINT PRIMARY KEY       NOT NULL REFERENCES token_transfers(id) ON DELETE CASCADE,
+            deferred_at TIMESTAMP WITH TIME ZONE NOT NULL
+        );
+        CREATE TABLE IF NOT EXISTS outbound_attempts (
+            id           BIGSERIAL PRIMARY KEY    NOT NULL,
+            transfer_id  BIGINT                   NOT NULL REFERENCES token_transfers(id) ON DELETE CASCADE,
+            network_id   INTEGER                  NOT NULL,
+            tx_hash      BYTEA,
+            attempted_at TIMESTAMP WITH TIME ZONE NOT NULL
+        );
+        CREATE INDEX IF NOT EXISTS outbound_attempts_transfer_id_idx ON outbound_attempts(transfer_id, id);
+        CREATE TABLE IF NOT EXISTS screening_denylist (
+            network_id INTEGER                  NOT NULL,
+            address    BYTEA                    NOT NULL,
+            reason     VARCHAR                  NOT NULL,
+            operator   VARCHAR                  NOT NULL,
+            created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+            PRIMARY KEY (network_id, address)
+        );
+        CREATE TABLE IF NOT EXISTS screening_holds (
+            transfer_id BIGINT PRIMARY KEY       NOT NULL REFERENCES token_transfers(id) ON DELETE CASCADE,
+            network_id  INTEGER                  NOT NULL,
+            address     BYTEA                    NOT NULL,
+            provider    VARCHAR                  NOT NULL,
+            reason      VARCHAR                  NOT NULL,
+            created_at  TIMESTAMP WITH TIME ZONE NOT NULL
+        );
+        CREATE TABLE IF NOT EXISTS transfer_status_history (
+            id          BIGSERIAL PRIMARY KEY    NOT NULL,
+            transfer_id BIGINT                   NOT NULL,
+            from_status VARCHAR                  NOT NULL DEFAULT '',
+            to_status   VARCHAR                  NOT NULL,
+            cause       VARCHAR                  NOT NULL,
+            changed_at  TIMESTAMP WITH TIME ZONE NOT NULL
+        );
+        CREATE INDEX IF NOT EXISTS transfer_status_history_transfer_id_idx ON transfer_status_history(transfer_id, id);
+        CREATE TABLE IF NOT EXISTS dry_run_decisions (
+            id                   BIGSERIAL PRIMARY KEY    NOT NULL,
+            network_id           INTEGER                  NOT NULL,
+            tx_hash              BYTEA                    NOT NULL,
+            log_index            BIGINT                   NOT NULL,
+            block_number         BIGINT                   NOT NULL,
+            transfer_id          BIGINT                   NOT NULL DEFAULT 0,
+            action               VARCHAR                  NOT NULL,
+            reason               VARCHAR                  NOT NULL DEFAULT '',
+            recipient_network_id INTEGER                  NOT NULL DEFAULT 0,
+            recipient_address    BYTEA,
+            amount               NUMERIC(78, 0),
+            token                BYTEA,
+            decided_at           TIMESTAMP WITH TIME ZONE NOT NULL
+        );
+        CREATE UNIQUE INDEX IF NOT EXISTS dry_run_decisions_network_id_tx_hash_log_index_idx ON dry_run_decisions(network_id, tx_hash, log_index);
+        CREATE TABLE IF NOT EXISTS audit_log (
+            id           BIGSERIAL PRIMARY KEY    NOT NULL,
+            transfer_id  BIGINT                   NOT NULL,
+            kind         VARCHAR                  NOT NULL,
+            operator     VARCHAR                  NOT NULL,
+            reason       VARCHAR                  NOT NULL,
+            change       VARCHAR                  NOT NULL,
+            state_before JSONB                    NOT NULL,
+            state_after  JSONB                    NOT NULL,
+            created_at   TIMESTAMP WITH TIME ZONE NOT NULL
+        );
+        CREATE INDEX IF NOT EXISTS audit_log_transfer_id_idx ON audit_log(transfer_id, id);
+        CREATE OR REPLACE FUNCTION reject_audit_log_change() RETURNS TRIGGER AS $$
+        BEGIN
+            RAISE EXCEPTION 'audit_log is append-only';
+        END;
+        $$ LANGUAGE plpgsql;
+        DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+        CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+            FOR EACH ROW EXECUTE PROCEDURE reject_audit_log_change();
+        DROP TRIGGER IF EXISTS audit_log_no_truncate ON audit_log;
+        CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON audit_log
+            FOR EACH STATEMENT EXECUTE PROCEDURE reject_audit_log_change();
+        CREATE TABLE IF NOT EXISTS schema_migrations (
+            version    INTEGER PRIMARY KEY      NOT NULL,
+            applied_at TIMESTAMP WITH TIME ZONE NOT NULL
         );`
 
 	_, err := db.conn.ExecContext(ctx, createTableQuery)
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return db.migrate(ctx, migrationUntagCasperAddresses, db.untagCasperAddresses)
+}
+
+const (
+	// migrationUntagCasperAddresses is a version of the migration, which strips tags from casper addresses.
+	migrationUntagCasperAddresses = 1
+)
+
+// migrate applies migration of the version once, it is recorded to schema_migrations in the same transaction.
+func (db *database) migrate(ctx context.Context, version int, migration func(ctx context.Context, tx *sql.Tx) error) (err error) {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, Error.Wrap(tx.Rollback()))
+			return
+		}
+		err = Error.Wrap(tx.Commit())
+	}()
+
+	query := "INSERT INTO schema_migrations(version, applied_at) VALUES ($1, now()) ON CONFLICT (version) DO NOTHING"
+	result, err := tx.ExecContext(ctx, query, version)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if rowsAffected == 0 {
+		return nil
+	}
+
+	return Error.Wrap(migration(ctx, tx))
+}
+
+// untagCasperAddresses strips the tag of the key from casper account and contract hashes. Casper networks are
+// selected by type, both from the networks registry and from the networks table.
+func (db *database) untagCasperAddresses(ctx context.Context, tx *sql.Tx) error {
+	var casperIDs []int64
+	for _, definition := range networks.List() {
+		if definition.Type == networks.TypeCasper {
+			casperIDs = append(casperIDs, int64(definition.ID))
+		}
+	}
+
+	query := `UPDATE token_transfers SET sender_address = substring(sender_address FROM 2)
+        WHERE (sender_network_id = ANY($1) OR sender_network_id IN (SELECT id FROM networks WHERE type = $2))
+            AND length(sender_address) = 33 AND get_byte(sender_address, 0) = 0`
+	if _, err := tx.ExecContext(ctx, query, pq.Array(casperIDs), networks.TypeCasper); err != nil {
+		return err
+	}
+
+	query = `UPDATE network_tokens SET contract_key = substring(contract_key FROM 2)
+        WHERE (network_id = ANY($1) OR network_id IN (SELECT id FROM networks WHERE type = $2))
+            AND length(contract_key) = 33 AND get_byte(contract_key, 0) IN (0, 1)`
+	_, err := tx.ExecContext(ctx, query, pq.Array(casperIDs), networks.TypeCasper)
+	return err
 }
 
 // Close closes underlying db connection.
@@ -95,6 +397,11 @@ func (db *database) Close() error {
 	return Error.Wrap(db.conn.Close())
 }
 
+// Networks provides access to network definitions db.
+func (db *database) Networks() networks.Definitions {
+	return &networksDB{conn: db.conn}
+}
+
 // NetworkBlocks provides access to accounts db.
 func (db *database) NetworkBlocks() networks.NetworkBlocks {
 	return &networkBlocksDB{conn: db.conn}
@@ -115,11 +422,96 @@ func (db *database) TokenTransfers() transfers.TokenTransfers {
 	return &tokenTransfersDB{conn: db.conn}
 }
 
+// TransferStatusChanges provides notifications about changes of token transfers.
+func (db *database) TransferStatusChanges() transfers.StatusChanges {
+	return &transferStatusChangesDB{databaseURL: db.databaseURL}
+}
+
+// TransferStatusHistory provides access to the recorded status transitions of token transfers.
+func (db *database) TransferStatusHistory() transfers.StatusHistory {
+	return &transferStatusHistoryDB{conn: db.conn}
+}
+
+// WebhookEndpoints provides access to webhook endpoints db.
+func (db *database) WebhookEndpoints() webhooks.Endpoints {
+	return &webhookEndpointsDB{conn: db.conn}
+}
+
+// WebhookOutbox provides access to webhook deliveries db.
+func (db *database) WebhookOutbox() webhooks.Outbox {
+	return &webhookOutboxDB{conn: db.conn}
+}
+
+// DryRunDecisions provides access to decisions of the bridge in dry run mode db.
+func (db *database) DryRunDecisions() dryrun.Decisions {
+	return &dryRunDecisionsDB{conn: db.conn}
+}
+
+// DryRunOutcomes provides access to outcomes of the events in the live bridge to compare dry run with.
+func (db *database) DryRunOutcomes() dryrun.Outcomes {
+	return &dryRunOutcomesDB{conn: db.conn}
+}
+
+// ReconciliationLedger provides access to token transfers in the form required for reconciliation.
+func (db *database) ReconciliationLedger() reconciliation.Ledger {
+	return &reconciliationLedgerDB{conn: db.conn}
+}
+
+// ReconciliationBaselines provides access to baselines of bridge contract balances db.
+func (db *database) ReconciliationBaselines() reconciliation.Baselines {
+	return &reconciliationBaselinesDB{conn: db.conn}
+}
+
+// LimitCounters provides access to rolling volume counters of transfer limits db.
+func (db *database) LimitCounters() limits.Counters {
+	return &limitCountersDB{conn: db.conn}
+}
+
+// TransferApprovals provides access to the queue of transfers which wait for manual approval db.
+func (db *database) TransferApprovals() limits.Approvals {
+	return &transferApprovalsDB{conn: db.conn}
+}
+
+// Pauses provides access to pauses of the bridge and networks db.
+func (db *database) Pauses() pause.Pauses {
+	return &pausesDB{conn: db.conn}
+}
+
+// PausedTransfers provides access to transfers, bridge out of which was deferred by pause, db.
+func (db *database) PausedTransfers() pause.Backlog {
+	return &pausedTransfersDB{conn: db.conn}
+}
+
+// OutboundAttempts provides access to the attempts of bridge out of transfers db.
+func (db *database) OutboundAttempts() transfers.OutboundAttempts {
+	return &outboundAttemptsDB{conn: db.conn}
+}
+
+// Denylist provides access to addresses denied by operators db.
+func (db *database) Denylist() screening.Denylist {
+	return &denylistDB{conn: db.conn}
+}
+
+// ScreeningHolds provides access to the audit of transfers held by screening db.
+func (db *database) ScreeningHolds() screening.Holds {
+	return &screeningHoldsDB{conn: db.conn}
+}
+
+// AuditLog provides access to the append-only audit log of operator interventions db.
+func (db *database) AuditLog() interventions.AuditLog {
+	return &auditLogDB{conn: db.conn}
+}
+
 // Tokens provides access to accounts db.
 func (db *database) Tokens() bridge.Tokens {
 	return &tokensDB{conn: db.conn}
 }
 
+// UnmatchedEvents provides access to accounts db.
+func (db *database) UnmatchedEvents() transfers.UnmatchedEvents {
+	return &unmatchedEventsDB{conn: db.conn}
+}
+
 // Transactions provides access to accounts db.
 func (db *database) Transactions() transactions.DB {
 	return &transactionsDB{conn: db.conn}
@@ -5,9 +5,12 @@ package dbtesting
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 
+	"github.com/zeebo/errs"
+
 	"tricorn/bridge"
 	"tricorn/bridge/database"
 	"tricorn/internal/postgres"
@@ -85,3 +88,23 @@ func CreateMasterDBOnTopOf(tempDB *tempdb.TempDatabase) (bridge.DB, error) {
 	masterDB, err := database.New(tempDB.ConnStr)
 	return &tempMasterDB{DB: masterDB, tempDB: tempDB}, err
 }
+
+// Exec executes raw query in the schema of the test database, it is used to bring database to the state which is not
+// reachable through bridge.DB, e.g. to roll back applied migration.
+func Exec(ctx context.Context, db bridge.DB, query string, args ...interface{}) (err error) {
+	masterDB, ok := db.(*tempMasterDB)
+	if !ok {
+		return fmt.Errorf("database is not created by dbtesting")
+	}
+
+	conn, err := sql.Open("postgres", masterDB.tempDB.ConnStr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	_, err = conn.ExecContext(ctx, query, args...)
+	return err
+}
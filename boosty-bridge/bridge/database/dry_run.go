@@ -0,0 +1,158 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/dryrun"
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+)
+
+// ensures that dryRunDecisionsDB implements dryrun.Decisions.
+var _ dryrun.Decisions = (*dryRunDecisionsDB)(nil)
+
+// ensures that dryRunOutcomesDB implements dryrun.Outcomes.
+var _ dryrun.Outcomes = (*dryRunOutcomesDB)(nil)
+
+var (
+	// ErrDryRunDecisions indicates that there was an error in the database.
+	ErrDryRunDecisions = errs.Class("dry run decisions repository")
+	// ErrDryRunOutcomes indicates that there was an error in the database.
+	ErrDryRunOutcomes = errs.Class("dry run outcomes repository")
+)
+
+// dryRunDecisionsDB provides access to decisions of the bridge in dry run mode.
+//
+// architecture: Database
+type dryRunDecisionsDB struct {
+	conn *sql.DB
+}
+
+// Create records decision on the event, decision on the event which is already recorded is ignored.
+func (dryRunDecisionsDB *dryRunDecisionsDB) Create(ctx context.Context, decision dryrun.Decision) error {
+	var amount sql.NullString
+	if decision.BridgeOut.Amount != nil {
+		amount = sql.NullString{String: decision.BridgeOut.Amount.String(), Valid: true}
+	}
+
+	query := `INSERT INTO dry_run_decisions(network_id, tx_hash, log_index, block_number, transfer_id, action, reason,
+            recipient_network_id, recipient_address, amount, token, decided_at)
+        VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::NUMERIC, $11, $12)
+        ON CONFLICT (network_id, tx_hash, log_index) DO NOTHING`
+
+	_, err := dryRunDecisionsDB.conn.ExecContext(ctx, query, decision.Event.NetworkID, decision.Event.TxHash,
+		int64(decision.Event.LogIndex), int64(decision.Event.BlockNumber), decision.TransferID, decision.Action,
+		decision.Reason, decision.BridgeOut.RecipientNetworkID, decision.BridgeOut.RecipientAddress, amount,
+		decision.BridgeOut.Token, decision.DecidedAt)
+	return ErrDryRunDecisions.Wrap(err)
+}
+
+// List returns recorded decisions from the oldest one.
+func (dryRunDecisionsDB *dryRunDecisionsDB) List(ctx context.Context) (_ []dryrun.Decision, err error) {
+	query := `SELECT network_id, tx_hash, log_index, block_number, transfer_id, action, reason, recipient_network_id,
+            recipient_address, amount::TEXT, token, decided_at
+        FROM dry_run_decisions
+        ORDER BY id`
+
+	rows, err := dryRunDecisionsDB.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrDryRunDecisions.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	decisions := make([]dryrun.Decision, 0)
+	for rows.Next() {
+		var (
+			decision              dryrun.Decision
+			logIndex, blockNumber int64
+			amount                sql.NullString
+		)
+		err = rows.Scan(&decision.Event.NetworkID, &decision.Event.TxHash, &logIndex, &blockNumber, &decision.TransferID,
+			&decision.Action, &decision.Reason, &decision.BridgeOut.RecipientNetworkID, &decision.BridgeOut.RecipientAddress,
+			&amount, &decision.BridgeOut.Token, &decision.DecidedAt)
+		if err != nil {
+			return nil, ErrDryRunDecisions.Wrap(err)
+		}
+
+		if amount.Valid {
+			var ok bool
+			if decision.BridgeOut.Amount, ok = new(big.Int).SetString(amount.String, 10); !ok {
+				return nil, ErrDryRunDecisions.New("invalid amount %s", amount.String)
+			}
+		}
+
+		decision.Event.LogIndex, decision.Event.BlockNumber = uint64(logIndex), uint64(blockNumber)
+		decision.DecidedAt = decision.DecidedAt.UTC()
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, ErrDryRunDecisions.Wrap(rows.Err())
+}
+
+// dryRunOutcomesDB provides access to outcomes of the events in the live bridge.
+//
+// architecture: Database
+type dryRunOutcomesDB struct {
+	conn *sql.DB
+}
+
+// Get returns outcome of the funds in event processed by the live bridge. Live bridge records transaction of the event
+// before matching it, so event with transaction, which is not bound to any transfer, was flagged as unmatched.
+func (dryRunOutcomesDB *dryRunOutcomesDB) Get(ctx context.Context, event dryrun.Event) (dryrun.Outcome, error) {
+	query := `SELECT token_transfers.id, token_transfers.recipient_network_id, token_transfers.recipient_address,
+            token_transfers.amount,
+            EXISTS(SELECT 1 FROM screening_holds WHERE transfer_id = token_transfers.id),
+            EXISTS(SELECT 1 FROM transfer_approvals WHERE transfer_id = token_transfers.id),
+            EXISTS(SELECT 1 FROM paused_transfers WHERE transfer_id = token_transfers.id)
+        FROM transactions
+        LEFT JOIN token_transfers ON token_transfers.triggering_tx = transactions.id
+        WHERE transactions.network_id = $1 AND transactions.tx_hash = $2 AND transactions.log_index = $3`
+
+	var (
+		transferID, recipientNetworkID sql.NullInt64
+		recipientAddress, amount       []byte
+		held, approval, deferred       bool
+	)
+	err := dryRunOutcomesDB.conn.QueryRowContext(ctx, query, event.NetworkID, event.TxHash, int64(event.LogIndex)).Scan(
+		&transferID, &recipientNetworkID, &recipientAddress, &amount, &held, &approval, &deferred)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dryrun.Outcome{}, nil
+		}
+		return dryrun.Outcome{}, ErrDryRunOutcomes.Wrap(err)
+	}
+
+	if !transferID.Valid {
+		return dryrun.Outcome{Action: dryrun.ActionUnmatched}, nil
+	}
+
+	outcome := dryrun.Outcome{
+		Action:     dryrun.ActionBridgeOut,
+		TransferID: transfers.ID(transferID.Int64),
+		BridgeOut: dryrun.BridgeOut{
+			RecipientNetworkID: networks.ID(recipientNetworkID.Int64),
+			RecipientAddress:   recipientAddress,
+			Amount:             new(big.Int).SetBytes(amount),
+		},
+	}
+	switch {
+	case held:
+		outcome.Action = dryrun.ActionHold
+	case approval:
+		outcome.Action = dryrun.ActionApproval
+	case deferred:
+		outcome.Action = dryrun.ActionDefer
+	}
+
+	return outcome, nil
+}
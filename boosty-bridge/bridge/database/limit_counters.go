@@ -0,0 +1,83 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/limits"
+)
+
+// ensures that limitCountersDB implements limits.Counters.
+var _ limits.Counters = (*limitCountersDB)(nil)
+
+// ErrLimitCounters indicates that there was an error in the database.
+var ErrLimitCounters = errs.Class("limit counters repository")
+
+// limitCountersDB provides access to rolling volume counters of transfer limits.
+//
+// architecture: Database
+type limitCountersDB struct {
+	conn *sql.DB
+}
+
+// Add adds amount of the transfer to the counter of its route and sender address.
+func (limitCountersDB *limitCountersDB) Add(ctx context.Context, usage limits.Usage) error {
+	query := `INSERT INTO limit_counters(token_id, sender_network_id, recipient_network_id, sender_address, bucket, amount)
+        VALUES($1, $2, $3, $4, $5, $6::NUMERIC)
+        ON CONFLICT (token_id, sender_network_id, recipient_network_id, sender_address, bucket)
+        DO UPDATE SET amount = limit_counters.amount + EXCLUDED.amount`
+
+	_, err := limitCountersDB.conn.ExecContext(ctx, query, usage.TokenID, usage.SenderNetworkID, usage.RecipientNetworkID,
+		usage.SenderAddress, usage.At.UTC().Truncate(limits.CounterBucket), usage.Amount.String())
+	return ErrLimitCounters.Wrap(err)
+}
+
+// Sum returns sum of transfers in the scope counted since specified time.
+func (limitCountersDB *limitCountersDB) Sum(ctx context.Context, scope limits.Scope, since time.Time) (*big.Int, error) {
+	args := []interface{}{scope.TokenID, since.UTC().Truncate(limits.CounterBucket)}
+	conditions := []string{"token_id = $1", "bucket >= $2"}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if len(scope.SenderNetworks) > 0 {
+		addCondition("sender_network_id = ANY($%d)", pq.Array(networkIDs(scope.SenderNetworks)))
+	}
+	if len(scope.RecipientNetworks) > 0 {
+		addCondition("recipient_network_id = ANY($%d)", pq.Array(networkIDs(scope.RecipientNetworks)))
+	}
+	if scope.SenderAddress != nil {
+		addCondition("sender_address = $%d", scope.SenderAddress)
+	}
+
+	query := fmt.Sprintf(`SELECT COALESCE(SUM(amount), 0)::TEXT FROM limit_counters WHERE %s`, strings.Join(conditions, " AND "))
+
+	var sum string
+	if err := limitCountersDB.conn.QueryRowContext(ctx, query, args...).Scan(&sum); err != nil {
+		return nil, ErrLimitCounters.Wrap(err)
+	}
+
+	volume, ok := new(big.Int).SetString(sum, 10)
+	if !ok {
+		return nil, ErrLimitCounters.New("invalid volume %s", sum)
+	}
+
+	return volume, nil
+}
+
+// DeleteBefore deletes counters of buckets which started before specified time.
+func (limitCountersDB *limitCountersDB) DeleteBefore(ctx context.Context, before time.Time) error {
+	_, err := limitCountersDB.conn.ExecContext(ctx, `DELETE FROM limit_counters WHERE bucket < $1`, before.UTC())
+	return ErrLimitCounters.Wrap(err)
+}
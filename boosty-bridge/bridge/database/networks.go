@@ -0,0 +1,61 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+)
+
+// ensures that networksDB implements networks.Definitions.
+var _ networks.Definitions = (*networksDB)(nil)
+
+// ErrNetworks indicates that there was an error in the database.
+var ErrNetworks = errs.Class("networks repository")
+
+// networksDB provide access to network definitions DB.
+//
+// architecture: Database
+type networksDB struct {
+	conn *sql.DB
+}
+
+// List returns all network definitions from database.
+func (networksDB *networksDB) List(ctx context.Context) (_ []networks.Definition, err error) {
+	query := "SELECT id, name, type, is_testnet, chain_id, codec FROM networks ORDER BY id"
+	rows, err := networksDB.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrNetworks.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	definitions := make([]networks.Definition, 0)
+	for rows.Next() {
+		var definition networks.Definition
+		err = rows.Scan(&definition.ID, &definition.Name, &definition.Type, &definition.IsTestnet, &definition.ChainID, &definition.Codec)
+		if err != nil {
+			return nil, ErrNetworks.Wrap(err)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, ErrNetworks.Wrap(rows.Err())
+}
+
+// Upsert inserts network definition to database or updates existing one with the same id.
+func (networksDB *networksDB) Upsert(ctx context.Context, definition networks.Definition) error {
+	query := `INSERT INTO networks(id, name, type, is_testnet, chain_id, codec)
+	          VALUES($1, $2, $3, $4, $5, $6)
+	          ON CONFLICT(id) DO UPDATE SET name = $2, type = $3, is_testnet = $4, chain_id = $5, codec = $6`
+	_, err := networksDB.conn.ExecContext(ctx, query, definition.ID, definition.Name, definition.Type,
+		definition.IsTestnet, definition.ChainID, definition.Codec)
+	return ErrNetworks.Wrap(err)
+}
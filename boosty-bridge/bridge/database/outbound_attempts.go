@@ -0,0 +1,82 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+)
+
+// ensures that outboundAttemptsDB implements transfers.OutboundAttempts.
+var _ transfers.OutboundAttempts = (*outboundAttemptsDB)(nil)
+
+// ErrOutboundAttempts indicates that there was an error in the database.
+var ErrOutboundAttempts = errs.Class("outbound attempts repository")
+
+// outboundAttemptsDB provides access to the attempts of bridge out of transfers.
+//
+// architecture: Database
+type outboundAttemptsDB struct {
+	conn *sql.DB
+}
+
+// Create records attempt of bridge out of the transfer and returns its id.
+func (outboundAttemptsDB *outboundAttemptsDB) Create(ctx context.Context, attempt transfers.OutboundAttempt) (int64, error) {
+	query := `INSERT INTO outbound_attempts(transfer_id, network_id, attempted_at) VALUES($1, $2, $3) RETURNING id`
+
+	var id int64
+	err := outboundAttemptsDB.conn.QueryRowContext(ctx, query, attempt.TransferID, attempt.NetworkID, attempt.AttemptedAt).Scan(&id)
+	return id, ErrOutboundAttempts.Wrap(err)
+}
+
+// SetTxHash records hash of the transaction broadcast by the attempt.
+func (outboundAttemptsDB *outboundAttemptsDB) SetTxHash(ctx context.Context, id int64, txHash []byte) error {
+	result, err := outboundAttemptsDB.conn.ExecContext(ctx, `UPDATE outbound_attempts SET tx_hash = $1 WHERE id = $2`, txHash, id)
+	if err != nil {
+		return ErrOutboundAttempts.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return ErrOutboundAttempts.Wrap(err)
+	}
+	if rowsAffected == 0 {
+		return ErrOutboundAttempts.New("attempt %d does not exist", id)
+	}
+
+	return nil
+}
+
+// List returns attempts of bridge out of the transfer from the oldest one to the newest one.
+func (outboundAttemptsDB *outboundAttemptsDB) List(ctx context.Context, transferID transfers.ID) (_ []transfers.OutboundAttempt, err error) {
+	query := `SELECT id, transfer_id, network_id, tx_hash, attempted_at FROM outbound_attempts
+        WHERE transfer_id = $1
+        ORDER BY id`
+
+	rows, err := outboundAttemptsDB.conn.QueryContext(ctx, query, transferID)
+	if err != nil {
+		return nil, ErrOutboundAttempts.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	var attempts []transfers.OutboundAttempt
+	for rows.Next() {
+		var attempt transfers.OutboundAttempt
+		err = rows.Scan(&attempt.ID, &attempt.TransferID, &attempt.NetworkID, &attempt.TxHash, &attempt.AttemptedAt)
+		if err != nil {
+			return nil, ErrOutboundAttempts.Wrap(err)
+		}
+
+		attempt.AttemptedAt = attempt.AttemptedAt.UTC()
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, ErrOutboundAttempts.Wrap(rows.Err())
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/transfers"
+)
+
+// ensures that pausesDB implements pause.Pauses.
+var _ pause.Pauses = (*pausesDB)(nil)
+
+// ensures that pausedTransfersDB implements pause.Backlog.
+var _ pause.Backlog = (*pausedTransfersDB)(nil)
+
+var (
+	// ErrPauses indicates that there was an error in the database.
+	ErrPauses = errs.Class("pauses repository")
+	// ErrPausedTransfers indicates that there was an error in the database.
+	ErrPausedTransfers = errs.Class("paused transfers repository")
+)
+
+// pausesDB provides access to pauses of the bridge and networks.
+//
+// architecture: Database
+type pausesDB struct {
+	conn *sql.DB
+}
+
+// Pause stores pause of the bridge or network, existing pause of the same network is replaced.
+func (pausesDB *pausesDB) Pause(ctx context.Context, networkPause pause.Pause) error {
+	query := `INSERT INTO bridge_pauses(network, reason, operator, paused_at) VALUES($1, $2, $3, $4)
+        ON CONFLICT (network) DO UPDATE SET reason = EXCLUDED.reason, operator = EXCLUDED.operator, paused_at = EXCLUDED.paused_at`
+
+	_, err := pausesDB.conn.ExecContext(ctx, query, networkPause.Network, networkPause.Reason, networkPause.Operator, networkPause.PausedAt)
+	return ErrPauses.Wrap(err)
+}
+
+// Resume deletes pause of the network, empty network resumes the whole bridge. ErrNotPaused is returned if
+// there is no such pause.
+func (pausesDB *pausesDB) Resume(ctx context.Context, network networks.Name) error {
+	result, err := pausesDB.conn.ExecContext(ctx, `DELETE FROM bridge_pauses WHERE network = $1`, network)
+	if err != nil {
+		return ErrPauses.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return ErrPauses.Wrap(err)
+	}
+
+	if rowsAffected == 0 {
+		if network == "" {
+			return pause.ErrNotPaused.New("bridge")
+		}
+		return pause.ErrNotPaused.New("network %s", network)
+	}
+
+	return nil
+}
+
+// List returns all pauses.
+func (pausesDB *pausesDB) List(ctx context.Context) (_ []pause.Pause, err error) {
+	rows, err := pausesDB.conn.QueryContext(ctx, `SELECT network, reason, operator, paused_at FROM bridge_pauses ORDER BY paused_at`)
+	if err != nil {
+		return nil, ErrPauses.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	pauses := make([]pause.Pause, 0)
+	for rows.Next() {
+		var networkPause pause.Pause
+		if err = rows.Scan(&networkPause.Network, &networkPause.Reason, &networkPause.Operator, &networkPause.PausedAt); err != nil {
+			return nil, ErrPauses.Wrap(err)
+		}
+
+		networkPause.PausedAt = networkPause.PausedAt.UTC()
+		pauses = append(pauses, networkPause)
+	}
+
+	return pauses, ErrPauses.Wrap(rows.Err())
+}
+
+// pausedTransfersDB provides access to confirming transfers, bridge out of which was deferred by pause.
+//
+// architecture: Database
+type pausedTransfersDB struct {
+	conn *sql.DB
+}
+
+// Add defers bridge out of the transfer, already deferred transfer is kept as is.
+func (pausedTransfersDB *pausedTransfersDB) Add(ctx context.Context, transferID transfers.ID, deferredAt time.Time) error {
+	query := `INSERT INTO paused_transfers(transfer_id, deferred_at) VALUES($1, $2) ON CONFLICT (transfer_id) DO NOTHING`
+
+	_, err := pausedTransfersDB.conn.ExecContext(ctx, query, transferID, deferredAt)
+	return ErrPausedTransfers.Wrap(err)
+}
+
+// List returns deferred transfers from the oldest to the newest one.
+func (pausedTransfersDB *pausedTransfersDB) List(ctx context.Context) (_ []transfers.ID, err error) {
+	rows, err := pausedTransfersDB.conn.QueryContext(ctx, `SELECT transfer_id FROM paused_transfers ORDER BY deferred_at, transfer_id`)
+	if err != nil {
+		return nil, ErrPausedTransfers.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	var transferIDs []transfers.ID
+	for rows.Next() {
+		var transferID transfers.ID
+		if err = rows.Scan(&transferID); err != nil {
+			return nil, ErrPausedTransfers.Wrap(err)
+		}
+
+		transferIDs = append(transferIDs, transferID)
+	}
+
+	return transferIDs, ErrPausedTransfers.Wrap(rows.Err())
+}
+
+// Remove removes transfer from the backlog, false is returned if it was not deferred.
+func (pausedTransfersDB *pausedTransfersDB) Remove(ctx context.Context, transferID transfers.ID) (bool, error) {
+	result, err := pausedTransfersDB.conn.ExecContext(ctx, `DELETE FROM paused_transfers WHERE transfer_id = $1`, transferID)
+	if err != nil {
+		return false, ErrPausedTransfers.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	return rowsAffected > 0, ErrPausedTransfers.Wrap(err)
+}
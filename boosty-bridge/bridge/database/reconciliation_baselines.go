@@ -0,0 +1,59 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/reconciliation"
+)
+
+// ensures that reconciliationBaselinesDB implements reconciliation.Baselines.
+var _ reconciliation.Baselines = (*reconciliationBaselinesDB)(nil)
+
+// ErrReconciliationBaselines indicates that there was an error in the database.
+var ErrReconciliationBaselines = errs.Class("reconciliation baselines repository")
+
+// reconciliationBaselinesDB provides access to baselines of bridge contract balances.
+//
+// architecture: Database
+type reconciliationBaselinesDB struct {
+	conn *sql.DB
+}
+
+// Get returns baseline of bridge contract balance of the token, ErrNoBaseline is returned if there is no one.
+func (reconciliationBaselinesDB *reconciliationBaselinesDB) Get(ctx context.Context, holding reconciliation.Holding) (*big.Int, error) {
+	query := `SELECT amount::TEXT FROM reconciliation_baselines WHERE network_id = $1 AND token_id = $2`
+
+	var amount string
+	err := reconciliationBaselinesDB.conn.QueryRowContext(ctx, query, holding.NetworkID, holding.TokenID).Scan(&amount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReconciliationBaselines.Wrap(reconciliation.ErrNoBaseline)
+		}
+
+		return nil, ErrReconciliationBaselines.Wrap(err)
+	}
+
+	baseline, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, ErrReconciliationBaselines.New("invalid baseline %s", amount)
+	}
+
+	return baseline, nil
+}
+
+// Create stores baseline of bridge contract balance of the token, already existing baseline is kept.
+func (reconciliationBaselinesDB *reconciliationBaselinesDB) Create(ctx context.Context, holding reconciliation.Holding, amount *big.Int) error {
+	query := `INSERT INTO reconciliation_baselines(network_id, token_id, amount) VALUES($1, $2, $3::NUMERIC)
+        ON CONFLICT (network_id, token_id) DO NOTHING`
+
+	_, err := reconciliationBaselinesDB.conn.ExecContext(ctx, query, holding.NetworkID, holding.TokenID, amount.String())
+	return ErrReconciliationBaselines.Wrap(err)
+}
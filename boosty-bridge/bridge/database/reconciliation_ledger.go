@@ -0,0 +1,126 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/reconciliation"
+	"tricorn/bridge/transfers"
+)
+
+// ensures that reconciliationLedgerDB implements reconciliation.Ledger.
+var _ reconciliation.Ledger = (*reconciliationLedgerDB)(nil)
+
+// ErrReconciliationLedger indicates that there was an error in the database.
+var ErrReconciliationLedger = errs.Class("reconciliation ledger repository")
+
+// reconciliationLedgerDB provides access to token transfers in the form required for reconciliation.
+//
+// architecture: Database
+type reconciliationLedgerDB struct {
+	conn *sql.DB
+}
+
+// RouteTotals returns amount and sum of transfers grouped by token, route and status. Amounts are stored as bytes,
+// so they are summed up after reading.
+func (reconciliationLedgerDB *reconciliationLedgerDB) RouteTotals(ctx context.Context) (_ []reconciliation.RouteTotal, err error) {
+	query := `SELECT token_id, sender_network_id, recipient_network_id, status, amount FROM token_transfers
+        ORDER BY token_id, sender_network_id, recipient_network_id, status`
+
+	rows, err := reconciliationLedgerDB.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrReconciliationLedger.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	var routes []reconciliation.RouteTotal
+	for rows.Next() {
+		var (
+			route  reconciliation.RouteTotal
+			amount []byte
+		)
+		if err = rows.Scan(&route.TokenID, &route.SenderNetworkID, &route.RecipientNetworkID, &route.Status, &amount); err != nil {
+			return nil, ErrReconciliationLedger.Wrap(err)
+		}
+
+		// rows are ordered, so transfers of the same route and status go one by one.
+		if last := len(routes) - 1; last >= 0 && routes[last].TokenID == route.TokenID &&
+			routes[last].SenderNetworkID == route.SenderNetworkID && routes[last].RecipientNetworkID == route.RecipientNetworkID &&
+			routes[last].Status == route.Status {
+			routes[last].Count++
+			routes[last].Amount.Add(routes[last].Amount, new(big.Int).SetBytes(amount))
+			continue
+		}
+
+		route.Count = 1
+		route.Amount = new(big.Int).SetBytes(amount)
+		routes = append(routes, route)
+	}
+
+	return routes, ErrReconciliationLedger.Wrap(rows.Err())
+}
+
+// FinishedWithoutOutbound returns finished transfers which have no outbound transaction.
+func (reconciliationLedgerDB *reconciliationLedgerDB) FinishedWithoutOutbound(ctx context.Context) ([]reconciliation.Anomaly, error) {
+	query := `SELECT id, token_id, sender_network_id, recipient_network_id, amount, created_at FROM token_transfers
+        WHERE status = $1 AND COALESCE(outbound_tx, 0) = 0
+        ORDER BY id`
+
+	return reconciliationLedgerDB.anomalies(ctx, reconciliation.AnomalyFinishedWithoutOutbound, query, transfers.StatusFinished)
+}
+
+// ConfirmingSince returns confirming transfers which triggering transaction was seen before specified time.
+// Creation time is used for transfers without triggering transaction.
+func (reconciliationLedgerDB *reconciliationLedgerDB) ConfirmingSince(ctx context.Context, before time.Time) ([]reconciliation.Anomaly, error) {
+	query := `SELECT tt.id, tt.token_id, tt.sender_network_id, tt.recipient_network_id, tt.amount,
+            COALESCE(t.seen_at, tt.created_at) AS since
+        FROM token_transfers AS tt
+        LEFT JOIN transactions AS t ON t.id = tt.triggering_tx
+        WHERE tt.status = $1 AND COALESCE(t.seen_at, tt.created_at) < $2
+        ORDER BY tt.id`
+
+	return reconciliationLedgerDB.anomalies(ctx, reconciliation.AnomalyConfirmingOverdue, query, transfers.StatusConfirming, before)
+}
+
+// anomalies returns transfers selected by query as anomalies of the specified kind.
+func (reconciliationLedgerDB *reconciliationLedgerDB) anomalies(ctx context.Context, kind reconciliation.AnomalyKind, query string,
+	args ...interface{}) (_ []reconciliation.Anomaly, err error) {
+	rows, err := reconciliationLedgerDB.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, ErrReconciliationLedger.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	var anomalies []reconciliation.Anomaly
+	for rows.Next() {
+		var (
+			anomaly            = reconciliation.Anomaly{Kind: kind}
+			senderNetworkID    int64
+			recipientNetworkID int64
+			amount             []byte
+		)
+		if err = rows.Scan(&anomaly.TransferID, &anomaly.TokenID, &senderNetworkID, &recipientNetworkID, &amount, &anomaly.Since); err != nil {
+			return nil, ErrReconciliationLedger.Wrap(err)
+		}
+
+		anomaly.SenderNetworkID = networks.ID(senderNetworkID)
+		anomaly.RecipientNetworkID = networks.ID(recipientNetworkID)
+		anomaly.Amount = new(big.Int).SetBytes(amount)
+		anomaly.Since = anomaly.Since.UTC()
+		anomalies = append(anomalies, anomaly)
+	}
+
+	return anomalies, ErrReconciliationLedger.Wrap(rows.Err())
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/screening"
+)
+
+// ensures that denylistDB implements screening.Denylist.
+var _ screening.Denylist = (*denylistDB)(nil)
+
+// ensures that screeningHoldsDB implements screening.Holds.
+var _ screening.Holds = (*screeningHoldsDB)(nil)
+
+var (
+	// ErrDenylist indicates that there was an error in the database.
+	ErrDenylist = errs.Class("denylist repository")
+	// ErrScreeningHolds indicates that there was an error in the database.
+	ErrScreeningHolds = errs.Class("screening holds repository")
+)
+
+// denylistDB provides access to addresses denied by operators.
+//
+// architecture: Database
+type denylistDB struct {
+	conn *sql.DB
+}
+
+// Add adds address to the denylist, existing entry of the same address is replaced.
+func (denylistDB *denylistDB) Add(ctx context.Context, entry screening.Entry) error {
+	query := `INSERT INTO screening_denylist(network_id, address, reason, operator, created_at) VALUES($1, $2, $3, $4, $5)
+        ON CONFLICT (network_id, address) DO UPDATE SET reason = EXCLUDED.reason, operator = EXCLUDED.operator, created_at = EXCLUDED.created_at`
+
+	_, err := denylistDB.conn.ExecContext(ctx, query, entry.NetworkID, entry.Address, entry.Reason, entry.Operator, entry.CreatedAt)
+	return ErrDenylist.Wrap(err)
+}
+
+// Get returns entry of the address, ErrNotDenied is returned if address is not denied.
+func (denylistDB *denylistDB) Get(ctx context.Context, subject screening.Subject) (screening.Entry, error) {
+	query := `SELECT network_id, address, reason, operator, created_at FROM screening_denylist WHERE network_id = $1 AND address = $2`
+
+	entry, err := scanDenylistEntry(denylistDB.conn.QueryRowContext(ctx, query, subject.NetworkID, subject.Address))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return screening.Entry{}, screening.ErrNotDenied.New("%s", subject)
+		}
+		return screening.Entry{}, ErrDenylist.Wrap(err)
+	}
+
+	return entry, nil
+}
+
+// List returns all entries of the denylist.
+func (denylistDB *denylistDB) List(ctx context.Context) (_ []screening.Entry, err error) {
+	query := `SELECT network_id, address, reason, operator, created_at FROM screening_denylist ORDER BY created_at`
+
+	rows, err := denylistDB.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrDenylist.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	entries := make([]screening.Entry, 0)
+	for rows.Next() {
+		var entry screening.Entry
+		if entry, err = scanDenylistEntry(rows); err != nil {
+			return nil, ErrDenylist.Wrap(err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, ErrDenylist.Wrap(rows.Err())
+}
+
+// Remove removes address from the denylist, ErrNotDenied is returned if address is not denied.
+func (denylistDB *denylistDB) Remove(ctx context.Context, subject screening.Subject) error {
+	query := `DELETE FROM screening_denylist WHERE network_id = $1 AND address = $2`
+
+	result, err := denylistDB.conn.ExecContext(ctx, query, subject.NetworkID, subject.Address)
+	if err != nil {
+		return ErrDenylist.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return ErrDenylist.Wrap(err)
+	}
+
+	if rowsAffected == 0 {
+		return screening.ErrNotDenied.New("%s", subject)
+	}
+
+	return nil
+}
+
+// scanDenylistEntry reads denylist entry from the row.
+func scanDenylistEntry(row interface{ Scan(...interface{}) error }) (screening.Entry, error) {
+	var entry screening.Entry
+	err := row.Scan(&entry.NetworkID, &entry.Address, &entry.Reason, &entry.Operator, &entry.CreatedAt)
+	if err != nil {
+		return screening.Entry{}, err
+	}
+
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	return entry, nil
+}
+
+// screeningHoldsDB provides access to the audit of transfers held by screening.
+//
+// architecture: Database
+type screeningHoldsDB struct {
+	conn *sql.DB
+}
+
+// Create records hold of the transfer, already held transfer is kept as is.
+func (screeningHoldsDB *screeningHoldsDB) Create(ctx context.Context, hold screening.Hold) error {
+	query := `INSERT INTO screening_holds(transfer_id, network_id, address, provider, reason, created_at)
+        VALUES($1, $2, $3, $4, $5, $6) ON CONFLICT (transfer_id) DO NOTHING`
+
+	_, err := screeningHoldsDB.conn.ExecContext(ctx, query, hold.TransferID, hold.NetworkID, hold.Address, hold.Provider,
+		hold.Reason, hold.CreatedAt)
+	return ErrScreeningHolds.Wrap(err)
+}
+
+// List returns holds from the oldest to the newest one.
+func (screeningHoldsDB *screeningHoldsDB) List(ctx context.Context) (_ []screening.Hold, err error) {
+	query := `SELECT transfer_id, network_id, address, provider, reason, created_at FROM screening_holds
+        ORDER BY created_at, transfer_id`
+
+	rows, err := screeningHoldsDB.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrScreeningHolds.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	holds := make([]screening.Hold, 0)
+	for rows.Next() {
+		var hold screening.Hold
+		err = rows.Scan(&hold.TransferID, &hold.NetworkID, &hold.Address, &hold.Provider, &hold.Reason, &hold.CreatedAt)
+		if err != nil {
+			return nil, ErrScreeningHolds.Wrap(err)
+		}
+
+		hold.CreatedAt = hold.CreatedAt.UTC()
+		holds = append(holds, hold)
+	}
+
+	return holds, ErrScreeningHolds.Wrap(rows.Err())
+}
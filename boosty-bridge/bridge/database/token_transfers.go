@@ -7,7 +7,11 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/zeebo/errs"
 
 	"tricorn/bridge"
@@ -29,16 +33,74 @@ type tokenTransfersDB struct {
 	conn *sql.DB
 }
 
-// Create inserts token transfer to database.
+// Create inserts token transfer to database, its status is recorded as the first transition of the history.
+// Creation time is set to the current time if transfer has none.
 func (tokenTransfersDB *tokenTransfersDB) Create(ctx context.Context, tokenTransfer transfers.TokenTransfer) error {
-	query := `INSERT INTO token_transfers(triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,
-		recipient_network_id,recipient_address) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9)`
+	nonce, deadline := signatureParams(tokenTransfer)
+	createdAt := sql.NullTime{Time: tokenTransfer.CreatedAt, Valid: !tokenTransfer.CreatedAt.IsZero()}
+
+	query := `WITH created AS (
+            INSERT INTO token_transfers(triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,
+		    recipient_network_id,recipient_address,nonce,deadline,created_at) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,COALESCE($13, now()))
+            RETURNING id, status, created_at
+        )
+        INSERT INTO transfer_status_history(transfer_id, from_status, to_status, cause, changed_at)
+        SELECT id, '', status, $12, created_at FROM created`
 	_, err := tokenTransfersDB.conn.ExecContext(ctx, query, tokenTransfer.TriggeringTx, tokenTransfer.OutboundTx, tokenTransfer.TokenID,
 		tokenTransfer.Amount.Bytes(), tokenTransfer.Status, tokenTransfer.SenderNetworkID, tokenTransfer.SenderAddress,
-		tokenTransfer.RecipientNetworkID, tokenTransfer.RecipientAddress)
+		tokenTransfer.RecipientNetworkID, tokenTransfer.RecipientAddress, nonce, deadline, transfers.CauseCreated, createdAt)
 	return ErrTokenTransfers.Wrap(err)
 }
 
+// CreateWithNonce atomically reserves next nonce of the sender network and inserts token transfer bound to it.
+// The nonce row stays locked until sign returns, so concurrent requests for the same network never share a nonce.
+func (tokenTransfersDB *tokenTransfersDB) CreateWithNonce(ctx context.Context, tokenTransfer transfers.TokenTransfer, sign transfers.SignFunc) (_ transfers.TokenTransfer, err error) {
+	tx, err := tokenTransfersDB.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return tokenTransfer, ErrTokenTransfers.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrTokenTransfers.Wrap(tx.Rollback()))
+		}
+	}()
+
+	query := "UPDATE network_nonces SET nonce = nonce + 1 WHERE network_id = $1 RETURNING nonce - 1"
+	if err = tx.QueryRowContext(ctx, query, tokenTransfer.SenderNetworkID).Scan(&tokenTransfer.Nonce); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return tokenTransfer, ErrTokenTransfers.Wrap(bridge.ErrNoNetworkNonce)
+		}
+
+		return tokenTransfer, ErrTokenTransfers.Wrap(err)
+	}
+
+	tokenTransfer.Deadline, err = sign(tokenTransfer.Nonce)
+	if err != nil {
+		return tokenTransfer, ErrTokenTransfers.Wrap(err)
+	}
+
+	query = `INSERT INTO token_transfers(triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,
+		recipient_network_id,recipient_address,nonce,deadline) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING id, created_at`
+	row := tx.QueryRowContext(ctx, query, tokenTransfer.TriggeringTx, tokenTransfer.OutboundTx, tokenTransfer.TokenID,
+		tokenTransfer.Amount.Bytes(), tokenTransfer.Status, tokenTransfer.SenderNetworkID, tokenTransfer.SenderAddress,
+		tokenTransfer.RecipientNetworkID, tokenTransfer.RecipientAddress, tokenTransfer.Nonce, tokenTransfer.Deadline)
+	if err = row.Scan(&tokenTransfer.ID, &tokenTransfer.CreatedAt); err != nil {
+		return tokenTransfer, ErrTokenTransfers.Wrap(err)
+	}
+	tokenTransfer.CreatedAt = tokenTransfer.CreatedAt.UTC()
+
+	err = recordStatusTransition(ctx, tx, transfers.ID(tokenTransfer.ID), transfers.StatusTransition{
+		To:        tokenTransfer.Status,
+		Cause:     transfers.CauseCreated,
+		ChangedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return tokenTransfer, ErrTokenTransfers.Wrap(err)
+	}
+
+	return tokenTransfer, ErrTokenTransfers.Wrap(tx.Commit())
+}
+
 // Get returns token transfer by id from database.
 func (tokenTransfersDB *tokenTransfersDB) Get(ctx context.Context, id int64) (transfers.TokenTransfer, error) {
 	var (
@@ -46,15 +108,17 @@ func (tokenTransfersDB *tokenTransfersDB) Get(ctx context.Context, id int64) (tr
 		amount        []byte
 		outboundTx    sql.NullInt64
 		triggeringTx  sql.NullInt64
+		nonce         sql.NullInt64
+		deadline      sql.NullTime
 	)
 
-	query := `SELECT id,triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,recipient_network_id,recipient_address 
+	query := `SELECT id,triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,recipient_network_id,recipient_address,nonce,deadline,created_at
 	FROM token_transfers WHERE id = $1`
 	row := tokenTransfersDB.conn.QueryRowContext(ctx, query, id)
 
 	if err := row.Scan(&tokenTransfer.ID, &triggeringTx, &outboundTx, &tokenTransfer.TokenID, &amount,
 		&tokenTransfer.Status, &tokenTransfer.SenderNetworkID, &tokenTransfer.SenderAddress, &tokenTransfer.RecipientNetworkID,
-		&tokenTransfer.RecipientAddress); err != nil {
+		&tokenTransfer.RecipientAddress, &nonce, &deadline, &tokenTransfer.CreatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return tokenTransfer, ErrTokenTransfers.Wrap(bridge.ErrNoTokenTransfer)
 		}
@@ -69,6 +133,13 @@ func (tokenTransfersDB *tokenTransfersDB) Get(ctx context.Context, id int64) (tr
 	if outboundTx.Valid {
 		tokenTransfer.OutboundTx = transactions.ID(outboundTx.Int64)
 	}
+	if nonce.Valid {
+		tokenTransfer.Nonce = nonce.Int64
+	}
+	if deadline.Valid {
+		tokenTransfer.Deadline = deadline.Time
+	}
+	tokenTransfer.CreatedAt = tokenTransfer.CreatedAt.UTC()
 
 	return tokenTransfer, nil
 }
@@ -80,9 +151,11 @@ func (tokenTransfersDB *tokenTransfersDB) GetByAllParams(ctx context.Context, pa
 		outboundTx    sql.NullInt64
 		triggeringTx  sql.NullInt64
 		amount        []byte
+		nonce         sql.NullInt64
+		deadline      sql.NullTime
 	)
 
-	query := `SELECT id,triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,recipient_network_id,recipient_address
+	query := `SELECT id,triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,recipient_network_id,recipient_address,nonce,deadline,created_at
 	          FROM token_transfers
 	          WHERE token_id = $1 AND amount=$2 AND sender_address = $3 AND recipient_address = $4
 			  ORDER BY id DESC`
@@ -91,7 +164,7 @@ func (tokenTransfersDB *tokenTransfersDB) GetByAllParams(ctx context.Context, pa
 
 	if err := row.Scan(&tokenTransfer.ID, &triggeringTx, &outboundTx, &tokenTransfer.TokenID, &amount,
 		&tokenTransfer.Status, &tokenTransfer.SenderNetworkID, &tokenTransfer.SenderAddress, &tokenTransfer.RecipientNetworkID,
-		&tokenTransfer.RecipientAddress); err != nil {
+		&tokenTransfer.RecipientAddress, &nonce, &deadline, &tokenTransfer.CreatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return tokenTransfer, ErrTokenTransfers.Wrap(bridge.ErrNoTokenTransfer)
 		}
@@ -106,10 +179,37 @@ func (tokenTransfersDB *tokenTransfersDB) GetByAllParams(ctx context.Context, pa
 	if outboundTx.Valid {
 		tokenTransfer.OutboundTx = transactions.ID(outboundTx.Int64)
 	}
+	if nonce.Valid {
+		tokenTransfer.Nonce = nonce.Int64
+	}
+	if deadline.Valid {
+		tokenTransfer.Deadline = deadline.Time
+	}
+	tokenTransfer.CreatedAt = tokenTransfer.CreatedAt.UTC()
 
 	return tokenTransfer, nil
 }
 
+// GetByNonce returns token transfer by sender network and bridge in signature nonce from database.
+func (tokenTransfersDB *tokenTransfersDB) GetByNonce(ctx context.Context, senderNetworkID networks.ID, nonce int64) (transfers.TokenTransfer, error) {
+	query := `SELECT id,triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,recipient_network_id,recipient_address,nonce,deadline,created_at
+	          FROM token_transfers
+	          WHERE sender_network_id = $1 AND nonce = $2`
+	row := tokenTransfersDB.conn.QueryRowContext(ctx, query, senderNetworkID, nonce)
+
+	return scanTokenTransfer(row)
+}
+
+// GetByTriggeringTx returns token transfer by id of the transaction which triggered it from database.
+func (tokenTransfersDB *tokenTransfersDB) GetByTriggeringTx(ctx context.Context, triggeringTx transactions.ID) (transfers.TokenTransfer, error) {
+	query := `SELECT id,triggering_tx,outbound_tx,token_id,amount,status,sender_network_id,sender_address,recipient_network_id,recipient_address,nonce,deadline,created_at
+	          FROM token_transfers
+	          WHERE triggering_tx = $1`
+	row := tokenTransfersDB.conn.QueryRowContext(ctx, query, triggeringTx)
+
+	return scanTokenTransfer(row)
+}
+
 // GetByNetworkAndTx returns token transfer by network and hash from database.
 func (tokenTransfersDB *tokenTransfersDB) GetByNetworkAndTx(ctx context.Context, networkID networks.ID, txHash []byte) (transfers.TokenTransfer, error) {
 	var (
@@ -117,9 +217,11 @@ func (tokenTransfersDB *tokenTransfersDB) GetByNetworkAndTx(ctx context.Context,
 		outboundTx    sql.NullInt64
 		triggeringTx  sql.NullInt64
 		amount        []byte
+		nonce         sql.NullInt64
+		deadline      sql.NullTime
 	)
 
-	query := `SELECT tt.id,tt.triggering_tx,tt.outbound_tx,tt.token_id,tt.amount,tt.status,tt.sender_network_id,tt.sender_address,tt.recipient_network_id,tt.recipient_address 
+	query := `SELECT tt.id,tt.triggering_tx,tt.outbound_tx,tt.token_id,tt.amount,tt.status,tt.sender_network_id,tt.sender_address,tt.recipient_network_id,tt.recipient_address,tt.nonce,tt.deadline,tt.created_at
 	    FROM token_transfers as tt
 	    LEFT JOIN transactions as txt ON tt.triggering_tx = txt.id
         LEFT JOIN transactions as txo ON tt.outbound_tx = txo.id
@@ -128,7 +230,7 @@ func (tokenTransfersDB *tokenTransfersDB) GetByNetworkAndTx(ctx context.Context,
 
 	if err := row.Scan(&tokenTransfer.ID, &triggeringTx, &outboundTx, &tokenTransfer.TokenID, &amount,
 		&tokenTransfer.Status, &tokenTransfer.SenderNetworkID, &tokenTransfer.SenderAddress, &tokenTransfer.RecipientNetworkID,
-		&tokenTransfer.RecipientAddress); err != nil {
+		&tokenTransfer.RecipientAddress, &nonce, &deadline, &tokenTransfer.CreatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return tokenTransfer, ErrTokenTransfers.Wrap(bridge.ErrNoTokenTransfer)
 		}
@@ -142,6 +244,13 @@ func (tokenTransfersDB *tokenTransfersDB) GetByNetworkAndTx(ctx context.Context,
 	if outboundTx.Valid {
 		tokenTransfer.OutboundTx = transactions.ID(outboundTx.Int64)
 	}
+	if nonce.Valid {
+		tokenTransfer.Nonce = nonce.Int64
+	}
+	if deadline.Valid {
+		tokenTransfer.Deadline = deadline.Time
+	}
+	tokenTransfer.CreatedAt = tokenTransfer.CreatedAt.UTC()
 	tokenTransfer.Amount.SetBytes(amount)
 
 	return tokenTransfer, nil
@@ -152,7 +261,7 @@ func (tokenTransfersDB *tokenTransfersDB) ListByUser(ctx context.Context, offset
 	tokenTransfers := make([]transfers.TokenTransfer, 0)
 
 	selectQuery := `SELECT tt.id, tt.triggering_tx, tt.outbound_tx, tt.token_id, tt.amount, tt.status, tt.sender_network_id,
-   	    tt.sender_address, tt.recipient_network_id, tt.recipient_address
+   	    tt.sender_address, tt.recipient_network_id, tt.recipient_address, tt.nonce, tt.deadline, tt.created_at
         FROM token_transfers as tt 
         LEFT JOIN transactions as txt ON tt.triggering_tx = txt.id
         LEFT JOIN transactions as txo ON tt.outbound_tx = txo.id
@@ -174,10 +283,12 @@ func (tokenTransfersDB *tokenTransfersDB) ListByUser(ctx context.Context, offset
 			outboundTx    sql.NullInt64
 			triggeringTx  sql.NullInt64
 			amount        []byte
+			nonce         sql.NullInt64
+			deadline      sql.NullTime
 		)
 		if err := rows.Scan(&tokenTransfer.ID, &triggeringTx, &outboundTx, &tokenTransfer.TokenID, &amount,
 			&tokenTransfer.Status, &tokenTransfer.SenderNetworkID, &tokenTransfer.SenderAddress, &tokenTransfer.RecipientNetworkID,
-			&tokenTransfer.RecipientAddress); err != nil {
+			&tokenTransfer.RecipientAddress, &nonce, &deadline, &tokenTransfer.CreatedAt); err != nil {
 			return tokenTransfers, Error.Wrap(err)
 		}
 
@@ -187,6 +298,13 @@ func (tokenTransfersDB *tokenTransfersDB) ListByUser(ctx context.Context, offset
 		if outboundTx.Valid {
 			tokenTransfer.OutboundTx = transactions.ID(outboundTx.Int64)
 		}
+		if nonce.Valid {
+			tokenTransfer.Nonce = nonce.Int64
+		}
+		if deadline.Valid {
+			tokenTransfer.Deadline = deadline.Time
+		}
+		tokenTransfer.CreatedAt = tokenTransfer.CreatedAt.UTC()
 		tokenTransfer.Amount.SetBytes(amount)
 
 		tokenTransfers = append(tokenTransfers, tokenTransfer)
@@ -195,6 +313,101 @@ func (tokenTransfersDB *tokenTransfersDB) ListByUser(ctx context.Context, offset
 	return tokenTransfers, nil
 }
 
+// ListByUserAfter returns token transfers, which user sent or received and which match the filter, that follow
+// the cursor in the sort order. Transfers are ordered by creation time, transfers created at the same time are
+// ordered by id.
+func (tokenTransfersDB *tokenTransfersDB) ListByUserAfter(ctx context.Context, query transfers.HistoryQuery) (_ []transfers.TokenTransfer, err error) {
+	tokenTransfers := make([]transfers.TokenTransfer, 0)
+
+	args := []interface{}{query.NetworkID, query.UserAddress}
+	conditions := []string{`((tt.sender_network_id = $1 AND tt.sender_address = $2) OR
+        (tt.recipient_network_id = $1 AND tt.recipient_address = $2))`}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	filter := query.Filter
+	if len(filter.Statuses) > 0 {
+		addCondition("tt.status = ANY($%d)", pq.Array(statusStrings(filter.Statuses)))
+	}
+	if len(filter.SenderNetworks) > 0 {
+		addCondition("tt.sender_network_id = ANY($%d)", pq.Array(networkIDs(filter.SenderNetworks)))
+	}
+	if len(filter.RecipientNetworks) > 0 {
+		addCondition("tt.recipient_network_id = ANY($%d)", pq.Array(networkIDs(filter.RecipientNetworks)))
+	}
+	if len(filter.TokenIDs) > 0 {
+		addCondition("tt.token_id = ANY($%d)", pq.Array(filter.TokenIDs))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		addCondition("tt.created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		addCondition("tt.created_at < $%d", filter.CreatedTo)
+	}
+
+	order, after := "DESC", "(tt.created_at, tt.id) < ($%d, $%d)"
+	if query.Sort == transfers.SortOldest {
+		order, after = "ASC", "(tt.created_at, tt.id) > ($%d, $%d)"
+	}
+	if !query.After.IsZero() {
+		args = append(args, query.After.CreatedAt, query.After.ID)
+		conditions = append(conditions, fmt.Sprintf(after, len(args)-1, len(args)))
+	}
+
+	args = append(args, query.Limit)
+	selectQuery := fmt.Sprintf(`SELECT tt.id, tt.triggering_tx, tt.outbound_tx, tt.token_id, tt.amount, tt.status, tt.sender_network_id,
+        tt.sender_address, tt.recipient_network_id, tt.recipient_address, tt.nonce, tt.deadline, tt.created_at
+        FROM token_transfers as tt
+        WHERE %s
+        ORDER BY tt.created_at %s, tt.id %s
+        LIMIT $%d`, strings.Join(conditions, " AND "), order, order, len(args))
+	rows, err := tokenTransfersDB.conn.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return tokenTransfers, ErrTokenTransfers.Wrap(err)
+	}
+
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	for rows.Next() {
+		var (
+			tokenTransfer transfers.TokenTransfer
+			outboundTx    sql.NullInt64
+			triggeringTx  sql.NullInt64
+			amount        []byte
+			nonce         sql.NullInt64
+			deadline      sql.NullTime
+		)
+		if err := rows.Scan(&tokenTransfer.ID, &triggeringTx, &outboundTx, &tokenTransfer.TokenID, &amount,
+			&tokenTransfer.Status, &tokenTransfer.SenderNetworkID, &tokenTransfer.SenderAddress, &tokenTransfer.RecipientNetworkID,
+			&tokenTransfer.RecipientAddress, &nonce, &deadline, &tokenTransfer.CreatedAt); err != nil {
+			return tokenTransfers, ErrTokenTransfers.Wrap(err)
+		}
+
+		if triggeringTx.Valid {
+			tokenTransfer.TriggeringTx = transactions.ID(triggeringTx.Int64)
+		}
+		if outboundTx.Valid {
+			tokenTransfer.OutboundTx = transactions.ID(outboundTx.Int64)
+		}
+		if nonce.Valid {
+			tokenTransfer.Nonce = nonce.Int64
+		}
+		if deadline.Valid {
+			tokenTransfer.Deadline = deadline.Time
+		}
+		tokenTransfer.CreatedAt = tokenTransfer.CreatedAt.UTC()
+		tokenTransfer.Amount.SetBytes(amount)
+
+		tokenTransfers = append(tokenTransfers, tokenTransfer)
+	}
+
+	return tokenTransfers, ErrTokenTransfers.Wrap(rows.Err())
+}
+
 // CountByUser counts total amount of transactions for user in one network.
 func (tokenTransfersDB *tokenTransfersDB) CountByUser(ctx context.Context, networkID networks.ID, userWalletAddress []byte) (amount uint64, err error) {
 	query := `SELECT COUNT(*) as total_value FROM token_transfers as tt WHERE tt.sender_network_id = $1 AND tt.sender_address = $2`
@@ -207,21 +420,184 @@ func (tokenTransfersDB *tokenTransfersDB) CountByUser(ctx context.Context, netwo
 	return amount, nil
 }
 
-// Update updates token transfer in database.
-func (tokenTransfersDB *tokenTransfersDB) Update(ctx context.Context, tokenTransfer transfers.TokenTransfer) error {
+// Update updates token transfer in database and records its status transition to the history in the same
+// transaction. ErrIllegalTransition is returned if transfer is not in the status the transition starts from.
+func (tokenTransfersDB *tokenTransfersDB) Update(ctx context.Context, tokenTransfer transfers.TokenTransfer, transition transfers.StatusTransition) (err error) {
+	if err = transition.Validate(); err != nil {
+		return ErrTokenTransfers.Wrap(err)
+	}
+
+	tx, err := tokenTransfersDB.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return ErrTokenTransfers.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrTokenTransfers.Wrap(tx.Rollback()))
+		}
+	}()
+
+	if err = lockTransition(ctx, tx, tokenTransfer.ID, transition); err != nil {
+		return ErrTokenTransfers.Wrap(err)
+	}
+
+	nonce, deadline := signatureParams(tokenTransfer)
+
 	query := `UPDATE token_transfers SET triggering_tx = $1, outbound_tx = $2, token_id = $3, amount = $4, status = $5, sender_network_id = $6,
-	sender_address = $7, recipient_network_id = $8, recipient_address = $9 WHERE id = $10`
-	result, err := tokenTransfersDB.conn.ExecContext(ctx, query, tokenTransfer.TriggeringTx, tokenTransfer.OutboundTx, tokenTransfer.TokenID,
-		tokenTransfer.Amount.Bytes(), tokenTransfer.Status, tokenTransfer.SenderNetworkID, tokenTransfer.SenderAddress,
-		tokenTransfer.RecipientNetworkID, tokenTransfer.RecipientAddress, tokenTransfer.ID)
+	sender_address = $7, recipient_network_id = $8, recipient_address = $9, nonce = $10, deadline = $11 WHERE id = $12`
+	_, err = tx.ExecContext(ctx, query, tokenTransfer.TriggeringTx, tokenTransfer.OutboundTx, tokenTransfer.TokenID,
+		tokenTransfer.Amount.Bytes(), transition.To, tokenTransfer.SenderNetworkID, tokenTransfer.SenderAddress,
+		tokenTransfer.RecipientNetworkID, tokenTransfer.RecipientAddress, nonce, deadline, tokenTransfer.ID)
 	if err != nil {
 		return ErrTokenTransfers.Wrap(err)
 	}
 
+	if err = recordStatusTransition(ctx, tx, transfers.ID(tokenTransfer.ID), transition); err != nil {
+		return ErrTokenTransfers.Wrap(err)
+	}
+
+	return ErrTokenTransfers.Wrap(tx.Commit())
+}
+
+// CancelWithNonce atomically reserves next nonce of the network and moves token transfer to cancelled status along
+// with recording its transition, returns reserved nonce. The nonce row stays locked until sign returns, so concurrent
+// requests for the same network never share a nonce.
+func (tokenTransfersDB *tokenTransfersDB) CancelWithNonce(ctx context.Context, tokenTransfer transfers.TokenTransfer, networkID networks.ID,
+	transition transfers.StatusTransition, sign transfers.CancelSignFunc) (_ int64, err error) {
+	if err = transition.Validate(); err != nil {
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+
+	tx, err := tokenTransfersDB.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, ErrTokenTransfers.Wrap(tx.Rollback()))
+		}
+	}()
+
+	if err = lockTransition(ctx, tx, tokenTransfer.ID, transition); err != nil {
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+
+	var nonce int64
+	query := "UPDATE network_nonces SET nonce = nonce + 1 WHERE network_id = $1 RETURNING nonce - 1"
+	if err = tx.QueryRowContext(ctx, query, networkID).Scan(&nonce); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrTokenTransfers.Wrap(bridge.ErrNoNetworkNonce)
+		}
+
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+
+	if err = sign(nonce); err != nil {
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "UPDATE token_transfers SET status = $1 WHERE id = $2", transition.To, tokenTransfer.ID); err != nil {
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+
+	if err = recordStatusTransition(ctx, tx, transfers.ID(tokenTransfer.ID), transition); err != nil {
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+
+	return nonce, ErrTokenTransfers.Wrap(tx.Commit())
+}
+
+// lockTransition locks transfer row, so concurrent updates can not move it from the same status twice, and checks that
+// transfer is in the status the transition starts from.
+func lockTransition(ctx context.Context, tx *sql.Tx, id int64, transition transfers.StatusTransition) error {
+	var status transfers.Status
+	if err := tx.QueryRowContext(ctx, "SELECT status FROM token_transfers WHERE id = $1 FOR UPDATE", id).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return bridge.ErrNoTokenTransfer
+		}
+
+		return err
+	}
+	if status != transition.From {
+		return transfers.ErrIllegalTransition.New("transfer %d is %s, it is not %s anymore", id, status, transition.From)
+	}
+
+	return nil
+}
+
+// Expire moves waiting token transfers which deadline has passed more than grace ago to expired status and records
+// their transitions to the history, returns amount of expired transfers.
+func (tokenTransfersDB *tokenTransfersDB) Expire(ctx context.Context, now time.Time, grace time.Duration) (int64, error) {
+	query := `WITH expired AS (
+            UPDATE token_transfers SET status = $1 WHERE status = $2 AND deadline < $5 RETURNING id
+        )
+        INSERT INTO transfer_status_history(transfer_id, from_status, to_status, cause, changed_at)
+        SELECT id, $2, $1, $4, $3 FROM expired`
+	result, err := tokenTransfersDB.conn.ExecContext(ctx, query, transfers.StatusExpired, transfers.StatusWaiting, now,
+		transfers.CauseExpiration, now.Add(-grace))
+	if err != nil {
+		return 0, ErrTokenTransfers.Wrap(err)
+	}
+
 	rowNum, err := result.RowsAffected()
-	if rowNum == 0 && err == nil {
-		return ErrTokenTransfers.Wrap(bridge.ErrNoTokenTransfer)
+	return rowNum, ErrTokenTransfers.Wrap(err)
+}
+
+// scanTokenTransfer scans single token transfer row.
+func scanTokenTransfer(row *sql.Row) (transfers.TokenTransfer, error) {
+	var (
+		tokenTransfer transfers.TokenTransfer
+		outboundTx    sql.NullInt64
+		triggeringTx  sql.NullInt64
+		amount        []byte
+		nonce         sql.NullInt64
+		deadline      sql.NullTime
+	)
+
+	if err := row.Scan(&tokenTransfer.ID, &triggeringTx, &outboundTx, &tokenTransfer.TokenID, &amount,
+		&tokenTransfer.Status, &tokenTransfer.SenderNetworkID, &tokenTransfer.SenderAddress, &tokenTransfer.RecipientNetworkID,
+		&tokenTransfer.RecipientAddress, &nonce, &deadline, &tokenTransfer.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return tokenTransfer, ErrTokenTransfers.Wrap(bridge.ErrNoTokenTransfer)
+		}
+
+		return tokenTransfer, ErrTokenTransfers.Wrap(err)
 	}
 
-	return ErrTokenTransfers.Wrap(err)
+	if triggeringTx.Valid {
+		tokenTransfer.TriggeringTx = transactions.ID(triggeringTx.Int64)
+	}
+	if outboundTx.Valid {
+		tokenTransfer.OutboundTx = transactions.ID(outboundTx.Int64)
+	}
+	if nonce.Valid {
+		tokenTransfer.Nonce = nonce.Int64
+	}
+	if deadline.Valid {
+		tokenTransfer.Deadline = deadline.Time
+	}
+	tokenTransfer.CreatedAt = tokenTransfer.CreatedAt.UTC()
+	tokenTransfer.Amount.SetBytes(amount)
+
+	return tokenTransfer, nil
+}
+
+// networkIDs converts network ids to the type of database column.
+func networkIDs(ids []networks.ID) []int64 {
+	converted := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		converted = append(converted, int64(id))
+	}
+
+	return converted
+}
+
+// signatureParams returns nullable nonce and deadline of token transfer, both are set only for transfers which bridge in
+// signature was issued by the bridge.
+func signatureParams(tokenTransfer transfers.TokenTransfer) (nonce sql.NullInt64, deadline sql.NullTime) {
+	if tokenTransfer.Deadline.IsZero() {
+		return nonce, deadline
+	}
+
+	return sql.NullInt64{Int64: tokenTransfer.Nonce, Valid: true}, sql.NullTime{Time: tokenTransfer.Deadline, Valid: true}
 }
@@ -28,17 +28,18 @@ type transactionsDB struct {
 	conn *sql.DB
 }
 
-// Create inserts transaction to database.
+// Create inserts transaction to database, returns ErrTransactionAlreadyExists if event is already stored.
 func (transactionsDB *transactionsDB) Create(ctx context.Context, transaction transactions.Transaction) (transactions.ID, error) {
 	var id transactions.ID
 
-	query := "INSERT INTO transactions(network_id,tx_hash,sender,block_number,seen_at) VALUES($1,$2,$3,$4,$5) RETURNING id"
+	query := `INSERT INTO transactions(network_id,tx_hash,sender,block_number,seen_at,log_index) VALUES($1,$2,$3,$4,$5,$6)
+	ON CONFLICT (network_id,tx_hash,log_index) DO NOTHING RETURNING id`
 	row := transactionsDB.conn.QueryRowContext(ctx, query, transaction.NetworkID, transaction.TxHash, transaction.Sender,
-		transaction.BlockNumber, transaction.SeenAt)
+		transaction.BlockNumber, transaction.SeenAt, transaction.LogIndex)
 
 	if err := row.Scan(&id); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, ErrTransactions.Wrap(bridge.ErrNoTransaction)
+			return 0, ErrTransactions.Wrap(bridge.ErrTransactionAlreadyExists)
 		}
 
 		return 0, ErrTransactions.Wrap(err)
@@ -47,10 +48,10 @@ func (transactionsDB *transactionsDB) Create(ctx context.Context, transaction tr
 	return id, nil
 }
 
-// Exists returns nil if there is a new txHash for specified networkID.
-func (transactionsDB *transactionsDB) Exists(ctx context.Context, networkID networks.ID, txHash []byte) error {
-	query := "SELECT EXISTS(SELECT tx_Hash FROM transactions WHERE network_id = $1 AND tx_hash = $2)"
-	row := transactionsDB.conn.QueryRowContext(ctx, query, networkID, txHash)
+// Exists returns nil if there is a new event with logIndex in txHash for specified networkID.
+func (transactionsDB *transactionsDB) Exists(ctx context.Context, networkID networks.ID, txHash []byte, logIndex int64) error {
+	query := "SELECT EXISTS(SELECT tx_Hash FROM transactions WHERE network_id = $1 AND tx_hash = $2 AND log_index = $3)"
+	row := transactionsDB.conn.QueryRowContext(ctx, query, networkID, txHash, logIndex)
 
 	var exist bool
 	if err := row.Scan(&exist); err != nil {
@@ -70,10 +71,33 @@ func (transactionsDB *transactionsDB) Get(ctx context.Context, id transactions.I
 		ID: id,
 	}
 
-	query := "SELECT network_id,tx_hash,sender,block_number,seen_at FROM transactions WHERE id = $1"
+	query := "SELECT network_id,tx_hash,sender,block_number,seen_at,log_index FROM transactions WHERE id = $1"
 	row := transactionsDB.conn.QueryRowContext(ctx, query, id)
 
-	if err := row.Scan(&transaction.NetworkID, &transaction.TxHash, &transaction.Sender, &transaction.BlockNumber, &transaction.SeenAt); err != nil {
+	if err := row.Scan(&transaction.NetworkID, &transaction.TxHash, &transaction.Sender, &transaction.BlockNumber, &transaction.SeenAt,
+		&transaction.LogIndex); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return transaction, ErrTransactions.Wrap(bridge.ErrNoTransaction)
+		}
+
+		return transaction, ErrTransactions.Wrap(err)
+	}
+
+	return transaction, nil
+}
+
+// GetByEvent returns transaction of the event with logIndex in txHash for specified networkID.
+func (transactionsDB *transactionsDB) GetByEvent(ctx context.Context, networkID networks.ID, txHash []byte, logIndex int64) (transactions.Transaction, error) {
+	transaction := transactions.Transaction{
+		NetworkID: networkID,
+		TxHash:    txHash,
+		LogIndex:  logIndex,
+	}
+
+	query := "SELECT id,sender,block_number,seen_at FROM transactions WHERE network_id = $1 AND tx_hash = $2 AND log_index = $3"
+	row := transactionsDB.conn.QueryRowContext(ctx, query, networkID, txHash, logIndex)
+
+	if err := row.Scan(&transaction.ID, &transaction.Sender, &transaction.BlockNumber, &transaction.SeenAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return transaction, ErrTransactions.Wrap(bridge.ErrNoTransaction)
 		}
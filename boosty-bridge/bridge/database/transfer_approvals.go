@@ -0,0 +1,126 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/limits"
+	"tricorn/bridge/transfers"
+)
+
+// ensures that transferApprovalsDB implements limits.Approvals.
+var _ limits.Approvals = (*transferApprovalsDB)(nil)
+
+// ErrTransferApprovals indicates that there was an error in the database.
+var ErrTransferApprovals = errs.Class("transfer approvals repository")
+
+// transferApprovalsDB provides access to the queue of transfers which wait for manual approval.
+//
+// architecture: Database
+type transferApprovalsDB struct {
+	conn *sql.DB
+}
+
+// Create enqueues transfer for manual approval, already enqueued transfer is kept as is.
+func (transferApprovalsDB *transferApprovalsDB) Create(ctx context.Context, approval limits.Approval) error {
+	query := `INSERT INTO transfer_approvals(transfer_id, reason, status, created_at) VALUES($1, $2, $3, $4)
+        ON CONFLICT (transfer_id) DO NOTHING`
+
+	_, err := transferApprovalsDB.conn.ExecContext(ctx, query, approval.TransferID, approval.Reason, approval.Status, approval.CreatedAt)
+	return ErrTransferApprovals.Wrap(err)
+}
+
+// Get returns approval of the transfer.
+func (transferApprovalsDB *transferApprovalsDB) Get(ctx context.Context, transferID transfers.ID) (limits.Approval, error) {
+	query := `SELECT transfer_id, reason, status, operator, created_at, decided_at FROM transfer_approvals WHERE transfer_id = $1`
+
+	approval, err := scanApproval(transferApprovalsDB.conn.QueryRowContext(ctx, query, transferID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return limits.Approval{}, limits.ErrApprovalNotFound.New("%d", transferID)
+		}
+
+		return limits.Approval{}, ErrTransferApprovals.Wrap(err)
+	}
+
+	return approval, nil
+}
+
+// List returns approvals in specified status from the oldest to the newest one, all approvals are returned if
+// status is empty.
+func (transferApprovalsDB *transferApprovalsDB) List(ctx context.Context, status limits.ApprovalStatus) (_ []limits.Approval, err error) {
+	query := `SELECT transfer_id, reason, status, operator, created_at, decided_at FROM transfer_approvals
+        WHERE $1 = '' OR status = $1
+        ORDER BY created_at, transfer_id`
+
+	rows, err := transferApprovalsDB.conn.QueryContext(ctx, query, status)
+	if err != nil {
+		return nil, ErrTransferApprovals.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	approvals := make([]limits.Approval, 0)
+	for rows.Next() {
+		approval, err := scanApproval(rows)
+		if err != nil {
+			return nil, ErrTransferApprovals.Wrap(err)
+		}
+
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, ErrTransferApprovals.Wrap(rows.Err())
+}
+
+// Decide changes status of the approval if it is in the expected status, ErrApprovalNotFound is returned otherwise.
+// Operator of the previous decision is kept if decision has no operator.
+func (transferApprovalsDB *transferApprovalsDB) Decide(ctx context.Context, decision limits.Decision) error {
+	query := `UPDATE transfer_approvals SET status = $3, operator = COALESCE(NULLIF($4, ''), operator), decided_at = $5
+        WHERE transfer_id = $1 AND status = $2`
+
+	result, err := transferApprovalsDB.conn.ExecContext(ctx, query, decision.TransferID, decision.From, decision.To,
+		decision.Operator, decision.DecidedAt)
+	if err != nil {
+		return ErrTransferApprovals.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return ErrTransferApprovals.Wrap(err)
+	}
+
+	if rowsAffected == 0 {
+		return limits.ErrApprovalNotFound.New("%s approval of transfer %d", decision.From, decision.TransferID)
+	}
+
+	return nil
+}
+
+// scanApproval reads approval from the row.
+func scanApproval(row interface{ Scan(...interface{}) error }) (limits.Approval, error) {
+	var (
+		approval  limits.Approval
+		operator  sql.NullString
+		decidedAt sql.NullTime
+	)
+	err := row.Scan(&approval.TransferID, &approval.Reason, &approval.Status, &operator, &approval.CreatedAt, &decidedAt)
+	if err != nil {
+		return limits.Approval{}, err
+	}
+
+	approval.Operator = operator.String
+	approval.CreatedAt = approval.CreatedAt.UTC()
+	if decidedAt.Valid {
+		approval.DecidedAt = decidedAt.Time.UTC()
+	}
+
+	return approval, nil
+}
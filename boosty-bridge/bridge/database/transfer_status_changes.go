@@ -0,0 +1,85 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+)
+
+// ensures that transferStatusChangesDB implements transfers.StatusChanges.
+var _ transfers.StatusChanges = (*transferStatusChangesDB)(nil)
+
+// ErrTransferStatusChanges indicates that there was an error in the database.
+var ErrTransferStatusChanges = errs.Class("transfer status changes repository")
+
+const (
+	// transferStatusChannel defines channel, which is notified by token_transfers trigger with id of changed transfer.
+	transferStatusChannel = "token_transfer_status"
+
+	// minReconnectInterval defines minimal interval between attempts to restore lost listener connection.
+	minReconnectInterval = time.Second
+	// maxReconnectInterval defines maximal interval between attempts to restore lost listener connection.
+	maxReconnectInterval = time.Minute
+	// listenerPingInterval defines how often listener connection is checked if there are no notifications.
+	listenerPingInterval = 90 * time.Second
+)
+
+// transferStatusChangesDB provides notifications about changes of token transfers over postgres LISTEN/NOTIFY.
+//
+// architecture: Database
+type transferStatusChangesDB struct {
+	databaseURL string
+}
+
+// Listen sends id of token transfer to changes each time it is created or its status is changed, until context is
+// cancelled. Zero id is sent if notifications could have been missed, so all watched transfers have to be reloaded.
+func (transferStatusChangesDB *transferStatusChangesDB) Listen(ctx context.Context, changes chan<- transfers.ID) (err error) {
+	listener := pq.NewListener(transferStatusChangesDB.databaseURL, minReconnectInterval, maxReconnectInterval, nil)
+	defer func() {
+		err = errs.Combine(err, ErrTransferStatusChanges.Wrap(listener.Close()))
+	}()
+
+	if err = listener.Listen(transferStatusChannel); err != nil {
+		return ErrTransferStatusChanges.Wrap(err)
+	}
+
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+
+	for {
+		var id transfers.ID
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// failed ping makes listener reconnect, nil notification is sent then.
+			_ = listener.Ping()
+			continue
+		case notification := <-listener.Notify:
+			// nil notification is sent after connection was restored, notifications could have been lost meanwhile.
+			if notification != nil {
+				parsed, err := strconv.ParseUint(notification.Extra, 10, 64)
+				if err != nil {
+					return ErrTransferStatusChanges.Wrap(err)
+				}
+
+				id = transfers.ID(parsed)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case changes <- id:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+)
+
+// ensures that transferStatusHistoryDB implements transfers.StatusHistory.
+var _ transfers.StatusHistory = (*transferStatusHistoryDB)(nil)
+
+// ErrTransferStatusHistory indicates that there was an error in the database.
+var ErrTransferStatusHistory = errs.Class("transfer status history repository")
+
+// transferStatusHistoryDB provides access to the recorded status transitions of token transfers.
+//
+// architecture: Database
+type transferStatusHistoryDB struct {
+	conn *sql.DB
+}
+
+// List returns status transitions of the transfer from the oldest one to the newest one.
+func (transferStatusHistoryDB *transferStatusHistoryDB) List(ctx context.Context, transferID transfers.ID) (_ []transfers.StatusTransition, err error) {
+	query := `SELECT from_status, to_status, cause, changed_at FROM transfer_status_history
+        WHERE transfer_id = $1
+        ORDER BY id`
+
+	rows, err := transferStatusHistoryDB.conn.QueryContext(ctx, query, transferID)
+	if err != nil {
+		return nil, ErrTransferStatusHistory.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	timeline := make([]transfers.StatusTransition, 0)
+	for rows.Next() {
+		var transition transfers.StatusTransition
+		if err = rows.Scan(&transition.From, &transition.To, &transition.Cause, &transition.ChangedAt); err != nil {
+			return nil, ErrTransferStatusHistory.Wrap(err)
+		}
+
+		transition.ChangedAt = transition.ChangedAt.UTC()
+		timeline = append(timeline, transition)
+	}
+
+	return timeline, ErrTransferStatusHistory.Wrap(rows.Err())
+}
+
+// recordStatusTransition inserts status transition of the transfer to the history through connection or transaction.
+func recordStatusTransition(ctx context.Context, conn interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}, transferID transfers.ID, transition transfers.StatusTransition) error {
+	query := `INSERT INTO transfer_status_history(transfer_id, from_status, to_status, cause, changed_at)
+        VALUES($1, $2, $3, $4, $5)`
+
+	_, err := conn.ExecContext(ctx, query, transferID, transition.From, transition.To, transition.Cause, transition.ChangedAt)
+	return err
+}
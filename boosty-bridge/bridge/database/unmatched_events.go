@@ -0,0 +1,87 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge"
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+)
+
+// ensures that unmatchedEventsDB implements transfers.UnmatchedEvents.
+var _ transfers.UnmatchedEvents = (*unmatchedEventsDB)(nil)
+
+// ErrUnmatchedEvents indicates that there was an error in the database.
+var ErrUnmatchedEvents = errs.Class("unmatched events repository")
+
+// unmatchedEventsDB provide access to unmatched events DB.
+//
+// architecture: Database
+type unmatchedEventsDB struct {
+	conn *sql.DB
+}
+
+// Create inserts unmatched event to database.
+func (unmatchedEventsDB *unmatchedEventsDB) Create(ctx context.Context, event transfers.UnmatchedEvent) error {
+	query := `INSERT INTO unmatched_events(kind,network_id,tx_hash,reference,amount,reason,created_at)
+	VALUES($1,$2,$3,$4,$5,$6,$7)`
+	_, err := unmatchedEventsDB.conn.ExecContext(ctx, query, event.Kind, event.NetworkID, event.TxHash, event.Reference,
+		event.Amount.Bytes(), event.Reason, event.CreatedAt)
+	return ErrUnmatchedEvents.Wrap(err)
+}
+
+// List returns unmatched events which are not reviewed yet from database.
+func (unmatchedEventsDB *unmatchedEventsDB) List(ctx context.Context) (_ []transfers.UnmatchedEvent, err error) {
+	query := `SELECT id,kind,network_id,tx_hash,reference,amount,reason,created_at
+	FROM unmatched_events WHERE resolved_at IS NULL ORDER BY id`
+	rows, err := unmatchedEventsDB.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrUnmatchedEvents.Wrap(err)
+	}
+
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	var events []transfers.UnmatchedEvent
+	for rows.Next() {
+		var (
+			event     transfers.UnmatchedEvent
+			networkID int64
+			amount    []byte
+		)
+		if err = rows.Scan(&event.ID, &event.Kind, &networkID, &event.TxHash, &event.Reference, &amount,
+			&event.Reason, &event.CreatedAt); err != nil {
+			return nil, ErrUnmatchedEvents.Wrap(err)
+		}
+
+		event.NetworkID = networks.ID(networkID)
+		event.Amount.SetBytes(amount)
+		events = append(events, event)
+	}
+
+	return events, ErrUnmatchedEvents.Wrap(rows.Err())
+}
+
+// Resolve marks unmatched event as reviewed.
+func (unmatchedEventsDB *unmatchedEventsDB) Resolve(ctx context.Context, id int64, resolvedAt time.Time) error {
+	query := "UPDATE unmatched_events SET resolved_at = $1 WHERE id = $2 AND resolved_at IS NULL"
+	result, err := unmatchedEventsDB.conn.ExecContext(ctx, query, resolvedAt, id)
+	if err != nil {
+		return ErrUnmatchedEvents.Wrap(err)
+	}
+
+	rowNum, err := result.RowsAffected()
+	if rowNum == 0 && err == nil {
+		return ErrUnmatchedEvents.Wrap(bridge.ErrNoUnmatchedEvent)
+	}
+
+	return ErrUnmatchedEvents.Wrap(err)
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+)
+
+// ensures that webhookEndpointsDB implements webhooks.Endpoints.
+var _ webhooks.Endpoints = (*webhookEndpointsDB)(nil)
+
+// ErrWebhookEndpoints indicates that there was an error in the database.
+var ErrWebhookEndpoints = errs.Class("webhook endpoints repository")
+
+// webhookEndpointsDB provides access to webhook endpoints DB.
+//
+// architecture: Database
+type webhookEndpointsDB struct {
+	conn *sql.DB
+}
+
+// Create inserts endpoint to the database and returns its id.
+func (webhookEndpointsDB *webhookEndpointsDB) Create(ctx context.Context, endpoint webhooks.Endpoint) (webhooks.EndpointID, error) {
+	query := `INSERT INTO webhook_endpoints(owner, url, secret, statuses, created_at)
+	          VALUES($1, $2, $3, $4, $5) RETURNING id`
+
+	var id webhooks.EndpointID
+	err := webhookEndpointsDB.conn.QueryRowContext(ctx, query, endpoint.Owner, endpoint.URL, endpoint.Secret,
+		pq.Array(statusStrings(endpoint.Statuses)), endpoint.CreatedAt).Scan(&id)
+	return id, ErrWebhookEndpoints.Wrap(err)
+}
+
+// Get returns endpoint by id from the database.
+func (webhookEndpointsDB *webhookEndpointsDB) Get(ctx context.Context, id webhooks.EndpointID) (webhooks.Endpoint, error) {
+	query := "SELECT id, owner, url, secret, statuses, created_at FROM webhook_endpoints WHERE id = $1"
+
+	endpoint, err := scanWebhookEndpoint(webhookEndpointsDB.conn.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return endpoint, ErrWebhookEndpoints.Wrap(webhooks.ErrEndpointNotFound.New("%d", id))
+	}
+
+	return endpoint, ErrWebhookEndpoints.Wrap(err)
+}
+
+// List returns endpoints of the integrator from the database.
+func (webhookEndpointsDB *webhookEndpointsDB) List(ctx context.Context, owner string) (_ []webhooks.Endpoint, err error) {
+	query := "SELECT id, owner, url, secret, statuses, created_at FROM webhook_endpoints WHERE owner = $1 ORDER BY id"
+	rows, err := webhookEndpointsDB.conn.QueryContext(ctx, query, owner)
+	if err != nil {
+		return nil, ErrWebhookEndpoints.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	endpoints := make([]webhooks.Endpoint, 0)
+	for rows.Next() {
+		endpoint, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, ErrWebhookEndpoints.Wrap(err)
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints, ErrWebhookEndpoints.Wrap(rows.Err())
+}
+
+// Delete deletes endpoint of the integrator from the database, its deliveries are deleted by cascade.
+func (webhookEndpointsDB *webhookEndpointsDB) Delete(ctx context.Context, owner string, id webhooks.EndpointID) error {
+	query := "DELETE FROM webhook_endpoints WHERE id = $1 AND owner = $2"
+	result, err := webhookEndpointsDB.conn.ExecContext(ctx, query, id, owner)
+	if err != nil {
+		return ErrWebhookEndpoints.Wrap(err)
+	}
+
+	rowNum, err := result.RowsAffected()
+	if rowNum == 0 && err == nil {
+		return ErrWebhookEndpoints.Wrap(webhooks.ErrEndpointNotFound.New("%d", id))
+	}
+
+	return ErrWebhookEndpoints.Wrap(err)
+}
+
+// scanWebhookEndpoint scans endpoint from the row.
+func scanWebhookEndpoint(row interface{ Scan(...interface{}) error }) (webhooks.Endpoint, error) {
+	var (
+		endpoint webhooks.Endpoint
+		statuses []string
+	)
+	err := row.Scan(&endpoint.ID, &endpoint.Owner, &endpoint.URL, &endpoint.Secret, pq.Array(&statuses), &endpoint.CreatedAt)
+	if err != nil {
+		return endpoint, err
+	}
+
+	endpoint.Statuses = make([]transfers.Status, 0, len(statuses))
+	for _, status := range statuses {
+		endpoint.Statuses = append(endpoint.Statuses, transfers.Status(status))
+	}
+
+	return endpoint, nil
+}
+
+// statusStrings converts transfer statuses to strings, which are stored in the database.
+func statusStrings(statuses []transfers.Status) []string {
+	result := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, string(status))
+	}
+
+	return result
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transactions"
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+)
+
+// ensures that webhookOutboxDB implements webhooks.Outbox.
+var _ webhooks.Outbox = (*webhookOutboxDB)(nil)
+
+// ErrWebhookOutbox indicates that there was an error in the database.
+var ErrWebhookOutbox = errs.Class("webhook outbox repository")
+
+// webhookDeliveryColumns defines selected columns of the webhook_outbox table.
+const webhookDeliveryColumns = `id, endpoint_id, transfer_id, status, previous_status, state, attempts, next_attempt_at,
+        last_error, created_at, delivered_at, transfer`
+
+// webhookOutboxDB provides access to webhook deliveries DB, which are enqueued by token_transfers trigger.
+//
+// architecture: Database
+type webhookOutboxDB struct {
+	conn *sql.DB
+}
+
+// Claim returns up to limit pending deliveries which are due at now and postpones them until leaseUntil, so they
+// are not claimed again while being sent. Delivery is not claimed until earlier pending delivery of the same
+// transfer to the same endpoint is sent.
+func (webhookOutboxDB *webhookOutboxDB) Claim(ctx context.Context, now, leaseUntil time.Time, limit int) (_ []webhooks.Delivery, err error) {
+	query := fmt.Sprintf(`UPDATE webhook_outbox SET next_attempt_at = $2
+        WHERE id IN (
+            SELECT wo.id FROM webhook_outbox AS wo
+            WHERE wo.state = $3 AND wo.next_attempt_at <= $1 AND NOT EXISTS (
+                SELECT 1 FROM webhook_outbox AS earlier
+                WHERE earlier.endpoint_id = wo.endpoint_id AND earlier.transfer_id = wo.transfer_id
+                AND earlier.state = $3 AND earlier.id < wo.id
+            )
+            ORDER BY wo.id
+            LIMIT $4
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING %s`, webhookDeliveryColumns)
+
+	rows, err := webhookOutboxDB.conn.QueryContext(ctx, query, now, leaseUntil, webhooks.DeliveryStatePending, limit)
+	if err != nil {
+		return nil, ErrWebhookOutbox.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	return scanWebhookDeliveries(rows)
+}
+
+// MarkDelivered records successful attempt of the delivery.
+func (webhookOutboxDB *webhookOutboxDB) MarkDelivered(ctx context.Context, id webhooks.DeliveryID, deliveredAt time.Time) error {
+	query := `UPDATE webhook_outbox SET state = $1, attempts = attempts + 1, last_error = '', delivered_at = $2
+	          WHERE id = $3`
+	return webhookOutboxDB.update(ctx, query, webhooks.DeliveryStateDelivered, deliveredAt, id)
+}
+
+// MarkRetry records failed attempt of the delivery, which is retried at nextAttemptAt.
+func (webhookOutboxDB *webhookOutboxDB) MarkRetry(ctx context.Context, id webhooks.DeliveryID, nextAttemptAt time.Time, lastError string) error {
+	query := `UPDATE webhook_outbox SET attempts = attempts + 1, next_attempt_at = $1, last_error = $2
+	          WHERE id = $3`
+	return webhookOutboxDB.update(ctx, query, nextAttemptAt, lastError, id)
+}
+
+// MarkDead records failed attempt of the delivery and moves it to dead letters.
+func (webhookOutboxDB *webhookOutboxDB) MarkDead(ctx context.Context, id webhooks.DeliveryID, lastError string) error {
+	query := "UPDATE webhook_outbox SET state = $1, attempts = attempts + 1, last_error = $2 WHERE id = $3"
+	return webhookOutboxDB.update(ctx, query, webhooks.DeliveryStateDead, lastError, id)
+}
+
+// List returns the newest deliveries of the endpoint, all states are returned if state is empty.
+func (webhookOutboxDB *webhookOutboxDB) List(ctx context.Context, endpointID webhooks.EndpointID, state webhooks.DeliveryState, limit int) (_ []webhooks.Delivery, err error) {
+	query := fmt.Sprintf(`SELECT %s FROM webhook_outbox
+        WHERE endpoint_id = $1 AND ($2 = '' OR state = $2)
+        ORDER BY id DESC
+        LIMIT $3`, webhookDeliveryColumns)
+
+	rows, err := webhookOutboxDB.conn.QueryContext(ctx, query, endpointID, state, limit)
+	if err != nil {
+		return nil, ErrWebhookOutbox.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	return scanWebhookDeliveries(rows)
+}
+
+// Replay makes delivery of the endpoint pending again at now, all dead deliveries of the endpoint are replayed if
+// delivery id is zero. Returns amount of replayed deliveries.
+func (webhookOutboxDB *webhookOutboxDB) Replay(ctx context.Context, endpointID webhooks.EndpointID, deliveryID webhooks.DeliveryID, now time.Time) (int64, error) {
+	query := `UPDATE webhook_outbox SET state = $1, attempts = 0, next_attempt_at = $2, last_error = '', delivered_at = NULL
+	          WHERE endpoint_id = $3 AND ((id = $4 AND $4 <> 0) OR ($4 = 0 AND state = $5))`
+	result, err := webhookOutboxDB.conn.ExecContext(ctx, query, webhooks.DeliveryStatePending, now, endpointID, deliveryID,
+		webhooks.DeliveryStateDead)
+	if err != nil {
+		return 0, ErrWebhookOutbox.Wrap(err)
+	}
+
+	replayed, err := result.RowsAffected()
+	return replayed, ErrWebhookOutbox.Wrap(err)
+}
+
+// update executes update query of the single delivery.
+func (webhookOutboxDB *webhookOutboxDB) update(ctx context.Context, query string, args ...interface{}) error {
+	result, err := webhookOutboxDB.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return ErrWebhookOutbox.Wrap(err)
+	}
+
+	rowNum, err := result.RowsAffected()
+	if rowNum == 0 && err == nil {
+		return ErrWebhookOutbox.Wrap(webhooks.ErrDeliveryNotFound.New("%v", args[len(args)-1]))
+	}
+
+	return ErrWebhookOutbox.Wrap(err)
+}
+
+// scanWebhookDeliveries scans deliveries from the rows.
+func scanWebhookDeliveries(rows *sql.Rows) ([]webhooks.Delivery, error) {
+	deliveries := make([]webhooks.Delivery, 0)
+	for rows.Next() {
+		var (
+			delivery    webhooks.Delivery
+			deliveredAt sql.NullTime
+			snapshot    []byte
+		)
+		err := rows.Scan(&delivery.ID, &delivery.EndpointID, &delivery.TransferID, &delivery.Status,
+			&delivery.PreviousStatus, &delivery.State, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastError,
+			&delivery.CreatedAt, &deliveredAt, &snapshot)
+		if err != nil {
+			return nil, ErrWebhookOutbox.Wrap(err)
+		}
+
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = &deliveredAt.Time
+		}
+
+		if snapshot != nil {
+			if delivery.Transfer, err = decodeTransferSnapshot(delivery.TransferID, snapshot); err != nil {
+				return nil, ErrWebhookOutbox.Wrap(err)
+			}
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, ErrWebhookOutbox.Wrap(rows.Err())
+}
+
+// transferSnapshotJSON is a snapshot of the token transfer, which is built by the outbox trigger, bytes are hex encoded.
+type transferSnapshotJSON struct {
+	TokenID            int64                    `json:"tokenId"`
+	Amount             string                   `json:"amount"`
+	Status             transfers.Status         `json:"status"`
+	SenderNetworkID    int64                    `json:"senderNetworkId"`
+	SenderAddress      string                   `json:"senderAddress"`
+	RecipientNetworkID int64                    `json:"recipientNetworkId"`
+	RecipientAddress   string                   `json:"recipientAddress"`
+	CreatedAt          time.Time                `json:"createdAt"`
+	TriggeringTx       *transactionSnapshotJSON `json:"triggeringTx"`
+	OutboundTx         *transactionSnapshotJSON `json:"outboundTx"`
+}
+
+// transactionSnapshotJSON is a snapshot of the transaction of the token transfer.
+type transactionSnapshotJSON struct {
+	NetworkID networks.ID `json:"networkId"`
+	TxHash    string      `json:"txHash"`
+	SeenAt    time.Time   `json:"seenAt"`
+}
+
+// decodeTransferSnapshot decodes snapshot of the token transfer stored to the outbox by the trigger.
+func decodeTransferSnapshot(transferID transfers.ID, data []byte) (*webhooks.TransferSnapshot, error) {
+	var snapshot transferSnapshotJSON
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+
+	var err error
+	decode := func(value string) []byte {
+		decoded, decodeErr := hex.DecodeString(value)
+		err = errs.Combine(err, decodeErr)
+		return decoded
+	}
+
+	tokenTransfer := transfers.TokenTransfer{
+		ID:                 int64(transferID),
+		TokenID:            snapshot.TokenID,
+		Status:             snapshot.Status,
+		SenderNetworkID:    snapshot.SenderNetworkID,
+		SenderAddress:      decode(snapshot.SenderAddress),
+		RecipientNetworkID: snapshot.RecipientNetworkID,
+		RecipientAddress:   decode(snapshot.RecipientAddress),
+		CreatedAt:          snapshot.CreatedAt,
+	}
+	tokenTransfer.Amount = *new(big.Int).SetBytes(decode(snapshot.Amount))
+
+	transaction := func(snapshot *transactionSnapshotJSON) *transactions.Transaction {
+		if snapshot == nil {
+			return nil
+		}
+
+		return &transactions.Transaction{NetworkID: snapshot.NetworkID, TxHash: decode(snapshot.TxHash), SeenAt: snapshot.SeenAt}
+	}
+
+	transferSnapshot := &webhooks.TransferSnapshot{
+		TokenTransfer: tokenTransfer,
+		TriggeringTx:  transaction(snapshot.TriggeringTx),
+		OutboundTx:    transaction(snapshot.OutboundTx),
+	}
+
+	return transferSnapshot, err
+}
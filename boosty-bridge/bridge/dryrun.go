@@ -0,0 +1,160 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tricorn/bridge/dryrun"
+	"tricorn/bridge/limits"
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+	"tricorn/chains"
+)
+
+// dryRunEvent records decision of the bridge in dry run mode on the event. Funds out events are results of bridge outs
+// of the live bridge, so there is nothing to decide on, same as on funds in events removed from the chain.
+func (service *Service) dryRunEvent(ctx context.Context, eventFund chains.EventVariant, networkName networks.Name) error {
+	if eventFund.Type != chains.EventTypeIn || eventFund.EventFundsIn.Removed {
+		return nil
+	}
+
+	decision, err := service.decideEventIn(ctx, eventFund.EventFundsIn, networkName)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Debug(fmt.Sprintf("dry run decision on funds in event from tx %x: %s %s", decision.Event.TxHash,
+		decision.Action, decision.Reason))
+
+	return Error.Wrap(service.dryRunDecisions.Create(ctx, decision))
+}
+
+// decideEventIn decides on funds in event by decideFundsIn as eventInReaction does, but only reads the database, so
+// bridge in dry run mode shares it with the live bridge. Limits are checked against volumes counted by the live bridge.
+func (service *Service) decideEventIn(ctx context.Context, event chains.EventFundsIn, networkName networks.Name) (dryrun.Decision, error) {
+	senderNetworkID, _ := networks.IDByName(networkName)
+	decision := dryrun.Decision{
+		Event: dryrun.Event{
+			NetworkID:   senderNetworkID,
+			TxHash:      event.Tx.Hash,
+			LogIndex:    event.Tx.LogIndex,
+			BlockNumber: event.Tx.BlockNumber,
+		},
+		DecidedAt: time.Now().UTC(),
+	}
+
+	// live bridge rejects event with malformed addresses or amount as well.
+	in, err := parseFundsIn(event, networkName)
+	if err != nil {
+		return decision, err
+	}
+
+	tokenTransfer, err := service.tokenTransfers.GetByNonce(ctx, senderNetworkID, int64(event.Nonce))
+	if err != nil {
+		if !errors.Is(err, ErrNoTokenTransfer) {
+			return decision, err
+		}
+
+		decision.Action, decision.Reason = dryrun.ActionUnmatched, "no transfer with such nonce"
+		return decision, nil
+	}
+
+	// live bridge may have reacted on the event already, then transfer is judged as it was before the reaction.
+	triggered, err := service.isTriggeredBy(ctx, tokenTransfer, decision.Event)
+	if err != nil {
+		return decision, err
+	}
+	judged := tokenTransfer
+	if triggered {
+		judged.Status = transfers.StatusWaiting
+	}
+
+	fundsInDecision, err := service.decideFundsIn(ctx, judged, in)
+	if err != nil {
+		return decision, err
+	}
+
+	decision.Action, decision.Reason = fundsInDecision.action, fundsInDecision.reason
+	if decision.Action == dryrun.ActionUnmatched {
+		return decision, nil
+	}
+
+	token, err := service.networkTokens.Get(ctx, in.recipientNetworkID, 1) // TODO: add dynamic token id.
+	if err != nil {
+		return decision, err
+	}
+
+	decision.TransferID = transfers.ID(tokenTransfer.ID)
+	decision.BridgeOut = dryrun.BridgeOut{
+		RecipientNetworkID: in.recipientNetworkID,
+		RecipientAddress:   in.recipientAddress,
+		Amount:             in.amount,
+		Token:              token.ContractAddress,
+	}
+	if decision.Action != dryrun.ActionBridgeOut {
+		return decision, nil
+	}
+
+	// transfer, which live bridge has already counted in volumes, would be checked against its own amount otherwise.
+	counted := false
+	if triggered {
+		if counted, err = service.isCounted(ctx, tokenTransfer); err != nil {
+			return decision, err
+		}
+	}
+
+	usage := limits.Usage{
+		TokenID:            tokenTransfer.TokenID,
+		SenderNetworkID:    in.senderNetworkID,
+		RecipientNetworkID: in.recipientNetworkID,
+		SenderAddress:      in.senderAddress,
+		Amount:             in.amount,
+		At:                 time.Now().UTC(),
+	}
+	check := service.limiter.Check
+	if counted {
+		check = service.limiter.CheckCounted
+	}
+
+	violation, err := check(ctx, usage)
+	if err != nil {
+		return decision, err
+	}
+	if violation != "" {
+		decision.Action, decision.Reason = dryrun.ActionApproval, violation
+	}
+
+	return decision, nil
+}
+
+// isCounted reports whether live bridge has counted the transfer in volumes of limits. Transfer is counted once its
+// bridge out is attempted, so transfer waiting in the approval queue or in the backlog of paused networks is not counted.
+func (service *Service) isCounted(ctx context.Context, tokenTransfer transfers.TokenTransfer) (bool, error) {
+	if tokenTransfer.Status != transfers.StatusConfirming && tokenTransfer.Status != transfers.StatusFinished {
+		return false, nil
+	}
+
+	return service.isAttempted(ctx, transfers.ID(tokenTransfer.ID))
+}
+
+// isTriggeredBy reports whether live bridge has bound the transfer to the transaction of the event.
+func (service *Service) isTriggeredBy(ctx context.Context, tokenTransfer transfers.TokenTransfer, event dryrun.Event) (bool, error) {
+	if tokenTransfer.TriggeringTx == 0 {
+		return false, nil
+	}
+
+	transaction, err := service.transactions.GetByEvent(ctx, event.NetworkID, event.TxHash, int64(event.LogIndex))
+	if err != nil {
+		if errors.Is(err, ErrNoTransaction) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return transaction.ID == tokenTransfer.TriggeringTx, nil
+}
@@ -0,0 +1,178 @@
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+)
+
+// ErrDryRun indicates that there was an error in the dry run of the bridge.
+var ErrDryRun = errs.Class("dry run")
+
+// Decisions exposes access to the decisions made by the bridge running in dry run mode.
+//
+// architecture: DB
+type Decisions interface {
+	// Create records decision on the event, decision on the event which is already recorded is ignored.
+	Create(ctx context.Context, decision Decision) error
+	// List returns recorded decisions from the oldest one.
+	List(ctx context.Context) ([]Decision, error)
+}
+
+// Outcomes exposes access to the outcomes of the events processed by the live bridge.
+//
+// architecture: DB
+type Outcomes interface {
+	// Get returns outcome of the funds in event processed by the live bridge, outcome without action is returned
+	// if live bridge has not processed the event yet.
+	Get(ctx context.Context, event Event) (Outcome, error)
+}
+
+// Action defines what bridge does with the transfer on its funds in event.
+type Action string
+
+const (
+	// ActionBridgeOut sends bridge out of the transfer.
+	ActionBridgeOut Action = "BRIDGE_OUT"
+	// ActionDefer defers bridge out of the transfer until paused bridge or network is resumed.
+	ActionDefer Action = "DEFER"
+	// ActionApproval holds transfer, which exceeds limits, for manual approval.
+	ActionApproval Action = "APPROVAL"
+	// ActionHold holds transfer, which address was flagged by screening.
+	ActionHold Action = "HOLD"
+	// ActionUnmatched flags event, which is not bound to any transfer, for manual review.
+	ActionUnmatched Action = "UNMATCHED"
+)
+
+// Event identifies funds in event on its network.
+type Event struct {
+	NetworkID   networks.ID `json:"networkId"`
+	TxHash      []byte      `json:"txHash"`
+	LogIndex    uint64      `json:"logIndex"`
+	BlockNumber uint64      `json:"blockNumber"`
+}
+
+// BridgeOut describes bridge out of the transfer. Token is a contract address of the token on the recipient network,
+// it is known for bridge out of the dry run only.
+type BridgeOut struct {
+	RecipientNetworkID networks.ID `json:"recipientNetworkId"`
+	RecipientAddress   []byte      `json:"recipientAddress"`
+	Amount             *big.Int    `json:"amount"`
+	Token              []byte      `json:"token,omitempty"`
+}
+
+// Decision describes what bridge running in dry run mode would have done on the funds in event. Bridge out is
+// described for each event bound to the transfer, even if it is not sent right away.
+type Decision struct {
+	Event      Event        `json:"event"`
+	TransferID transfers.ID `json:"transferId,omitempty"`
+	Action     Action       `json:"action"`
+	// Reason explains why bridge out is not sent right away.
+	Reason    string    `json:"reason,omitempty"`
+	BridgeOut BridgeOut `json:"bridgeOut"`
+	DecidedAt time.Time `json:"decidedAt"`
+}
+
+// Outcome describes what live bridge did on the funds in event.
+type Outcome struct {
+	// Action is empty if live bridge has not processed the event yet.
+	Action     Action       `json:"action,omitempty"`
+	TransferID transfers.ID `json:"transferId,omitempty"`
+	BridgeOut  BridgeOut    `json:"bridgeOut"`
+}
+
+// Comparison describes decision of the dry run along with outcome of the same event in the live bridge.
+type Comparison struct {
+	Decision Decision `json:"decision"`
+	Live     Outcome  `json:"live"`
+	// Divergence describes how decision differs from the live outcome, it is empty if they agree.
+	Divergence string `json:"divergence,omitempty"`
+}
+
+// IsPending reports whether live bridge has not processed the event yet.
+func (comparison Comparison) IsPending() bool {
+	return comparison.Live.Action == ""
+}
+
+// Compare compares decision of the dry run with outcome of the same event in the live bridge. Deferred transfer is
+// bridged out by the live bridge once it is resumed, so its bridge out agrees with deferral.
+func Compare(decision Decision, live Outcome) Comparison {
+	comparison := Comparison{
+		Decision: decision,
+		Live:     live,
+	}
+
+	switch {
+	case comparison.IsPending():
+	case decision.Action != live.Action && !(decision.Action == ActionDefer && live.Action == ActionBridgeOut):
+		comparison.Divergence = fmt.Sprintf("action %s differs from live %s", decision.Action, live.Action)
+	case decision.TransferID != live.TransferID:
+		comparison.Divergence = fmt.Sprintf("transfer %d differs from live %d", decision.TransferID, live.TransferID)
+	case decision.TransferID != 0:
+		comparison.Divergence = compareBridgeOut(decision.BridgeOut, live.BridgeOut)
+	}
+
+	return comparison
+}
+
+// compareBridgeOut describes how bridge out of the dry run differs from the live one, token is not compared since live
+// bridge does not record it.
+func compareBridgeOut(bridgeOut, live BridgeOut) string {
+	switch {
+	case bridgeOut.RecipientNetworkID != live.RecipientNetworkID:
+		return fmt.Sprintf("recipient network %d differs from live %d", bridgeOut.RecipientNetworkID, live.RecipientNetworkID)
+	case !bytes.Equal(bridgeOut.RecipientAddress, live.RecipientAddress):
+		return fmt.Sprintf("recipient address %x differs from live %x", bridgeOut.RecipientAddress, live.RecipientAddress)
+	case bridgeOut.Amount == nil || live.Amount == nil || bridgeOut.Amount.Cmp(live.Amount) != 0:
+		return fmt.Sprintf("amount %s differs from live %s", bridgeOut.Amount, live.Amount)
+	default:
+		return ""
+	}
+}
+
+// Report describes how decisions of the dry run agree with the live bridge. Decisions on events, which live bridge
+// has not processed yet, are counted as pending.
+type Report struct {
+	Decisions   int          `json:"decisions"`
+	Agreed      int          `json:"agreed"`
+	Pending     int          `json:"pending"`
+	Divergences []Comparison `json:"divergences"`
+}
+
+// NewReport compares all recorded decisions of the dry run with outcomes of the live bridge.
+func NewReport(ctx context.Context, decisions Decisions, outcomes Outcomes) (Report, error) {
+	list, err := decisions.List(ctx)
+	if err != nil {
+		return Report{}, ErrDryRun.Wrap(err)
+	}
+
+	report := Report{
+		Decisions:   len(list),
+		Divergences: make([]Comparison, 0),
+	}
+	for _, decision := range list {
+		live, err := outcomes.Get(ctx, decision.Event)
+		if err != nil {
+			return Report{}, ErrDryRun.Wrap(err)
+		}
+
+		comparison := Compare(decision, live)
+		switch {
+		case comparison.IsPending():
+			report.Pending++
+		case comparison.Divergence != "":
+			report.Divergences = append(report.Divergences, comparison)
+		default:
+			report.Agreed++
+		}
+	}
+
+	return report, nil
+}
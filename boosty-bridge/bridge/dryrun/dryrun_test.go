@@ -0,0 +1,90 @@
+package dryrun_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/dryrun"
+	"tricorn/bridge/networks"
+)
+
+func TestCompare(t *testing.T) {
+	bridgeOut := dryrun.BridgeOut{
+		RecipientNetworkID: networks.IDEth,
+		RecipientAddress:   []byte{1, 2, 3},
+		Amount:             big.NewInt(100),
+		Token:              []byte{4, 5},
+	}
+	decision := dryrun.Decision{TransferID: 1, Action: dryrun.ActionBridgeOut, BridgeOut: bridgeOut}
+	live := dryrun.Outcome{TransferID: 1, Action: dryrun.ActionBridgeOut, BridgeOut: bridgeOut}
+	live.BridgeOut.Token = nil
+
+	comparison := dryrun.Compare(decision, live)
+	assert.False(t, comparison.IsPending())
+	assert.Empty(t, comparison.Divergence)
+
+	pending := dryrun.Compare(decision, dryrun.Outcome{})
+	assert.True(t, pending.IsPending())
+	assert.Empty(t, pending.Divergence)
+
+	deferred := decision
+	deferred.Action = dryrun.ActionDefer
+	assert.Empty(t, dryrun.Compare(deferred, live).Divergence)
+
+	held := live
+	held.Action = dryrun.ActionHold
+	assert.Equal(t, "action BRIDGE_OUT differs from live HOLD", dryrun.Compare(decision, held).Divergence)
+
+	unmatched := dryrun.Decision{Action: dryrun.ActionUnmatched}
+	assert.Empty(t, dryrun.Compare(unmatched, dryrun.Outcome{Action: dryrun.ActionUnmatched}).Divergence)
+
+	otherTransfer := live
+	otherTransfer.TransferID = 2
+	assert.Equal(t, "transfer 1 differs from live 2", dryrun.Compare(decision, otherTransfer).Divergence)
+
+	otherAmount := live
+	otherAmount.BridgeOut.Amount = big.NewInt(99)
+	assert.Equal(t, "amount 100 differs from live 99", dryrun.Compare(decision, otherAmount).Divergence)
+
+	otherRecipient := live
+	otherRecipient.BridgeOut.RecipientAddress = []byte{3, 2, 1}
+	assert.Equal(t, "recipient address 010203 differs from live 030201", dryrun.Compare(decision, otherRecipient).Divergence)
+}
+
+// decisionsList is a list of decisions of the dry run.
+type decisionsList []dryrun.Decision
+
+func (list decisionsList) Create(ctx context.Context, decision dryrun.Decision) error { return nil }
+
+func (list decisionsList) List(ctx context.Context) ([]dryrun.Decision, error) { return list, nil }
+
+// outcomesByLogIndex returns outcome of the live bridge by log index of the event.
+type outcomesByLogIndex map[uint64]dryrun.Outcome
+
+func (outcomes outcomesByLogIndex) Get(ctx context.Context, event dryrun.Event) (dryrun.Outcome, error) {
+	return outcomes[event.LogIndex], nil
+}
+
+func TestNewReport(t *testing.T) {
+	decisions := decisionsList{
+		{Event: dryrun.Event{LogIndex: 1}, Action: dryrun.ActionUnmatched},
+		{Event: dryrun.Event{LogIndex: 2}, Action: dryrun.ActionUnmatched},
+		{Event: dryrun.Event{LogIndex: 3}, Action: dryrun.ActionUnmatched},
+	}
+	outcomes := outcomesByLogIndex{
+		1: {Action: dryrun.ActionUnmatched},
+		2: {Action: dryrun.ActionBridgeOut, TransferID: 2},
+	}
+
+	report, err := dryrun.NewReport(context.Background(), decisions, outcomes)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Decisions)
+	assert.Equal(t, 1, report.Agreed)
+	assert.Equal(t, 1, report.Pending)
+	require.Len(t, report.Divergences, 1)
+	assert.Equal(t, uint64(2), report.Divergences[0].Decision.Event.LogIndex)
+}
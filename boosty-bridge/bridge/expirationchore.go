@@ -0,0 +1,59 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tricorn/bridge/transfers"
+	"tricorn/internal/logger"
+)
+
+// ExpirationChore moves waiting transfers which bridge in signature deadline has passed to expired status. Transfers
+// are expired only after grace passes since the deadline, so funds in sent right before it are indexed first.
+//
+// architecture: Chore
+type ExpirationChore struct {
+	log logger.Logger
+
+	tokenTransfers transfers.TokenTransfers
+	interval       time.Duration
+	grace          time.Duration
+}
+
+// NewExpirationChore instantiates ExpirationChore.
+func NewExpirationChore(log logger.Logger, tokenTransfers transfers.TokenTransfers, interval, grace time.Duration) *ExpirationChore {
+	return &ExpirationChore{
+		log:            log,
+		tokenTransfers: tokenTransfers,
+		interval:       interval,
+		grace:          grace,
+	}
+}
+
+// Run expires overdue waiting transfers every interval until context is cancelled.
+func (chore *ExpirationChore) Run(ctx context.Context) error {
+	ticker := time.NewTicker(chore.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		expired, err := chore.tokenTransfers.Expire(ctx, time.Now().UTC(), chore.grace)
+		if err != nil {
+			chore.log.Error("couldn't expire waiting transfers", Error.Wrap(err))
+			continue
+		}
+
+		if expired > 0 {
+			chore.log.Debug(fmt.Sprintf("%d waiting transfers expired", expired))
+		}
+	}
+}
@@ -14,8 +14,10 @@ import (
 
 	peer "tricorn"
 	"tricorn/bridge/gateway"
+	"tricorn/bridge/interventions"
 	"tricorn/bridge/networks"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/communication"
 	"tricorn/communication/mockcommunication"
 	"tricorn/communication/rpc"
@@ -58,6 +60,12 @@ func Run(t *testing.T, test func(ctx context.Context, t *testing.T)) {
 		// declares all transfers specific modules.
 		transfers *transfers.Service
 
+		// declares all webhooks specific modules.
+		webhooks *webhooks.Service
+
+		// declares all interventions specific modules.
+		interventions *interventions.Service
+
 		// declares all gateway server specific modules.
 		listener net.Listener
 		server   *gateway.Server
@@ -85,6 +93,18 @@ func Run(t *testing.T, test func(ctx context.Context, t *testing.T)) {
 		)
 	}
 
+	{ // webhooks setup.
+		g.webhooks = webhooks.NewService(
+			g.communication.Webhooks(),
+		)
+	}
+
+	{ // interventions setup.
+		g.interventions = interventions.NewService(
+			g.communication.Interventions(),
+		)
+	}
+
 	{ // server setup.
 		g.listener, err = net.Listen("tcp", config.Server.Address)
 		require.NoError(t, err)
@@ -95,6 +115,8 @@ func Run(t *testing.T, test func(ctx context.Context, t *testing.T)) {
 			g.listener,
 			g.networks,
 			g.transfers,
+			g.webhooks,
+			g.interventions,
 		)
 	}
 
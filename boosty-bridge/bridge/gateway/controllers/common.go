@@ -1,6 +1,61 @@
 package controllers
 
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"tricorn/internal/logger"
+)
+
+// bearerPrefix is the first part of the Authorization header value.
+const bearerPrefix = "Bearer "
+
 // ErrorResponse is a type used to send api error response.
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// apiKey is api key of the integrator or operator.
+type apiKey struct {
+	owner string
+	key   []byte
+}
+
+// parseAPIKeys parses api keys in the "owner:key" format, malformed ones are ignored.
+func parseAPIKeys(log logger.Logger, kind string, entries []string) []apiKey {
+	apiKeys := make([]apiKey, 0, len(entries))
+	for _, entry := range entries {
+		owner, key, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || owner == "" || key == "" {
+			log.Warn("ignoring malformed " + kind + " api key, expected owner:key format")
+			continue
+		}
+
+		apiKeys = append(apiKeys, apiKey{owner: owner, key: []byte(key)})
+	}
+
+	return apiKeys
+}
+
+// authenticate returns owner of the api key passed as bearer token.
+func authenticate(apiKeys []apiKey, r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", errors.New("api key is required")
+	}
+
+	key := []byte(strings.TrimPrefix(header, bearerPrefix))
+	owner := ""
+	for _, apiKey := range apiKeys {
+		if subtle.ConstantTimeCompare(apiKey.key, key) == 1 {
+			owner = apiKey.owner
+		}
+	}
+	if owner == "" {
+		return "", errors.New("api key is invalid")
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/transfers"
+	"tricorn/internal/logger"
+)
+
+// ErrInterventions is an internal error type for interventions controller.
+var ErrInterventions = errs.Class("interventions controller")
+
+// operatorKey is the context key of the operator authenticated by api key.
+type operatorKey struct{}
+
+// Interventions is an api controller that exposes operator interventions into the stuck transfers.
+type Interventions struct {
+	log logger.Logger
+
+	interventions *interventions.Service
+
+	apiKeys []apiKey
+}
+
+// NewInterventions is a constructor for interventions api controller, apiKeys are in the "operator:key" format and
+// malformed ones are ignored.
+func NewInterventions(log logger.Logger, interventions *interventions.Service, apiKeys []string) *Interventions {
+	return &Interventions{
+		log:           log,
+		interventions: interventions,
+		apiKeys:       parseAPIKeys(log, "operator", apiKeys),
+	}
+}
+
+// Authenticate resolves operator by the api key passed as bearer token and rejects requests without valid key.
+func (controller *Interventions) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operator, err := authenticate(controller.apiKeys, r)
+		if err != nil {
+			controller.serveError(w, http.StatusUnauthorized, ErrInterventions.Wrap(err))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), operatorKey{}, operator)))
+	})
+}
+
+// Intervene performs operator intervention into the transfer, operator is identified by the api key.
+func (controller *Interventions) Intervene(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+
+	transferID, err := parseTransferID(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrInterventions.Wrap(err))
+		return
+	}
+
+	request := struct {
+		Kind        string `json:"kind"`
+		Reason      string `json:"reason"`
+		TxHash      string `json:"txHash"`
+		BlockNumber uint64 `json:"blockNumber"`
+		Note        string `json:"note"`
+	}{}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrInterventions.Wrap(err))
+		return
+	}
+
+	operator, _ := ctx.Value(operatorKey{}).(string)
+	entry, err := controller.interventions.Intervene(ctx, interventions.Request{
+		TransferID:  transferID,
+		Kind:        interventions.Kind(strings.ToUpper(request.Kind)),
+		Operator:    operator,
+		Reason:      request.Reason,
+		TxHash:      request.TxHash,
+		BlockNumber: request.BlockNumber,
+		Note:        request.Note,
+	})
+	if err != nil {
+		controller.serveInterventionsError(w, "could not intervene into transfer", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(entry); err != nil {
+		controller.log.Error("failed to write json error response", ErrInterventions.Wrap(err))
+	}
+}
+
+// AuditLog returns audit log of the transfer from the oldest entry to the newest one.
+func (controller *Interventions) AuditLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+
+	transferID, err := parseTransferID(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrInterventions.Wrap(err))
+		return
+	}
+
+	entries, err := controller.interventions.AuditLog(ctx, transferID)
+	if err != nil {
+		controller.serveInterventionsError(w, "could not return audit log", err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(entries); err != nil {
+		controller.log.Error("failed to write json error response", ErrInterventions.Wrap(err))
+	}
+}
+
+// parseTransferID parses transfer id path parameter.
+func parseTransferID(r *http.Request) (transfers.ID, error) {
+	transferID, err := strconv.ParseUint(mux.Vars(r)["transfer-id"], 10, 64)
+	if err != nil {
+		return 0, errs.Combine(errors.New("transfer-id parameter invalid"), err)
+	}
+
+	return transfers.ID(transferID), nil
+}
+
+// serveInterventionsError replies to the request with status code which corresponds to the interventions error.
+func (controller *Interventions) serveInterventionsError(w http.ResponseWriter, msg string, err error) {
+	switch {
+	case interventions.ErrInvalidRequest.Has(err):
+		controller.serveError(w, http.StatusBadRequest, ErrInterventions.Wrap(err))
+	case interventions.ErrTransferNotFound.Has(err):
+		controller.serveError(w, http.StatusNotFound, ErrInterventions.Wrap(err))
+	case interventions.ErrNotAllowed.Has(err):
+		controller.serveError(w, http.StatusConflict, ErrInterventions.Wrap(err))
+	default:
+		controller.log.Error(msg, ErrInterventions.Wrap(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrInterventions.Wrap(err))
+	}
+}
+
+// serveError replies to the request with specific code and error message.
+func (controller *Interventions) serveError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	response := ErrorResponse{
+		Error: err.Error(),
+	}
+
+	if err = json.NewEncoder(w).Encode(response); err != nil {
+		controller.log.Error("failed to write json error response", err)
+	}
+}
@@ -0,0 +1,106 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/gateway/controllers/apitesting"
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/transfers"
+	"tricorn/internal/config/envparse"
+)
+
+func TestInterventions(t *testing.T) {
+	err := godotenv.Overload("./apitesting/configs/.test.gateway.env")
+	if err != nil {
+		t.Fatalf("could not load config: %v", err)
+	}
+
+	config := new(apitesting.Config)
+	envOpt := env.Options{RequiredIfNoDef: true}
+	err = env.ParseWithFuncs(config, envparse.EvmParseOpts(), envOpt)
+	if err != nil {
+		t.Fatalf("could not parse ENV config: %v", err)
+	}
+
+	require.NotEmpty(t, config.Server.OperatorAPIKeys)
+	operator, apiKey, _ := strings.Cut(config.Server.OperatorAPIKeys[0], ":")
+
+	apitesting.Run(t, func(ctx context.Context, t *testing.T) {
+		baseURL := fmt.Sprintf("http://%s/api/v1/operator/transfers/1", config.Server.Address)
+
+		do := func(method, url, key string, body io.Reader) *http.Response {
+			req, err := http.NewRequestWithContext(ctx, method, url, body)
+			require.NoError(t, err)
+			if key != "" {
+				req.Header.Set("Authorization", "Bearer "+key)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			return resp
+		}
+
+		t.Run("api key missing", func(t *testing.T) {
+			resp := do(http.MethodGet, baseURL+"/audit-log", "", nil)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("webhook api key is not accepted", func(t *testing.T) {
+			_, webhookKey, _ := strings.Cut(config.Server.WebhookAPIKeys[0], ":")
+			resp := do(http.MethodGet, baseURL+"/audit-log", webhookKey, nil)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("intervene without reason", func(t *testing.T) {
+			resp := do(http.MethodPost, baseURL+"/interventions", apiKey, strings.NewReader(`{"kind":"retry"}`))
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("intervene unknown kind", func(t *testing.T) {
+			resp := do(http.MethodPost, baseURL+"/interventions", apiKey, strings.NewReader(`{"kind":"delete","reason":"stuck"}`))
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("annotate", func(t *testing.T) {
+			resp := do(http.MethodPost, baseURL+"/interventions", apiKey,
+				strings.NewReader(`{"kind":"annotate","reason":"support ticket","note":"user contacted support"}`))
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+
+			var entry interventions.Entry
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&entry))
+			assert.Equal(t, transfers.ID(1), entry.TransferID)
+			assert.Equal(t, interventions.KindAnnotate, entry.Kind)
+			assert.Equal(t, operator, entry.Operator)
+			assert.Equal(t, "support ticket", entry.Reason)
+		})
+
+		t.Run("audit log", func(t *testing.T) {
+			resp := do(http.MethodGet, baseURL+"/audit-log", apiKey, nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+
+			var entries []interventions.Entry
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&entries))
+		})
+	})
+}
@@ -7,11 +7,15 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
 
 	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/transfers"
 	"tricorn/internal/logger"
 )
@@ -24,13 +28,18 @@ type Transfers struct {
 	log logger.Logger
 
 	transfers *transfers.Service
+
+	webAppAddress     string
+	heartbeatInterval time.Duration
 }
 
 // NewTransfers is a constructor for transfers api controller.
-func NewTransfers(log logger.Logger, transfers *transfers.Service) *Transfers {
+func NewTransfers(log logger.Logger, transfers *transfers.Service, webAppAddress string, heartbeatInterval time.Duration) *Transfers {
 	return &Transfers{
-		log:       log,
-		transfers: transfers,
+		log:               log,
+		transfers:         transfers,
+		webAppAddress:     webAppAddress,
+		heartbeatInterval: heartbeatInterval,
 	}
 }
 
@@ -143,6 +152,112 @@ func (controller *Transfers) History(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// HistoryByCursor returns filtered list of transfers, which is paginated by cursor.
+func (controller *Transfers) HistoryByCursor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+
+	request, err := parseHistoryRequest(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrTransfers.Wrap(err))
+		return
+	}
+
+	history, err := controller.transfers.HistoryByCursor(ctx, request)
+	if err != nil {
+		controller.log.Error("could not get transfer history", ErrTransfers.Wrap(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrTransfers.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(history); err != nil {
+		controller.log.Error("failed to write json error response", ErrTransfers.Wrap(err))
+	}
+}
+
+// parseHistoryRequest parses path and query parameters of the history request.
+func parseHistoryRequest(r *http.Request) (request transfers.HistoryRequest, err error) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	request.Signature, err = hex.DecodeString(params["signature-hex"])
+	if err != nil {
+		return request, errs.Combine(errors.New("signature parameter is not in hex format"), err)
+	}
+
+	request.PublicKey, err = hex.DecodeString(params["pub-key-hex"])
+	if err != nil {
+		return request, errs.Combine(errors.New("public key parameter is not in hex format"), err)
+	}
+
+	networkID, err := strconv.ParseUint(query.Get("network-id"), 10, 32)
+	if err != nil {
+		return request, errs.Combine(errors.New("network-id parameter invalid"), err)
+	}
+	request.NetworkID = uint32(networkID)
+
+	if limit := query.Get("limit"); limit != "" {
+		if request.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			return request, errs.Combine(errors.New("limit parameter invalid"), err)
+		}
+	}
+
+	request.Cursor = query.Get("cursor")
+	request.Sort = transfers.Sort(strings.ToUpper(query.Get("sort")))
+	if request.Sort == "" {
+		request.Sort = transfers.SortNewest
+	}
+	if err = request.Sort.Validate(); err != nil {
+		return request, err
+	}
+
+	for _, statusParam := range query["status"] {
+		status := transfers.Status(strings.ToUpper(statusParam))
+		if err = status.Validate(); err != nil {
+			return request, err
+		}
+		request.Filter.Statuses = append(request.Filter.Statuses, status)
+	}
+
+	for _, senderNetwork := range query["sender-network-id"] {
+		id, err := strconv.ParseUint(senderNetwork, 10, 32)
+		if err != nil {
+			return request, errs.Combine(errors.New("sender-network-id parameter invalid"), err)
+		}
+		request.Filter.SenderNetworks = append(request.Filter.SenderNetworks, networks.ID(id))
+	}
+
+	for _, recipientNetwork := range query["recipient-network-id"] {
+		id, err := strconv.ParseUint(recipientNetwork, 10, 32)
+		if err != nil {
+			return request, errs.Combine(errors.New("recipient-network-id parameter invalid"), err)
+		}
+		request.Filter.RecipientNetworks = append(request.Filter.RecipientNetworks, networks.ID(id))
+	}
+
+	for _, token := range query["token-id"] {
+		id, err := strconv.ParseUint(token, 10, 32)
+		if err != nil {
+			return request, errs.Combine(errors.New("token-id parameter invalid"), err)
+		}
+		request.Filter.TokenIDs = append(request.Filter.TokenIDs, int64(id))
+	}
+
+	if createdFrom := query.Get("created-from"); createdFrom != "" {
+		if request.Filter.CreatedFrom, err = time.Parse(time.RFC3339, createdFrom); err != nil {
+			return request, errs.Combine(errors.New("created-from parameter invalid"), err)
+		}
+	}
+
+	if createdTo := query.Get("created-to"); createdTo != "" {
+		if request.Filter.CreatedTo, err = time.Parse(time.RFC3339, createdTo); err != nil {
+			return request, errs.Combine(errors.New("created-to parameter invalid"), err)
+		}
+	}
+
+	return request, nil
+}
+
 // BridgeInSignature returns signature for user to send bridgeIn transaction.
 func (controller *Transfers) BridgeInSignature(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -166,6 +281,15 @@ func (controller *Transfers) BridgeInSignature(w http.ResponseWriter, r *http.Re
 		Destination: request.Destination,
 	})
 	if err != nil {
+		switch {
+		case pause.ErrPaused.Has(err):
+			controller.serveError(w, http.StatusServiceUnavailable, ErrTransfers.Wrap(err))
+			return
+		case screening.ErrDenied.Has(err):
+			controller.serveError(w, http.StatusForbidden, ErrTransfers.Wrap(err))
+			return
+		}
+
 		controller.log.Error("could not get bridge in signature", ErrTransfers.Wrap(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrTransfers.Wrap(err))
 		return
@@ -216,13 +340,19 @@ func (controller *Transfers) CancelSignature(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	signature, err := networks.StringToBytes(networks.ID(networkID), signatureParam)
+	networkCodec, err := networks.ID(networkID).Codec()
 	if err != nil {
 		controller.serveError(w, http.StatusBadRequest, ErrTransfers.Wrap(err))
 		return
 	}
 
-	publicKey, err := networks.StringToBytes(networks.ID(networkID), publicKeyParam)
+	signature, err := networkCodec.ParseSignature(signatureParam)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrTransfers.Wrap(err))
+		return
+	}
+
+	publicKey, err := networkCodec.ParsePublicKey(publicKeyParam)
 	if err != nil {
 		controller.serveError(w, http.StatusBadRequest, ErrTransfers.Wrap(err))
 		return
@@ -240,6 +370,27 @@ func (controller *Transfers) CancelSignature(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	signatureString, err := networkCodec.FormatSignature(signatureResponse.Signature)
+	if err != nil {
+		controller.log.Error("could not format cancel signature", ErrTransfers.Wrap(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrTransfers.Wrap(err))
+		return
+	}
+
+	token, err := networkCodec.FormatContract(signatureResponse.Token)
+	if err != nil {
+		controller.log.Error("could not format token", ErrTransfers.Wrap(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrTransfers.Wrap(err))
+		return
+	}
+
+	recipient, err := networkCodec.FormatAddress(signatureResponse.Recipient)
+	if err != nil {
+		controller.log.Error("could not format recipient", ErrTransfers.Wrap(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrTransfers.Wrap(err))
+		return
+	}
+
 	response := struct {
 		Status     string `json:"status"`
 		Nonce      uint64 `json:"nonce"`
@@ -251,9 +402,9 @@ func (controller *Transfers) CancelSignature(w http.ResponseWriter, r *http.Requ
 	}{
 		Status:     signatureResponse.Status,
 		Nonce:      signatureResponse.Nonce,
-		Signature:  fmt.Sprintf("0x%s", networks.BytesToString(networks.ID(networkID), signatureResponse.Signature)),
-		Token:      networks.BytesToString(networks.ID(networkID), signatureResponse.Token),
-		Recipient:  networks.BytesToString(networks.ID(networkID), signatureResponse.Recipient),
+		Signature:  signatureString,
+		Token:      token,
+		Recipient:  recipient,
 		Commission: signatureResponse.Commission,
 		Amount:     signatureResponse.Amount,
 	}
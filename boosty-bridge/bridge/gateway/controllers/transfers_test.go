@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/caarlos0/env/v6"
+	"github.com/gorilla/websocket"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +19,7 @@ import (
 	transferspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/transfers"
 
 	"tricorn/bridge/gateway"
+	"tricorn/bridge/gateway/controllers"
 	"tricorn/bridge/gateway/controllers/apitesting"
 	"tricorn/bridge/networks"
 	"tricorn/bridge/transfers"
@@ -253,6 +256,134 @@ func TestTransfers(t *testing.T) {
 			}
 		})
 
+		historyV1URL := fmt.Sprintf("http://%s/api/v1/transfers/history/", config.Server.Address) +
+			"34850b7e36e635783df0563c7202c3ac776df59db5015d2b6f0add33955bb5c43ce35efb5ce695a243bc4c5dc4298db40cd765f3ea5612d2d57da1e4933b2f201b/" +
+			"34850b7e36e635783df0563c7202c3ac776df59db5015d2b6f0add33955bb5c43ce35efb5ce695a243bc4c5dc4298db40cd765f3ea5612d2d57da1e4933b2f201b"
+
+		t.Run("transfer history by cursor wrong parameters", func(t *testing.T) {
+			queries := []string{
+				"?limit=1",
+				"?network-id=1&limit=w",
+				"?network-id=1&sort=random",
+				"?network-id=1&status=unknown",
+				"?network-id=1&sender-network-id=w",
+				"?network-id=1&token-id=w",
+				"?network-id=1&created-from=yesterday",
+			}
+
+			for _, query := range queries {
+				resp, err := apitesting.HTTPDo(ctx, historyV1URL+query, http.MethodGet, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+				require.NoError(t, resp.Body.Close())
+			}
+		})
+
+		t.Run("transfer history by cursor", func(t *testing.T) {
+			query := "?network-id=1&limit=1&sort=oldest&status=finished&status=waiting&recipient-network-id=4&token-id=1&created-from=2023-01-01T00:00:00Z"
+			resp, err := apitesting.HTTPDo(ctx, historyV1URL+query, http.MethodGet, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			defer func() {
+				err = resp.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			var result transfers.CursorPage
+			err = json.NewDecoder(resp.Body).Decode(&result)
+			require.NoError(t, err)
+
+			expected, err := g.transfers.HistoryByCursor(ctx, transfers.HistoryRequest{NetworkID: 1, Limit: 1})
+			require.NoError(t, err)
+
+			assert.Equal(t, expected.NextCursor, result.NextCursor)
+			require.Equal(t, len(expected.Transfers), len(result.Transfers))
+			for i := 0; i < len(expected.Transfers); i++ {
+				assert.Equal(t, expected.Transfers[i].ID, result.Transfers[i].ID)
+				assert.Equal(t, expected.Transfers[i].Amount, result.Transfers[i].Amount)
+				assert.Equal(t, expected.Transfers[i].Status, result.Transfers[i].Status)
+				assert.Equal(t, expected.Transfers[i].TriggeringTx, result.Transfers[i].TriggeringTx)
+			}
+		})
+
+		transfersV1URL := fmt.Sprintf("http://%s/api/v1/transfers", config.Server.Address)
+		txPath := "/tx/GOERLI/0x879e513fcdf956e8f65ba9267b1e278cb8afca733bd0b0f8456bc8fe6d2c3a62"
+
+		t.Run("watch transfer wrong parameters", func(t *testing.T) {
+			urls := []string{
+				transfersV1URL + "/17/events?last-event-id=17:UNKNOWN",
+				transfersV1URL + "/17/events?last-event-id=17",
+				transfersV1URL + txPath + "/ws?last-event-id=x:FINISHED",
+			}
+
+			for _, url := range urls {
+				resp, err := apitesting.HTTPDo(ctx, url, http.MethodGet, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode, url)
+				require.NoError(t, resp.Body.Close())
+			}
+		})
+
+		t.Run("watch transfer events", func(t *testing.T) {
+			resp, err := apitesting.HTTPDo(ctx, transfersV1URL+"/17/events", http.MethodGet, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+			defer func() {
+				err = resp.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			events := strings.Split(strings.TrimSpace(string(body)), "\n\n")
+			require.Len(t, events, 3)
+			assert.True(t, strings.HasPrefix(events[0], "id: 17:CONFIRMING\nevent: transfer\ndata: "))
+			assert.True(t, strings.HasPrefix(events[1], "id: 17:FINISHED\nevent: transfer\ndata: "))
+			assert.Equal(t, "event: done\ndata: {}", events[2])
+
+			var transfer transfers.Transfer
+			err = json.Unmarshal([]byte(strings.SplitN(events[1], "data: ", 2)[1]), &transfer)
+			require.NoError(t, err)
+			assert.Equal(t, transfers.ID(17), transfer.ID)
+			assert.Equal(t, transfers.StatusFinished, transfer.Status)
+		})
+
+		t.Run("watch transfer events resumed in final status", func(t *testing.T) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, transfersV1URL+txPath+"/events", nil)
+			require.NoError(t, err)
+			req.Header.Set("Last-Event-ID", "17:FINISHED")
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("watch transfer websocket", func(t *testing.T) {
+			url := strings.Replace(transfersV1URL, "http://", "ws://", 1) + txPath + "/ws?last-event-id=17:CONFIRMING"
+			conn, resp, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			defer func() {
+				require.NoError(t, conn.Close())
+			}()
+
+			var event controllers.WatchEvent
+			require.NoError(t, conn.ReadJSON(&event))
+			assert.Equal(t, "transfer", event.Event)
+			assert.Equal(t, "17:FINISHED", event.ID)
+			require.NotNil(t, event.Transfer)
+			assert.Equal(t, transfers.StatusFinished, event.Transfer.Status)
+
+			require.NoError(t, conn.ReadJSON(&event))
+			assert.Equal(t, "done", event.Event)
+
+			_, _, err = conn.ReadMessage()
+			assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+		})
+
 		t.Run("get bridge in signature", func(t *testing.T) {
 			url := baseURL + "/bridge-in-signature"
 			request := transferspb.BridgeInSignatureRequest{
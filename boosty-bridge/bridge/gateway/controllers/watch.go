@@ -0,0 +1,279 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"tricorn/bridge/transfers"
+)
+
+const (
+	// lastEventIDHeader defines header, which is set by EventSource to the id of the last received event on reconnection.
+	lastEventIDHeader = "Last-Event-ID"
+	// lastEventIDParam defines query parameter with id of the last received event, it is used by websocket clients and
+	// by server-sent events clients which can't set headers.
+	lastEventIDParam = "last-event-id"
+
+	// eventTransfer defines name of the event with transfer update.
+	eventTransfer = "transfer"
+	// eventDone defines name of the event, which is sent when transfer status became final and stream is closed.
+	eventDone = "done"
+	// eventError defines name of the event, which is sent when watching failed after stream was started.
+	eventError = "error"
+
+	// websocketWriteTimeout defines how long websocket message can be written.
+	websocketWriteTimeout = 10 * time.Second
+)
+
+// WatchEvent is a message, which is sent to websocket clients.
+type WatchEvent struct {
+	Event    string              `json:"event"`
+	ID       string              `json:"id,omitempty"`
+	Transfer *transfers.Transfer `json:"transfer,omitempty"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// WatchEvents streams status changes of transfer as server-sent events until it is finished, cancelled or expired.
+// Transfer is identified by id or by network name and triggering transaction hash.
+func (controller *Transfers) WatchEvents(w http.ResponseWriter, r *http.Request) {
+	lastEventID := r.Header.Get(lastEventIDHeader)
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get(lastEventIDParam)
+	}
+
+	req, err := parseWatchRequest(r, lastEventID)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		controller.serveError(w, http.StatusBadRequest, ErrTransfers.Wrap(err))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.Header().Set("Content-Type", "application/json")
+		controller.serveError(w, http.StatusInternalServerError, ErrTransfers.New("streaming is not supported"))
+		return
+	}
+
+	// headers are written lazily, so errors which happened before the first event are replied with proper status.
+	started := false
+	writeEvent := func(format string, args ...interface{}) error {
+		if !started {
+			started = true
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("Connection", "keep-alive")
+			w.Header().Set("X-Accel-Buffering", "no")
+			w.WriteHeader(http.StatusOK)
+		}
+
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return err
+		}
+
+		flusher.Flush()
+		return nil
+	}
+
+	onUpdate := func(transfer transfers.Transfer) error {
+		// transfer is marshaled by pointer as amount implements json.Marshaler on pointer receiver.
+		data, err := json.Marshal(&transfer)
+		if err != nil {
+			return err
+		}
+
+		return writeEvent("id: %s\nevent: %s\ndata: %s\n\n", transfer.EventID(), eventTransfer, data)
+	}
+	onHeartbeat := func() error {
+		return writeEvent(": heartbeat\n\n")
+	}
+
+	err = controller.watch(r.Context(), req, onUpdate, onHeartbeat)
+	switch {
+	case r.Context().Err() != nil:
+		return
+	case err != nil && !started:
+		controller.log.Error("could not watch transfer", ErrTransfers.Wrap(err))
+		w.Header().Set("Content-Type", "application/json")
+		controller.serveError(w, watchErrorStatus(err), ErrTransfers.Wrap(err))
+	case err != nil:
+		controller.log.Error("could not watch transfer", ErrTransfers.Wrap(err))
+		data, _ := json.Marshal(ErrorResponse{Error: ErrTransfers.Wrap(err).Error()})
+		_ = writeEvent("event: %s\ndata: %s\n\n", eventError, data)
+	case !started:
+		// no content stops EventSource from reconnecting to transfer, which client has already seen in final status.
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		_ = writeEvent("event: %s\ndata: {}\n\n", eventDone)
+	}
+}
+
+// WatchWebSocket streams status changes of transfer over websocket until it is finished, cancelled or expired.
+// Transfer is identified by id or by network name and triggering transaction hash.
+func (controller *Transfers) WatchWebSocket(w http.ResponseWriter, r *http.Request) {
+	req, err := parseWatchRequest(r, r.URL.Query().Get(lastEventIDParam))
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		controller.serveError(w, http.StatusBadRequest, ErrTransfers.Wrap(err))
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			return origin == "" || origin == controller.webAppAddress || origin == "http://"+r.Host || origin == "https://"+r.Host
+		},
+	}
+
+	// upgrader replies with error by itself.
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		controller.log.Error("could not upgrade to websocket", ErrTransfers.Wrap(err))
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			controller.log.Error("could not close websocket", ErrTransfers.Wrap(err))
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// client messages are discarded, reading is needed to handle pong and close frames. Client is considered gone if
+	// it does not answer to pings for two heartbeat intervals.
+	readDeadline := func() time.Time {
+		return time.Now().Add(2 * controller.heartbeatInterval)
+	}
+	_ = conn.SetReadDeadline(readDeadline())
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(readDeadline())
+	})
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	writeEvent := func(event WatchEvent) error {
+		if err := conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout)); err != nil {
+			return err
+		}
+
+		return conn.WriteJSON(event)
+	}
+
+	onUpdate := func(transfer transfers.Transfer) error {
+		return writeEvent(WatchEvent{Event: eventTransfer, ID: transfer.EventID(), Transfer: &transfer})
+	}
+	onHeartbeat := func() error {
+		return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(websocketWriteTimeout))
+	}
+
+	err = controller.watch(ctx, req, onUpdate, onHeartbeat)
+	if ctx.Err() != nil {
+		return
+	}
+
+	closeCode, closeText := websocket.CloseNormalClosure, eventDone
+	if err != nil {
+		controller.log.Error("could not watch transfer", ErrTransfers.Wrap(err))
+		_ = writeEvent(WatchEvent{Event: eventError, Error: ErrTransfers.Wrap(err).Error()})
+		closeCode, closeText = websocket.CloseInternalServerErr, eventError
+		if watchErrorStatus(err) != http.StatusInternalServerError {
+			closeCode = websocket.ClosePolicyViolation
+		}
+	} else {
+		_ = writeEvent(WatchEvent{Event: eventDone})
+	}
+
+	closeMessage := websocket.FormatCloseMessage(closeCode, closeText)
+	_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(websocketWriteTimeout))
+}
+
+// watch watches transfer and calls onUpdate for each its update and onHeartbeat every heartbeat interval, until
+// transfer status becomes final, context is cancelled or callback fails.
+func (controller *Transfers) watch(ctx context.Context, req transfers.WatchRequest, onUpdate func(transfers.Transfer) error, onHeartbeat func() error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	updates := make(chan transfers.Transfer)
+	watchErr := make(chan error, 1)
+	go func() {
+		defer close(updates)
+		watchErr <- controller.transfers.Watch(ctx, req, updates)
+	}()
+
+	ticker := time.NewTicker(controller.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case transfer, ok := <-updates:
+			if !ok {
+				return <-watchErr
+			}
+
+			if err := onUpdate(transfer); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := onHeartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseWatchRequest parses watched transfer from path parameters and resume point from id of the last received event.
+func parseWatchRequest(r *http.Request, lastEventID string) (req transfers.WatchRequest, err error) {
+	params := mux.Vars(r)
+	if transferID, ok := params["transfer-id"]; ok {
+		id, err := strconv.ParseUint(transferID, 10, 64)
+		if err != nil {
+			return req, transfers.ErrInvalidWatchRequest.New("transfer-id parameter invalid")
+		}
+
+		req.TransferID = transfers.ID(id)
+	} else {
+		req.NetworkName = params["network-name"]
+		req.TxHash = params["tx"]
+	}
+
+	if lastEventID != "" {
+		transferID, status, err := transfers.ParseEventID(lastEventID)
+		if err != nil {
+			return req, err
+		}
+
+		// resume point of other transfer is ignored. Id of the transfer watched by tx is not known until it is loaded
+		// by bridge, so resume point is trusted then.
+		if req.TransferID == 0 || req.TransferID == transferID {
+			req.LastStatus = status
+		}
+	}
+
+	return req, req.Validate()
+}
+
+// watchErrorStatus returns http status code of the watching error.
+func watchErrorStatus(err error) int {
+	switch {
+	case transfers.ErrInvalidWatchRequest.Has(err):
+		return http.StatusBadRequest
+	case transfers.ErrTransferNotFound.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
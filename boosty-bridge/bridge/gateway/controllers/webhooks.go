@@ -0,0 +1,245 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+	"tricorn/internal/logger"
+)
+
+// ErrWebhooks is an internal error type for webhooks controller.
+var ErrWebhooks = errs.Class("webhooks controller")
+
+// ownerKey is the context key of the integrator authenticated by api key.
+type ownerKey struct{}
+
+// Webhooks is an api controller that exposes webhooks management endpoints to integrators.
+type Webhooks struct {
+	log logger.Logger
+
+	webhooks *webhooks.Service
+
+	apiKeys []apiKey
+}
+
+// NewWebhooks is a constructor for webhooks api controller, apiKeys are in the "owner:key" format and malformed ones
+// are ignored.
+func NewWebhooks(log logger.Logger, webhooks *webhooks.Service, apiKeys []string) *Webhooks {
+	return &Webhooks{
+		log:      log,
+		webhooks: webhooks,
+		apiKeys:  parseAPIKeys(log, "webhook", apiKeys),
+	}
+}
+
+// Authenticate resolves integrator by the api key passed as bearer token and rejects requests without valid key.
+func (controller *Webhooks) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		owner, err := authenticate(controller.apiKeys, r)
+		if err != nil {
+			controller.serveError(w, http.StatusUnauthorized, ErrWebhooks.Wrap(err))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ownerKey{}, owner)))
+	})
+}
+
+// Create registers webhook endpoint of the integrator, signing secret is returned only in this response.
+func (controller *Webhooks) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+
+	request := struct {
+		URL      string   `json:"url"`
+		Statuses []string `json:"statuses"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrWebhooks.Wrap(err))
+		return
+	}
+
+	statuses := make([]transfers.Status, 0, len(request.Statuses))
+	for _, status := range request.Statuses {
+		statuses = append(statuses, transfers.Status(strings.ToUpper(status)))
+	}
+
+	endpoint, err := controller.webhooks.CreateEndpoint(ctx, webhooks.CreateEndpointRequest{
+		Owner:    owner(ctx),
+		URL:      request.URL,
+		Statuses: statuses,
+	})
+	if err != nil {
+		controller.serveWebhooksError(w, "could not create webhook endpoint", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(endpoint); err != nil {
+		controller.log.Error("failed to write json error response", ErrWebhooks.Wrap(err))
+	}
+}
+
+// List returns webhook endpoints of the integrator.
+func (controller *Webhooks) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+
+	endpoints, err := controller.webhooks.ListEndpoints(ctx, owner(ctx))
+	if err != nil {
+		controller.serveWebhooksError(w, "could not list webhook endpoints", err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(endpoints); err != nil {
+		controller.log.Error("failed to write json error response", ErrWebhooks.Wrap(err))
+	}
+}
+
+// Delete deletes webhook endpoint of the integrator together with its deliveries.
+func (controller *Webhooks) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	endpointID, err := parseEndpointID(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrWebhooks.Wrap(err))
+		return
+	}
+
+	if err = controller.webhooks.DeleteEndpoint(ctx, owner(ctx), endpointID); err != nil {
+		controller.serveWebhooksError(w, "could not delete webhook endpoint", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Deliveries returns the newest deliveries of the integrator webhook endpoint.
+func (controller *Webhooks) Deliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+
+	endpointID, err := parseEndpointID(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrWebhooks.Wrap(err))
+		return
+	}
+
+	request := webhooks.DeliveriesRequest{
+		Owner:      owner(ctx),
+		EndpointID: endpointID,
+		State:      webhooks.DeliveryState(strings.ToUpper(r.URL.Query().Get("state"))),
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrWebhooks.Wrap(errs.Combine(errors.New("limit parameter invalid"), err)))
+			return
+		}
+		request.Limit = uint32(parsedLimit)
+	}
+
+	deliveries, err := controller.webhooks.ListDeliveries(ctx, request)
+	if err != nil {
+		controller.serveWebhooksError(w, "could not list webhook deliveries", err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(deliveries); err != nil {
+		controller.log.Error("failed to write json error response", ErrWebhooks.Wrap(err))
+	}
+}
+
+// Replay schedules delivery of the integrator webhook endpoint to be sent again, all dead deliveries of the endpoint
+// are replayed if delivery is not specified.
+func (controller *Webhooks) Replay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+
+	endpointID, err := parseEndpointID(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrWebhooks.Wrap(err))
+		return
+	}
+
+	request := struct {
+		DeliveryID uint64 `json:"deliveryId"`
+	}{}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		controller.serveError(w, http.StatusBadRequest, ErrWebhooks.Wrap(err))
+		return
+	}
+
+	replayed, err := controller.webhooks.ReplayDeliveries(ctx, webhooks.ReplayRequest{
+		Owner:      owner(ctx),
+		EndpointID: endpointID,
+		DeliveryID: webhooks.DeliveryID(request.DeliveryID),
+	})
+	if err != nil {
+		controller.serveWebhooksError(w, "could not replay webhook deliveries", err)
+		return
+	}
+
+	response := struct {
+		Replayed uint64 `json:"replayed"`
+	}{
+		Replayed: replayed,
+	}
+
+	if err = json.NewEncoder(w).Encode(response); err != nil {
+		controller.log.Error("failed to write json error response", ErrWebhooks.Wrap(err))
+	}
+}
+
+// owner returns integrator authenticated by api key.
+func owner(ctx context.Context) string {
+	owner, _ := ctx.Value(ownerKey{}).(string)
+	return owner
+}
+
+// parseEndpointID parses endpoint id path parameter.
+func parseEndpointID(r *http.Request) (webhooks.EndpointID, error) {
+	endpointID, err := strconv.ParseUint(mux.Vars(r)["endpoint-id"], 10, 64)
+	if err != nil {
+		return 0, errs.Combine(errors.New("endpoint-id parameter invalid"), err)
+	}
+
+	return webhooks.EndpointID(endpointID), nil
+}
+
+// serveWebhooksError replies to the request with status code which corresponds to the webhooks error.
+func (controller *Webhooks) serveWebhooksError(w http.ResponseWriter, msg string, err error) {
+	switch {
+	case webhooks.ErrInvalidEndpoint.Has(err):
+		controller.serveError(w, http.StatusBadRequest, ErrWebhooks.Wrap(err))
+	case webhooks.ErrEndpointNotFound.Has(err), webhooks.ErrDeliveryNotFound.Has(err):
+		controller.serveError(w, http.StatusNotFound, ErrWebhooks.Wrap(err))
+	default:
+		controller.log.Error(msg, ErrWebhooks.Wrap(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrWebhooks.Wrap(err))
+	}
+}
+
+// serveError replies to the request with specific code and error message.
+func (controller *Webhooks) serveError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	response := ErrorResponse{
+		Error: err.Error(),
+	}
+
+	if err = json.NewEncoder(w).Encode(response); err != nil {
+		controller.log.Error("failed to write json error response", err)
+	}
+}
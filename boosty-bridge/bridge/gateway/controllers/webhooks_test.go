@@ -0,0 +1,144 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/gateway/controllers/apitesting"
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+	"tricorn/internal/config/envparse"
+)
+
+func TestWebhooks(t *testing.T) {
+	err := godotenv.Overload("./apitesting/configs/.test.gateway.env")
+	if err != nil {
+		t.Fatalf("could not load config: %v", err)
+	}
+
+	config := new(apitesting.Config)
+	envOpt := env.Options{RequiredIfNoDef: true}
+	err = env.ParseWithFuncs(config, envparse.EvmParseOpts(), envOpt)
+	if err != nil {
+		t.Fatalf("could not parse ENV config: %v", err)
+	}
+
+	require.NotEmpty(t, config.Server.WebhookAPIKeys)
+	_, apiKey, _ := strings.Cut(config.Server.WebhookAPIKeys[0], ":")
+
+	apitesting.Run(t, func(ctx context.Context, t *testing.T) {
+		baseURL := fmt.Sprintf("http://%s/api/v1/webhooks", config.Server.Address)
+
+		do := func(method, url, key string, body io.Reader) *http.Response {
+			req, err := http.NewRequestWithContext(ctx, method, url, body)
+			require.NoError(t, err)
+			if key != "" {
+				req.Header.Set("Authorization", "Bearer "+key)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			return resp
+		}
+
+		t.Run("api key missing", func(t *testing.T) {
+			resp := do(http.MethodGet, baseURL, "", nil)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("api key invalid", func(t *testing.T) {
+			resp := do(http.MethodGet, baseURL, "wrong-"+apiKey, nil)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("create invalid url", func(t *testing.T) {
+			resp := do(http.MethodPost, baseURL, apiKey, strings.NewReader(`{"url":"example.com/webhooks"}`))
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("create invalid status", func(t *testing.T) {
+			resp := do(http.MethodPost, baseURL, apiKey, strings.NewReader(`{"url":"https://example.com/webhooks","statuses":["unknown"]}`))
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("create", func(t *testing.T) {
+			resp := do(http.MethodPost, baseURL, apiKey, strings.NewReader(`{"url":"https://example.com/webhooks","statuses":["finished"]}`))
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+
+			var endpoint webhooks.Endpoint
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&endpoint))
+			assert.Equal(t, "https://example.com/webhooks", endpoint.URL)
+			assert.Equal(t, []transfers.Status{transfers.StatusFinished}, endpoint.Statuses)
+			assert.NotEmpty(t, endpoint.Secret)
+		})
+
+		t.Run("list", func(t *testing.T) {
+			resp := do(http.MethodGet, baseURL, apiKey, nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+
+			var endpoints []webhooks.Endpoint
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&endpoints))
+			require.Len(t, endpoints, 1)
+			assert.Empty(t, endpoints[0].Secret)
+		})
+
+		t.Run("deliveries invalid state", func(t *testing.T) {
+			resp := do(http.MethodGet, baseURL+"/1/deliveries?state=lost", apiKey, nil)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+
+		t.Run("deliveries", func(t *testing.T) {
+			resp := do(http.MethodGet, baseURL+"/1/deliveries?state=delivered&limit=10", apiKey, nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+
+			var deliveries []webhooks.Delivery
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&deliveries))
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, webhooks.EndpointID(1), deliveries[0].EndpointID)
+		})
+
+		t.Run("replay", func(t *testing.T) {
+			resp := do(http.MethodPost, baseURL+"/1/replay", apiKey, strings.NewReader(`{"deliveryId":1}`))
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+
+			var result struct {
+				Replayed uint64 `json:"replayed"`
+			}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+			assert.EqualValues(t, 1, result.Replayed)
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			resp := do(http.MethodDelete, baseURL+"/1", apiKey, nil)
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
@@ -13,8 +14,10 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"tricorn/bridge/gateway/controllers"
+	"tricorn/bridge/interventions"
 	"tricorn/bridge/networks"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/internal/logger"
 	"tricorn/internal/server"
 )
@@ -31,6 +34,13 @@ var (
 type Config struct {
 	Address       string `env:"GATEWAY_ADDRESS"`
 	WebAppAddress string `env:"WEP_APP_ADDRESS"`
+
+	WatchHeartbeatIntervalInSeconds uint32 `env:"WATCH_HEARTBEAT_INTERVAL_IN_SECONDS" envDefault:"15"`
+
+	// WebhookAPIKeys holds comma separated api keys of integrators in the "owner:key" format.
+	WebhookAPIKeys []string `env:"WEBHOOK_API_KEYS" envDefault:""`
+	// OperatorAPIKeys holds comma separated api keys of operators in the "operator:key" format.
+	OperatorAPIKeys []string `env:"OPERATOR_API_KEYS" envDefault:""`
 }
 
 // Server represents gateway server.
@@ -45,16 +55,22 @@ type Server struct {
 
 	networks  *networks.Service
 	transfers *transfers.Service
+	webhooks  *webhooks.Service
+
+	interventions *interventions.Service
 }
 
 // NewServer is a constructor for gateway server.
-func NewServer(config Config, log logger.Logger, listener net.Listener, networks *networks.Service, transfers *transfers.Service) *Server {
+func NewServer(config Config, log logger.Logger, listener net.Listener, networks *networks.Service, transfers *transfers.Service,
+	webhooks *webhooks.Service, interventions *interventions.Service) *Server {
 	server := &Server{
-		log:       log,
-		config:    config,
-		listener:  listener,
-		networks:  networks,
-		transfers: transfers,
+		log:           log,
+		config:        config,
+		listener:      listener,
+		networks:      networks,
+		transfers:     transfers,
+		webhooks:      webhooks,
+		interventions: interventions,
 	}
 
 	router := mux.NewRouter()
@@ -65,7 +81,8 @@ func NewServer(config Config, log logger.Logger, listener net.Listener, networks
 	networksRouter.HandleFunc("", networksController.Connected).Methods(http.MethodGet)
 	networksRouter.HandleFunc("/{network-id}/supported-tokens", networksController.SupportedTokens).Methods(http.MethodGet)
 
-	transfersController := controllers.NewTransfers(server.log, server.transfers)
+	heartbeatInterval := time.Duration(config.WatchHeartbeatIntervalInSeconds) * time.Second
+	transfersController := controllers.NewTransfers(server.log, server.transfers, config.WebAppAddress, heartbeatInterval)
 	transfersRouter := apiRouter.PathPrefix("/transfers").Subrouter()
 	transfersRouter.HandleFunc("/history/{signature-hex}/{pub-key-hex}", transfersController.History).Methods(http.MethodGet)
 	transfersRouter.HandleFunc("/{tx}", transfersController.Info).Methods(http.MethodGet)
@@ -73,6 +90,29 @@ func NewServer(config Config, log logger.Logger, listener net.Listener, networks
 	transfersRouter.HandleFunc("/bridge-in-signature", transfersController.BridgeInSignature).Methods(http.MethodPost)
 	transfersRouter.HandleFunc("/cancel-signature/{transfer-id}/{network-id}/{signature}/{public-key}", transfersController.CancelSignature).Methods(http.MethodGet)
 
+	apiV1Router := router.PathPrefix("/api/v1").Subrouter()
+	transfersV1Router := apiV1Router.PathPrefix("/transfers").Subrouter()
+	transfersV1Router.HandleFunc("/history/{signature-hex}/{pub-key-hex}", transfersController.HistoryByCursor).Methods(http.MethodGet)
+	transfersV1Router.HandleFunc("/{transfer-id:[0-9]+}/events", transfersController.WatchEvents).Methods(http.MethodGet)
+	transfersV1Router.HandleFunc("/{transfer-id:[0-9]+}/ws", transfersController.WatchWebSocket).Methods(http.MethodGet)
+	transfersV1Router.HandleFunc("/tx/{network-name}/{tx}/events", transfersController.WatchEvents).Methods(http.MethodGet)
+	transfersV1Router.HandleFunc("/tx/{network-name}/{tx}/ws", transfersController.WatchWebSocket).Methods(http.MethodGet)
+
+	webhooksController := controllers.NewWebhooks(server.log, server.webhooks, config.WebhookAPIKeys)
+	webhooksV1Router := apiV1Router.PathPrefix("/webhooks").Subrouter()
+	webhooksV1Router.Use(webhooksController.Authenticate)
+	webhooksV1Router.HandleFunc("", webhooksController.Create).Methods(http.MethodPost)
+	webhooksV1Router.HandleFunc("", webhooksController.List).Methods(http.MethodGet)
+	webhooksV1Router.HandleFunc("/{endpoint-id:[0-9]+}", webhooksController.Delete).Methods(http.MethodDelete)
+	webhooksV1Router.HandleFunc("/{endpoint-id:[0-9]+}/deliveries", webhooksController.Deliveries).Methods(http.MethodGet)
+	webhooksV1Router.HandleFunc("/{endpoint-id:[0-9]+}/replay", webhooksController.Replay).Methods(http.MethodPost)
+
+	interventionsController := controllers.NewInterventions(server.log, server.interventions, config.OperatorAPIKeys)
+	operatorV1Router := apiV1Router.PathPrefix("/operator/transfers").Subrouter()
+	operatorV1Router.Use(interventionsController.Authenticate)
+	operatorV1Router.HandleFunc("/{transfer-id:[0-9]+}/interventions", interventionsController.Intervene).Methods(http.MethodPost)
+	operatorV1Router.HandleFunc("/{transfer-id:[0-9]+}/audit-log", interventionsController.AuditLog).Methods(http.MethodGet)
+
 	apiRouter.PathPrefix("/docs/").Handler(http.StripPrefix("/api/v0/docs", http.FileServer(http.Dir("./bridge/gateway/docs/console"))))
 
 	c := cors.New(cors.Options{
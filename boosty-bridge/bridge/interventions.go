@@ -0,0 +1,300 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/limits"
+	"tricorn/bridge/networks"
+	"tricorn/bridge/screening"
+	"tricorn/bridge/transactions"
+	"tricorn/bridge/transfers"
+	"tricorn/chains"
+)
+
+// Intervene performs operator intervention into the transfer and returns its audit log entry.
+func (service *Service) Intervene(ctx context.Context, req interventions.Request) (interventions.Entry, error) {
+	if err := req.Validate(); err != nil {
+		return interventions.Entry{}, err
+	}
+
+	tokenTransfer, err := service.tokenTransfers.Get(ctx, int64(req.TransferID))
+	if err != nil {
+		return interventions.Entry{}, Error.Wrap(err)
+	}
+
+	entry := interventions.Entry{
+		TransferID: req.TransferID,
+		Kind:       req.Kind,
+		Operator:   req.Operator,
+		Reason:     req.Reason,
+		Before:     interventions.StateOf(tokenTransfer),
+		CreatedAt:  time.Now().UTC(),
+	}
+	entry.After = entry.Before
+
+	switch req.Kind {
+	case interventions.KindRetry:
+		entry, err = service.retryBridgeOut(ctx, tokenTransfer, entry)
+	case interventions.KindAttachOutboundTx:
+		entry, err = service.attachOutboundTx(ctx, tokenTransfer, req, entry)
+	case interventions.KindRelease:
+		entry, err = service.releaseHeldTransfer(ctx, tokenTransfer, entry)
+	case interventions.KindForceCancel:
+		if !tokenTransfer.Status.CanMoveTo(transfers.StatusCancelled) {
+			return interventions.Entry{}, interventions.ErrNotAllowed.New("%s transfer %d is not cancelled", tokenTransfer.Status, tokenTransfer.ID)
+		}
+
+		entry.After.Status = transfers.StatusCancelled
+		entry.Change = fmt.Sprintf("status is changed from %s to %s", entry.Before.Status, entry.After.Status)
+		entry.ID, err = service.auditLog.Apply(ctx, entry)
+	case interventions.KindAnnotate:
+		entry.Change = req.Note
+		entry.ID, err = service.auditLog.Append(ctx, entry)
+	}
+
+	return entry, Error.Wrap(err)
+}
+
+// AuditLog returns audit log of the transfer from the oldest entry to the newest one.
+func (service *Service) AuditLog(ctx context.Context, transferID transfers.ID) ([]interventions.Entry, error) {
+	if _, err := service.tokenTransfers.Get(ctx, int64(transferID)); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	entries, err := service.auditLog.List(ctx, transferID)
+	return entries, Error.Wrap(err)
+}
+
+// retryBridgeOut sends bridge out of the confirming transfer again. Limits are not checked, transfer is counted in
+// volumes of limits only if none of its earlier bridge outs was attempted. Retry is not allowed while earlier bridge out
+// transaction is pending or succeeded, attempts with unknown outcome are verified on chain by the operator who retries.
+// Retry is recorded to the audit log before bridge out is sent, so the attempt is audited even if bridge out fails.
+func (service *Service) retryBridgeOut(ctx context.Context, tokenTransfer transfers.TokenTransfer, entry interventions.Entry) (interventions.Entry, error) {
+	transferID := transfers.ID(tokenTransfer.ID)
+	if tokenTransfer.Status != transfers.StatusConfirming {
+		return entry, interventions.ErrNotAllowed.New("bridge out of %s transfer %d is not retried", tokenTransfer.Status, transferID)
+	}
+
+	// transfer waiting for operator decision or for execution of the approval is sent by the approval flow only.
+	approval, err := service.approvals.Get(ctx, transferID)
+	switch {
+	case err == nil && approval.Status != limits.ApprovalExecuted:
+		return entry, interventions.ErrNotAllowed.New("transfer %d is %s in the approval queue", transferID, approval.Status)
+	case err != nil && !limits.ErrApprovalNotFound.Has(err):
+		return entry, err
+	}
+
+	networkPause, paused, err := service.findPause(ctx, transferNetworks(tokenTransfer)...)
+	if err != nil {
+		return entry, err
+	}
+	if paused {
+		return entry, interventions.ErrNotAllowed.New("%s", networkPause)
+	}
+
+	usage, request, err := outbound(tokenTransfer)
+	if err != nil {
+		return entry, err
+	}
+
+	recipientNetworkName, _ := networks.NameByID(usage.RecipientNetworkID)
+	connector, exists := service.connectors[recipientNetworkName]
+	if !exists {
+		return entry, ErrNotConnectedNetwork
+	}
+
+	err = service.checkOutboundAttempts(ctx, transferID, connector, true)
+	if err != nil {
+		if ErrOutboundInFlight.Has(err) {
+			return entry, interventions.ErrNotAllowed.Wrap(err)
+		}
+		return entry, err
+	}
+
+	// deferred transfer is sent by retry, so it is not sent once more after resume.
+	if _, err = service.backlog.Remove(ctx, transferID); err != nil {
+		return entry, err
+	}
+
+	entry.Change = "bridge out is sent again"
+	if entry.ID, err = service.auditLog.Append(ctx, entry); err != nil {
+		return entry, err
+	}
+
+	counted, err := service.isAttempted(ctx, transferID)
+	if err != nil {
+		return entry, err
+	}
+
+	if !counted {
+		if err = service.limiter.Record(ctx, usage); err != nil {
+			return entry, err
+		}
+	}
+
+	return entry, service.countedBridgeOut(ctx, transferID, usage, request, counted, true)
+}
+
+// releaseHeldTransfer moves held transfer to confirming once its addresses pass screening again, and sends its bridge
+// out the same way as for the new transfer, so pauses and limits are applied. Release is recorded to the audit log
+// along with the status change before bridge out is sent, so it is audited even if bridge out fails.
+func (service *Service) releaseHeldTransfer(ctx context.Context, tokenTransfer transfers.TokenTransfer, entry interventions.Entry) (interventions.Entry, error) {
+	transferID := transfers.ID(tokenTransfer.ID)
+	if tokenTransfer.Status != transfers.StatusHeld {
+		return entry, interventions.ErrNotAllowed.New("%s transfer %d is not released", tokenTransfer.Status, transferID)
+	}
+
+	// transfer held by failed screening is released only once screening succeeds.
+	verdict := service.screenTransfer(ctx,
+		screening.Subject{NetworkID: networks.ID(tokenTransfer.SenderNetworkID), Address: tokenTransfer.SenderAddress},
+		screening.Subject{NetworkID: networks.ID(tokenTransfer.RecipientNetworkID), Address: tokenTransfer.RecipientAddress},
+	)
+	if verdict.Flagged {
+		return entry, interventions.ErrNotAllowed.New("transfer %d is still flagged: %s", transferID, verdict)
+	}
+
+	usage, request, err := outbound(tokenTransfer)
+	if err != nil {
+		return entry, err
+	}
+
+	entry.After.Status = transfers.StatusConfirming
+	entry.Change = fmt.Sprintf("status is changed from %s to %s, addresses passed screening", entry.Before.Status, entry.After.Status)
+	if entry.ID, err = service.auditLog.Apply(ctx, entry); err != nil {
+		return entry, err
+	}
+
+	networkPause, paused, err := service.findPause(ctx, transferNetworks(tokenTransfer)...)
+	if err != nil {
+		return entry, err
+	}
+	if paused {
+		return entry, service.deferTransfer(ctx, transferID, networkPause)
+	}
+
+	return entry, service.transferOut(ctx, transferID, usage, request)
+}
+
+// attachOutboundTx finishes confirming transfer by bridge out transaction sent outside of the bridge. Bridge out event
+// of the transfer is looked up in the block of the transaction through the connector of the recipient network, so
+// only transaction which actually paid the transfer out is attached.
+func (service *Service) attachOutboundTx(ctx context.Context, tokenTransfer transfers.TokenTransfer, req interventions.Request,
+	entry interventions.Entry) (interventions.Entry, error) {
+	if tokenTransfer.Status != transfers.StatusConfirming {
+		return entry, interventions.ErrNotAllowed.New("outbound transaction is not attached to %s transfer %d", tokenTransfer.Status, tokenTransfer.ID)
+	}
+
+	recipientNetworkID := networks.ID(tokenTransfer.RecipientNetworkID)
+	recipientCodec, err := recipientNetworkID.Codec()
+	if err != nil {
+		return entry, err
+	}
+
+	txHash, err := recipientCodec.ParseHash(req.TxHash)
+	if err != nil {
+		return entry, interventions.ErrInvalidRequest.Wrap(err)
+	}
+
+	recipientNetworkName, _ := networks.NameByID(recipientNetworkID)
+	connector, exists := service.connectors[recipientNetworkName]
+	if !exists {
+		return entry, ErrNotConnectedNetwork
+	}
+
+	events, err := connector.EventRange(ctx, req.BlockNumber, req.BlockNumber)
+	if err != nil {
+		return entry, err
+	}
+
+	eventFund, found, err := service.findOutboundEvent(ctx, tokenTransfer, events, txHash)
+	if err != nil {
+		return entry, err
+	}
+	if !found {
+		return entry, interventions.ErrNotAllowed.New("transaction %s of block %d has no bridge out event of transfer %d",
+			req.TxHash, req.BlockNumber, tokenTransfer.ID)
+	}
+
+	// bridge out transaction is recorded under the sender network, same as eventOutReaction does.
+	senderNetworkID := networks.ID(tokenTransfer.SenderNetworkID)
+	transaction, err := service.transactions.GetByEvent(ctx, senderNetworkID, txHash, int64(eventFund.Tx.LogIndex))
+	if err != nil {
+		if !errors.Is(err, ErrNoTransaction) {
+			return entry, err
+		}
+
+		transaction.ID, err = service.transactions.Create(ctx, transactions.Transaction{
+			NetworkID:   senderNetworkID,
+			TxHash:      txHash,
+			Sender:      tokenTransfer.SenderAddress,
+			BlockNumber: int64(eventFund.Tx.BlockNumber),
+			SeenAt:      time.Now().UTC(),
+			LogIndex:    int64(eventFund.Tx.LogIndex),
+		})
+		if err != nil {
+			return entry, err
+		}
+	}
+
+	entry.After = interventions.State{
+		Status:     transfers.StatusFinished,
+		OutboundTx: transaction.ID,
+	}
+	entry.Change = fmt.Sprintf("outbound transaction %s of block %d is attached", req.TxHash, req.BlockNumber)
+	entry.ID, err = service.auditLog.Apply(ctx, entry)
+	return entry, err
+}
+
+// findOutboundEvent returns bridge out event of the transaction which pays the transfer out. Event has to send the
+// transfer amount from its sender network to its recipient and must not belong to another transfer.
+func (service *Service) findOutboundEvent(ctx context.Context, tokenTransfer transfers.TokenTransfer, events []chains.EventVariant,
+	txHash []byte) (chains.EventFundsOut, bool, error) {
+	senderNetworkName, _ := networks.NameByID(networks.ID(tokenTransfer.SenderNetworkID))
+	recipientCodec, err := networks.ID(tokenTransfer.RecipientNetworkID).Codec()
+	if err != nil {
+		return chains.EventFundsOut{}, false, err
+	}
+
+	recipient, err := recipientCodec.FormatAddress(tokenTransfer.RecipientAddress)
+	if err != nil {
+		return chains.EventFundsOut{}, false, err
+	}
+
+	for _, eventFund := range events {
+		event := eventFund.EventFundsOut
+		if eventFund.Type != chains.EventTypeOut || !bytes.Equal(event.Tx.Hash, txHash) {
+			continue
+		}
+
+		eventRecipient, err := recipientCodec.FormatAddress(event.To)
+		if err != nil || eventRecipient != recipient {
+			continue
+		}
+
+		if event.From.NetworkName != senderNetworkName.String() || event.Amount != tokenTransfer.Amount.String() {
+			continue
+		}
+
+		if event.TransactionID != 0 && event.TransactionID != uint64(tokenTransfer.TriggeringTx) {
+			other, err := service.tokenTransfers.GetByTriggeringTx(ctx, transactions.ID(event.TransactionID))
+			if err == nil && other.ID != tokenTransfer.ID {
+				continue
+			}
+			if err != nil && !errors.Is(err, ErrNoTokenTransfer) {
+				return chains.EventFundsOut{}, false, err
+			}
+		}
+
+		return event, true, nil
+	}
+
+	return chains.EventFundsOut{}, false, nil
+}
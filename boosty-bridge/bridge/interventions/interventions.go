@@ -0,0 +1,155 @@
+package interventions
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transactions"
+	"tricorn/bridge/transfers"
+)
+
+var (
+	// ErrInvalidRequest indicates that intervention request is malformed.
+	ErrInvalidRequest = errs.Class("invalid intervention")
+	// ErrNotAllowed indicates that intervention is not allowed in the current state of the transfer.
+	ErrNotAllowed = errs.Class("intervention not allowed")
+	// ErrTransferNotFound indicates that transfer does not exist.
+	ErrTransferNotFound = errs.Class("transfer not found")
+)
+
+// Bridge exposes access to the bridge back-end methods related to operator interventions.
+type Bridge interface {
+	// Intervene performs operator intervention into the transfer and returns its audit log entry.
+	Intervene(ctx context.Context, req Request) (Entry, error)
+	// AuditLog returns audit log of the transfer from the oldest entry to the newest one.
+	AuditLog(ctx context.Context, transferID transfers.ID) ([]Entry, error)
+}
+
+// AuditLog exposes access to the append-only audit log of operator interventions. Entries are never updated or
+// deleted, database rejects such changes.
+//
+// architecture: DB
+type AuditLog interface {
+	// Append appends entry, which does not change state of the transfer, to the audit log and returns its id.
+	Append(ctx context.Context, entry Entry) (EntryID, error)
+	// Apply changes state of the transfer from the entry before state to its after state, records status transition
+	// and appends entry to the audit log in one database transaction. ErrNotAllowed is returned if status transition
+	// is not allowed or transfer is not in the before state anymore.
+	Apply(ctx context.Context, entry Entry) (EntryID, error)
+	// List returns audit log of the transfer from the oldest entry to the newest one.
+	List(ctx context.Context, transferID transfers.ID) ([]Entry, error)
+}
+
+// Kind defines kind of the operator intervention.
+type Kind string
+
+const (
+	// KindRetry sends bridge out of the confirming transfer again.
+	KindRetry Kind = "RETRY"
+	// KindAttachOutboundTx finishes confirming transfer by bridge out transaction sent outside of the bridge, which is
+	// verified on chain through the connector.
+	KindAttachOutboundTx Kind = "ATTACH_OUTBOUND_TX"
+	// KindRelease releases held transfer, which passes screening again, and sends its bridge out.
+	KindRelease Kind = "RELEASE"
+	// KindForceCancel cancels transfer, which is not finished yet.
+	KindForceCancel Kind = "FORCE_CANCEL"
+	// KindAnnotate adds note to the transfer without changing it.
+	KindAnnotate Kind = "ANNOTATE"
+)
+
+// Validate validates intervention kind.
+func (kind Kind) Validate() error {
+	switch kind {
+	case KindRetry, KindAttachOutboundTx, KindRelease, KindForceCancel, KindAnnotate:
+		return nil
+	default:
+		return ErrInvalidRequest.New("unknown intervention kind %q", kind)
+	}
+}
+
+// Cause returns cause of the transfer status change made by intervention of the kind.
+func (kind Kind) Cause() transfers.Cause {
+	if kind == KindRelease {
+		return transfers.CauseRelease
+	}
+
+	return transfers.CauseIntervention
+}
+
+// Request describes operator intervention into the transfer.
+type Request struct {
+	TransferID transfers.ID
+	Kind       Kind
+	// Operator is an identity of the operator who intervenes.
+	Operator string
+	Reason   string
+	// TxHash and BlockNumber identify bridge out transaction, which is attached to the transfer.
+	TxHash      string
+	BlockNumber uint64
+	// Note is a text of the annotation.
+	Note string
+}
+
+// Validate checks that request has operator and reason along with parameters required by its kind.
+func (req Request) Validate() error {
+	if err := req.Kind.Validate(); err != nil {
+		return err
+	}
+
+	if req.TransferID == 0 {
+		return ErrInvalidRequest.New("transfer id is required")
+	}
+	if req.Operator == "" {
+		return ErrInvalidRequest.New("operator is required")
+	}
+	if req.Reason == "" {
+		return ErrInvalidRequest.New("reason is required")
+	}
+
+	switch req.Kind {
+	case KindAttachOutboundTx:
+		if req.TxHash == "" || req.BlockNumber == 0 {
+			return ErrInvalidRequest.New("tx hash and block number are required to attach outbound transaction")
+		}
+	case KindAnnotate:
+		if req.Note == "" {
+			return ErrInvalidRequest.New("note is required to annotate transfer")
+		}
+	}
+
+	return nil
+}
+
+// State describes state of the transfer, which is changed by intervention.
+type State struct {
+	Status transfers.Status `json:"status"`
+	// OutboundTx is an internal id of the outbound transaction, it is zero if transfer has none.
+	OutboundTx transactions.ID `json:"outboundTx,omitempty"`
+}
+
+// StateOf returns state of the token transfer.
+func StateOf(tokenTransfer transfers.TokenTransfer) State {
+	return State{
+		Status:     tokenTransfer.Status,
+		OutboundTx: tokenTransfer.OutboundTx,
+	}
+}
+
+// EntryID is a type-alias for audit log entry id.
+type EntryID uint64
+
+// Entry is a record of the audit log, it describes intervention along with state of the transfer before and after it.
+type Entry struct {
+	ID         EntryID      `json:"id"`
+	TransferID transfers.ID `json:"transferId"`
+	Kind       Kind         `json:"kind"`
+	Operator   string       `json:"operator"`
+	Reason     string       `json:"reason"`
+	// Change describes what intervention did.
+	Change    string    `json:"change"`
+	Before    State     `json:"before"`
+	After     State     `json:"after"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,58 @@
+package interventions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/transfers"
+)
+
+func TestRequestValidate(t *testing.T) {
+	retry := interventions.Request{TransferID: 1, Kind: interventions.KindRetry, Operator: "alice", Reason: "stuck"}
+	assert.NoError(t, retry.Validate())
+
+	release := interventions.Request{TransferID: 1, Kind: interventions.KindRelease, Operator: "alice", Reason: "false positive"}
+	assert.NoError(t, release.Validate())
+
+	cancel := interventions.Request{TransferID: 1, Kind: interventions.KindForceCancel, Operator: "alice", Reason: "refunded"}
+	assert.NoError(t, cancel.Validate())
+
+	unknownKind := interventions.Request{TransferID: 1, Kind: "DELETE", Operator: "alice", Reason: "stuck"}
+	assert.True(t, interventions.ErrInvalidRequest.Has(unknownKind.Validate()))
+
+	noTransfer := interventions.Request{Kind: interventions.KindRetry, Operator: "alice", Reason: "stuck"}
+	assert.True(t, interventions.ErrInvalidRequest.Has(noTransfer.Validate()))
+
+	noOperator := interventions.Request{TransferID: 1, Kind: interventions.KindRetry, Reason: "stuck"}
+	assert.True(t, interventions.ErrInvalidRequest.Has(noOperator.Validate()))
+
+	noReason := interventions.Request{TransferID: 1, Kind: interventions.KindRetry, Operator: "alice"}
+	assert.True(t, interventions.ErrInvalidRequest.Has(noReason.Validate()))
+
+	attach := interventions.Request{TransferID: 1, Kind: interventions.KindAttachOutboundTx, Operator: "alice", Reason: "paid manually",
+		TxHash: "0x01", BlockNumber: 100}
+	assert.NoError(t, attach.Validate())
+
+	attachNoBlock := attach
+	attachNoBlock.BlockNumber = 0
+	assert.True(t, interventions.ErrInvalidRequest.Has(attachNoBlock.Validate()))
+
+	attachNoTx := attach
+	attachNoTx.TxHash = ""
+	assert.True(t, interventions.ErrInvalidRequest.Has(attachNoTx.Validate()))
+
+	annotate := interventions.Request{TransferID: 1, Kind: interventions.KindAnnotate, Operator: "alice", Reason: "ticket", Note: "user contacted support"}
+	assert.NoError(t, annotate.Validate())
+
+	annotateNoNote := annotate
+	annotateNoNote.Note = ""
+	assert.True(t, interventions.ErrInvalidRequest.Has(annotateNoNote.Validate()))
+}
+
+func TestKindCause(t *testing.T) {
+	assert.Equal(t, transfers.CauseRelease, interventions.KindRelease.Cause())
+	assert.Equal(t, transfers.CauseIntervention, interventions.KindForceCancel.Cause())
+	assert.Equal(t, transfers.CauseIntervention, interventions.KindAttachOutboundTx.Cause())
+}
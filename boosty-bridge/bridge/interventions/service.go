@@ -0,0 +1,42 @@
+package interventions
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+)
+
+// Error that error was from interventions service.
+var Error = errs.Class("interventions service")
+
+// Service contains operator interventions specific business rules.
+//
+// architecture: Service
+type Service struct {
+	bridge Bridge
+}
+
+// NewService is a constructor for interventions service.
+func NewService(bridge Bridge) *Service {
+	return &Service{
+		bridge: bridge,
+	}
+}
+
+// Intervene performs operator intervention into the transfer and returns its audit log entry.
+func (service *Service) Intervene(ctx context.Context, req Request) (Entry, error) {
+	if err := req.Validate(); err != nil {
+		return Entry{}, Error.Wrap(err)
+	}
+
+	entry, err := service.bridge.Intervene(ctx, req)
+	return entry, Error.Wrap(err)
+}
+
+// AuditLog returns audit log of the transfer from the oldest entry to the newest one.
+func (service *Service) AuditLog(ctx context.Context, transferID transfers.ID) ([]Entry, error) {
+	entries, err := service.bridge.AuditLog(ctx, transferID)
+	return entries, Error.Wrap(err)
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tricorn/bridge/limits"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/transfers"
+)
+
+// holdForApproval enqueues confirming transfer, which exceeded limits, for manual approval instead of bridge out.
+func (service *Service) holdForApproval(ctx context.Context, transferID transfers.ID, reason string) error {
+	service.log.Warn(fmt.Sprintf("transfer %d is held for manual approval: %s", transferID, reason))
+
+	return service.approvals.Create(ctx, limits.Approval{
+		TransferID: transferID,
+		Reason:     reason,
+		Status:     limits.ApprovalPending,
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+// ExecuteApprovedTransfers sends bridge out of transfers approved by operator and returns their amount. Approval is
+// marked executed before bridge out, so transfer is never paid out twice, and is returned to approved status if
+// bridge out fails before it is attempted, so it is retried later. Attempted transfer may have been paid out, so
+// operator retries it or attaches its bridge out transaction. Transfers of paused networks wait until they are resumed.
+func (service *Service) ExecuteApprovedTransfers(ctx context.Context) (int, error) {
+	approvals, err := service.approvals.List(ctx, limits.ApprovalApproved)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	pauses, err := service.pauses.List(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var executed int
+	for _, approval := range approvals {
+		tokenTransfer, err := service.tokenTransfers.Get(ctx, int64(approval.TransferID))
+		if err != nil {
+			return executed, Error.Wrap(err)
+		}
+
+		if tokenTransfer.Status != transfers.StatusConfirming {
+			service.log.Warn(fmt.Sprintf("approved transfer %d has %s status and is not executed", approval.TransferID, tokenTransfer.Status))
+			continue
+		}
+
+		if _, paused := pause.Find(pauses, transferNetworks(tokenTransfer)...); paused {
+			continue
+		}
+
+		err = service.approvals.Decide(ctx, limits.Decision{
+			TransferID: approval.TransferID,
+			From:       limits.ApprovalApproved,
+			To:         limits.ApprovalExecuted,
+			DecidedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			// approval was changed concurrently.
+			if limits.ErrApprovalNotFound.Has(err) {
+				continue
+			}
+			return executed, Error.Wrap(err)
+		}
+
+		if err = service.executeApprovedTransfer(ctx, tokenTransfer); err != nil {
+			service.log.Error(fmt.Sprintf("couldn't execute approved transfer %d", approval.TransferID), Error.Wrap(err))
+
+			attempted, err := service.isAttempted(ctx, approval.TransferID)
+			if err != nil {
+				return executed, Error.Wrap(err)
+			}
+			if attempted {
+				continue
+			}
+
+			err = service.approvals.Decide(ctx, limits.Decision{
+				TransferID: approval.TransferID,
+				From:       limits.ApprovalExecuted,
+				To:         limits.ApprovalApproved,
+				DecidedAt:  time.Now().UTC(),
+			})
+			if err != nil {
+				return executed, Error.Wrap(err)
+			}
+			continue
+		}
+
+		executed++
+	}
+
+	return executed, nil
+}
+
+// executeApprovedTransfer counts approved transfer in volumes of limits, unless its earlier bridge out attempt counted
+// it already, and sends its bridge out.
+func (service *Service) executeApprovedTransfer(ctx context.Context, tokenTransfer transfers.TokenTransfer) error {
+	transferID := transfers.ID(tokenTransfer.ID)
+	usage, request, err := outbound(tokenTransfer)
+	if err != nil {
+		return err
+	}
+
+	counted, err := service.isAttempted(ctx, transferID)
+	if err != nil {
+		return err
+	}
+
+	if !counted {
+		if err = service.limiter.Record(ctx, usage); err != nil {
+			return err
+		}
+	}
+
+	return service.countedBridgeOut(ctx, transferID, usage, request, counted, false)
+}
@@ -0,0 +1,73 @@
+package limits
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+)
+
+// ErrApprovalNotFound indicates that approval does not exist or has another status.
+var ErrApprovalNotFound = errs.Class("transfer approval not found")
+
+// Approvals exposes access to the queue of transfers which exceeded limits and wait for manual approval.
+//
+// architecture: DB
+type Approvals interface {
+	// Create enqueues transfer for manual approval, already enqueued transfer is kept as is.
+	Create(ctx context.Context, approval Approval) error
+	// Get returns approval of the transfer.
+	Get(ctx context.Context, transferID transfers.ID) (Approval, error)
+	// List returns approvals in specified status from the oldest to the newest one, all approvals are returned if
+	// status is empty.
+	List(ctx context.Context, status ApprovalStatus) ([]Approval, error)
+	// Decide changes status of the approval if it is in the expected status, ErrApprovalNotFound is returned otherwise.
+	Decide(ctx context.Context, decision Decision) error
+}
+
+// ApprovalStatus defines state of the transfer in the approval queue.
+type ApprovalStatus string
+
+const (
+	// ApprovalPending indicates that transfer waits for operator decision.
+	ApprovalPending ApprovalStatus = "PENDING"
+	// ApprovalApproved indicates that operator approved transfer and it waits for bridge out.
+	ApprovalApproved ApprovalStatus = "APPROVED"
+	// ApprovalRejected indicates that operator rejected transfer, so its funds stay locked on the sender network.
+	ApprovalRejected ApprovalStatus = "REJECTED"
+	// ApprovalExecuted indicates that bridge out of the approved transfer was sent.
+	ApprovalExecuted ApprovalStatus = "EXECUTED"
+)
+
+// Validate validates approval status.
+func (status ApprovalStatus) Validate() error {
+	switch status {
+	case ApprovalPending, ApprovalApproved, ApprovalRejected, ApprovalExecuted:
+		return nil
+	default:
+		return ErrLimits.New("unknown approval status %q", status)
+	}
+}
+
+// Approval describes transfer which exceeded limits.
+type Approval struct {
+	TransferID transfers.ID   `json:"transferId"`
+	Reason     string         `json:"reason"`
+	Status     ApprovalStatus `json:"status"`
+	// Operator is an identity of the operator who decided on the transfer.
+	Operator  string    `json:"operator,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	// DecidedAt is zero until status of the approval is changed.
+	DecidedAt time.Time `json:"decidedAt,omitempty"`
+}
+
+// Decision describes change of the approval status.
+type Decision struct {
+	TransferID transfers.ID
+	From       ApprovalStatus
+	To         ApprovalStatus
+	Operator   string
+	DecidedAt  time.Time
+}
@@ -0,0 +1,298 @@
+package limits
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+)
+
+// ErrLimits indicates that there was an error in the transfer limits.
+var ErrLimits = errs.Class("transfer limits")
+
+// Rule describes limits of transfers of the token by the route. Empty network matches any network, so rule without
+// networks limits all transfers of the token. Volume limits are applied to transfers in rolling window.
+type Rule struct {
+	TokenID          int64         `json:"tokenId"`
+	SenderNetwork    networks.Name `json:"senderNetwork,omitempty"`
+	RecipientNetwork networks.Name `json:"recipientNetwork,omitempty"`
+	// MaxTransfer is a maximal amount of the single transfer.
+	MaxTransfer *big.Int `json:"maxTransfer,omitempty"`
+	// WindowInSeconds is a duration of the rolling window of volume limits.
+	WindowInSeconds uint32 `json:"windowInSeconds,omitempty"`
+	// MaxVolume is a maximal sum of transfers by the route in the window.
+	MaxVolume *big.Int `json:"maxVolume,omitempty"`
+	// MaxAddressVolume is a maximal sum of transfers of the single sender address by the route in the window.
+	MaxAddressVolume *big.Int `json:"maxAddressVolume,omitempty"`
+}
+
+// Validate validates that networks of the rule are registered and volume limits have window.
+func (rule Rule) Validate() error {
+	for _, name := range []networks.Name{rule.SenderNetwork, rule.RecipientNetwork} {
+		if name == "" {
+			continue
+		}
+		if _, ok := networks.IDByName(name); !ok {
+			return ErrLimits.New("rule of token %d has unknown network %s", rule.TokenID, name)
+		}
+	}
+
+	if (rule.MaxVolume != nil || rule.MaxAddressVolume != nil) && rule.WindowInSeconds == 0 {
+		return ErrLimits.New("rule of token %d has volume limit without window", rule.TokenID)
+	}
+
+	return nil
+}
+
+// Window returns duration of the rolling window of volume limits.
+func (rule Rule) Window() time.Duration {
+	return time.Duration(rule.WindowInSeconds) * time.Second
+}
+
+// String returns description of the token and route the rule is applied to.
+func (rule Rule) String() string {
+	route := func(name networks.Name) string {
+		if name == "" {
+			return "*"
+		}
+		return name.String()
+	}
+
+	return fmt.Sprintf("token %d %s->%s", rule.TokenID, route(rule.SenderNetwork), route(rule.RecipientNetwork))
+}
+
+// LoadFile reads rules from json file, empty path means that there are no limits.
+func LoadFile(path string) ([]Rule, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ErrLimits.Wrap(err)
+	}
+
+	var rules []Rule
+	if err = json.Unmarshal(data, &rules); err != nil {
+		return nil, ErrLimits.Wrap(err)
+	}
+
+	return rules, nil
+}
+
+// Counters exposes access to the rolling volume counters db. Volumes are counted in buckets of CounterBucket
+// duration, so rolling window is extended up to the bucket duration.
+//
+// architecture: DB
+type Counters interface {
+	// Add adds amount of the transfer to the counter of its route and sender address, negative amount is subtracted.
+	Add(ctx context.Context, usage Usage) error
+	// Sum returns sum of transfers in the scope counted since specified time.
+	Sum(ctx context.Context, scope Scope, since time.Time) (*big.Int, error)
+	// DeleteBefore deletes counters of buckets which started before specified time.
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// CounterBucket defines duration of buckets volumes are counted in.
+const CounterBucket = time.Minute
+
+// Usage describes transfer which is counted by limits.
+type Usage struct {
+	TokenID            int64
+	SenderNetworkID    networks.ID
+	RecipientNetworkID networks.ID
+	SenderAddress      []byte
+	Amount             *big.Int
+	At                 time.Time
+}
+
+// Scope describes which counters are summed. Empty lists and address are not applied.
+type Scope struct {
+	TokenID           int64
+	SenderNetworks    []networks.ID
+	RecipientNetworks []networks.ID
+	SenderAddress     []byte
+}
+
+// Limiter checks transfers against limits. Transfers are checked and counted by the single bridge instance,
+// so reservations are serialized to keep concurrent transfers from passing the same remaining volume.
+type Limiter struct {
+	rules     []Rule
+	counters  Counters
+	maxWindow time.Duration
+
+	mutex sync.Mutex
+}
+
+// NewLimiter is a constructor for limiter, rules are validated so networks have to be registered already.
+func NewLimiter(rules []Rule, counters Counters) (*Limiter, error) {
+	limiter := &Limiter{
+		rules:    rules,
+		counters: counters,
+	}
+
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+
+		if rule.Window() > limiter.maxWindow {
+			limiter.maxWindow = rule.Window()
+		}
+	}
+
+	return limiter, nil
+}
+
+// Check returns description of the first limit transfer exceeds, empty string is returned if transfer is within
+// all limits.
+func (limiter *Limiter) Check(ctx context.Context, usage Usage) (string, error) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	return limiter.check(ctx, usage, false)
+}
+
+// CheckCounted checks transfer, which is already counted in rolling volumes, against limits, so its amount is not
+// added to volumes once more. Description of the first exceeded limit is returned, empty string otherwise.
+func (limiter *Limiter) CheckCounted(ctx context.Context, usage Usage) (string, error) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	return limiter.check(ctx, usage, true)
+}
+
+// Reserve checks transfer against limits and counts it in rolling volumes if it is within all limits, so no other
+// transfer is checked in between. Description of the first exceeded limit is returned otherwise.
+func (limiter *Limiter) Reserve(ctx context.Context, usage Usage) (string, error) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	violation, err := limiter.check(ctx, usage, false)
+	if err != nil || violation != "" {
+		return violation, err
+	}
+
+	return "", limiter.record(ctx, usage)
+}
+
+// Record counts transfer in rolling volumes and deletes counters which are out of all windows.
+// Nothing is counted if there are no volume limits.
+func (limiter *Limiter) Record(ctx context.Context, usage Usage) error {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	return limiter.record(ctx, usage)
+}
+
+// Release removes counted transfer from rolling volumes, e.g. once its bridge out could not be sent.
+func (limiter *Limiter) Release(ctx context.Context, usage Usage) error {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	released := usage
+	released.Amount = new(big.Int).Neg(usage.Amount)
+
+	return limiter.record(ctx, released)
+}
+
+// check returns description of the first limit transfer exceeds, amount of the counted transfer is already in volumes.
+func (limiter *Limiter) check(ctx context.Context, usage Usage, counted bool) (string, error) {
+	amount := usage.Amount
+	if counted {
+		amount = new(big.Int)
+	}
+
+	for _, rule := range limiter.rules {
+		scope, ok := rule.scope(usage)
+		if !ok {
+			continue
+		}
+
+		if rule.MaxTransfer != nil && usage.Amount.Cmp(rule.MaxTransfer) > 0 {
+			return fmt.Sprintf("amount %s exceeds max transfer %s of %s", usage.Amount, rule.MaxTransfer, rule), nil
+		}
+
+		since := usage.At.Add(-rule.Window())
+		if rule.MaxVolume != nil {
+			exceeded, err := limiter.exceeds(ctx, scope, since, amount, rule.MaxVolume)
+			if err != nil || exceeded {
+				return limiter.violation(exceeded, "volume", rule, rule.MaxVolume), err
+			}
+		}
+
+		if rule.MaxAddressVolume != nil {
+			scope.SenderAddress = usage.SenderAddress
+			exceeded, err := limiter.exceeds(ctx, scope, since, amount, rule.MaxAddressVolume)
+			if err != nil || exceeded {
+				return limiter.violation(exceeded, "address volume", rule, rule.MaxAddressVolume), err
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// record counts transfer in rolling volumes and deletes counters which are out of all windows.
+func (limiter *Limiter) record(ctx context.Context, usage Usage) error {
+	if limiter.maxWindow == 0 {
+		return nil
+	}
+
+	if err := limiter.counters.Add(ctx, usage); err != nil {
+		return ErrLimits.Wrap(err)
+	}
+
+	return ErrLimits.Wrap(limiter.counters.DeleteBefore(ctx, usage.At.Add(-limiter.maxWindow-CounterBucket)))
+}
+
+// exceeds reports whether transfer of amount makes volume of the scope since specified time exceed max.
+func (limiter *Limiter) exceeds(ctx context.Context, scope Scope, since time.Time, amount, max *big.Int) (bool, error) {
+	volume, err := limiter.counters.Sum(ctx, scope, since)
+	if err != nil {
+		return false, ErrLimits.Wrap(err)
+	}
+
+	return volume.Add(volume, amount).Cmp(max) > 0, nil
+}
+
+// violation describes exceeded volume limit, empty string is returned if limit is not exceeded.
+func (limiter *Limiter) violation(exceeded bool, kind string, rule Rule, max *big.Int) string {
+	if !exceeded {
+		return ""
+	}
+
+	return fmt.Sprintf("%s exceeds max %s %s of %s in %s", kind, kind, max, rule, rule.Window())
+}
+
+// scope returns scope of volumes of the rule, false is returned if rule is not applied to the transfer.
+func (rule Rule) scope(usage Usage) (Scope, bool) {
+	if rule.TokenID != usage.TokenID {
+		return Scope{}, false
+	}
+
+	scope := Scope{TokenID: rule.TokenID}
+	if rule.SenderNetwork != "" {
+		networkID, _ := networks.IDByName(rule.SenderNetwork)
+		if networkID != usage.SenderNetworkID {
+			return Scope{}, false
+		}
+		scope.SenderNetworks = []networks.ID{networkID}
+	}
+	if rule.RecipientNetwork != "" {
+		networkID, _ := networks.IDByName(rule.RecipientNetwork)
+		if networkID != usage.RecipientNetworkID {
+			return Scope{}, false
+		}
+		scope.RecipientNetworks = []networks.ID{networkID}
+	}
+
+	return scope, true
+}
@@ -0,0 +1,264 @@
+package limits_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/limits"
+	"tricorn/bridge/networks"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	rules := []limits.Rule{
+		{TokenID: 1, SenderNetwork: networks.NameCasper, RecipientNetwork: networks.NameEth, MaxTransfer: big.NewInt(100)},
+		{TokenID: 1, WindowInSeconds: 3600, MaxVolume: big.NewInt(250), MaxAddressVolume: big.NewInt(150)},
+	}
+
+	counters := &countersMock{}
+	limiter, err := limits.NewLimiter(rules, counters)
+	require.NoError(t, err)
+
+	usage := func(sender []byte, amount int64, at time.Time) limits.Usage {
+		return limits.Usage{
+			TokenID:            1,
+			SenderNetworkID:    networks.IDCasper,
+			RecipientNetworkID: networks.IDEth,
+			SenderAddress:      sender,
+			Amount:             big.NewInt(amount),
+			At:                 at,
+		}
+	}
+
+	alice, bob, carol := []byte{1}, []byte{2}, []byte{3}
+
+	t.Run("max transfer", func(t *testing.T) {
+		violation, err := limiter.Check(ctx, usage(alice, 101, now))
+		require.NoError(t, err)
+		assert.Equal(t, "amount 101 exceeds max transfer 100 of token 1 CASPER->ETH", violation)
+
+		// max transfer is limited only by the route.
+		other := usage(alice, 101, now)
+		other.SenderNetworkID = networks.IDSolana
+		violation, err = limiter.Check(ctx, other)
+		require.NoError(t, err)
+		assert.Empty(t, violation)
+
+		// rules of other tokens are not applied.
+		other = usage(alice, 1000, now)
+		other.TokenID = 2
+		violation, err = limiter.Check(ctx, other)
+		require.NoError(t, err)
+		assert.Empty(t, violation)
+	})
+
+	t.Run("address volume", func(t *testing.T) {
+		first := usage(alice, 100, now.Add(-30*time.Minute))
+		violation, err := limiter.Check(ctx, first)
+		require.NoError(t, err)
+		assert.Empty(t, violation)
+		require.NoError(t, limiter.Record(ctx, first))
+
+		violation, err = limiter.Check(ctx, usage(alice, 60, now))
+		require.NoError(t, err)
+		assert.Equal(t, "address volume exceeds max address volume 150 of token 1 *->* in 1h0m0s", violation)
+
+		violation, err = limiter.Check(ctx, usage(bob, 60, now))
+		require.NoError(t, err)
+		assert.Empty(t, violation)
+	})
+
+	t.Run("volume", func(t *testing.T) {
+		second := usage(bob, 100, now)
+		require.NoError(t, limiter.Record(ctx, second))
+
+		violation, err := limiter.Check(ctx, usage(carol, 60, now))
+		require.NoError(t, err)
+		assert.Equal(t, "volume exceeds max volume 250 of token 1 *->* in 1h0m0s", violation)
+
+		// counted transfer is not added to volumes once more.
+		violation, err = limiter.Check(ctx, second)
+		require.NoError(t, err)
+		assert.Equal(t, "volume exceeds max volume 250 of token 1 *->* in 1h0m0s", violation)
+
+		violation, err = limiter.CheckCounted(ctx, second)
+		require.NoError(t, err)
+		assert.Empty(t, violation)
+
+		violation, err = limiter.CheckCounted(ctx, usage(bob, 101, now))
+		require.NoError(t, err)
+		assert.Equal(t, "amount 101 exceeds max transfer 100 of token 1 CASPER->ETH", violation)
+	})
+
+	t.Run("rolling window", func(t *testing.T) {
+		// first transfer of alice is out of the window.
+		violation, err := limiter.Check(ctx, usage(carol, 60, now.Add(31*time.Minute)))
+		require.NoError(t, err)
+		assert.Empty(t, violation)
+
+		require.NoError(t, limiter.Record(ctx, usage(carol, 10, now.Add(2*time.Hour))))
+		assert.Len(t, counters.usages, 1)
+	})
+}
+
+func TestLimiterReserve(t *testing.T) {
+	ctx := context.Background()
+
+	rules := []limits.Rule{{TokenID: 1, WindowInSeconds: 3600, MaxVolume: big.NewInt(250)}}
+	counters := &countersMock{}
+	limiter, err := limits.NewLimiter(rules, counters)
+	require.NoError(t, err)
+
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		reserved int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			violation, err := limiter.Reserve(ctx, limits.Usage{
+				TokenID:            1,
+				SenderNetworkID:    networks.IDCasper,
+				RecipientNetworkID: networks.IDEth,
+				SenderAddress:      []byte{1},
+				Amount:             big.NewInt(100),
+				At:                 time.Now().UTC(),
+			})
+			assert.NoError(t, err)
+
+			if violation == "" {
+				mutex.Lock()
+				reserved++
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// only transfers which fit the volume together are counted.
+	assert.Equal(t, 2, reserved)
+	assert.Len(t, counters.usages, 2)
+}
+
+func TestLimiterRelease(t *testing.T) {
+	ctx := context.Background()
+
+	rules := []limits.Rule{{TokenID: 1, WindowInSeconds: 3600, MaxVolume: big.NewInt(250)}}
+	counters := &countersMock{}
+	limiter, err := limits.NewLimiter(rules, counters)
+	require.NoError(t, err)
+
+	usage := limits.Usage{
+		TokenID:            1,
+		SenderNetworkID:    networks.IDCasper,
+		RecipientNetworkID: networks.IDEth,
+		SenderAddress:      []byte{1},
+		Amount:             big.NewInt(200),
+		At:                 time.Now().UTC(),
+	}
+
+	violation, err := limiter.Reserve(ctx, usage)
+	require.NoError(t, err)
+	assert.Empty(t, violation)
+
+	// bridge out failed, so transfer is reserved again once it is retried.
+	require.NoError(t, limiter.Release(ctx, usage))
+
+	violation, err = limiter.Reserve(ctx, usage)
+	require.NoError(t, err)
+	assert.Empty(t, violation)
+
+	sum, err := counters.Sum(ctx, limits.Scope{TokenID: 1}, usage.At.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "200", sum.String())
+}
+
+func TestRules(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		_, err := limits.NewLimiter([]limits.Rule{{TokenID: 1, SenderNetwork: "UNKNOWN"}}, &countersMock{})
+		require.Error(t, err)
+
+		_, err = limits.NewLimiter([]limits.Rule{{TokenID: 1, MaxVolume: big.NewInt(1)}}, &countersMock{})
+		require.Error(t, err)
+	})
+
+	t.Run("load file", func(t *testing.T) {
+		rules, err := limits.LoadFile("")
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+
+		path := filepath.Join(t.TempDir(), "limits.json")
+		err = os.WriteFile(path, []byte(`[{"tokenId": 1, "recipientNetwork": "GOERLI", "maxTransfer": 1000000000000000000000000,
+			"windowInSeconds": 60, "maxVolume": 5}]`), 0644)
+		require.NoError(t, err)
+
+		rules, err = limits.LoadFile(path)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, networks.NameGoerli, rules[0].RecipientNetwork)
+		assert.Equal(t, "1000000000000000000000000", rules[0].MaxTransfer.String())
+		assert.Equal(t, time.Minute, rules[0].Window())
+		assert.Nil(t, rules[0].MaxAddressVolume)
+	})
+}
+
+// countersMock is an in-memory storage of counted transfers.
+type countersMock struct {
+	usages []limits.Usage
+}
+
+// Add stores usage.
+func (mock *countersMock) Add(ctx context.Context, usage limits.Usage) error {
+	mock.usages = append(mock.usages, usage)
+	return nil
+}
+
+// Sum returns sum of usages in the scope.
+func (mock *countersMock) Sum(ctx context.Context, scope limits.Scope, since time.Time) (*big.Int, error) {
+	sum := new(big.Int)
+	for _, usage := range mock.usages {
+		if usage.TokenID != scope.TokenID || usage.At.Before(since) {
+			continue
+		}
+		if len(scope.SenderNetworks) > 0 && scope.SenderNetworks[0] != usage.SenderNetworkID {
+			continue
+		}
+		if len(scope.RecipientNetworks) > 0 && scope.RecipientNetworks[0] != usage.RecipientNetworkID {
+			continue
+		}
+		if scope.SenderAddress != nil && !bytes.Equal(scope.SenderAddress, usage.SenderAddress) {
+			continue
+		}
+
+		sum.Add(sum, usage.Amount)
+	}
+
+	return sum, nil
+}
+
+// DeleteBefore deletes usages before specified time.
+func (mock *countersMock) DeleteBefore(ctx context.Context, before time.Time) error {
+	var kept []limits.Usage
+	for _, usage := range mock.usages {
+		if !usage.At.Before(before) {
+			kept = append(kept, usage)
+		}
+	}
+	mock.usages = kept
+
+	return nil
+}
@@ -2,13 +2,7 @@ package networks
 
 import (
 	"context"
-	"encoding/hex"
 	"errors"
-	"fmt"
-
-	"github.com/mr-tron/base58"
-
-	"tricorn/pkg/hexutils"
 )
 
 var (
@@ -93,11 +87,9 @@ const (
 	NameAvalancheTest Name = "AVALANCHE-TEST"
 )
 
-// Validate validates supported network name.
+// Validate validates that network with the name is registered.
 func (name Name) Validate() error {
-	if name == NameCasper || name == NameEth || name == NameSolana || name == NamePolygon || name == NameCasperTest ||
-		name == NameGoerli || name == NameSolanaTest || name == NameMumbai || name == NameAvalanche ||
-		name == NameAvalancheTest || name == NameBNB || name == NameBNBTest {
+	if _, ok := ByName(name); ok {
 		return nil
 	}
 
@@ -158,7 +150,7 @@ const (
 	// IDBNB describes BNB smart chain network id.
 	IDBNB ID = 8
 	// IDBNBTest describes BNB smart chain test network id.
-	IDBNBTest = 9
+	IDBNBTest ID = 9
 	// IDAvalanche describes Avalanche network id.
 	IDAvalanche ID = 10
 	// IDAvalancheTest describes Avalanche test network id.
@@ -168,54 +160,16 @@ const (
 // PublicKey represents public key of cross-chain account.
 type PublicKey []byte
 
-// IDToNetworkName describes id-to-name ratio for network.
-var IDToNetworkName = map[ID]Name{
-	IDCasper:        NameCasper,
-	IDEth:           NameEth,
-	IDSolana:        NameSolana,
-	IDPolygon:       NamePolygon,
-	IDCasperTest:    NameCasperTest,
-	IDGoerli:        NameGoerli,
-	IDSolanaTest:    NameSolanaTest,
-	IDMumbai:        NameMumbai,
-	IDBNB:           NameBNB,
-	IDBNBTest:       NameBNBTest,
-	IDAvalanche:     NameAvalanche,
-	IDAvalancheTest: NameAvalancheTest,
-}
-
-// NetworkNameToID describes name-to-id ratio for network.
-var NetworkNameToID = map[Name]ID{
-	NameCasper:        IDCasper,
-	NameEth:           IDEth,
-	NameSolana:        IDSolana,
-	NamePolygon:       IDPolygon,
-	NameCasperTest:    IDCasperTest,
-	NameGoerli:        IDGoerli,
-	NameSolanaTest:    IDSolanaTest,
-	NameMumbai:        IDMumbai,
-	NameBNB:           IDBNB,
-	NameBNBTest:       IDBNBTest,
-	NameAvalanche:     IDAvalanche,
-	NameAvalancheTest: IDAvalancheTest,
-}
-
-// Type returns network type by id.
+// Type returns type of the registered network, empty type is returned for unknown network.
 func (networkID ID) Type() Type {
-	switch networkID {
-	case IDCasper, IDCasperTest:
-		return TypeCasper
-	case IDSolana, IDSolanaTest:
-		return TypeSolana
-	}
-
-	return TypeEVM
+	definition, _ := ByID(networkID)
+	return definition.Type
 }
 
-// IsTestnet returns testnet value by network id.
+// IsTestnet returns testnet value of the registered network.
 func (networkID ID) IsTestnet() bool {
-	return networkID == IDMumbai || networkID == IDCasperTest || networkID == IDGoerli || networkID == IDSolanaTest ||
-		networkID == IDBNBTest || networkID == IDAvalancheTest
+	definition, _ := ByID(networkID)
+	return definition.IsTestnet
 }
 
 // Token holds information about supported by golden-gate tokens.
@@ -237,162 +191,3 @@ type Address struct {
 	NetworkName string `json:"networkName,omitempty"`
 	Address     string `json:"address,omitempty"`
 }
-
-// StringToBytes converts signature/public key to bytes depending on the given network.
-// TODO: place to signatures package after bridge will be rewritten.
-func StringToBytes(networkID ID, signatureStr string) ([]byte, error) {
-	switch networkID {
-	case IDCasper, IDEth, IDPolygon, IDCasperTest, IDGoerli, IDMumbai, IDBNB, IDBNBTest, IDAvalanche, IDAvalancheTest:
-		if hexutils.Has0xPrefix(signatureStr) {
-			return hex.DecodeString(signatureStr[2:])
-		}
-		if hexutils.Has00Prefix(signatureStr) {
-			return hex.DecodeString(signatureStr[2:])
-		}
-		if hexutils.HasAccountHashPrefix(signatureStr) {
-			return hex.DecodeString(signatureStr[13:])
-		}
-		if hexutils.HasHashPrefix(signatureStr) {
-			return hex.DecodeString(signatureStr[5:])
-		}
-		return hex.DecodeString(signatureStr)
-	case IDSolana, IDSolanaTest:
-		return base58.Decode(signatureStr)
-	default:
-		return nil, ErrTransactionNameInvalid
-	}
-}
-
-// BytesToString converts signature/public key from bytes to string depending on the given network.
-// TODO: place to signatures package after bridge will be rewritten.
-func BytesToString(networkID ID, signatureBytes []byte) string {
-	switch networkID {
-	case IDCasper, IDEth, IDPolygon, IDCasperTest, IDGoerli, IDMumbai, IDBNB, IDBNBTest, IDAvalanche, IDAvalancheTest:
-		return hex.EncodeToString(signatureBytes)
-	case IDSolana, IDSolanaTest:
-		return base58.Encode(signatureBytes)
-	default:
-		return ""
-	}
-}
-
-// IsAddressValid validates address/public key in given network.
-func (network Type) IsAddressValid(address string) bool {
-	switch network {
-	case TypeEVM:
-		return IsEVMHexAddress(address)
-	case TypeCasper:
-		return IsCasperHexAddress(address)
-	case TypeSolana:
-		_, err := base58.Decode(address)
-		if err != nil {
-			return false
-		}
-
-		return true
-	default:
-		return false
-	}
-}
-
-// DecodeAddress decodes address/public key in given network to bytes.
-func (network Type) DecodeAddress(address string) ([]byte, error) {
-	switch network {
-	case TypeEVM:
-		if !IsEVMHexAddress(address) {
-			return nil, errors.New("invalid EVM address")
-		}
-
-		if hexutils.Has0xPrefix(address) {
-			address = address[2:]
-		}
-
-		return hex.DecodeString(address)
-	case TypeCasper:
-		if !IsCasperHexAddress(address) {
-			return nil, errors.New("invalid casper address")
-		}
-
-		return hex.DecodeString(address)
-	case TypeSolana:
-		return base58.Decode(address)
-	default:
-		return nil, fmt.Errorf("unsupported network type %v", network)
-	}
-}
-
-const (
-	// EVMAddressLength defines length in bytes of wallet address in evm networks.
-	EVMAddressLength = 20
-	// CasperAddressLength defines length in bytes of public key in casper networks.
-	CasperAddressLength = 32
-	// EVMHashLength defines length in bytes of tx/block hash in evm networks.
-	EVMHashLength = 32
-	// CasperHashLength defines length in bytes of deploy/block hash in evm networks.
-	CasperHashLength = 32
-)
-
-// IsEVMHexAddress validates evm address.
-func IsEVMHexAddress(s string) bool {
-	if hexutils.Has0xPrefix(s) {
-		s = s[2:]
-	}
-
-	return len(s) == 2*EVMAddressLength && hexutils.IsHex(s)
-}
-
-// IsCasperHexAddress validates public key in casper network.
-func IsCasperHexAddress(s string) bool {
-	if !hexutils.HasTagPrefix(s) {
-		return false
-	}
-
-	if len(s) < 3 {
-		return false
-	}
-
-	// remove tag(first two items in string) which defines algorithm which used to create wallet.
-	s = s[2:]
-	return len(s) == 2*CasperAddressLength && hexutils.IsHex(s)
-}
-
-// IsEVMHash validates transaction/block hash in casper network.
-func IsEVMHash(s string) bool {
-	if hexutils.Has0xPrefix(s) {
-		s = s[2:]
-	}
-
-	return len(s) == 2*EVMHashLength && hexutils.IsHex(s)
-}
-
-// IsCasperHash validates deploy/block hash in casper network.
-func IsCasperHash(s string) bool {
-	return len(s) == 2*CasperHashLength && hexutils.IsHex(s)
-}
-
-// DecodeHash decodes transaction/block hash in given network.
-func (network Type) DecodeHash(txHash string) ([]byte, error) {
-	switch network {
-	case TypeEVM:
-		// since txHash in evm networks starts with '0x' we should cut off this prefix(first two symbols).
-		if !IsEVMHash(txHash) {
-			return nil, errors.New("invalid EVM hash")
-		}
-
-		if hexutils.Has0xPrefix(txHash) {
-			txHash = txHash[2:]
-		}
-
-		return hex.DecodeString(txHash)
-	case TypeCasper:
-		if !IsCasperHash(txHash) {
-			return nil, errors.New("invalid casper hash")
-		}
-
-		return hex.DecodeString(txHash)
-	case TypeSolana:
-		return base58.Decode(txHash)
-	default:
-		return nil, fmt.Errorf("unsupported network type %v", network)
-	}
-}
@@ -0,0 +1,245 @@
+package networks
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/pkg/codec"
+)
+
+// ErrRegistry indicates that there was an error in the networks registry.
+var ErrRegistry = errs.Class("networks registry")
+
+// defaultCodec returns codec used by networks of the type if definition does not specify it.
+func (network Type) defaultCodec() codec.Name {
+	switch network {
+	case TypeCasper:
+		return codec.NameCasper
+	case TypeSolana:
+		return codec.NameSolana
+	default:
+		return codec.NameEVM
+	}
+}
+
+// Definition describes network, which is known by the bridge.
+type Definition struct {
+	ID        ID         `json:"id"`
+	Name      Name       `json:"name"`
+	Type      Type       `json:"type"`
+	IsTestnet bool       `json:"isTestnet"`
+	ChainID   uint64     `json:"chainId,omitempty"` // chain id of evm networks, zero for the others.
+	Codec     codec.Name `json:"codec,omitempty"`
+}
+
+// Definitions is exposing access to network definitions db.
+//
+// architecture: DB
+type Definitions interface {
+	// List returns all network definitions from database.
+	List(ctx context.Context) ([]Definition, error)
+	// Upsert inserts network definition to database or updates existing one with the same id.
+	Upsert(ctx context.Context, definition Definition) error
+}
+
+// DefaultDefinitions describes networks, which are known without any configuration.
+var DefaultDefinitions = []Definition{
+	{ID: IDCasper, Name: NameCasper, Type: TypeCasper},
+	{ID: IDEth, Name: NameEth, Type: TypeEVM, ChainID: 1},
+	{ID: IDSolana, Name: NameSolana, Type: TypeSolana},
+	{ID: IDPolygon, Name: NamePolygon, Type: TypeEVM, ChainID: 137},
+	{ID: IDCasperTest, Name: NameCasperTest, Type: TypeCasper, IsTestnet: true},
+	{ID: IDGoerli, Name: NameGoerli, Type: TypeEVM, IsTestnet: true, ChainID: 5},
+	{ID: IDSolanaTest, Name: NameSolanaTest, Type: TypeSolana, IsTestnet: true},
+	{ID: IDMumbai, Name: NameMumbai, Type: TypeEVM, IsTestnet: true, ChainID: 80001},
+	{ID: IDBNB, Name: NameBNB, Type: TypeEVM, ChainID: 56},
+	{ID: IDBNBTest, Name: NameBNBTest, Type: TypeEVM, IsTestnet: true, ChainID: 97},
+	{ID: IDAvalanche, Name: NameAvalanche, Type: TypeEVM, ChainID: 43114},
+	{ID: IDAvalancheTest, Name: NameAvalancheTest, Type: TypeEVM, IsTestnet: true, ChainID: 43113},
+}
+
+// Registry holds definitions of networks known by the bridge.
+type Registry struct {
+	mutex  sync.RWMutex
+	byID   map[ID]Definition
+	byName map[Name]ID
+}
+
+// NewRegistry is a constructor for Registry.
+func NewRegistry(definitions ...Definition) (*Registry, error) {
+	registry := &Registry{
+		byID:   make(map[ID]Definition),
+		byName: make(map[Name]ID),
+	}
+
+	return registry, registry.Register(definitions...)
+}
+
+// Register adds definitions to the registry. Definition replaces previously registered one with the same id,
+// so defaults could be overridden by configuration.
+func (registry *Registry) Register(definitions ...Definition) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	for _, definition := range definitions {
+		if definition.Name == "" {
+			return ErrRegistry.New("network %d has no name", definition.ID)
+		}
+		if err := definition.Type.Validate(); err != nil {
+			return ErrRegistry.New("network %s: %v", definition.Name, err)
+		}
+		if definition.Codec == "" {
+			definition.Codec = definition.Type.defaultCodec()
+		}
+		if err := definition.Codec.Validate(); err != nil {
+			return ErrRegistry.New("network %s: %v", definition.Name, err)
+		}
+
+		if id, ok := registry.byName[definition.Name]; ok && id != definition.ID {
+			return ErrRegistry.New("network name %s is already used by network %d", definition.Name, id)
+		}
+
+		if previous, ok := registry.byID[definition.ID]; ok {
+			delete(registry.byName, previous.Name)
+		}
+
+		registry.byID[definition.ID] = definition
+		registry.byName[definition.Name] = definition.ID
+	}
+
+	return nil
+}
+
+// ByID returns network definition by its id.
+func (registry *Registry) ByID(id ID) (Definition, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	definition, ok := registry.byID[id]
+	return definition, ok
+}
+
+// ByName returns network definition by its name.
+func (registry *Registry) ByName(name Name) (Definition, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	id, ok := registry.byName[name]
+	if !ok {
+		return Definition{}, false
+	}
+
+	return registry.byID[id], true
+}
+
+// List returns all registered definitions ordered by id.
+func (registry *Registry) List() []Definition {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	definitions := make([]Definition, 0, len(registry.byID))
+	for _, definition := range registry.byID {
+		definitions = append(definitions, definition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].ID < definitions[j].ID
+	})
+
+	return definitions
+}
+
+// registry is a process wide registry, which is consulted by network ids, names and codecs.
+var registry = mustNewRegistry(DefaultDefinitions...)
+
+// mustNewRegistry is a constructor for Registry, which panics on invalid definitions.
+func mustNewRegistry(definitions ...Definition) *Registry {
+	registry, err := NewRegistry(definitions...)
+	if err != nil {
+		panic(err)
+	}
+
+	return registry
+}
+
+// Register adds definitions to the process wide registry.
+func Register(definitions ...Definition) error {
+	return registry.Register(definitions...)
+}
+
+// ByID returns network definition from the process wide registry by its id.
+func ByID(id ID) (Definition, bool) {
+	return registry.ByID(id)
+}
+
+// ByName returns network definition from the process wide registry by its name.
+func ByName(name Name) (Definition, bool) {
+	return registry.ByName(name)
+}
+
+// List returns all definitions of the process wide registry ordered by id.
+func List() []Definition {
+	return registry.List()
+}
+
+// IDByName returns network id by its name.
+func IDByName(name Name) (ID, bool) {
+	definition, ok := ByName(name)
+	return definition.ID, ok
+}
+
+// NameByID returns network name by its id.
+func NameByID(id ID) (Name, bool) {
+	definition, ok := ByID(id)
+	return definition.Name, ok
+}
+
+// Codec returns codec of the registered network.
+func (networkID ID) Codec() (codec.Codec, error) {
+	definition, ok := ByID(networkID)
+	if !ok {
+		return nil, ErrTransactionNameInvalid
+	}
+
+	networkCodec, ok := codec.ByName(definition.Codec)
+	if !ok {
+		return nil, ErrRegistry.New("network %s has unsupported codec %q", definition.Name, definition.Codec)
+	}
+
+	return networkCodec, nil
+}
+
+// LoadFile reads network definitions from json file.
+func LoadFile(path string) ([]Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ErrRegistry.Wrap(err)
+	}
+
+	var definitions []Definition
+	if err = json.Unmarshal(data, &definitions); err != nil {
+		return nil, ErrRegistry.Wrap(err)
+	}
+
+	return definitions, nil
+}
+
+// RegisterFile adds network definitions from json file to the process wide registry. Empty path is ignored,
+// so only default networks are known.
+func RegisterFile(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	definitions, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return Register(definitions...)
+}
@@ -0,0 +1,87 @@
+package networks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/networks"
+	"tricorn/pkg/codec"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("Default networks", func(t *testing.T) {
+		registry, err := networks.NewRegistry(networks.DefaultDefinitions...)
+		require.NoError(t, err)
+		require.Len(t, registry.List(), len(networks.DefaultDefinitions))
+
+		definition, ok := registry.ByName(networks.NameBNBTest)
+		require.True(t, ok)
+		assert.Equal(t, networks.IDBNBTest, definition.ID)
+		assert.Equal(t, networks.TypeEVM, definition.Type)
+		assert.True(t, definition.IsTestnet)
+		assert.Equal(t, uint64(97), definition.ChainID)
+		assert.Equal(t, codec.NameEVM, definition.Codec)
+
+		definition, ok = registry.ByID(networks.IDSolana)
+		require.True(t, ok)
+		assert.Equal(t, codec.NameSolana, definition.Codec)
+	})
+
+	t.Run("Definition overrides previous one with the same id", func(t *testing.T) {
+		registry, err := networks.NewRegistry(networks.DefaultDefinitions...)
+		require.NoError(t, err)
+
+		err = registry.Register(networks.Definition{ID: networks.IDGoerli, Name: "SEPOLIA", Type: networks.TypeEVM, IsTestnet: true, ChainID: 11155111})
+		require.NoError(t, err)
+
+		_, ok := registry.ByName(networks.NameGoerli)
+		assert.False(t, ok)
+
+		definition, ok := registry.ByName("SEPOLIA")
+		require.True(t, ok)
+		assert.Equal(t, networks.IDGoerli, definition.ID)
+	})
+
+	t.Run("Negative invalid definitions", func(t *testing.T) {
+		registry, err := networks.NewRegistry(networks.DefaultDefinitions...)
+		require.NoError(t, err)
+
+		err = registry.Register(networks.Definition{ID: 100, Name: networks.NameEth, Type: networks.TypeEVM})
+		require.Error(t, err)
+		err = registry.Register(networks.Definition{ID: 100, Type: networks.TypeEVM})
+		require.Error(t, err)
+		err = registry.Register(networks.Definition{ID: 100, Name: "TRON", Type: "NT_TRON"})
+		require.Error(t, err)
+		err = registry.Register(networks.Definition{ID: 100, Name: "TRON", Type: networks.TypeEVM, Codec: "BASE64"})
+		require.Error(t, err)
+
+		_, ok := registry.ByID(100)
+		assert.False(t, ok)
+	})
+
+	t.Run("Networks from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "networks.json")
+		data := `[{"id": 12, "name": "ARBITRUM", "type": "NT_EVM", "chainId": 42161}]`
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+		require.NoError(t, networks.RegisterFile(path))
+
+		id, ok := networks.IDByName("ARBITRUM")
+		require.True(t, ok)
+		assert.Equal(t, networks.ID(12), id)
+		assert.Equal(t, networks.TypeEVM, id.Type())
+		assert.False(t, id.IsTestnet())
+		assert.NoError(t, networks.Name("ARBITRUM").Validate())
+
+		networkCodec, err := id.Codec()
+		require.NoError(t, err)
+		assert.Equal(t, codec.EVM, networkCodec)
+
+		_, err = networks.ID(13).Codec()
+		require.Error(t, err)
+	})
+}
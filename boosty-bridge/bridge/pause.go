@@ -0,0 +1,113 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/transfers"
+)
+
+// findPause returns pause which applies to any of the networks, false is returned if they are not paused.
+func (service *Service) findPause(ctx context.Context, names ...networks.Name) (pause.Pause, bool, error) {
+	pauses, err := service.pauses.List(ctx)
+	if err != nil {
+		return pause.Pause{}, false, err
+	}
+
+	networkPause, paused := pause.Find(pauses, names...)
+	return networkPause, paused, nil
+}
+
+// deferTransfer adds confirming transfer of the paused network to the backlog, its bridge out is sent after resume.
+func (service *Service) deferTransfer(ctx context.Context, transferID transfers.ID, networkPause pause.Pause) error {
+	service.log.Warn(fmt.Sprintf("bridge out of transfer %d is deferred: %s", transferID, networkPause))
+
+	return service.backlog.Add(ctx, transferID, time.Now().UTC())
+}
+
+// ProcessBacklog sends bridge out of deferred transfers whose networks were resumed and returns their amount.
+// Transfer is removed from the backlog before bridge out, so it is never paid out twice, and is returned to the
+// backlog if bridge out fails before it is attempted, so it is retried later. Attempted transfer may have been paid out,
+// so operator retries it or attaches its bridge out transaction.
+func (service *Service) ProcessBacklog(ctx context.Context) (int, error) {
+	transferIDs, err := service.backlog.List(ctx)
+	if err != nil || len(transferIDs) == 0 {
+		return 0, Error.Wrap(err)
+	}
+
+	pauses, err := service.pauses.List(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var processed int
+	for _, transferID := range transferIDs {
+		tokenTransfer, err := service.tokenTransfers.Get(ctx, int64(transferID))
+		if err != nil {
+			return processed, Error.Wrap(err)
+		}
+
+		if _, paused := pause.Find(pauses, transferNetworks(tokenTransfer)...); paused {
+			continue
+		}
+
+		removed, err := service.backlog.Remove(ctx, transferID)
+		if err != nil {
+			return processed, Error.Wrap(err)
+		}
+		// transfer was processed concurrently.
+		if !removed {
+			continue
+		}
+
+		if tokenTransfer.Status != transfers.StatusConfirming {
+			service.log.Warn(fmt.Sprintf("deferred transfer %d has %s status and is not sent", transferID, tokenTransfer.Status))
+			continue
+		}
+
+		if err = service.processDeferredTransfer(ctx, tokenTransfer); err != nil {
+			service.log.Error(fmt.Sprintf("couldn't send deferred transfer %d", transferID), Error.Wrap(err))
+
+			attempted, err := service.isAttempted(ctx, transferID)
+			if err != nil {
+				return processed, Error.Wrap(err)
+			}
+			if attempted {
+				continue
+			}
+
+			if err = service.backlog.Add(ctx, transferID, time.Now().UTC()); err != nil {
+				return processed, Error.Wrap(err)
+			}
+			continue
+		}
+
+		processed++
+	}
+
+	return processed, nil
+}
+
+// processDeferredTransfer sends bridge out of the deferred transfer, limits are checked the same way as for new ones.
+func (service *Service) processDeferredTransfer(ctx context.Context, tokenTransfer transfers.TokenTransfer) error {
+	usage, request, err := outbound(tokenTransfer)
+	if err != nil {
+		return err
+	}
+
+	return service.transferOut(ctx, transfers.ID(tokenTransfer.ID), usage, request)
+}
+
+// transferNetworks returns names of sender and recipient networks of the transfer.
+func transferNetworks(tokenTransfer transfers.TokenTransfer) []networks.Name {
+	senderNetworkName, _ := networks.NameByID(networks.ID(tokenTransfer.SenderNetworkID))
+	recipientNetworkName, _ := networks.NameByID(networks.ID(tokenTransfer.RecipientNetworkID))
+
+	return []networks.Name{senderNetworkName, recipientNetworkName}
+}
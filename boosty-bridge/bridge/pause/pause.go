@@ -0,0 +1,84 @@
+package pause
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+)
+
+var (
+	// ErrPaused indicates that request was refused, since bridge or network is paused.
+	ErrPaused = errs.Class("paused")
+	// ErrNotPaused indicates that bridge or network is not paused.
+	ErrNotPaused = errs.Class("not paused")
+)
+
+// Pauses exposes access to the pauses db.
+//
+// architecture: DB
+type Pauses interface {
+	// Pause stores pause of the bridge or network, existing pause of the same network is replaced.
+	Pause(ctx context.Context, pause Pause) error
+	// Resume deletes pause of the network, empty network resumes the whole bridge. ErrNotPaused is returned if
+	// there is no such pause.
+	Resume(ctx context.Context, network networks.Name) error
+	// List returns all pauses.
+	List(ctx context.Context) ([]Pause, error)
+}
+
+// Backlog exposes access to confirming transfers, bridge out of which was deferred while bridge or network was
+// paused.
+//
+// architecture: DB
+type Backlog interface {
+	// Add defers bridge out of the transfer, already deferred transfer is kept as is.
+	Add(ctx context.Context, transferID transfers.ID, deferredAt time.Time) error
+	// List returns deferred transfers from the oldest to the newest one.
+	List(ctx context.Context) ([]transfers.ID, error)
+	// Remove removes transfer from the backlog, false is returned if it was not deferred.
+	Remove(ctx context.Context, transferID transfers.ID) (bool, error)
+}
+
+// Pause describes paused bridge or network. Paused bridge stops issuing bridge in signatures and sending bridge outs,
+// but keeps indexing events.
+type Pause struct {
+	// Network is a paused network, empty one pauses the whole bridge.
+	Network  networks.Name `json:"network,omitempty"`
+	Reason   string        `json:"reason"`
+	Operator string        `json:"operator"`
+	PausedAt time.Time     `json:"pausedAt"`
+}
+
+// String returns description of the pause.
+func (pause Pause) String() string {
+	if pause.Network == "" {
+		return fmt.Sprintf("bridge is paused: %s", pause.Reason)
+	}
+
+	return fmt.Sprintf("network %s is paused: %s", pause.Network, pause.Reason)
+}
+
+// Find returns pause, which applies to any of the networks, pause of the whole bridge takes precedence.
+// False is returned if none of the networks is paused.
+func Find(pauses []Pause, names ...networks.Name) (Pause, bool) {
+	for _, pause := range pauses {
+		if pause.Network == "" {
+			return pause, true
+		}
+	}
+
+	for _, pause := range pauses {
+		for _, name := range names {
+			if pause.Network == name {
+				return pause, true
+			}
+		}
+	}
+
+	return Pause{}, false
+}
@@ -0,0 +1,31 @@
+package pause_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+)
+
+func TestFind(t *testing.T) {
+	goerli := pause.Pause{Network: networks.NameGoerli, Reason: "incident"}
+	bridge := pause.Pause{Reason: "upgrade"}
+
+	_, ok := pause.Find(nil, networks.NameGoerli)
+	assert.False(t, ok)
+
+	_, ok = pause.Find([]pause.Pause{goerli}, networks.NameCasperTest)
+	assert.False(t, ok)
+
+	found, ok := pause.Find([]pause.Pause{goerli}, networks.NameCasperTest, networks.NameGoerli)
+	assert.True(t, ok)
+	assert.Equal(t, goerli, found)
+	assert.Equal(t, "network GOERLI is paused: incident", found.String())
+
+	found, ok = pause.Find([]pause.Pause{goerli, bridge}, networks.NameGoerli)
+	assert.True(t, ok)
+	assert.Equal(t, bridge, found)
+	assert.Equal(t, "bridge is paused: upgrade", found.String())
+}
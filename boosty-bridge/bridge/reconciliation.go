@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/reconciliation"
+)
+
+// ensures that Service implements reconciliation.Chains.
+var _ reconciliation.Chains = (*Service)(nil)
+
+// TokenSupplies returns bridge contract balances and total supplies of all tokens on all connected networks.
+// Failure to read supply from the network is reported with the supply, so other networks are still reconciled.
+func (service *Service) TokenSupplies(ctx context.Context) ([]reconciliation.Supply, error) {
+	var supplies []reconciliation.Supply
+	for networkName, connector := range service.GetConnectors() {
+		networkID, ok := networks.IDByName(networkName)
+		if !ok {
+			continue
+		}
+
+		tokens, err := service.tokens.List(ctx, networkID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, token := range tokens {
+			networkToken, err := service.networkTokens.Get(ctx, networkID, token.ID)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+
+			supply := reconciliation.Supply{
+				Holding: reconciliation.Holding{NetworkID: networkID, TokenID: token.ID},
+			}
+
+			tokenSupply, err := connector.TokenSupply(ctx, networkToken.ContractAddress)
+			if err != nil {
+				supply.Err = Error.Wrap(err)
+			} else {
+				supply.BridgeBalance = tokenSupply.BridgeBalance
+				supply.TotalSupply = tokenSupply.TotalSupply
+			}
+
+			supplies = append(supplies, supply)
+		}
+	}
+
+	return supplies, nil
+}
@@ -0,0 +1,198 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/internal/logger"
+)
+
+// ErrAuditor indicates that there was an error in the reconciliation auditor.
+var ErrAuditor = errs.Class("reconciliation auditor")
+
+// Config defines how often and how strictly transfers are reconciled.
+type Config struct {
+	// Interval defines how often reconciliation is performed.
+	Interval time.Duration
+	// ConfirmingSLA defines how long transfer may stay confirming.
+	ConfirmingSLA time.Duration
+}
+
+// Auditor periodically reconciles transfers accounted by the bridge with balances of bridge contracts and checks
+// that transfers do not break lifecycle invariants. Baseline of the bridge contract balance is calibrated by the
+// first reconciliation of it, all later changes of the balance have to be explained by transfers.
+//
+// architecture: Chore
+type Auditor struct {
+	log    logger.Logger
+	config Config
+
+	ledger    Ledger
+	baselines Baselines
+	chains    Chains
+
+	mutex  sync.Mutex
+	report *Report
+}
+
+// NewAuditor is a constructor for reconciliation auditor.
+func NewAuditor(log logger.Logger, config Config, ledger Ledger, baselines Baselines, chains Chains) *Auditor {
+	return &Auditor{
+		log:       log,
+		config:    config,
+		ledger:    ledger,
+		baselines: baselines,
+		chains:    chains,
+	}
+}
+
+// Run reconciles transfers every interval until context is cancelled.
+func (auditor *Auditor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(auditor.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		report, err := auditor.Audit(ctx)
+		if err != nil {
+			auditor.log.Error("couldn't reconcile transfers", ErrAuditor.Wrap(err))
+			continue
+		}
+
+		if !report.Healthy {
+			encoded, err := json.Marshal(report)
+			if err != nil {
+				auditor.log.Error("couldn't encode reconciliation report", ErrAuditor.Wrap(err))
+				continue
+			}
+
+			auditor.log.Warn(fmt.Sprintf("reconciliation found violations: %s", encoded))
+		}
+	}
+}
+
+// Audit reconciles transfers with balances of bridge contracts and returns report, which is kept as the latest one.
+func (auditor *Auditor) Audit(ctx context.Context) (Report, error) {
+	now := time.Now().UTC()
+
+	routes, err := auditor.ledger.RouteTotals(ctx)
+	if err != nil {
+		return Report{}, ErrAuditor.Wrap(err)
+	}
+
+	finished, err := auditor.ledger.FinishedWithoutOutbound(ctx)
+	if err != nil {
+		return Report{}, ErrAuditor.Wrap(err)
+	}
+
+	overdue, err := auditor.ledger.ConfirmingSince(ctx, now.Add(-auditor.config.ConfirmingSLA))
+	if err != nil {
+		return Report{}, ErrAuditor.Wrap(err)
+	}
+
+	supplies, err := auditor.chains.TokenSupplies(ctx)
+	if err != nil {
+		return Report{}, ErrAuditor.Wrap(err)
+	}
+
+	report := Report{
+		GeneratedAt: now,
+		Routes:      append(make([]RouteTotal, 0, len(routes)), routes...),
+		Balances:    make([]Balance, 0, len(supplies)),
+		Anomalies:   append(append(make([]Anomaly, 0, len(finished)+len(overdue)), finished...), overdue...),
+	}
+
+	flows := Flows(routes)
+	for _, supply := range supplies {
+		flow, ok := flows[supply.Holding]
+		if !ok {
+			flow = Flow{Locked: new(big.Int), Released: new(big.Int)}
+		}
+		delete(flows, supply.Holding)
+
+		baseline, err := auditor.baseline(ctx, supply, flow)
+		if err != nil {
+			return Report{}, ErrAuditor.Wrap(err)
+		}
+
+		report.Balances = append(report.Balances, Reconcile(supply, flow, baseline))
+	}
+
+	// transfers went through networks, balances of which were not read, e.g. connector is not connected.
+	for holding, flow := range flows {
+		report.Balances = append(report.Balances, Balance{
+			Holding:  holding,
+			Locked:   flow.Locked,
+			Released: flow.Released,
+			Error:    "bridge contract balance is unknown",
+		})
+	}
+
+	sort.Slice(report.Balances, func(i, j int) bool {
+		if report.Balances[i].NetworkID != report.Balances[j].NetworkID {
+			return report.Balances[i].NetworkID < report.Balances[j].NetworkID
+		}
+		return report.Balances[i].TokenID < report.Balances[j].TokenID
+	})
+
+	report.Healthy = report.IsHealthy()
+
+	auditor.mutex.Lock()
+	auditor.report = &report
+	auditor.mutex.Unlock()
+
+	return report, nil
+}
+
+// Report returns the latest reconciliation report, false is returned if there was no reconciliation yet.
+func (auditor *Auditor) Report() (Report, bool) {
+	auditor.mutex.Lock()
+	defer auditor.mutex.Unlock()
+
+	if auditor.report == nil {
+		return Report{}, false
+	}
+
+	return *auditor.report, true
+}
+
+// baseline returns baseline of the bridge contract balance, it is calibrated from the actual balance if there is
+// no one yet. Nil is returned while there is no baseline and balance could not be read.
+func (auditor *Auditor) baseline(ctx context.Context, supply Supply, flow Flow) (*big.Int, error) {
+	baseline, err := auditor.baselines.Get(ctx, supply.Holding)
+	if err == nil {
+		return baseline, nil
+	}
+	if !errors.Is(err, ErrNoBaseline) {
+		return nil, err
+	}
+
+	if supply.Err != nil {
+		return nil, nil
+	}
+
+	baseline = new(big.Int).Sub(supply.BridgeBalance, flow.Locked)
+	baseline.Add(baseline, flow.Released)
+	if err = auditor.baselines.Create(ctx, supply.Holding, baseline); err != nil {
+		return nil, err
+	}
+
+	auditor.log.Debug(fmt.Sprintf("reconciliation baseline of token %d on network %d is calibrated to %s",
+		supply.TokenID, supply.NetworkID, baseline))
+
+	// baseline could be created concurrently, the stored one is used.
+	return auditor.baselines.Get(ctx, supply.Holding)
+}
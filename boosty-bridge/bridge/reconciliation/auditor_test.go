@@ -0,0 +1,233 @@
+package reconciliation_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/reconciliation"
+	"tricorn/bridge/transfers"
+	"tricorn/internal/logger/zaplog"
+)
+
+func TestAuditor(t *testing.T) {
+	ctx := context.Background()
+
+	const tokenID int64 = 1
+	casper := reconciliation.Holding{NetworkID: networks.IDCasper, TokenID: tokenID}
+	eth := reconciliation.Holding{NetworkID: networks.IDEth, TokenID: tokenID}
+
+	ledger := &ledgerMock{
+		routes: []reconciliation.RouteTotal{
+			{TokenID: tokenID, SenderNetworkID: networks.IDCasper, RecipientNetworkID: networks.IDEth, Status: transfers.StatusFinished, Count: 2, Amount: big.NewInt(30)},
+			{TokenID: tokenID, SenderNetworkID: networks.IDCasper, RecipientNetworkID: networks.IDEth, Status: transfers.StatusConfirming, Count: 1, Amount: big.NewInt(5)},
+			{TokenID: tokenID, SenderNetworkID: networks.IDEth, RecipientNetworkID: networks.IDCasper, Status: transfers.StatusWaiting, Count: 1, Amount: big.NewInt(100)},
+		},
+	}
+	baselines := &baselinesMock{baselines: make(map[reconciliation.Holding]*big.Int)}
+	chains := &chainsMock{
+		supplies: []reconciliation.Supply{
+			{Holding: eth, BridgeBalance: big.NewInt(1000), TotalSupply: big.NewInt(5000)},
+			{Holding: casper, BridgeBalance: big.NewInt(35), TotalSupply: big.NewInt(5000)},
+		},
+	}
+
+	auditor := reconciliation.NewAuditor(zaplog.NewLog(), reconciliation.Config{Interval: time.Minute, ConfirmingSLA: time.Hour},
+		ledger, baselines, chains)
+
+	t.Run("no report before audit", func(t *testing.T) {
+		_, ok := auditor.Report()
+		assert.False(t, ok)
+	})
+
+	t.Run("baseline is calibrated by the first audit", func(t *testing.T) {
+		report, err := auditor.Audit(ctx)
+		require.NoError(t, err)
+		assert.True(t, report.Healthy)
+		assert.Len(t, report.Routes, 3)
+		assert.Empty(t, report.Anomalies)
+
+		require.Len(t, report.Balances, 2)
+		casperBalance, ethBalance := report.Balances[0], report.Balances[1]
+
+		assert.Equal(t, casper, casperBalance.Holding)
+		assert.Equal(t, "0", casperBalance.Baseline.String())
+		assert.Equal(t, "35", casperBalance.Locked.String())
+		assert.Equal(t, "0", casperBalance.Released.String())
+		assert.Equal(t, "35", casperBalance.Expected.String())
+		assert.Equal(t, "0", casperBalance.Drift.String())
+
+		assert.Equal(t, eth, ethBalance.Holding)
+		assert.Equal(t, "1030", ethBalance.Baseline.String())
+		assert.Equal(t, "30", ethBalance.Released.String())
+		assert.Equal(t, "1000", ethBalance.Expected.String())
+		assert.Empty(t, ethBalance.Violation)
+
+		stored, ok := auditor.Report()
+		require.True(t, ok)
+		assert.Equal(t, report, stored)
+	})
+
+	t.Run("double release breaks invariant", func(t *testing.T) {
+		chains.setBalance(eth, big.NewInt(985))
+
+		report, err := auditor.Audit(ctx)
+		require.NoError(t, err)
+		assert.False(t, report.Healthy)
+
+		ethBalance := report.Balances[1]
+		assert.Equal(t, "1030", ethBalance.Baseline.String())
+		assert.Equal(t, "-15", ethBalance.Drift.String())
+		assert.Equal(t, "bridge contract holds less than expected", ethBalance.Violation)
+
+		chains.setBalance(eth, big.NewInt(1000))
+	})
+
+	t.Run("balance above total supply breaks invariant", func(t *testing.T) {
+		chains.setTotalSupply(casper, big.NewInt(20))
+
+		report, err := auditor.Audit(ctx)
+		require.NoError(t, err)
+		assert.False(t, report.Healthy)
+		assert.Equal(t, "bridge contract holds more than total supply", report.Balances[0].Violation)
+
+		chains.setTotalSupply(casper, big.NewInt(5000))
+	})
+
+	t.Run("anomalies", func(t *testing.T) {
+		ledger.anomalies = []reconciliation.Anomaly{
+			{Kind: reconciliation.AnomalyFinishedWithoutOutbound, TransferID: 7, TokenID: tokenID, Amount: big.NewInt(10)},
+		}
+
+		report, err := auditor.Audit(ctx)
+		require.NoError(t, err)
+		assert.False(t, report.Healthy)
+		assert.Len(t, report.Anomalies, 1)
+
+		ledger.anomalies = nil
+	})
+
+	t.Run("unknown balance", func(t *testing.T) {
+		chains.setErr(eth, errors.New("connector is not available"))
+
+		report, err := auditor.Audit(ctx)
+		require.NoError(t, err)
+		assert.False(t, report.Healthy)
+		assert.Equal(t, "connector is not available", report.Balances[1].Error)
+		assert.Nil(t, report.Balances[1].Drift)
+
+		chains.supplies = chains.supplies[1:]
+		report, err = auditor.Audit(ctx)
+		require.NoError(t, err)
+		require.Len(t, report.Balances, 2)
+		assert.Equal(t, eth, report.Balances[1].Holding)
+		assert.Equal(t, "bridge contract balance is unknown", report.Balances[1].Error)
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		report, ok := auditor.Report()
+		require.True(t, ok)
+
+		var metrics bytes.Buffer
+		require.NoError(t, reconciliation.WriteMetrics(&metrics, report))
+		assert.Contains(t, metrics.String(), "tricorn_reconciliation_healthy 0\n")
+		assert.Contains(t, metrics.String(), `tricorn_reconciliation_route_amount{token_id="1",sender_network="CASPER",recipient_network="ETH",status="FINISHED"} 30`)
+		assert.Contains(t, metrics.String(), `tricorn_reconciliation_drift{token_id="1",network="CASPER"} 0`)
+		assert.Contains(t, metrics.String(), `tricorn_reconciliation_balance_violation{token_id="1",network="ETH"} 1`)
+		assert.Contains(t, metrics.String(), `tricorn_reconciliation_anomalies{kind="CONFIRMING_OVERDUE"} 0`)
+	})
+}
+
+// ledgerMock is a mock of transfers ledger.
+type ledgerMock struct {
+	routes    []reconciliation.RouteTotal
+	anomalies []reconciliation.Anomaly
+}
+
+// RouteTotals returns route totals.
+func (mock *ledgerMock) RouteTotals(ctx context.Context) ([]reconciliation.RouteTotal, error) {
+	return mock.routes, nil
+}
+
+// FinishedWithoutOutbound returns anomalies.
+func (mock *ledgerMock) FinishedWithoutOutbound(ctx context.Context) ([]reconciliation.Anomaly, error) {
+	return mock.anomalies, nil
+}
+
+// ConfirmingSince returns no overdue transfers.
+func (mock *ledgerMock) ConfirmingSince(ctx context.Context, before time.Time) ([]reconciliation.Anomaly, error) {
+	return nil, nil
+}
+
+// baselinesMock is an in-memory storage of baselines.
+type baselinesMock struct {
+	mutex     sync.Mutex
+	baselines map[reconciliation.Holding]*big.Int
+}
+
+// Get returns baseline.
+func (mock *baselinesMock) Get(ctx context.Context, holding reconciliation.Holding) (*big.Int, error) {
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+
+	baseline, ok := mock.baselines[holding]
+	if !ok {
+		return nil, reconciliation.ErrNoBaseline
+	}
+
+	return baseline, nil
+}
+
+// Create stores baseline if there is no one.
+func (mock *baselinesMock) Create(ctx context.Context, holding reconciliation.Holding, amount *big.Int) error {
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+
+	if _, ok := mock.baselines[holding]; !ok {
+		mock.baselines[holding] = amount
+	}
+
+	return nil
+}
+
+// chainsMock returns predefined supplies.
+type chainsMock struct {
+	supplies []reconciliation.Supply
+}
+
+// TokenSupplies returns supplies.
+func (mock *chainsMock) TokenSupplies(ctx context.Context) ([]reconciliation.Supply, error) {
+	return mock.supplies, nil
+}
+
+// setBalance changes bridge balance of the holding.
+func (mock *chainsMock) setBalance(holding reconciliation.Holding, balance *big.Int) {
+	mock.update(holding, func(supply *reconciliation.Supply) { supply.BridgeBalance = balance })
+}
+
+// setTotalSupply changes total supply of the holding.
+func (mock *chainsMock) setTotalSupply(holding reconciliation.Holding, totalSupply *big.Int) {
+	mock.update(holding, func(supply *reconciliation.Supply) { supply.TotalSupply = totalSupply })
+}
+
+// setErr makes supply of the holding unreadable.
+func (mock *chainsMock) setErr(holding reconciliation.Holding, err error) {
+	mock.update(holding, func(supply *reconciliation.Supply) { supply.Err = err })
+}
+
+// update applies change to supply of the holding.
+func (mock *chainsMock) update(holding reconciliation.Holding, change func(supply *reconciliation.Supply)) {
+	for i := range mock.supplies {
+		if mock.supplies[i].Holding == holding {
+			change(&mock.supplies[i])
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package reconciliation
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"tricorn/bridge/networks"
+)
+
+// metricsPrefix is a prefix of names of all reconciliation metrics.
+const metricsPrefix = "tricorn_reconciliation_"
+
+// WriteMetrics writes report in the prometheus text exposition format.
+func WriteMetrics(w io.Writer, report Report) error {
+	var metrics strings.Builder
+
+	gauge := func(name, help string) {
+		fmt.Fprintf(&metrics, "# HELP %s%s %s\n# TYPE %s%s gauge\n", metricsPrefix, name, help, metricsPrefix, name)
+	}
+	sample := func(name string, labels string, value string) {
+		fmt.Fprintf(&metrics, "%s%s{%s} %s\n", metricsPrefix, name, labels, value)
+	}
+
+	gauge("healthy", "Whether all balances are reconciled and there are no anomalies.")
+	fmt.Fprintf(&metrics, "%shealthy %d\n", metricsPrefix, boolValue(report.Healthy))
+	gauge("generated_timestamp_seconds", "Time when the report was generated.")
+	fmt.Fprintf(&metrics, "%sgenerated_timestamp_seconds %d\n", metricsPrefix, report.GeneratedAt.Unix())
+
+	gauge("route_transfers", "Number of transfers by token, route and status.")
+	for _, route := range report.Routes {
+		sample("route_transfers", routeLabels(route), strconv.FormatUint(route.Count, 10))
+	}
+	gauge("route_amount", "Sum of transfers by token, route and status.")
+	for _, route := range report.Routes {
+		sample("route_amount", routeLabels(route), floatValue(route.Amount))
+	}
+
+	balanceMetrics := []struct {
+		name  string
+		help  string
+		value func(Balance) *big.Int
+	}{
+		{"bridge_balance", "Balance of the token held by bridge contract.", func(b Balance) *big.Int { return b.BridgeBalance }},
+		{"total_supply", "Total supply of the token.", func(b Balance) *big.Int { return b.TotalSupply }},
+		{"locked", "Sum of transfers received by bridge contract.", func(b Balance) *big.Int { return b.Locked }},
+		{"released", "Sum of transfers sent by bridge contract.", func(b Balance) *big.Int { return b.Released }},
+		{"expected_balance", "Balance bridge contract has to hold according to transfers.", func(b Balance) *big.Int { return b.Expected }},
+		{"drift", "Difference between actual and expected balance of bridge contract.", func(b Balance) *big.Int { return b.Drift }},
+	}
+	for _, metric := range balanceMetrics {
+		gauge(metric.name, metric.help)
+		for _, balance := range report.Balances {
+			if value := metric.value(balance); value != nil {
+				sample(metric.name, holdingLabels(balance.Holding), floatValue(value))
+			}
+		}
+	}
+
+	gauge("balance_violation", "Whether balance of bridge contract breaks invariants or could not be reconciled.")
+	for _, balance := range report.Balances {
+		violated := balance.Violation != "" || balance.Error != ""
+		sample("balance_violation", holdingLabels(balance.Holding), strconv.Itoa(boolValue(violated)))
+	}
+
+	anomalies := map[AnomalyKind]int{AnomalyFinishedWithoutOutbound: 0, AnomalyConfirmingOverdue: 0}
+	for _, anomaly := range report.Anomalies {
+		anomalies[anomaly.Kind]++
+	}
+	gauge("anomalies", "Number of transfers which break lifecycle invariants by kind.")
+	for _, kind := range []AnomalyKind{AnomalyFinishedWithoutOutbound, AnomalyConfirmingOverdue} {
+		sample("anomalies", fmt.Sprintf(`kind=%q`, kind), strconv.Itoa(anomalies[kind]))
+	}
+
+	_, err := io.WriteString(w, metrics.String())
+	return err
+}
+
+// routeLabels returns labels of route total.
+func routeLabels(route RouteTotal) string {
+	return fmt.Sprintf(`token_id="%d",sender_network=%q,recipient_network=%q,status=%q`, route.TokenID,
+		networkName(route.SenderNetworkID), networkName(route.RecipientNetworkID), route.Status)
+}
+
+// holdingLabels returns labels of bridge contract balance.
+func holdingLabels(holding Holding) string {
+	return fmt.Sprintf(`token_id="%d",network=%q`, holding.TokenID, networkName(holding.NetworkID))
+}
+
+// networkName returns name of the network, id is used for networks which are not registered.
+func networkName(networkID networks.ID) string {
+	name, ok := networks.NameByID(networkID)
+	if !ok {
+		return strconv.Itoa(int(networkID))
+	}
+
+	return name.String()
+}
+
+// floatValue formats amount as a metric value.
+func floatValue(amount *big.Int) string {
+	return new(big.Float).SetInt(amount).Text('g', -1)
+}
+
+// boolValue converts flag to a metric value.
+func boolValue(flag bool) int {
+	if flag {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,202 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+)
+
+// ErrNoBaseline indicates that baseline of the bridge contract balance is not calibrated yet.
+var ErrNoBaseline = errors.New("reconciliation baseline does not exist")
+
+// Ledger exposes transfers, which are accounted by the bridge, in the form required for reconciliation.
+//
+// architecture: Database
+type Ledger interface {
+	// RouteTotals returns amount and sum of transfers grouped by token, route and status.
+	RouteTotals(ctx context.Context) ([]RouteTotal, error)
+	// FinishedWithoutOutbound returns finished transfers which have no outbound transaction.
+	FinishedWithoutOutbound(ctx context.Context) ([]Anomaly, error)
+	// ConfirmingSince returns confirming transfers which triggering transaction was seen before specified time.
+	ConfirmingSince(ctx context.Context, before time.Time) ([]Anomaly, error)
+}
+
+// Baselines exposes access to balances of bridge contracts, which are not explained by transfers, e.g. liquidity
+// provided before the bridge started accounting transfers.
+//
+// architecture: Database
+type Baselines interface {
+	// Get returns baseline of bridge contract balance of the token, ErrNoBaseline is returned if there is no one.
+	Get(ctx context.Context, holding Holding) (*big.Int, error)
+	// Create stores baseline of bridge contract balance of the token, already existing baseline is kept.
+	Create(ctx context.Context, holding Holding, amount *big.Int) error
+}
+
+// Chains exposes balances of bridge contracts on connected networks.
+type Chains interface {
+	// TokenSupplies returns bridge contract balances and total supplies of all tokens on all connected networks.
+	TokenSupplies(ctx context.Context) ([]Supply, error)
+}
+
+// Holding identifies balance of the token held by bridge contract on the network.
+type Holding struct {
+	NetworkID networks.ID `json:"networkId"`
+	TokenID   int64       `json:"tokenId"`
+}
+
+// RouteTotal describes transfers of the token by the route in the specific status.
+type RouteTotal struct {
+	TokenID            int64            `json:"tokenId"`
+	SenderNetworkID    networks.ID      `json:"senderNetworkId"`
+	RecipientNetworkID networks.ID      `json:"recipientNetworkId"`
+	Status             transfers.Status `json:"status"`
+	Count              uint64           `json:"count"`
+	Amount             *big.Int         `json:"amount"`
+}
+
+// Supply describes balance of the bridge contract and total supply of the token on the network.
+// Err is set if supply could not be read from the network.
+type Supply struct {
+	Holding
+	BridgeBalance *big.Int
+	TotalSupply   *big.Int
+	Err           error
+}
+
+// AnomalyKind defines kind of transfer which breaks transfer lifecycle invariants.
+type AnomalyKind string
+
+const (
+	// AnomalyFinishedWithoutOutbound indicates that transfer is finished, but there is no outbound transaction.
+	AnomalyFinishedWithoutOutbound AnomalyKind = "FINISHED_WITHOUT_OUTBOUND_TX"
+	// AnomalyConfirmingOverdue indicates that transfer is confirming for longer than allowed.
+	AnomalyConfirmingOverdue AnomalyKind = "CONFIRMING_OVERDUE"
+)
+
+// Anomaly describes transfer which breaks transfer lifecycle invariants.
+type Anomaly struct {
+	Kind               AnomalyKind  `json:"kind"`
+	TransferID         transfers.ID `json:"transferId"`
+	TokenID            int64        `json:"tokenId"`
+	SenderNetworkID    networks.ID  `json:"senderNetworkId"`
+	RecipientNetworkID networks.ID  `json:"recipientNetworkId"`
+	Amount             *big.Int     `json:"amount"`
+	// Since is a time since which transfer is in its status as far as it is known.
+	Since time.Time `json:"since"`
+}
+
+// Flow describes funds of the token, which went through bridge contract on the network.
+type Flow struct {
+	// Locked is a sum of transfers, funds of which were received by bridge contract.
+	Locked *big.Int
+	// Released is a sum of transfers, funds of which were sent by bridge contract.
+	Released *big.Int
+}
+
+// Flows sums route totals into funds, which went through bridge contracts. Funds are locked on the sender network
+// as soon as transfer is confirming or held, and released on the recipient network when it is finished.
+func Flows(routes []RouteTotal) map[Holding]Flow {
+	flows := make(map[Holding]Flow)
+	flow := func(holding Holding) Flow {
+		current, ok := flows[holding]
+		if !ok {
+			current = Flow{Locked: new(big.Int), Released: new(big.Int)}
+			flows[holding] = current
+		}
+
+		return current
+	}
+
+	for _, route := range routes {
+		if route.Status != transfers.StatusConfirming && route.Status != transfers.StatusHeld &&
+			route.Status != transfers.StatusFinished {
+			continue
+		}
+
+		sender := flow(Holding{NetworkID: route.SenderNetworkID, TokenID: route.TokenID})
+		sender.Locked.Add(sender.Locked, route.Amount)
+
+		if route.Status == transfers.StatusFinished {
+			recipient := flow(Holding{NetworkID: route.RecipientNetworkID, TokenID: route.TokenID})
+			recipient.Released.Add(recipient.Released, route.Amount)
+		}
+	}
+
+	return flows
+}
+
+// Balance describes reconciliation of the bridge contract balance with transfers accounted by the bridge.
+type Balance struct {
+	Holding
+	BridgeBalance *big.Int `json:"bridgeBalance,omitempty"`
+	TotalSupply   *big.Int `json:"totalSupply,omitempty"`
+	Baseline      *big.Int `json:"baseline,omitempty"`
+	Locked        *big.Int `json:"locked"`
+	Released      *big.Int `json:"released"`
+	// Expected is a balance bridge contract has to hold according to transfers, which is baseline + locked - released.
+	Expected *big.Int `json:"expected,omitempty"`
+	// Drift is a difference between actual and expected balance, negative drift means that bridge contract released
+	// more than it received, e.g. transfer was paid out twice.
+	Drift *big.Int `json:"drift,omitempty"`
+	// Violation describes broken invariant, it is empty if balance is reconciled.
+	Violation string `json:"violation,omitempty"`
+	// Error describes why balance could not be reconciled.
+	Error string `json:"error,omitempty"`
+}
+
+// Reconcile compares actual balance of the bridge contract with the expected one.
+func Reconcile(supply Supply, flow Flow, baseline *big.Int) Balance {
+	balance := Balance{
+		Holding:       supply.Holding,
+		BridgeBalance: supply.BridgeBalance,
+		TotalSupply:   supply.TotalSupply,
+		Baseline:      baseline,
+		Locked:        flow.Locked,
+		Released:      flow.Released,
+	}
+	if supply.Err != nil {
+		balance.Error = supply.Err.Error()
+		return balance
+	}
+
+	balance.Expected = new(big.Int).Add(baseline, flow.Locked)
+	balance.Expected.Sub(balance.Expected, flow.Released)
+	balance.Drift = new(big.Int).Sub(supply.BridgeBalance, balance.Expected)
+
+	switch {
+	case balance.Drift.Sign() < 0:
+		balance.Violation = "bridge contract holds less than expected"
+	case supply.BridgeBalance.Cmp(supply.TotalSupply) > 0:
+		balance.Violation = "bridge contract holds more than total supply"
+	}
+
+	return balance
+}
+
+// Report describes the result of reconciliation.
+type Report struct {
+	GeneratedAt time.Time    `json:"generatedAt"`
+	Healthy     bool         `json:"healthy"`
+	Routes      []RouteTotal `json:"routes"`
+	Balances    []Balance    `json:"balances"`
+	Anomalies   []Anomaly    `json:"anomalies"`
+}
+
+// IsHealthy returns true if all balances are reconciled and there are no anomalies.
+func (report Report) IsHealthy() bool {
+	if len(report.Anomalies) > 0 {
+		return false
+	}
+
+	for _, balance := range report.Balances {
+		if balance.Violation != "" || balance.Error != "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,104 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
+
+	"tricorn/internal/logger"
+	"tricorn/internal/server"
+)
+
+// ensures that Server implements server.Server.
+var _ server.Server = (*Server)(nil)
+
+// ErrServer indicates that there was an error in the reconciliation server.
+var ErrServer = errs.Class("reconciliation server")
+
+// Server exposes the latest reconciliation report as json and as prometheus metrics.
+//
+// architecture: Endpoint
+type Server struct {
+	log      logger.Logger
+	listener net.Listener
+	server   http.Server
+
+	auditor *Auditor
+}
+
+// NewServer is a constructor for reconciliation server.
+func NewServer(log logger.Logger, listener net.Listener, auditor *Auditor) *Server {
+	reconciliationServer := &Server{
+		log:      log,
+		listener: listener,
+		auditor:  auditor,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/reconciliation", reconciliationServer.serveReport)
+	mux.HandleFunc("/metrics", reconciliationServer.serveMetrics)
+	reconciliationServer.server = http.Server{
+		Handler: mux,
+	}
+
+	return reconciliationServer
+}
+
+// Run serves reports until context is cancelled.
+func (server *Server) Run(ctx context.Context) error {
+	server.log.Debug(fmt.Sprintf("running reconciliation server on %s", server.listener.Addr()))
+
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.server.Shutdown(context.Background())
+	})
+	group.Go(func() error {
+		err := server.server.Serve(server.listener)
+		if errors.Is(err, http.ErrServerClosed) {
+			err = nil
+		}
+		return err
+	})
+
+	return ErrServer.Wrap(group.Wait())
+}
+
+// Close closes server and underlying listener.
+func (server *Server) Close() error {
+	return ErrServer.Wrap(server.server.Close())
+}
+
+// serveReport replies with the latest reconciliation report in json.
+func (server *Server) serveReport(w http.ResponseWriter, r *http.Request) {
+	report, ok := server.auditor.Report()
+	if !ok {
+		http.Error(w, "reconciliation was not performed yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		server.log.Error("failed to write reconciliation report", ErrServer.Wrap(err))
+	}
+}
+
+// serveMetrics replies with the latest reconciliation report in prometheus text format.
+func (server *Server) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	report, ok := server.auditor.Report()
+	if !ok {
+		http.Error(w, "reconciliation was not performed yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if err := WriteMetrics(w, report); err != nil {
+		server.log.Error("failed to write reconciliation metrics", ErrServer.Wrap(err))
+	}
+}
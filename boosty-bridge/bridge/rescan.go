@@ -0,0 +1,88 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/rescan"
+	"tricorn/bridge/transfers"
+	"tricorn/chains"
+)
+
+// Rescan reads bridge events of the range of blocks from the network connector and processes them again.
+// Events already recorded as transactions are reported as known and are not processed.
+func (service *Service) Rescan(ctx context.Context, req rescan.Request) (rescan.Report, error) {
+	if err := req.Validate(); err != nil {
+		return rescan.Report{}, err
+	}
+
+	networkName, _ := networks.NameByID(req.NetworkID)
+	connector, exists := service.connectors[networkName]
+	if !exists {
+		return rescan.Report{}, Error.Wrap(ErrNotConnectedNetwork)
+	}
+
+	events, err := connector.EventRange(ctx, req.FromBlock, req.ToBlock)
+	if err != nil {
+		return rescan.Report{}, Error.Wrap(err)
+	}
+
+	report := rescan.Report{
+		Processed: make([]rescan.Event, 0),
+		Known:     make([]rescan.Event, 0),
+	}
+	for _, eventFund := range events {
+		networkID, event, ok := rescanEvent(eventFund, networkName)
+		if !ok {
+			continue
+		}
+
+		err = service.transactions.Exists(ctx, networkID, event.TxHash, int64(event.LogIndex))
+		if err != nil {
+			if !errors.Is(err, ErrTransactionAlreadyExists) {
+				return report, Error.Wrap(err)
+			}
+
+			report.Known = append(report.Known, event)
+			continue
+		}
+
+		if err = service.separateEvent(ctx, eventFund, networkName); err != nil {
+			return report, err
+		}
+
+		report.Processed = append(report.Processed, event)
+	}
+
+	return report, nil
+}
+
+// rescanEvent returns network under which transaction of the event is recorded and description of the event,
+// false is returned for events which are not recorded as transactions.
+func rescanEvent(eventFund chains.EventVariant, networkName networks.Name) (networks.ID, rescan.Event, bool) {
+	switch eventFund.Type {
+	case chains.EventTypeIn:
+		networkID, _ := networks.IDByName(networkName)
+		return networkID, rescan.Event{
+			Kind:        transfers.EventKindFundsIn,
+			TxHash:      eventFund.EventFundsIn.Tx.Hash,
+			LogIndex:    eventFund.EventFundsIn.Tx.LogIndex,
+			BlockNumber: eventFund.EventFundsIn.Tx.BlockNumber,
+		}, true
+	case chains.EventTypeOut:
+		// bridge out transaction is recorded under the sender network, same as eventOutReaction does.
+		networkID, _ := networks.IDByName(networks.Name(eventFund.EventFundsOut.From.NetworkName))
+		return networkID, rescan.Event{
+			Kind:        transfers.EventKindFundsOut,
+			TxHash:      eventFund.EventFundsOut.Tx.Hash,
+			LogIndex:    eventFund.EventFundsOut.Tx.LogIndex,
+			BlockNumber: eventFund.EventFundsOut.Tx.BlockNumber,
+		}, true
+	default:
+		return 0, rescan.Event{}, false
+	}
+}
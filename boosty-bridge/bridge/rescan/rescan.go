@@ -0,0 +1,51 @@
+package rescan
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+	"tricorn/chains"
+)
+
+// ErrRescan indicates that there was an error during rescan of the network blocks.
+var ErrRescan = errs.Class("rescan")
+
+// Bridge exposes access to the bridge back-end methods related to rescan of the network blocks.
+type Bridge interface {
+	// Rescan processes bridge events of the range of blocks again, events which are already known are skipped.
+	Rescan(ctx context.Context, req Request) (Report, error)
+}
+
+// Request describes range of blocks of the network, events of which are processed again.
+type Request struct {
+	NetworkID networks.ID
+	FromBlock uint64
+	ToBlock   uint64
+}
+
+// Validate checks that network is known and range of blocks is bounded.
+func (req Request) Validate() error {
+	if _, ok := networks.NameByID(req.NetworkID); !ok {
+		return ErrRescan.New("unknown network %d", req.NetworkID)
+	}
+
+	return ErrRescan.Wrap(chains.ValidateEventRange(req.FromBlock, req.ToBlock))
+}
+
+// Event describes bridge event found by the rescan.
+type Event struct {
+	Kind        transfers.EventKind `json:"kind"`
+	TxHash      []byte              `json:"txHash"`
+	LogIndex    uint64              `json:"logIndex"`
+	BlockNumber uint64              `json:"blockNumber"`
+}
+
+// Report describes outcome of the rescan. Processed events were missed before and are processed by the rescan,
+// known ones were already processed.
+type Report struct {
+	Processed []Event `json:"processed"`
+	Known     []Event `json:"known"`
+}
@@ -0,0 +1,31 @@
+package rescan_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/rescan"
+	"tricorn/chains"
+)
+
+func TestRequestValidate(t *testing.T) {
+	valid := rescan.Request{NetworkID: networks.IDGoerli, FromBlock: 100, ToBlock: 200}
+	assert.NoError(t, valid.Validate())
+
+	single := rescan.Request{NetworkID: networks.IDGoerli, FromBlock: 100, ToBlock: 100}
+	assert.NoError(t, single.Validate())
+
+	unknownNetwork := rescan.Request{NetworkID: 1000, FromBlock: 100, ToBlock: 200}
+	assert.True(t, rescan.ErrRescan.Has(unknownNetwork.Validate()))
+
+	reversed := rescan.Request{NetworkID: networks.IDGoerli, FromBlock: 200, ToBlock: 100}
+	assert.True(t, rescan.ErrRescan.Has(reversed.Validate()))
+
+	tooWide := rescan.Request{NetworkID: networks.IDGoerli, FromBlock: 1, ToBlock: 1 + chains.MaxEventRangeBlocks}
+	assert.True(t, rescan.ErrRescan.Has(tooWide.Validate()))
+
+	widest := rescan.Request{NetworkID: networks.IDGoerli, FromBlock: 1, ToBlock: chains.MaxEventRangeBlocks}
+	assert.NoError(t, widest.Validate())
+}
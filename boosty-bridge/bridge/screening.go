@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tricorn/bridge/screening"
+	"tricorn/bridge/transfers"
+)
+
+// screeningFailureProvider is a provider of verdicts issued when addresses couldn't be screened.
+const screeningFailureProvider = "screening failure"
+
+// screenTransfer screens sender and recipient of the transfer. Failed screening flags the transfer, so it is held
+// until operator releases it once screening succeeds, instead of being bridged out unscreened.
+func (service *Service) screenTransfer(ctx context.Context, sender, recipient screening.Subject) screening.Verdict {
+	verdict, err := screening.Check(ctx, service.screener, sender, recipient)
+	if err != nil {
+		service.log.Error("couldn't screen transfer addresses", Error.Wrap(err))
+
+		return screening.Verdict{
+			Subject:  verdict.Subject,
+			Flagged:  true,
+			Provider: screeningFailureProvider,
+			Reason:   err.Error(),
+		}
+	}
+
+	return verdict
+}
+
+// holdTransfer records hold of the transfer, which address was flagged by screening.
+func (service *Service) holdTransfer(ctx context.Context, transferID transfers.ID, verdict screening.Verdict) error {
+	service.log.Warn(fmt.Sprintf("transfer %d is held: %s", transferID, verdict))
+
+	return service.holds.Create(ctx, screening.Hold{
+		TransferID: transferID,
+		NetworkID:  verdict.Subject.NetworkID,
+		Address:    verdict.Subject.Address,
+		Provider:   verdict.Provider,
+		Reason:     verdict.Reason,
+		CreatedAt:  time.Now().UTC(),
+	})
+}
@@ -0,0 +1,66 @@
+package screening
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrNotDenied indicates that address is not in the denylist.
+var ErrNotDenied = errs.Class("address is not denied")
+
+// DenylistProvider is a name of the provider of verdicts issued by denylist.
+const DenylistProvider = "denylist"
+
+// Denylist exposes access to the addresses denied by operators.
+//
+// architecture: DB
+type Denylist interface {
+	// Add adds address to the denylist, existing entry of the same address is replaced.
+	Add(ctx context.Context, entry Entry) error
+	// Get returns entry of the address, ErrNotDenied is returned if address is not denied.
+	Get(ctx context.Context, subject Subject) (Entry, error)
+	// List returns all entries of the denylist.
+	List(ctx context.Context) ([]Entry, error)
+	// Remove removes address from the denylist, ErrNotDenied is returned if address is not denied.
+	Remove(ctx context.Context, subject Subject) error
+}
+
+// Entry describes address denied by operator.
+type Entry struct {
+	Subject
+	Reason    string
+	Operator  string
+	CreatedAt time.Time
+}
+
+// DenylistScreener flags addresses from the denylist.
+type DenylistScreener struct {
+	denylist Denylist
+}
+
+// NewDenylistScreener is a constructor for denylist screener.
+func NewDenylistScreener(denylist Denylist) *DenylistScreener {
+	return &DenylistScreener{
+		denylist: denylist,
+	}
+}
+
+// Screen flags address if it is in the denylist.
+func (screener *DenylistScreener) Screen(ctx context.Context, subject Subject) (Verdict, error) {
+	entry, err := screener.denylist.Get(ctx, subject)
+	if err != nil {
+		if ErrNotDenied.Has(err) {
+			return Verdict{Subject: subject}, nil
+		}
+		return Verdict{}, ErrScreening.Wrap(err)
+	}
+
+	return Verdict{
+		Subject:  subject,
+		Flagged:  true,
+		Provider: DenylistProvider,
+		Reason:   entry.Reason,
+	}, nil
+}
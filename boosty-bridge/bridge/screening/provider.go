@@ -0,0 +1,113 @@
+package screening
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"tricorn/bridge/networks"
+)
+
+// maxResponseLength defines how much of the failed provider response is kept as error.
+const maxResponseLength = 512
+
+// ProviderConfig defines how screening provider is requested.
+type ProviderConfig struct {
+	// Name is a name of the provider, which is recorded in verdicts.
+	Name string
+	URL  string
+	// Token is sent as bearer authorization if it is not empty.
+	Token   string
+	Timeout time.Duration
+}
+
+// ProviderRequest is a body of the request to the screening provider.
+type ProviderRequest struct {
+	Network networks.Name `json:"network"`
+	Address string        `json:"address"`
+}
+
+// ProviderResponse is a body of the screening provider response.
+type ProviderResponse struct {
+	Flagged bool   `json:"flagged"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// ProviderScreener screens addresses by external screening provider over http.
+type ProviderScreener struct {
+	config ProviderConfig
+	client *http.Client
+}
+
+// NewProviderScreener is a constructor for http screening provider.
+func NewProviderScreener(config ProviderConfig) *ProviderScreener {
+	return &ProviderScreener{
+		config: config,
+		client: &http.Client{
+			Timeout: config.Timeout,
+		},
+	}
+}
+
+// Screen posts address to the provider and returns its verdict.
+func (screener *ProviderScreener) Screen(ctx context.Context, subject Subject) (Verdict, error) {
+	name, ok := networks.NameByID(subject.NetworkID)
+	if !ok {
+		return Verdict{}, ErrScreening.New("unknown network %d", subject.NetworkID)
+	}
+
+	networkCodec, err := subject.NetworkID.Codec()
+	if err != nil {
+		return Verdict{}, ErrScreening.Wrap(err)
+	}
+
+	address, err := networkCodec.FormatAddress(subject.Address)
+	if err != nil {
+		return Verdict{}, ErrScreening.Wrap(err)
+	}
+
+	body, err := json.Marshal(ProviderRequest{Network: name, Address: address})
+	if err != nil {
+		return Verdict{}, ErrScreening.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, screener.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return Verdict{}, ErrScreening.Wrap(err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if screener.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+screener.config.Token)
+	}
+
+	resp, err := screener.client.Do(req)
+	if err != nil {
+		return Verdict{}, ErrScreening.Wrap(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		response, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseLength))
+		return Verdict{}, ErrScreening.New("%s responded with %d: %s", screener.config.Name, resp.StatusCode,
+			strings.TrimSpace(string(response)))
+	}
+
+	var response ProviderResponse
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return Verdict{}, ErrScreening.New("malformed response of %s: %v", screener.config.Name, err)
+	}
+
+	return Verdict{
+		Subject:  subject,
+		Flagged:  response.Flagged,
+		Provider: screener.config.Name,
+		Reason:   response.Reason,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package screening
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+)
+
+var (
+	// ErrScreening indicates that there was an error in the address screening.
+	ErrScreening = errs.Class("screening")
+	// ErrDenied indicates that request was refused, since address was flagged by screening.
+	ErrDenied = errs.Class("address denied")
+)
+
+// Screener checks whether address is sanctioned, so bridge has to refuse its transfers.
+type Screener interface {
+	// Screen returns verdict on the address.
+	Screen(ctx context.Context, subject Subject) (Verdict, error)
+}
+
+// Subject describes screened address.
+type Subject struct {
+	NetworkID networks.ID
+	Address   []byte
+}
+
+// String returns address formatted by the codec of its network.
+func (subject Subject) String() string {
+	name, _ := networks.NameByID(subject.NetworkID)
+
+	networkCodec, err := subject.NetworkID.Codec()
+	if err != nil {
+		return fmt.Sprintf("%s %x", name, subject.Address)
+	}
+
+	address, err := networkCodec.FormatAddress(subject.Address)
+	if err != nil {
+		return fmt.Sprintf("%s %x", name, subject.Address)
+	}
+
+	return fmt.Sprintf("%s %s", name, address)
+}
+
+// Verdict describes result of the address screening.
+type Verdict struct {
+	Subject Subject
+	Flagged bool
+	// Provider is a name of the screener which flagged address.
+	Provider string
+	Reason   string
+}
+
+// String returns description of the verdict.
+func (verdict Verdict) String() string {
+	if !verdict.Flagged {
+		return fmt.Sprintf("address %s is not flagged", verdict.Subject)
+	}
+
+	return fmt.Sprintf("address %s is flagged by %s: %s", verdict.Subject, verdict.Provider, verdict.Reason)
+}
+
+// Screeners combines screeners, address is flagged if any of them flags it.
+type Screeners []Screener
+
+// Screen returns the first flagged verdict of the screeners in their order.
+func (screeners Screeners) Screen(ctx context.Context, subject Subject) (Verdict, error) {
+	for _, screener := range screeners {
+		verdict, err := screener.Screen(ctx, subject)
+		if err != nil || verdict.Flagged {
+			return verdict, err
+		}
+	}
+
+	return Verdict{Subject: subject}, nil
+}
+
+// Check screens all subjects and returns the first flagged verdict, not flagged verdict is returned if all of them
+// passed screening.
+func Check(ctx context.Context, screener Screener, subjects ...Subject) (Verdict, error) {
+	for _, subject := range subjects {
+		verdict, err := screener.Screen(ctx, subject)
+		if err != nil {
+			return Verdict{Subject: subject}, ErrScreening.Wrap(err)
+		}
+
+		if verdict.Flagged {
+			return verdict, nil
+		}
+	}
+
+	return Verdict{}, nil
+}
+
+// Holds exposes access to the audit of transfers, which were held since their addresses were flagged by screening.
+//
+// architecture: DB
+type Holds interface {
+	// Create records hold of the transfer, already held transfer is kept as is.
+	Create(ctx context.Context, hold Hold) error
+	// List returns holds from the oldest to the newest one.
+	List(ctx context.Context) ([]Hold, error)
+}
+
+// Hold describes transfer, bridge out of which was withheld by screening.
+type Hold struct {
+	TransferID transfers.ID `json:"transferId"`
+	// NetworkID and Address describe flagged address.
+	NetworkID networks.ID `json:"networkId"`
+	Address   []byte      `json:"address"`
+	Provider  string      `json:"provider"`
+	Reason    string      `json:"reason"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
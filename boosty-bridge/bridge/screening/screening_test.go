@@ -0,0 +1,145 @@
+package screening_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/screening"
+)
+
+func TestScreening(t *testing.T) {
+	ctx := context.Background()
+
+	sanctioned := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	clean := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	other := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	denylist := &denylistMock{}
+	require.NoError(t, denylist.Add(ctx, screening.Entry{
+		Subject: screening.Subject{NetworkID: networks.IDEth, Address: sanctioned.Bytes()},
+		Reason:  "sanctioned",
+	}))
+
+	var requests []screening.ProviderRequest
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var request screening.ProviderRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, request)
+
+		if request.Address == other.Hex() {
+			_ = json.NewEncoder(w).Encode(screening.ProviderResponse{Flagged: true, Reason: "mixer"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(screening.ProviderResponse{})
+	}))
+	defer provider.Close()
+
+	providerConfig := screening.ProviderConfig{Name: "stub", URL: provider.URL, Token: "token", Timeout: time.Second}
+	screener := screening.Screeners{
+		screening.NewDenylistScreener(denylist),
+		screening.NewProviderScreener(providerConfig),
+	}
+
+	t.Run("denylist", func(t *testing.T) {
+		verdict, err := screener.Screen(ctx, screening.Subject{NetworkID: networks.IDEth, Address: sanctioned.Bytes()})
+		require.NoError(t, err)
+		assert.True(t, verdict.Flagged)
+		assert.Equal(t, screening.DenylistProvider, verdict.Provider)
+		assert.Equal(t, "sanctioned", verdict.Reason)
+
+		// denylisted address is not sent to the provider.
+		assert.Empty(t, requests)
+
+		// address is denied only in its network.
+		verdict, err = screener.Screen(ctx, screening.Subject{NetworkID: networks.IDPolygon, Address: sanctioned.Bytes()})
+		require.NoError(t, err)
+		assert.False(t, verdict.Flagged)
+	})
+
+	t.Run("provider", func(t *testing.T) {
+		verdict, err := screener.Screen(ctx, screening.Subject{NetworkID: networks.IDEth, Address: other.Bytes()})
+		require.NoError(t, err)
+		assert.True(t, verdict.Flagged)
+		assert.Equal(t, "stub", verdict.Provider)
+		assert.Equal(t, "mixer", verdict.Reason)
+
+		require.NotEmpty(t, requests)
+		assert.Equal(t, screening.ProviderRequest{Network: networks.NameEth, Address: other.Hex()}, requests[len(requests)-1])
+	})
+
+	t.Run("check", func(t *testing.T) {
+		sender := screening.Subject{NetworkID: networks.IDEth, Address: clean.Bytes()}
+		recipient := screening.Subject{NetworkID: networks.IDEth, Address: other.Bytes()}
+
+		verdict, err := screening.Check(ctx, screener, sender, sender)
+		require.NoError(t, err)
+		assert.False(t, verdict.Flagged)
+
+		verdict, err = screening.Check(ctx, screener, sender, recipient)
+		require.NoError(t, err)
+		assert.True(t, verdict.Flagged)
+		assert.Equal(t, recipient, verdict.Subject)
+		assert.Equal(t, "address ETH "+other.Hex()+" is flagged by stub: mixer", verdict.String())
+	})
+
+	t.Run("provider failure", func(t *testing.T) {
+		unauthorized := providerConfig
+		unauthorized.Token = ""
+
+		subject := screening.Subject{NetworkID: networks.IDEth, Address: clean.Bytes()}
+		verdict, err := screening.Check(ctx, screening.NewProviderScreener(unauthorized), subject)
+		require.Error(t, err)
+		assert.True(t, screening.ErrScreening.Has(err))
+		assert.Equal(t, subject, verdict.Subject)
+	})
+}
+
+// denylistMock is an in-memory denylist.
+type denylistMock struct {
+	entries []screening.Entry
+}
+
+// Add adds entry to the denylist.
+func (mock *denylistMock) Add(ctx context.Context, entry screening.Entry) error {
+	mock.entries = append(mock.entries, entry)
+	return nil
+}
+
+// Get returns entry of the address.
+func (mock *denylistMock) Get(ctx context.Context, subject screening.Subject) (screening.Entry, error) {
+	for _, entry := range mock.entries {
+		if entry.NetworkID == subject.NetworkID && bytes.Equal(entry.Address, subject.Address) {
+			return entry, nil
+		}
+	}
+
+	return screening.Entry{}, screening.ErrNotDenied.New("%s", subject)
+}
+
+// List returns all entries.
+func (mock *denylistMock) List(ctx context.Context) ([]screening.Entry, error) {
+	return mock.entries, nil
+}
+
+// Remove is not used by screening.
+func (mock *denylistMock) Remove(ctx context.Context, subject screening.Subject) error {
+	return nil
+}
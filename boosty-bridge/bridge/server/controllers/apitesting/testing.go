@@ -5,7 +5,7 @@ package apitesting
 
 import (
 	"context"
-	"math/big"
+	"strconv"
 	"testing"
 	"time"
 
@@ -21,7 +21,9 @@ import (
 	peer "tricorn"
 	"tricorn/bridge"
 	"tricorn/bridge/database/dbtesting"
+	"tricorn/bridge/limits"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/server/controllers"
 	"tricorn/bridge/transfers"
 	"tricorn/chains"
@@ -67,6 +69,11 @@ func GatewayRun(t *testing.T, test func(ctx context.Context, t *testing.T, db br
 	err = db.CreateSchema(ctx)
 	require.NoError(t, err)
 
+	transferWatcher := bridge.NewTransferWatcher(log, db.TransferStatusChanges())
+
+	limiter, err := limits.NewLimiter(nil, db.LimitCounters())
+	require.NoError(t, err)
+
 	service := bridge.New(
 		log,
 		mockSigner(),
@@ -76,10 +83,25 @@ func GatewayRun(t *testing.T, test func(ctx context.Context, t *testing.T, db br
 		db.Transactions(),
 		db.TokenTransfers(),
 		db.NetworkBlocks(),
+		db.UnmatchedEvents(),
+		transferWatcher,
+		db.WebhookEndpoints(),
+		db.WebhookOutbox(),
+		limiter,
+		db.TransferApprovals(),
+		db.Pauses(),
+		db.PausedTransfers(),
+		screening.NewDenylistScreener(db.Denylist()),
+		db.ScreeningHolds(),
+		db.AuditLog(),
+		db.TransferStatusHistory(),
+		db.OutboundAttempts(),
+		db.DryRunDecisions(),
+		false,
 	)
 
-	casperConnector := getMockConnector()
-	ethConnector := getMockConnector()
+	casperConnector := getMockConnector(networks.TypeCasper)
+	ethConnector := getMockConnector(networks.TypeEVM)
 
 	connectors := map[networks.Name]bridge.Connector{
 		networks.NameCasperTest: casperConnector,
@@ -101,6 +123,9 @@ func GatewayRun(t *testing.T, test func(ctx context.Context, t *testing.T, db br
 	gateway := peer.New(log, nil, nil, server, config.ServerName)
 
 	var group errgroup.Group
+	group.Go(func() error {
+		return transferWatcher.Run(ctx)
+	})
 	group.Go(func() error {
 		return gateway.Run(ctx)
 	})
@@ -150,6 +175,11 @@ func BridgeRun(t *testing.T, test func(ctx context.Context, t *testing.T, db bri
 	err = db.CreateSchema(ctx)
 	require.NoError(t, err)
 
+	transferWatcher := bridge.NewTransferWatcher(log, db.TransferStatusChanges())
+
+	limiter, err := limits.NewLimiter(nil, db.LimitCounters())
+	require.NoError(t, err)
+
 	service := bridge.New(
 		log, mockSigner(),
 		db.Nonces(),
@@ -158,10 +188,25 @@ func BridgeRun(t *testing.T, test func(ctx context.Context, t *testing.T, db bri
 		db.Transactions(),
 		db.TokenTransfers(),
 		db.NetworkBlocks(),
+		db.UnmatchedEvents(),
+		transferWatcher,
+		db.WebhookEndpoints(),
+		db.WebhookOutbox(),
+		limiter,
+		db.TransferApprovals(),
+		db.Pauses(),
+		db.PausedTransfers(),
+		screening.NewDenylistScreener(db.Denylist()),
+		db.ScreeningHolds(),
+		db.AuditLog(),
+		db.TransferStatusHistory(),
+		db.OutboundAttempts(),
+		db.DryRunDecisions(),
+		false,
 	)
 
-	casperConnector := getMockConnector()
-	ethConnector := getMockConnector()
+	casperConnector := getMockConnector(networks.TypeCasper)
+	ethConnector := getMockConnector(networks.TypeEVM)
 
 	connectors := map[networks.Name]bridge.Connector{
 		networks.NameCasperTest: casperConnector,
@@ -183,6 +228,9 @@ func BridgeRun(t *testing.T, test func(ctx context.Context, t *testing.T, db bri
 	server := grpc_server.NewServer(log, registerServer, config.ServerName, config.GrpcServerAddress)
 
 	var group errgroup.Group
+	group.Go(func() error {
+		return transferWatcher.Run(ctx)
+	})
 	group.Go(func() error {
 		return server.Run(ctx)
 	})
@@ -202,13 +250,18 @@ func BridgeRun(t *testing.T, test func(ctx context.Context, t *testing.T, db bri
 	}
 }
 
-func getMockConnector() bridge.Connector {
+func getMockConnector(networkType networks.Type) bridge.Connector {
 	connector := new(mockcommunication.ConnectorMock)
 	connector.SetEstimateTransfer(func(ctx context.Context, req transfers.EstimateTransfer) (chains.Estimation, error) {
 		return chains.Estimation{Fee: "1000", FeePercentage: "12", EstimatedConfirmation: 123}, nil
 	})
 	connector.SetBridgeInSignature(func(ctx context.Context, req bridge.BridgeInSignatureRequest) (bridge.BridgeInSignatureResponse, error) {
-		return bridge.BridgeInSignatureResponse{Nonce: new(big.Int)}, nil
+		deadline := time.Now().Add(time.Hour)
+		if networkType == networks.TypeCasper {
+			return bridge.BridgeInSignatureResponse{Nonce: req.Nonce, Deadline: strconv.FormatInt(deadline.UnixMilli(), 10)}, nil
+		}
+
+		return bridge.BridgeInSignatureResponse{Nonce: req.Nonce, Deadline: strconv.FormatInt(deadline.Unix(), 10)}, nil
 	})
 	connector.SetCancelSignature(func(ctx context.Context, req chains.CancelSignatureRequest) (chains.CancelSignatureResponse, error) {
 		return chains.CancelSignatureResponse{}, nil
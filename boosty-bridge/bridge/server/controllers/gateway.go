@@ -6,8 +6,10 @@ package controllers
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -19,8 +21,11 @@ import (
 
 	"tricorn/bridge"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/transfers"
 	"tricorn/internal/logger"
+	"tricorn/pkg/codec"
 )
 
 // ensures that Gateway implements gatewaybridgepb.GatewayBridgeServer.
@@ -109,9 +114,15 @@ func (gateway *Gateway) SupportedTokens(ctx context.Context, request *networkspb
 	for _, supportedToken := range supportedTokens {
 		var addresses []*networkspb.TokensResponse_TokenAddress
 		for _, supportedAddress := range supportedToken.Addresses {
+			contractAddress, err := formatContract(supportedAddress.NetworkID, supportedAddress.ContractAddress)
+			if err != nil {
+				gateway.log.Error("couldn't format token contract address", err)
+				return &resp, status.Error(codes.Internal, Error.Wrap(err).Error())
+			}
+
 			address := networkspb.TokensResponse_TokenAddress{
 				NetworkId: uint32(supportedAddress.NetworkID),
-				Address:   networks.BytesToString(supportedAddress.NetworkID, supportedAddress.ContractAddress),
+				Address:   contractAddress,
 				Decimals:  uint32(supportedAddress.Decimals),
 			}
 
@@ -185,10 +196,40 @@ func (gateway *Gateway) Transfer(ctx context.Context, request *transferspb.Trans
 	return &resp, nil
 }
 
+// formatContract formats token contract address with the codec of its network.
+func formatContract(networkID networks.ID, contractAddress []byte) (string, error) {
+	networkCodec, err := networkID.Codec()
+	if err != nil {
+		return "", err
+	}
+
+	return networkCodec.FormatContract(contractAddress)
+}
+
+// formatTxHash formats tx hash with the codec of its network, empty string is returned if network is not known yet.
+func formatTxHash(txHash transfers.StringTxHash) string {
+	networkID, ok := networks.IDByName(networks.Name(txHash.NetworkName))
+	if !ok {
+		return ""
+	}
+
+	networkCodec, err := networkID.Codec()
+	if err != nil {
+		return ""
+	}
+
+	hash, err := networkCodec.FormatHash(txHash.Hash.Bytes())
+	if err != nil {
+		return ""
+	}
+
+	return hash
+}
+
 // convertToPbTransfer converts transfers.Transfer to transferspb.TransferResponse_Transfer.
 func convertToPbTransfer(transfer transfers.Transfer) transferspb.TransferResponse_Transfer {
-	triggeringTxHash := networks.BytesToString(networks.NetworkNameToID[networks.Name(transfer.TriggeringTx.NetworkName)], transfer.TriggeringTx.Hash.Bytes())
-	outboundTxHash := networks.BytesToString(networks.NetworkNameToID[networks.Name(transfer.OutboundTx.NetworkName)], transfer.OutboundTx.Hash.Bytes())
+	triggeringTxHash := formatTxHash(transfer.TriggeringTx)
+	outboundTxHash := formatTxHash(transfer.OutboundTx)
 
 	return transferspb.TransferResponse_Transfer{
 		Id:     uint64(transfer.ID),
@@ -225,6 +266,16 @@ func convertToPbTransferStatus(status transfers.Status) transferspb.TransferResp
 	return transferspb.TransferResponse_Status(statusValue)
 }
 
+// convertFromPbTransferStatus converts transferspb.TransferResponse_Status to transfers.Status, unspecified status
+// is converted to empty one.
+func convertFromPbTransferStatus(pbStatus transferspb.TransferResponse_Status) transfers.Status {
+	if pbStatus == transferspb.TransferResponse_STATUS_UNSPECIFIED {
+		return ""
+	}
+
+	return transfers.Status(strings.TrimPrefix(pbStatus.String(), statusPrefixString))
+}
+
 // CancelTransfer generates and returns a signature to cancel a pending transfer.
 func (gateway *Gateway) CancelTransfer(ctx context.Context, request *transferspb.CancelTransferRequest) (*transferspb.CancelTransferResponse, error) {
 	var resp transferspb.CancelTransferResponse
@@ -265,6 +316,10 @@ func (gateway *Gateway) CancelTransfer(ctx context.Context, request *transferspb
 func (gateway *Gateway) TransferHistory(ctx context.Context, request *transferspb.TransferHistoryRequest) (*transferspb.TransferHistoryResponse, error) {
 	var resp transferspb.TransferHistoryResponse
 
+	if request.Cursor != nil {
+		return gateway.transferHistoryByCursor(ctx, request)
+	}
+
 	page, err := gateway.bridge.History(ctx, request.GetOffset(), request.GetLimit(), request.GetUserSignature(), request.GetPublicKey(), request.GetNetworkId())
 	if err != nil {
 		if errors.Is(err, bridge.ErrNotConnectedNetwork) {
@@ -290,6 +345,65 @@ func (gateway *Gateway) TransferHistory(ctx context.Context, request *transfersp
 	return &resp, nil
 }
 
+// transferHistoryByCursor returns filtered transfer history for user, which is paginated by cursor.
+func (gateway *Gateway) transferHistoryByCursor(ctx context.Context, request *transferspb.TransferHistoryRequest) (*transferspb.TransferHistoryResponse, error) {
+	var resp transferspb.TransferHistoryResponse
+
+	historyRequest := transfers.HistoryRequest{
+		Signature: request.GetUserSignature(),
+		PublicKey: request.GetPublicKey(),
+		NetworkID: request.GetNetworkId(),
+		Sort:      transfers.SortNewest,
+		Cursor:    request.GetCursor(),
+		Limit:     request.GetLimit(),
+	}
+	for _, pbStatus := range request.GetStatuses() {
+		historyRequest.Filter.Statuses = append(historyRequest.Filter.Statuses, convertFromPbTransferStatus(pbStatus))
+	}
+	for _, networkID := range request.GetSenderNetworkIds() {
+		historyRequest.Filter.SenderNetworks = append(historyRequest.Filter.SenderNetworks, networks.ID(networkID))
+	}
+	for _, networkID := range request.GetRecipientNetworkIds() {
+		historyRequest.Filter.RecipientNetworks = append(historyRequest.Filter.RecipientNetworks, networks.ID(networkID))
+	}
+	for _, tokenID := range request.GetTokenIds() {
+		historyRequest.Filter.TokenIDs = append(historyRequest.Filter.TokenIDs, int64(tokenID))
+	}
+	if request.CreatedFrom != nil {
+		historyRequest.Filter.CreatedFrom = request.GetCreatedFrom().AsTime()
+	}
+	if request.CreatedTo != nil {
+		historyRequest.Filter.CreatedTo = request.GetCreatedTo().AsTime()
+	}
+	if request.GetSort() == transferspb.TransferHistoryRequest_SORT_OLDEST {
+		historyRequest.Sort = transfers.SortOldest
+	}
+
+	page, err := gateway.bridge.HistoryByCursor(ctx, historyRequest)
+	if err != nil {
+		if errors.Is(err, bridge.ErrNotConnectedNetwork) {
+			gateway.log.Error("invalid network", err)
+			return &resp, status.Error(codes.NotFound, Error.Wrap(err).Error())
+		}
+
+		if errors.Is(err, networks.ErrTransactionNameInvalid) || transfers.ErrInvalidHistoryRequest.Has(err) {
+			gateway.log.Error("invalid request", err)
+			return &resp, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+		}
+
+		gateway.log.Error("couldn't get transfer history", err)
+		return &resp, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	resp.NextCursor = page.NextCursor
+	for _, transfer := range page.Transfers {
+		respTransfer := convertToPbTransfer(transfer)
+		resp.Statuses = append(resp.Statuses, &respTransfer)
+	}
+
+	return &resp, nil
+}
+
 // BridgeInSignature returns signature for user to send bridgeIn transaction.
 func (gateway *Gateway) BridgeInSignature(ctx context.Context, request *transferspb.BridgeInSignatureRequest) (*transferspb.BridgeInSignatureResponse, error) {
 	var resp transferspb.BridgeInSignatureResponse
@@ -311,6 +425,12 @@ func (gateway *Gateway) BridgeInSignature(ctx context.Context, request *transfer
 			gateway.log.Error("invalid request", err)
 			return &resp, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
 		}
+		if pause.ErrPaused.Has(err) {
+			return &resp, status.Error(codes.Unavailable, Error.Wrap(err).Error())
+		}
+		if screening.ErrDenied.Has(err) {
+			return &resp, status.Error(codes.PermissionDenied, Error.Wrap(err).Error())
+		}
 
 		gateway.log.Error("couldn't get bridge-in signature", err)
 		return &resp, status.Error(codes.Internal, Error.Wrap(err).Error())
@@ -329,3 +449,47 @@ func (gateway *Gateway) BridgeInSignature(ctx context.Context, request *transfer
 
 	return &resp, nil
 }
+
+// WatchTransfer streams status changes of transfer until it is finished, cancelled or expired.
+func (gateway *Gateway) WatchTransfer(request *transferspb.WatchTransferRequest, stream gatewaybridgepb.GatewayBridge_WatchTransferServer) error {
+	watchRequest := transfers.WatchRequest{
+		TransferID:  transfers.ID(request.GetTransferId()),
+		NetworkName: request.GetTxHash().GetNetworkName(),
+		TxHash:      request.GetTxHash().GetHash(),
+		LastStatus:  convertFromPbTransferStatus(request.GetLastStatus()),
+	}
+
+	updates := make(chan transfers.Transfer)
+	group, ctx := errgroup.WithContext(stream.Context())
+	group.Go(func() error {
+		defer close(updates)
+		return gateway.bridge.WatchTransfer(ctx, watchRequest, updates)
+	})
+	group.Go(func() error {
+		for transfer := range updates {
+			respTransfer := convertToPbTransfer(transfer)
+			if err := stream.Send(&transferspb.WatchTransferResponse{Transfer: &respTransfer}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	err := group.Wait()
+	if err != nil {
+		switch {
+		case transfers.ErrInvalidWatchRequest.Has(err), errors.Is(err, networks.ErrTransactionNameInvalid), codec.Error.Has(err):
+			gateway.log.Error("invalid request", err)
+			return status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+		case errors.Is(err, bridge.ErrNotConnectedNetwork), errors.Is(err, bridge.ErrNoTokenTransfer):
+			gateway.log.Error("transfer not found", err)
+			return status.Error(codes.NotFound, Error.Wrap(err).Error())
+		}
+
+		gateway.log.Error("couldn't watch transfer", err)
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package controllers_test
 import (
 	"context"
 	"encoding/hex"
+	"io"
 	"math/big"
 	"testing"
 	"time"
@@ -14,6 +15,8 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pb_networks "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/networks"
@@ -24,6 +27,7 @@ import (
 	"tricorn/bridge/server/controllers/apitesting"
 	"tricorn/bridge/transactions"
 	"tricorn/bridge/transfers"
+	"tricorn/pkg/codec"
 )
 
 func TestGateway(t *testing.T) {
@@ -37,7 +41,7 @@ func TestGateway(t *testing.T) {
 		ShortName: "TESTONIUM",
 		LongName:  "TESTONIUM TOKEN",
 	}
-	casperContractAddress, err := networks.StringToBytes(networks.IDCasper, "0xF035b4f54B4A5Dd154cD378ce9d77a12A2c97764")
+	casperContractAddress, err := codec.Casper.ParseContract("hash-f035b4f54b4a5dd154cd378ce9d77a12a2c97764f035b4f54b4a5dd154cd378c")
 	require.NoError(t, err)
 	networkTokenCasper := networks.NetworkToken{
 		NetworkID:       casperNetworkID,
@@ -54,7 +58,8 @@ func TestGateway(t *testing.T) {
 	recipientAddress, err := hex.DecodeString("0x9744bC7A2D91928017E1DEdf98Ff7d912d6Cd263")
 	require.Error(t, err)
 
-	casperHash, err := networks.StringToBytes(networks.IDCasperTest, "d92baa8981a59e0d9143d3b2d51775af65e626aa795229fb438f97e11fed8651")
+	casperHashString := "d92baa8981a59e0d9143d3b2d51775af65e626aa795229fb438f97e11fed8651"
+	casperHash, err := codec.Casper.ParseHash(casperHashString)
 	require.NoError(t, err)
 
 	txTime1 := time.Now()
@@ -67,7 +72,7 @@ func TestGateway(t *testing.T) {
 		SeenAt:      txTime1,
 	}
 
-	ethHash, err := networks.StringToBytes(networks.IDGoerli, "bf4d685afb739d609924b9c316c841a6a4d996e86b363b5cfb4386c9554144a6")
+	ethHash, err := codec.EVM.ParseHash("bf4d685afb739d609924b9c316c841a6a4d996e86b363b5cfb4386c9554144a6")
 	require.NoError(t, err)
 
 	txTime2 := txTime1.Add(5 * time.Second)
@@ -159,13 +164,46 @@ func TestGateway(t *testing.T) {
 			transferResponse, err := gatewayClient.Transfer(ctx, &pb_transfers.TransferRequest{
 				TxHash: &pb_transfers.StringTxHash{
 					NetworkName: senderNetwork.String(),
-					Hash:        networks.BytesToString(casperNetworkID, casperHash),
+					Hash:        casperHashString,
 				},
 			})
 			require.NoError(t, err)
 			assert.NotNil(t, transferResponse)
 		})
 
+		t.Run("WatchTransfer", func(t *testing.T) {
+			stream, err := gatewayClient.WatchTransfer(ctx, &pb_transfers.WatchTransferRequest{
+				TxHash: &pb_transfers.StringTxHash{
+					NetworkName: senderNetwork.String(),
+					Hash:        casperHashString,
+				},
+			})
+			require.NoError(t, err)
+
+			watchTransferResponse, err := stream.Recv()
+			require.NoError(t, err)
+			assert.Equal(t, uint64(tokenTransfer.ID), watchTransferResponse.GetTransfer().GetId())
+			assert.Equal(t, pb_transfers.TransferResponse_STATUS_FINISHED, watchTransferResponse.GetTransfer().GetStatus())
+
+			_, err = stream.Recv()
+			require.ErrorIs(t, err, io.EOF)
+
+			stream, err = gatewayClient.WatchTransfer(ctx, &pb_transfers.WatchTransferRequest{
+				TransferId: uint64(tokenTransfer.ID),
+				LastStatus: pb_transfers.TransferResponse_STATUS_FINISHED,
+			})
+			require.NoError(t, err)
+
+			_, err = stream.Recv()
+			require.ErrorIs(t, err, io.EOF)
+
+			stream, err = gatewayClient.WatchTransfer(ctx, &pb_transfers.WatchTransferRequest{TransferId: 100})
+			require.NoError(t, err)
+
+			_, err = stream.Recv()
+			require.Equal(t, codes.NotFound, status.Code(err))
+		})
+
 		t.Run("TransferHistory", func(t *testing.T) {
 			publicKey, err := hex.DecodeString("01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
 			require.NoError(t, err)
@@ -181,18 +219,45 @@ func TestGateway(t *testing.T) {
 			require.NotNil(t, transferHistoryResponse)
 		})
 
+		t.Run("TransferHistoryByCursor", func(t *testing.T) {
+			publicKey, err := hex.DecodeString("01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
+			require.NoError(t, err)
+
+			cursor := ""
+			transferHistoryResponse, err := gatewayClient.TransferHistory(ctx, &pb_transfers.TransferHistoryRequest{
+				Limit:     3,
+				NetworkId: uint32(transaction1.NetworkID),
+				PublicKey: publicKey,
+				Cursor:    &cursor,
+				Statuses:  []pb_transfers.TransferResponse_Status{pb_transfers.TransferResponse_STATUS_CONFIRMING},
+				Sort:      pb_transfers.TransferHistoryRequest_SORT_OLDEST,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, transferHistoryResponse)
+			assert.Empty(t, transferHistoryResponse.NextCursor)
+
+			cursor = "malformed"
+			_, err = gatewayClient.TransferHistory(ctx, &pb_transfers.TransferHistoryRequest{
+				Limit:     3,
+				NetworkId: uint32(transaction1.NetworkID),
+				PublicKey: publicKey,
+				Cursor:    &cursor,
+			})
+			require.Error(t, err)
+		})
+
 		t.Run("BridgeInSignature", func(t *testing.T) {
 			amount := "1000"
 			bridgeInSignatureResponse, err := gatewayClient.BridgeInSignature(ctx, &pb_transfers.BridgeInSignatureRequest{
 				Sender: &pb_transfers.StringNetworkAddress{
 					NetworkName: senderNetwork.String(),
-					Address:     networks.BytesToString(transaction1.NetworkID, senderAddress),
+					Address:     "01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c",
 				},
 				TokenId: uint32(token.ID),
 				Amount:  amount,
 				Destination: &pb_transfers.StringNetworkAddress{
 					NetworkName: recipientNetwork.String(),
-					Address:     networks.BytesToString(transaction2.NetworkID, recipientAddress),
+					Address:     "0x9744bC7A2D91928017E1DEdf98Ff7d912d6Cd263",
 				},
 			})
 			require.NoError(t, err)
@@ -213,15 +278,19 @@ func TestGateway(t *testing.T) {
 		})
 
 		t.Run("CancelTransfer", func(t *testing.T) {
-			tokenTransfer.Status = transfers.StatusWaiting
-			err := db.TokenTransfers().Update(ctx, tokenTransfer)
+			// finished transfer never moves back to waiting, so waiting one is created to be cancelled.
+			waitingTransfer := tokenTransfer
+			waitingTransfer.ID = 2
+			waitingTransfer.OutboundTx = 0
+			waitingTransfer.Status = transfers.StatusWaiting
+			err := db.TokenTransfers().Create(ctx, waitingTransfer)
 			require.NoError(t, err)
 
 			cancelTransferResponse, err := gatewayClient.CancelTransfer(ctx, &pb_transfers.CancelTransferRequest{
-				TransferId: uint64(tokenTransfer.ID),
+				TransferId: uint64(waitingTransfer.ID),
 				Signature:  transaction1.TxHash,
-				NetworkId:  uint32(tokenTransfer.SenderNetworkID),
-				PublicKey:  tokenTransfer.SenderAddress,
+				NetworkId:  uint32(waitingTransfer.SenderNetworkID),
+				PublicKey:  waitingTransfer.SenderAddress,
 			})
 			require.NoError(t, err)
 			assert.NotNil(t, cancelTransferResponse)
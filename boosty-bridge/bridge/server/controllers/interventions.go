@@ -0,0 +1,95 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	interventionspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/interventions"
+
+	"tricorn/bridge"
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/transfers"
+)
+
+// Intervene performs operator intervention into the transfer and returns its audit log entry.
+func (gateway *Gateway) Intervene(ctx context.Context, request *interventionspb.InterveneRequest) (*interventionspb.AuditEntry, error) {
+	entry, err := gateway.bridge.Intervene(ctx, interventions.Request{
+		TransferID:  transfers.ID(request.GetTransferId()),
+		Kind:        interventions.Kind(request.GetKind()),
+		Operator:    request.GetOperator(),
+		Reason:      request.GetReason(),
+		TxHash:      request.GetTxHash(),
+		BlockNumber: request.GetBlockNumber(),
+		Note:        request.GetNote(),
+	})
+	if err != nil {
+		gateway.log.Error("couldn't intervene into transfer", err)
+		return &interventionspb.AuditEntry{}, interventionError(err)
+	}
+
+	return convertToPbAuditEntry(entry), nil
+}
+
+// AuditLog returns audit log of the transfer from the oldest entry to the newest one.
+func (gateway *Gateway) AuditLog(ctx context.Context, request *interventionspb.AuditLogRequest) (*interventionspb.AuditLogResponse, error) {
+	entries, err := gateway.bridge.AuditLog(ctx, transfers.ID(request.GetTransferId()))
+	if err != nil {
+		gateway.log.Error("couldn't get audit log", err)
+		return &interventionspb.AuditLogResponse{}, interventionError(err)
+	}
+
+	resp := &interventionspb.AuditLogResponse{
+		Entries: make([]*interventionspb.AuditEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, convertToPbAuditEntry(entry))
+	}
+
+	return resp, nil
+}
+
+// interventionError converts interventions error to grpc status error.
+func interventionError(err error) error {
+	switch {
+	case interventions.ErrInvalidRequest.Has(err):
+		return status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	case interventions.ErrNotAllowed.Has(err):
+		return status.Error(codes.FailedPrecondition, Error.Wrap(err).Error())
+	case errors.Is(err, bridge.ErrNoTokenTransfer):
+		return status.Error(codes.NotFound, Error.Wrap(err).Error())
+	case errors.Is(err, bridge.ErrNotConnectedNetwork):
+		return status.Error(codes.Unavailable, Error.Wrap(err).Error())
+	default:
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+}
+
+// convertToPbAuditEntry converts interventions.Entry to interventionspb.AuditEntry.
+func convertToPbAuditEntry(entry interventions.Entry) *interventionspb.AuditEntry {
+	return &interventionspb.AuditEntry{
+		Id:         uint64(entry.ID),
+		TransferId: uint64(entry.TransferID),
+		Kind:       string(entry.Kind),
+		Operator:   entry.Operator,
+		Reason:     entry.Reason,
+		Change:     entry.Change,
+		Before:     convertToPbTransferState(entry.Before),
+		After:      convertToPbTransferState(entry.After),
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+	}
+}
+
+// convertToPbTransferState converts interventions.State to interventionspb.TransferState.
+func convertToPbTransferState(state interventions.State) *interventionspb.TransferState {
+	return &interventionspb.TransferState{
+		Status:     convertToPbTransferStatus(state.Status),
+		OutboundTx: uint64(state.OutboundTx),
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	networkspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/networks"
+
+	"tricorn/bridge"
+	"tricorn/bridge/networks"
+	"tricorn/bridge/rescan"
+)
+
+// Rescan processes bridge events of the range of blocks again, events which are already known are skipped.
+func (gateway *Gateway) Rescan(ctx context.Context, request *networkspb.RescanRequest) (*networkspb.RescanResponse, error) {
+	report, err := gateway.bridge.Rescan(ctx, rescan.Request{
+		NetworkID: networks.ID(request.GetNetworkId()),
+		FromBlock: request.GetFromBlock(),
+		ToBlock:   request.GetToBlock(),
+	})
+	if err != nil {
+		switch {
+		case rescan.ErrRescan.Has(err):
+			gateway.log.Error("invalid request", err)
+			return &networkspb.RescanResponse{}, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+		case errors.Is(err, bridge.ErrNotConnectedNetwork):
+			gateway.log.Error("invalid network", err)
+			return &networkspb.RescanResponse{}, status.Error(codes.NotFound, Error.Wrap(err).Error())
+		}
+
+		gateway.log.Error("couldn't rescan blocks", err)
+		return &networkspb.RescanResponse{}, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return &networkspb.RescanResponse{
+		Processed: convertToPbRescanEvents(report.Processed),
+		Known:     convertToPbRescanEvents(report.Known),
+	}, nil
+}
+
+// convertToPbRescanEvents converts rescan events to protobuf ones.
+func convertToPbRescanEvents(events []rescan.Event) []*networkspb.RescanEvent {
+	pbEvents := make([]*networkspb.RescanEvent, 0, len(events))
+	for _, event := range events {
+		pbEvents = append(pbEvents, &networkspb.RescanEvent{
+			Kind:        string(event.Kind),
+			TxHash:      event.TxHash,
+			LogIndex:    event.LogIndex,
+			BlockNumber: event.BlockNumber,
+		})
+	}
+
+	return pbEvents
+}
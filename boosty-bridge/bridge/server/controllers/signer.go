@@ -45,7 +45,12 @@ func (s *Signer) Sign(ctx context.Context, request *signerpb.SignRequest) (*sign
 		return nil, status.Error(codes.InvalidArgument, "empty data to sign")
 	}
 
-	signedData, err := s.bridge.Sign(ctx, networkType, request.Data, signer.Type(request.GetDataType().String()))
+	var signedData []byte
+	if len(request.GetPublicKey()) != 0 {
+		signedData, err = s.bridge.SignWithKey(ctx, networkType, request.GetPublicKey(), request.Data)
+	} else {
+		signedData, err = s.bridge.Sign(ctx, networkType, request.Data, signer.Type(request.GetDataType().String()))
+	}
 
 	return &signerpb.Signature{
 		NetworkId: request.NetworkId,
@@ -67,6 +72,25 @@ func (signer *Signer) PublicKey(ctx context.Context, request *signerpb.PublicKey
 	}, err
 }
 
+// PublicKeys returns public keys of all transaction keys in specific network.
+func (signer *Signer) PublicKeys(ctx context.Context, request *signerpb.PublicKeyRequest) (*signerpb.PublicKeysResponse, error) {
+	networkType, err := networkFromProto(request.NetworkId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	publicKeys, err := signer.bridge.PublicKeys(ctx, networkType)
+
+	response := &signerpb.PublicKeysResponse{
+		PublicKeys: make([][]byte, 0, len(publicKeys)),
+	}
+	for _, publicKey := range publicKeys {
+		response.PublicKeys = append(response.PublicKeys, publicKey)
+	}
+
+	return response, err
+}
+
 // networkFromProto casts proto network type to internal one.
 func networkFromProto(networkType networkspb.NetworkType) (networks.Type, error) {
 	switch networkType {
@@ -0,0 +1,167 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	transferspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/transfers"
+	webhookspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/webhooks"
+
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+)
+
+// statePrefixString is the first part of PbWebhookDeliveryState.
+const statePrefixString = "STATE_"
+
+// CreateWebhookEndpoint registers webhook endpoint of the integrator.
+func (gateway *Gateway) CreateWebhookEndpoint(ctx context.Context, request *webhookspb.CreateWebhookEndpointRequest) (*webhookspb.WebhookEndpoint, error) {
+	statuses := make([]transfers.Status, 0, len(request.GetStatuses()))
+	for _, pbStatus := range request.GetStatuses() {
+		statuses = append(statuses, convertFromPbTransferStatus(pbStatus))
+	}
+
+	endpoint, err := gateway.bridge.CreateWebhookEndpoint(ctx, webhooks.CreateEndpointRequest{
+		Owner:    request.GetOwner(),
+		URL:      request.GetUrl(),
+		Statuses: statuses,
+	})
+	if err != nil {
+		gateway.log.Error("couldn't create webhook endpoint", err)
+		return &webhookspb.WebhookEndpoint{}, webhookError(err)
+	}
+
+	return convertToPbWebhookEndpoint(endpoint), nil
+}
+
+// ListWebhookEndpoints returns webhook endpoints of the integrator.
+func (gateway *Gateway) ListWebhookEndpoints(ctx context.Context, request *webhookspb.ListWebhookEndpointsRequest) (*webhookspb.ListWebhookEndpointsResponse, error) {
+	var resp webhookspb.ListWebhookEndpointsResponse
+
+	endpoints, err := gateway.bridge.ListWebhookEndpoints(ctx, request.GetOwner())
+	if err != nil {
+		gateway.log.Error("couldn't list webhook endpoints", err)
+		return &resp, webhookError(err)
+	}
+
+	for _, endpoint := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, convertToPbWebhookEndpoint(endpoint))
+	}
+
+	return &resp, nil
+}
+
+// DeleteWebhookEndpoint deletes webhook endpoint of the integrator together with its deliveries.
+func (gateway *Gateway) DeleteWebhookEndpoint(ctx context.Context, request *webhookspb.DeleteWebhookEndpointRequest) (*emptypb.Empty, error) {
+	err := gateway.bridge.DeleteWebhookEndpoint(ctx, request.GetOwner(), webhooks.EndpointID(request.GetEndpointId()))
+	if err != nil {
+		gateway.log.Error("couldn't delete webhook endpoint", err)
+		return &emptypb.Empty{}, webhookError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhookDeliveries returns the newest deliveries of the integrator webhook endpoint.
+func (gateway *Gateway) ListWebhookDeliveries(ctx context.Context, request *webhookspb.ListWebhookDeliveriesRequest) (*webhookspb.ListWebhookDeliveriesResponse, error) {
+	var resp webhookspb.ListWebhookDeliveriesResponse
+
+	deliveries, err := gateway.bridge.ListWebhookDeliveries(ctx, webhooks.DeliveriesRequest{
+		Owner:      request.GetOwner(),
+		EndpointID: webhooks.EndpointID(request.GetEndpointId()),
+		State:      convertFromPbDeliveryState(request.GetState()),
+		Limit:      request.GetLimit(),
+	})
+	if err != nil {
+		gateway.log.Error("couldn't list webhook deliveries", err)
+		return &resp, webhookError(err)
+	}
+
+	for _, delivery := range deliveries {
+		pbDelivery := &webhookspb.WebhookDelivery{
+			Id:             uint64(delivery.ID),
+			EndpointId:     uint64(delivery.EndpointID),
+			TransferId:     uint64(delivery.TransferID),
+			Status:         convertToPbTransferStatus(delivery.Status),
+			PreviousStatus: convertToPbTransferStatus(delivery.PreviousStatus),
+			State:          convertToPbDeliveryState(delivery.State),
+			Attempts:       delivery.Attempts,
+			NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+			LastError:      delivery.LastError,
+			CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		}
+		if delivery.DeliveredAt != nil {
+			pbDelivery.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+		}
+
+		resp.Deliveries = append(resp.Deliveries, pbDelivery)
+	}
+
+	return &resp, nil
+}
+
+// ReplayWebhookDeliveries schedules deliveries of the integrator webhook endpoint to be sent again.
+func (gateway *Gateway) ReplayWebhookDeliveries(ctx context.Context, request *webhookspb.ReplayWebhookDeliveriesRequest) (*webhookspb.ReplayWebhookDeliveriesResponse, error) {
+	replayed, err := gateway.bridge.ReplayWebhookDeliveries(ctx, webhooks.ReplayRequest{
+		Owner:      request.GetOwner(),
+		EndpointID: webhooks.EndpointID(request.GetEndpointId()),
+		DeliveryID: webhooks.DeliveryID(request.GetDeliveryId()),
+	})
+	if err != nil {
+		gateway.log.Error("couldn't replay webhook deliveries", err)
+		return &webhookspb.ReplayWebhookDeliveriesResponse{}, webhookError(err)
+	}
+
+	return &webhookspb.ReplayWebhookDeliveriesResponse{Replayed: replayed}, nil
+}
+
+// webhookError converts error of webhooks management to grpc status error.
+func webhookError(err error) error {
+	switch {
+	case webhooks.ErrInvalidEndpoint.Has(err):
+		return status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	case webhooks.ErrEndpointNotFound.Has(err), webhooks.ErrDeliveryNotFound.Has(err):
+		return status.Error(codes.NotFound, Error.Wrap(err).Error())
+	default:
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+}
+
+// convertToPbWebhookEndpoint converts webhooks.Endpoint to webhookspb.WebhookEndpoint.
+func convertToPbWebhookEndpoint(endpoint webhooks.Endpoint) *webhookspb.WebhookEndpoint {
+	statuses := make([]transferspb.TransferResponse_Status, 0, len(endpoint.Statuses))
+	for _, transferStatus := range endpoint.Statuses {
+		statuses = append(statuses, convertToPbTransferStatus(transferStatus))
+	}
+
+	return &webhookspb.WebhookEndpoint{
+		Id:        uint64(endpoint.ID),
+		Url:       endpoint.URL,
+		Secret:    endpoint.Secret,
+		Statuses:  statuses,
+		CreatedAt: timestamppb.New(endpoint.CreatedAt),
+	}
+}
+
+// convertToPbDeliveryState converts webhooks.DeliveryState to webhookspb.WebhookDelivery_State.
+func convertToPbDeliveryState(state webhooks.DeliveryState) webhookspb.WebhookDelivery_State {
+	return webhookspb.WebhookDelivery_State(webhookspb.WebhookDelivery_State_value[statePrefixString+string(state)])
+}
+
+// convertFromPbDeliveryState converts webhookspb.WebhookDelivery_State to webhooks.DeliveryState, unspecified state
+// is converted to empty one.
+func convertFromPbDeliveryState(pbState webhookspb.WebhookDelivery_State) webhooks.DeliveryState {
+	if pbState == webhookspb.WebhookDelivery_STATE_UNSPECIFIED {
+		return ""
+	}
+
+	return webhooks.DeliveryState(strings.TrimPrefix(pbState.String(), statePrefixString))
+}
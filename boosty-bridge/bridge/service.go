@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"sync"
 	"time"
 
@@ -13,17 +14,27 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"tricorn/bridge/dryrun"
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/limits"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/transactions"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/chains"
 	"tricorn/internal/logger"
 	"tricorn/pkg/signature"
 	"tricorn/signer"
 )
 
-// Error is bridge default error type.
-var Error = errs.Class("bridge service")
+var (
+	// Error is bridge default error type.
+	Error = errs.Class("bridge service")
+	// ErrOutboundInFlight indicates that earlier bridge out of the transfer may still pay it out, so it is not sent again.
+	ErrOutboundInFlight = errs.Class("bridge out in flight")
+)
 
 // Service is handling bridge related logic.
 //
@@ -31,13 +42,36 @@ var Error = errs.Class("bridge service")
 type Service struct {
 	log logger.Logger
 
-	signer         Signer
-	nonces         networks.Nonces
-	networkTokens  networks.NetworkTokens
-	networkBlocks  networks.NetworkBlocks
-	transactions   transactions.DB
-	tokenTransfers transfers.TokenTransfers
-	tokens         Tokens
+	signer          Signer
+	nonces          networks.Nonces
+	networkTokens   networks.NetworkTokens
+	networkBlocks   networks.NetworkBlocks
+	transactions    transactions.DB
+	tokenTransfers  transfers.TokenTransfers
+	tokens          Tokens
+	unmatchedEvents transfers.UnmatchedEvents
+	transferWatcher *TransferWatcher
+
+	webhookEndpoints webhooks.Endpoints
+	webhookOutbox    webhooks.Outbox
+
+	limiter   *limits.Limiter
+	approvals limits.Approvals
+
+	pauses  pause.Pauses
+	backlog pause.Backlog
+
+	screener screening.Screener
+	holds    screening.Holds
+
+	auditLog interventions.AuditLog
+
+	statusHistory    transfers.StatusHistory
+	outboundAttempts transfers.OutboundAttempts
+
+	// dryRun defines whether bridge only records decisions on events instead of acting on them.
+	dryRun          bool
+	dryRunDecisions dryrun.Decisions
 
 	mutex      sync.Mutex
 	connectors map[networks.Name]Connector
@@ -45,17 +79,37 @@ type Service struct {
 
 // New is Service constructor.
 func New(log logger.Logger, signer Signer, nonces networks.Nonces, networkTokens networks.NetworkTokens,
-	tokens Tokens, transactions transactions.DB, tokenTransfers transfers.TokenTransfers, networkBlocks networks.NetworkBlocks) *Service {
+	tokens Tokens, transactions transactions.DB, tokenTransfers transfers.TokenTransfers, networkBlocks networks.NetworkBlocks,
+	unmatchedEvents transfers.UnmatchedEvents, transferWatcher *TransferWatcher, webhookEndpoints webhooks.Endpoints,
+	webhookOutbox webhooks.Outbox, limiter *limits.Limiter, approvals limits.Approvals, pauses pause.Pauses,
+	backlog pause.Backlog, screener screening.Screener, holds screening.Holds, auditLog interventions.AuditLog,
+	statusHistory transfers.StatusHistory, outboundAttempts transfers.OutboundAttempts, dryRunDecisions dryrun.Decisions,
+	dryRun bool) *Service {
 	return &Service{
-		log:            log,
-		signer:         signer,
-		nonces:         nonces,
-		tokenTransfers: tokenTransfers,
-		networkBlocks:  networkBlocks,
-		networkTokens:  networkTokens,
-		transactions:   transactions,
-		tokens:         tokens,
-		connectors:     make(map[networks.Name]Connector),
+		log:              log,
+		signer:           signer,
+		nonces:           nonces,
+		tokenTransfers:   tokenTransfers,
+		networkBlocks:    networkBlocks,
+		networkTokens:    networkTokens,
+		transactions:     transactions,
+		tokens:           tokens,
+		unmatchedEvents:  unmatchedEvents,
+		transferWatcher:  transferWatcher,
+		webhookEndpoints: webhookEndpoints,
+		webhookOutbox:    webhookOutbox,
+		limiter:          limiter,
+		approvals:        approvals,
+		pauses:           pauses,
+		backlog:          backlog,
+		screener:         screener,
+		holds:            holds,
+		auditLog:         auditLog,
+		statusHistory:    statusHistory,
+		outboundAttempts: outboundAttempts,
+		dryRun:           dryRun,
+		dryRunDecisions:  dryRunDecisions,
+		connectors:       make(map[networks.Name]Connector),
 	}
 }
 
@@ -110,7 +164,7 @@ func (service *Service) ListSupportedTokens(ctx context.Context, id uint32) ([]n
 // parseNetworkDataFromIDAndValidate returns network data and validateNetworkName for networks and connected connectors.
 func (service *Service) parseNetworkDataFromIDAndValidate(id uint32) (networkName networks.Name, networkID networks.ID, err error) {
 	networkID = networks.ID(id)
-	networkName, ok := networks.IDToNetworkName[networkID]
+	networkName, ok := networks.NameByID(networkID)
 	if !ok {
 		return networkName, networkID, Error.Wrap(fmt.Errorf("network %s, err: %v", networkName, ErrNotConnectedNetwork))
 	}
@@ -136,28 +190,100 @@ func (service *Service) validateNetworkName(networkName networks.Name) error {
 func (service *Service) TransfersInfo(ctx context.Context, networkName string, txHash string) ([]transfers.Transfer, error) {
 	transfersList := make([]transfers.Transfer, 0)
 
-	internalNetworkName, err := service.parseNetworkNameAndValidate(networkName)
+	tokenTransfer, err := service.getTransferByTx(ctx, networkName, txHash)
 	if err != nil {
 		return transfersList, Error.Wrap(err)
 	}
 
-	networkID := networks.NetworkNameToID[internalNetworkName]
-	transactionHash, err := networks.StringToBytes(networkID, txHash)
+	transfersList, err = service.parseTransfers(ctx, []transfers.TokenTransfer{tokenTransfer})
 	if err != nil {
 		return transfersList, Error.Wrap(err)
 	}
 
-	tokenTransfer, err := service.tokenTransfers.GetByNetworkAndTx(ctx, networkID, transactionHash)
+	for i := range transfersList {
+		transfersList[i].Timeline, err = service.statusHistory.List(ctx, transfersList[i].ID)
+		if err != nil {
+			return transfersList, Error.Wrap(err)
+		}
+	}
+
+	return transfersList, nil
+}
+
+// getTransferByTx returns token transfer by its triggering transaction hash on selected network.
+func (service *Service) getTransferByTx(ctx context.Context, networkName string, txHash string) (transfers.TokenTransfer, error) {
+	internalNetworkName, err := service.parseNetworkNameAndValidate(networkName)
 	if err != nil {
-		return transfersList, Error.Wrap(err)
+		return transfers.TokenTransfer{}, err
 	}
 
-	transfersList, err = service.parseTransfers(ctx, []transfers.TokenTransfer{tokenTransfer})
+	networkID, _ := networks.IDByName(internalNetworkName)
+	networkCodec, err := networkID.Codec()
 	if err != nil {
-		return transfersList, Error.Wrap(err)
+		return transfers.TokenTransfer{}, err
 	}
 
-	return transfersList, nil
+	transactionHash, err := networkCodec.ParseHash(txHash)
+	if err != nil {
+		return transfers.TokenTransfer{}, err
+	}
+
+	return service.tokenTransfers.GetByNetworkAndTx(ctx, networkID, transactionHash)
+}
+
+// WatchTransfer sends transfer to updates each time its status changes, until status becomes final or context is
+// cancelled. Current state of the transfer is sent first unless client has already received its status.
+func (service *Service) WatchTransfer(ctx context.Context, req transfers.WatchRequest, updates chan<- transfers.Transfer) error {
+	if err := req.Validate(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	transferID := req.TransferID
+	if transferID == 0 {
+		tokenTransfer, err := service.getTransferByTx(ctx, req.NetworkName, req.TxHash)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		transferID = transfers.ID(tokenTransfer.ID)
+	}
+
+	// subscription goes before the first read, so no change is missed in between.
+	notifications, unsubscribe := service.transferWatcher.Subscribe(transferID)
+	defer unsubscribe()
+
+	lastStatus := req.LastStatus
+	for {
+		tokenTransfer, err := service.tokenTransfers.Get(ctx, int64(transferID))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if tokenTransfer.Status != lastStatus {
+			transfersList, err := service.parseTransfers(ctx, []transfers.TokenTransfer{tokenTransfer})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case updates <- transfersList[0]:
+			}
+
+			lastStatus = tokenTransfer.Status
+		}
+
+		if lastStatus.IsFinal() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notifications:
+		}
+	}
 }
 
 // parseNetworkNameAndValidate parses network name and id from string and validateNetworkName for networks and connected connectors.
@@ -171,66 +297,86 @@ func (service *Service) parseNetworkNameAndValidate(stringNetworkName string) (n
 func (service *Service) parseTransfers(ctx context.Context, tokenTransfers []transfers.TokenTransfer) ([]transfers.Transfer, error) {
 	transfersList := make([]transfers.Transfer, 0, len(tokenTransfers))
 	for _, tokenTransfer := range tokenTransfers {
-		var (
-			triggeringTransaction transactions.Transaction
-			triggeringTx          transfers.StringTxHash
-			err                   error
-		)
-
-		if tokenTransfer.Status != transfers.StatusWaiting && tokenTransfer.Status != transfers.StatusCancelled {
-			triggeringTransaction, err = service.transactions.Get(ctx, tokenTransfer.TriggeringTx)
+		var triggeringTx, outboundTx *transactions.Transaction
+
+		if tokenTransfer.TriggeringTx != 0 {
+			triggeringTransaction, err := service.transactions.Get(ctx, tokenTransfer.TriggeringTx)
 			if err != nil {
 				return transfersList, err
 			}
 
-			triggeringTx = parseStringTxHash(triggeringTransaction.NetworkID, triggeringTransaction.TxHash)
+			triggeringTx = &triggeringTransaction
 		}
 
-		var outboundTx transfers.StringTxHash
-		if tokenTransfer.Status != transfers.StatusWaiting && tokenTransfer.Status != transfers.StatusConfirming {
+		if tokenTransfer.OutboundTx != 0 {
 			outboundTransaction, err := service.transactions.Get(ctx, tokenTransfer.OutboundTx)
 			if err != nil {
 				return transfersList, err
 			}
 
-			outboundTx = parseStringTxHash(outboundTransaction.NetworkID, outboundTransaction.TxHash)
+			outboundTx = &outboundTransaction
 		}
 
-		transfer := transfers.Transfer{
-			ID:           transfers.ID(tokenTransfer.ID),
-			Amount:       tokenTransfer.Amount,
-			Sender:       parseNetworkAddress(tokenTransfer.SenderNetworkID, tokenTransfer.SenderAddress),
-			Recipient:    parseNetworkAddress(tokenTransfer.RecipientNetworkID, tokenTransfer.RecipientAddress),
-			Status:       tokenTransfer.Status,
-			TriggeringTx: triggeringTx,
-			OutboundTx:   outboundTx,
-			CreatedAt:    triggeringTransaction.SeenAt,
-		}
-
-		transfersList = append(transfersList, transfer)
+		transfersList = append(transfersList, formatTransfer(tokenTransfer, triggeringTx, outboundTx))
 	}
 
 	return transfersList, nil
 }
 
+// formatTransfer returns token transfer the way it is shown to users, transactions are nil if transfer has none.
+func formatTransfer(tokenTransfer transfers.TokenTransfer, triggeringTx, outboundTx *transactions.Transaction) transfers.Transfer {
+	transfer := transfers.Transfer{
+		ID:        transfers.ID(tokenTransfer.ID),
+		Amount:    tokenTransfer.Amount,
+		Sender:    parseNetworkAddress(tokenTransfer.SenderNetworkID, tokenTransfer.SenderAddress),
+		Recipient: parseNetworkAddress(tokenTransfer.RecipientNetworkID, tokenTransfer.RecipientAddress),
+		Status:    tokenTransfer.Status,
+		CreatedAt: tokenTransfer.CreatedAt,
+	}
+
+	if triggeringTx != nil {
+		transfer.TriggeringTx = parseStringTxHash(triggeringTx.NetworkID, triggeringTx.TxHash)
+	}
+
+	if outboundTx != nil {
+		transfer.OutboundTx = parseStringTxHash(outboundTx.NetworkID, outboundTx.TxHash)
+	}
+
+	return transfer
+}
+
 // parseStringTxHash returns transfers.StringTxHash by network id and hash.
 func parseStringTxHash(networkID networks.ID, hash []byte) transfers.StringTxHash {
+	networkName, _ := networks.NameByID(networkID)
 	stringTxHash := transfers.StringTxHash{
-		NetworkName: networks.IDToNetworkName[networkID].String(),
+		NetworkName: networkName.String(),
 	}
 	stringTxHash.Hash.SetBytes(hash)
 
 	return stringTxHash
 }
 
-// parseNetworkAddress returns networks.Address by network id and address.
+// parseNetworkAddress returns networks.Address by network id and address. Address, which could not be formatted by
+// the codec of its network, is returned as plain hex, so single malformed row does not fail the whole list.
 func parseNetworkAddress(id int64, address []byte) networks.Address {
 	networkID := networks.ID(id)
+	networkName, _ := networks.NameByID(networkID)
 
-	return networks.Address{
-		NetworkName: networks.IDToNetworkName[networkID].String(),
-		Address:     networks.BytesToString(networkID, address),
+	networkAddress := networks.Address{
+		NetworkName: networkName.String(),
+		Address:     hex.EncodeToString(address),
 	}
+
+	networkCodec, err := networkID.Codec()
+	if err != nil {
+		return networkAddress
+	}
+
+	if formattedAddress, err := networkCodec.FormatAddress(address); err == nil {
+		networkAddress.Address = formattedAddress
+	}
+
+	return networkAddress
 }
 
 // History returns paginated transfer history for user.
@@ -273,10 +419,75 @@ func (service *Service) History(ctx context.Context, offset, limit uint64, sig,
 	return page, nil
 }
 
+// HistoryByCursor returns filtered transfer history of the user, which is paginated by cursor.
+func (service *Service) HistoryByCursor(ctx context.Context, req transfers.HistoryRequest) (transfers.CursorPage, error) {
+	page := transfers.CursorPage{
+		Transfers: make([]transfers.Transfer, 0),
+	}
+
+	if req.Sort == "" {
+		req.Sort = transfers.SortNewest
+	}
+	if err := req.Sort.Validate(); err != nil {
+		return page, Error.Wrap(err)
+	}
+	if err := req.Filter.Validate(); err != nil {
+		return page, Error.Wrap(err)
+	}
+
+	cursor, err := transfers.ParseCursor(req.Cursor)
+	if err != nil {
+		return page, Error.Wrap(err)
+	}
+	if err = cursor.Validate(req.Sort, req.Filter); err != nil {
+		return page, Error.Wrap(err)
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	_, userNetworkID, err := service.parseNetworkDataFromIDAndValidate(req.NetworkID)
+	if err != nil {
+		return page, Error.Wrap(err)
+	}
+
+	address, err := decodeSignature(userNetworkID, req.PublicKey, req.Signature)
+	if err != nil {
+		return page, Error.Wrap(err)
+	}
+
+	// one more transfer is requested to find out whether the next page exists.
+	tokenTransfers, err := service.tokenTransfers.ListByUserAfter(ctx, transfers.HistoryQuery{
+		NetworkID:   userNetworkID,
+		UserAddress: address,
+		Filter:      req.Filter,
+		Sort:        req.Sort,
+		After:       cursor,
+		Limit:       limit + 1,
+	})
+	if err != nil {
+		return page, Error.Wrap(err)
+	}
+
+	if uint64(len(tokenTransfers)) > limit {
+		tokenTransfers = tokenTransfers[:limit]
+		page.NextCursor = transfers.NewCursor(tokenTransfers[limit-1], req.Sort, req.Filter).String()
+	}
+
+	page.Transfers, err = service.parseTransfers(ctx, tokenTransfers)
+	if err != nil {
+		return page, Error.Wrap(err)
+	}
+
+	return page, nil
+}
+
 // decodeSignature decodes signature and returns public key/account hash of sender.
 func decodeSignature(networkID networks.ID, publicKey, sig []byte) ([]byte, error) {
-	switch networkID {
-	case networks.IDEth, networks.IDPolygon, networks.IDGoerli, networks.IDMumbai, networks.IDBNB, networks.IDBNBTest, networks.IDAvalanche, networks.IDAvalancheTest:
+	switch networkID.Type() {
+	case networks.TypeEVM:
 		pubKey, err := signature.RecoverEVMPublicKeyFrom(sig, authenticationMsg)
 		if err != nil {
 			return nil, err
@@ -287,8 +498,8 @@ func decodeSignature(networkID networks.ID, publicKey, sig []byte) ([]byte, erro
 			return nil, err
 		}
 
-		return networks.StringToBytes(networkID, address.String())
-	case networks.IDCasper, networks.IDCasperTest:
+		return address.Bytes(), nil
+	case networks.TypeCasper:
 		return publicKey, nil
 	}
 	return nil, nil
@@ -339,34 +550,67 @@ func (service *Service) GetBridgeInSignature(ctx context.Context, request transf
 		return BridgeInSignatureResponse{}, Error.Wrap(err)
 	}
 
+	// no new transfers are signed while bridge or their networks are paused.
+	networkPause, paused, err := service.findPause(ctx, senderNetworkName, recipientNetworkName)
+	if err != nil {
+		return BridgeInSignatureResponse{}, Error.Wrap(err)
+	}
+	if paused {
+		return BridgeInSignatureResponse{}, Error.Wrap(pause.ErrPaused.New("%s", networkPause))
+	}
+
 	amount, ok := new(big.Int).SetString(request.Amount, 10)
 	if !ok || amount.Int64() < 0 {
 		return BridgeInSignatureResponse{}, Error.Wrap(ErrInvalidAmount)
 	}
 
-	senderNetworkID := networks.NetworkNameToID[senderNetworkName]
-	recipientNetworkID := networks.NetworkNameToID[recipientNetworkName]
+	senderNetworkID, _ := networks.IDByName(senderNetworkName)
+	recipientNetworkID, _ := networks.IDByName(recipientNetworkName)
 
-	nonce, err := service.nonces.Get(ctx, senderNetworkID)
+	token, err := service.networkTokens.Get(ctx, senderNetworkID, int64(request.TokenID))
 	if err != nil {
 		return BridgeInSignatureResponse{}, Error.Wrap(err)
 	}
 
-	token, err := service.networkTokens.Get(ctx, senderNetworkID, int64(request.TokenID))
+	senderCodec, err := senderNetworkID.Codec()
 	if err != nil {
 		return BridgeInSignatureResponse{}, Error.Wrap(err)
 	}
 
-	senderAddress, err := networks.StringToBytes(senderNetworkID, request.Sender.Address)
+	senderAddress, err := senderCodec.ParseAddress(request.Sender.Address)
 	if err != nil {
 		return BridgeInSignatureResponse{}, Error.Wrap(err)
 	}
 
-	recipientAddress, err := networks.StringToBytes(recipientNetworkID, request.Destination.Address)
+	recipientCodec, err := recipientNetworkID.Codec()
 	if err != nil {
 		return BridgeInSignatureResponse{}, Error.Wrap(err)
 	}
 
+	recipientAddress, err := recipientCodec.ParseAddress(request.Destination.Address)
+	if err != nil {
+		return BridgeInSignatureResponse{}, Error.Wrap(err)
+	}
+
+	// destination address is signed and emitted by bridge in event in its canonical form, so the event is parsed
+	// back to the same recipient.
+	request.Destination.Address, err = recipientCodec.FormatAddress(recipientAddress)
+	if err != nil {
+		return BridgeInSignatureResponse{}, Error.Wrap(err)
+	}
+
+	// sanctioned addresses are refused before funds are sent, so they never get locked in the bridge.
+	verdict, err := screening.Check(ctx, service.screener,
+		screening.Subject{NetworkID: senderNetworkID, Address: senderAddress},
+		screening.Subject{NetworkID: recipientNetworkID, Address: recipientAddress},
+	)
+	if err != nil {
+		return BridgeInSignatureResponse{}, Error.Wrap(err)
+	}
+	if verdict.Flagged {
+		return BridgeInSignatureResponse{}, Error.Wrap(screening.ErrDenied.New("%s", verdict))
+	}
+
 	// TODO: uncomment after fix.
 	// destinationEstimation, err := service.connectors[recipientNetworkName].EstimateTransfer(ctx, transfers.EstimateTransfer{
 	//	SenderNetwork:    request.Sender.NetworkName,
@@ -392,41 +636,53 @@ func (service *Service) GetBridgeInSignature(ctx context.Context, request transf
 		return BridgeInSignatureResponse{}, status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
 
-	bridgeInSignature, err := connector.BridgeInSignature(ctx, BridgeInSignatureRequest{
-		User:          senderAddress,
-		Nonce:         big.NewInt(nonce),
-		Token:         token.ContractAddress,
-		Amount:        amount,
-		Destination:   request.Destination,
-		GasCommission: gasCommission,
-	})
-	if err != nil {
-		return BridgeInSignatureResponse{}, Error.Wrap(err)
-	}
-
-	senderName := networks.Name(request.Sender.NetworkName)
-	senderID, ok := networks.NetworkNameToID[senderName]
-	if !ok {
-		return BridgeInSignatureResponse{}, Error.Wrap(networks.ErrTransactionNameInvalid)
-	}
-
 	tokenTransfer := transfers.TokenTransfer{
 		TokenID:            1, // todo: dynamically change.
 		Amount:             *amount,
 		Status:             transfers.StatusWaiting,
-		SenderNetworkID:    int64(networks.NetworkNameToID[senderNetworkName]),
+		SenderNetworkID:    int64(senderNetworkID),
 		SenderAddress:      senderAddress,
 		RecipientNetworkID: int64(recipientNetworkID),
 		RecipientAddress:   recipientAddress,
 	}
 
-	err = service.tokenTransfers.Create(ctx, tokenTransfer)
+	var bridgeInSignature BridgeInSignatureResponse
+	_, err = service.tokenTransfers.CreateWithNonce(ctx, tokenTransfer, func(nonce int64) (time.Time, error) {
+		bridgeInSignature, err = connector.BridgeInSignature(ctx, BridgeInSignatureRequest{
+			User:          senderAddress,
+			Nonce:         big.NewInt(nonce),
+			Token:         token.ContractAddress,
+			Amount:        amount,
+			Destination:   request.Destination,
+			GasCommission: gasCommission,
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return parseDeadline(senderNetworkID.Type(), bridgeInSignature.Deadline)
+	})
 	if err != nil {
 		service.log.Error(fmt.Sprintf("couldn't create token transfer for network name %s", request.Sender.NetworkName), Error.Wrap(err))
 		return BridgeInSignatureResponse{}, Error.Wrap(err)
 	}
 
-	return bridgeInSignature, service.nonces.Increment(ctx, senderID)
+	return bridgeInSignature, nil
+}
+
+// parseDeadline parses signature deadline returned by connector of the given network type.
+// Casper contracts compare deadline with block time in milliseconds, while evm ones use seconds.
+func parseDeadline(networkType networks.Type, deadline string) (time.Time, error) {
+	value, err := strconv.ParseInt(deadline, 10, 64)
+	if err != nil {
+		return time.Time{}, Error.New("couldn't parse signature deadline %q", deadline)
+	}
+
+	if networkType == networks.TypeCasper {
+		return time.UnixMilli(value).UTC(), nil
+	}
+
+	return time.Unix(value, 0).UTC(), nil
 }
 
 // CancelTransfer cancels a pending transfer.
@@ -446,11 +702,6 @@ func (service *Service) CancelTransfer(ctx context.Context, transfer transfers.C
 		return transfers.CancelSignatureResponse{}, Error.Wrap(ErrInvalidTransferStatus)
 	}
 
-	nonce, err := service.nonces.Get(ctx, networkID)
-	if err != nil {
-		return transfers.CancelSignatureResponse{}, Error.Wrap(err)
-	}
-
 	token, err := service.networkTokens.Get(ctx, networkID, tokenTransfer.TokenID)
 	if err != nil {
 		return transfers.CancelSignatureResponse{}, Error.Wrap(err)
@@ -478,55 +729,89 @@ func (service *Service) CancelTransfer(ctx context.Context, transfer transfers.C
 		return transfers.CancelSignatureResponse{}, Error.New("couldn't parse commission")
 	}
 
-	cancelSignatureRequest := chains.CancelSignatureRequest{
-		Nonce:      new(big.Int).SetInt64(nonce),
-		Token:      token.ContractAddress,
-		Recipient:  transfer.PublicKey,
-		Commission: commission,
-		Amount:     &tokenTransfer.Amount,
+	transition, err := tokenTransfer.MoveTo(transfers.StatusCancelled, transfers.CauseCancelSignature)
+	if err != nil {
+		return transfers.CancelSignatureResponse{}, Error.Wrap(err)
 	}
 
-	cancelSignatureResponse, err := connector.CancelSignature(ctx, cancelSignatureRequest)
+	// cancel nonce is reserved in the same transaction transfer is cancelled in, so it is never shared.
+	var cancelSignatureResponse chains.CancelSignatureResponse
+	nonce, err := service.tokenTransfers.CancelWithNonce(ctx, tokenTransfer, networkID, transition, func(nonce int64) (err error) {
+		cancelSignatureResponse, err = connector.CancelSignature(ctx, chains.CancelSignatureRequest{
+			Nonce:      new(big.Int).SetInt64(nonce),
+			Token:      token.ContractAddress,
+			Recipient:  transfer.PublicKey,
+			Commission: commission,
+			Amount:     &tokenTransfer.Amount,
+		})
+		return err
+	})
 	if err != nil {
+		service.log.Error("", Error.Wrap(err))
 		return transfers.CancelSignatureResponse{}, Error.Wrap(err)
 	}
 
-	cancelTransferResponse := transfers.CancelSignatureResponse{
-		Status:     string(tokenTransfer.Status),
+	return transfers.CancelSignatureResponse{
+		Status:     string(transition.From),
 		Nonce:      uint64(nonce),
 		Signature:  cancelSignatureResponse.Signature,
 		Token:      token.ContractAddress,
 		Recipient:  tokenTransfer.SenderAddress,
 		Commission: commission.String(),
 		Amount:     tokenTransfer.Amount.String(),
-	}
-
-	tokenTransfer.Status = transfers.StatusCancelled
-	err = service.tokenTransfers.Update(ctx, tokenTransfer)
-	if err != nil {
-		service.log.Error("", Error.Wrap(err))
-		return transfers.CancelSignatureResponse{}, Error.Wrap(err)
-	}
-
-	return cancelTransferResponse, service.nonces.Increment(ctx, networkID)
+	}, nil
 }
 
 // Sign signs data for specific network.
 func (service *Service) Sign(ctx context.Context, networkType networks.Type, data []byte, dataType signer.Type) ([]byte, error) {
+	if service.dryRun {
+		return nil, Error.Wrap(dryrun.ErrDryRun.New("data is not signed"))
+	}
+
 	signedData, err := service.signer.Sign(ctx, networkType, data, dataType)
 	return signedData, Error.Wrap(err)
 }
 
+// SignWithKey signs data for specific network with the transaction key of the specified public key.
+func (service *Service) SignWithKey(ctx context.Context, networkType networks.Type, publicKey networks.PublicKey, data []byte) ([]byte, error) {
+	if service.dryRun {
+		return nil, Error.Wrap(dryrun.ErrDryRun.New("data is not signed"))
+	}
+
+	signedData, err := service.signer.SignWithKey(ctx, networkType, publicKey, data)
+	return signedData, Error.Wrap(err)
+}
+
 // PublicKey returns public key for specific network.
 func (service *Service) PublicKey(ctx context.Context, networkType networks.Type) ([]byte, error) {
 	publicKey, err := service.signer.PublicKey(ctx, networkType)
 	return publicKey, Error.Wrap(err)
 }
 
+// PublicKeys returns public keys of all transaction keys for specific network.
+func (service *Service) PublicKeys(ctx context.Context, networkType networks.Type) ([]networks.PublicKey, error) {
+	publicKeys, err := service.signer.PublicKeys(ctx, networkType)
+	return publicKeys, Error.Wrap(err)
+}
+
 // separateEvent separates events for different processing and recording in the database.
 func (service *Service) separateEvent(ctx context.Context, eventFund chains.EventVariant, networkName networks.Name) error {
+	if service.dryRun {
+		err := service.dryRunEvent(ctx, eventFund, networkName)
+		if err != nil {
+			service.log.Error("dry run event err: ", Error.Wrap(err))
+			return status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+
+		return nil
+	}
+
 	switch eventFund.Type {
 	case chains.EventTypeIn:
+		if eventFund.EventFundsIn.Removed {
+			return service.eventInRemovedReaction(ctx, eventFund, networkName)
+		}
+
 		err := service.eventInReaction(ctx, eventFund, networkName)
 		if err != nil {
 			service.log.Error("eventIn reaction err: ", Error.Wrap(err))
@@ -538,6 +823,8 @@ func (service *Service) separateEvent(ctx context.Context, eventFund chains.Even
 			service.log.Error("eventOut reaction err: ", Error.Wrap(err))
 			return status.Error(codes.Internal, Error.Wrap(err).Error())
 		}
+	case chains.EventTypeProgress:
+		// there is nothing to react on, reached block is saved by events reading chore.
 	default:
 		err := Error.New("invalid event type")
 		service.log.Error("", Error.Wrap(err))
@@ -547,31 +834,141 @@ func (service *Service) separateEvent(ctx context.Context, eventFund chains.Even
 	return nil
 }
 
-// eventInReaction performs actions after fundIn event.
-func (service *Service) eventInReaction(ctx context.Context, eventFund chains.EventVariant, networkName networks.Name) error {
-	senderNetworkID := networks.NetworkNameToID[networkName]
-	senderAddress, err := networks.StringToBytes(senderNetworkID, hex.EncodeToString(eventFund.EventFundsIn.From))
+// fundsIn describes funds in event with addresses and amount parsed by codecs of its networks.
+type fundsIn struct {
+	senderNetwork          networks.Name
+	senderNetworkID        networks.ID
+	senderAddress          []byte
+	formattedSenderAddress string
+	recipientNetwork       networks.Name
+	recipientNetworkID     networks.ID
+	recipientAddress       []byte
+	amount                 *big.Int
+}
+
+// parseFundsIn parses funds in event of the network.
+func parseFundsIn(event chains.EventFundsIn, networkName networks.Name) (fundsIn, error) {
+	in := fundsIn{
+		senderNetwork:    networkName,
+		senderAddress:    event.From,
+		recipientNetwork: networks.Name(event.To.NetworkName),
+	}
+	in.senderNetworkID, _ = networks.IDByName(in.senderNetwork)
+	in.recipientNetworkID, _ = networks.IDByName(in.recipientNetwork)
+
+	senderCodec, err := in.senderNetworkID.Codec()
+	if err != nil {
+		return in, err
+	}
+
+	// connector delivers sender address as bytes, formatting validates it and gives its canonical form.
+	in.formattedSenderAddress, err = senderCodec.FormatAddress(in.senderAddress)
+	if err != nil {
+		return in, err
+	}
+
+	recipientCodec, err := in.recipientNetworkID.Codec()
+	if err != nil {
+		return in, err
+	}
+
+	in.recipientAddress, err = recipientCodec.ParseAddress(event.To.Address)
+	if err != nil {
+		return in, err
+	}
+
+	var ok bool
+	if in.amount, ok = new(big.Int).SetString(event.Amount, 10); !ok {
+		return in, Error.New("could not set amount %s", event.Amount)
+	}
+
+	return in, nil
+}
+
+// fundsInDecision describes what bridge does on funds in event of the transfer before limits are applied, limits are
+// applied to bridge out only.
+type fundsInDecision struct {
+	action  dryrun.Action
+	reason  string
+	verdict screening.Verdict
+	pause   pause.Pause
+}
+
+// decideFundsIn decides on funds in event of the transfer. Event of the transfer, which is not waiting, is unmatched,
+// transfer with address flagged by screening is held and transfer of paused network is deferred, otherwise bridge
+// out is sent once transfer passes limits. Live bridge and dry run decide by it, so they differ in effects only.
+func (service *Service) decideFundsIn(ctx context.Context, tokenTransfer transfers.TokenTransfer, in fundsIn) (fundsInDecision, error) {
+	if tokenTransfer.Status != transfers.StatusWaiting {
+		return fundsInDecision{
+			action: dryrun.ActionUnmatched,
+			reason: fmt.Sprintf("transfer %d has %s status", tokenTransfer.ID, tokenTransfer.Status),
+		}, nil
+	}
+
+	verdict := service.screenTransfer(ctx,
+		screening.Subject{NetworkID: in.senderNetworkID, Address: in.senderAddress},
+		screening.Subject{NetworkID: in.recipientNetworkID, Address: in.recipientAddress},
+	)
+	if verdict.Flagged {
+		return fundsInDecision{action: dryrun.ActionHold, reason: verdict.String(), verdict: verdict}, nil
+	}
+
+	// bridge out is deferred until paused bridge or network is resumed, events are indexed meanwhile.
+	networkPause, paused, err := service.findPause(ctx, in.senderNetwork, in.recipientNetwork)
+	if err != nil {
+		return fundsInDecision{}, err
+	}
+	if paused {
+		return fundsInDecision{action: dryrun.ActionDefer, reason: networkPause.String(), pause: networkPause}, nil
+	}
+
+	return fundsInDecision{action: dryrun.ActionBridgeOut}, nil
+}
+
+// eventInRemovedReaction sends funds in event, which is removed from the chain due to reorganization, to manual review.
+// Transfer could be already bridged out on the strength of the event, while its funds are not locked anymore.
+func (service *Service) eventInRemovedReaction(ctx context.Context, eventFund chains.EventVariant, networkName networks.Name) error {
+	in, err := parseFundsIn(eventFund.EventFundsIn, networkName)
 	if err != nil {
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, err.Error())
 	}
 
-	recipientNetworkID := networks.NetworkNameToID[networks.Name(eventFund.EventFundsIn.To.NetworkName)]
-	recipientAddress, err := networks.StringToBytes(recipientNetworkID, eventFund.EventFundsIn.To.Address)
+	unmatchedEvent := transfers.UnmatchedEvent{
+		Kind:      transfers.EventKindFundsIn,
+		NetworkID: in.senderNetworkID,
+		TxHash:    eventFund.EventFundsIn.Tx.Hash,
+		Reference: int64(eventFund.EventFundsIn.Nonce),
+		Amount:    *in.amount,
+		Reason:    "event is removed due to chain reorganization",
+	}
+
+	tokenTransfer, err := service.tokenTransfers.GetByNonce(ctx, in.senderNetworkID, int64(eventFund.EventFundsIn.Nonce))
 	if err != nil {
+		if errors.Is(err, ErrNoTokenTransfer) {
+			return service.flagUnmatchedEvent(ctx, unmatchedEvent)
+		}
+
 		service.log.Error("", Error.Wrap(err))
-		return status.Error(codes.Internal, err.Error())
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
 
-	amount, ok := new(big.Int).SetString(eventFund.EventFundsIn.Amount, 10)
-	if !ok {
-		service.log.Error("", Error.New("could not set amount %v", amount))
-		return status.Error(codes.Internal, "could not set amount")
+	unmatchedEvent.Reason = fmt.Sprintf("event of transfer %d with %s status is removed due to chain reorganization",
+		tokenTransfer.ID, tokenTransfer.Status)
+	return service.flagUnmatchedEvent(ctx, unmatchedEvent)
+}
+
+// eventInReaction performs actions after fundIn event.
+func (service *Service) eventInReaction(ctx context.Context, eventFund chains.EventVariant, networkName networks.Name) error {
+	in, err := parseFundsIn(eventFund.EventFundsIn, networkName)
+	if err != nil {
+		service.log.Error("", Error.Wrap(err))
+		return status.Error(codes.Internal, err.Error())
 	}
 
-	networkID := networks.NetworkNameToID[networkName]
+	networkID := in.senderNetworkID
 
-	err = service.transactions.Exists(ctx, networkID, eventFund.EventFundsIn.Tx.Hash)
+	err = service.transactions.Exists(ctx, networkID, eventFund.EventFundsIn.Tx.Hash, int64(eventFund.EventFundsIn.Tx.LogIndex))
 	if err != nil {
 		if errors.Is(err, ErrTransactionAlreadyExists) {
 			return nil
@@ -582,71 +979,105 @@ func (service *Service) eventInReaction(ctx context.Context, eventFund chains.Ev
 	transactionID, err := service.transactions.Create(ctx, transactions.Transaction{
 		NetworkID:   networkID,
 		TxHash:      eventFund.EventFundsIn.Tx.Hash,
-		Sender:      senderAddress,
+		Sender:      in.senderAddress,
 		BlockNumber: int64(eventFund.EventFundsIn.Tx.BlockNumber),
 		SeenAt:      time.Now().UTC(),
+		LogIndex:    int64(eventFund.EventFundsIn.Tx.LogIndex),
 	})
 	if err != nil {
+		// event was stored concurrently after existence check.
+		if errors.Is(err, ErrTransactionAlreadyExists) {
+			return nil
+		}
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
 
-	{ // call BridgeOut.
-		toAddress, err := networks.StringToBytes(recipientNetworkID, eventFund.EventFundsIn.To.Address)
-		if err != nil {
-			service.log.Error("", Error.Wrap(err))
-			return status.Error(codes.Internal, Error.Wrap(err).Error())
-		}
+	unmatchedEvent := transfers.UnmatchedEvent{
+		Kind:      transfers.EventKindFundsIn,
+		NetworkID: networkID,
+		TxHash:    eventFund.EventFundsIn.Tx.Hash,
+		Reference: int64(eventFund.EventFundsIn.Nonce),
+		Amount:    *in.amount,
+	}
 
-		token, err := service.networkTokens.Get(ctx, recipientNetworkID, 1) // TODO: add dynamic token id.
-		if err != nil {
-			service.log.Error("", Error.Wrap(err))
-			return status.Error(codes.Internal, Error.Wrap(err).Error())
+	tokenTransfer, err := service.tokenTransfers.GetByNonce(ctx, networkID, int64(eventFund.EventFundsIn.Nonce))
+	if err != nil {
+		if errors.Is(err, ErrNoTokenTransfer) {
+			unmatchedEvent.Reason = "no transfer with such nonce"
+			return service.flagUnmatchedEvent(ctx, unmatchedEvent)
 		}
 
-		connector, exists := service.connectors[networks.Name(eventFund.EventFundsIn.To.NetworkName)]
-		if !exists {
-			err := fmt.Errorf("%s connector is not connected", eventFund.EventFundsIn.To.NetworkName)
-			service.log.Error("", Error.Wrap(err))
-			return status.Error(codes.Internal, Error.Wrap(err).Error())
-		}
-		bridgeOut, err := connector.BridgeOut(ctx, chains.TokenOutRequest{
-			Amount: amount,
-			Token:  token.ContractAddress,
-			To:     toAddress,
-			From: networks.Address{
-				NetworkName: networkName.String(),
-				Address:     hex.EncodeToString(senderAddress),
-			},
-			TransactionID: big.NewInt(int64(transactionID)),
-		})
-		if err != nil {
-			service.log.Error("", Error.Wrap(err))
-			return status.Error(codes.Internal, Error.Wrap(err).Error())
-		}
-		if len(bridgeOut.Txhash) == 0 {
-			err = errs.New("couldn't send bridgeOut in network %s", eventFund.EventFundsIn.To.NetworkName)
+		service.log.Error("", Error.Wrap(err))
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	decision, err := service.decideFundsIn(ctx, tokenTransfer, in)
+	if err != nil {
+		service.log.Error("", Error.Wrap(err))
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	// transfer is bound to the triggering transaction before bridge out, so funds out event is able to find it.
+	next, cause := transfers.StatusConfirming, transfers.CauseFundsIn
+	tokenTransfer.TriggeringTx = transactionID
+	switch decision.action {
+	case dryrun.ActionUnmatched:
+		unmatchedEvent.Reason = decision.reason
+		return service.flagUnmatchedEvent(ctx, unmatchedEvent)
+	case dryrun.ActionHold:
+		// bridge out of the flagged transfer is withheld, its funds stay locked on the sender network.
+		next, cause = transfers.StatusHeld, transfers.CauseScreening
+		if err = service.holdTransfer(ctx, transfers.ID(tokenTransfer.ID), decision.verdict); err != nil {
 			service.log.Error("", Error.Wrap(err))
 			return status.Error(codes.Internal, Error.Wrap(err).Error())
 		}
 	}
 
-	tokenTransfer := transfers.TokenTransfer{
-		TokenID:          1, // todo: dynamically change.
-		Amount:           *amount,
-		SenderAddress:    senderAddress,
-		RecipientAddress: recipientAddress,
+	transition, err := tokenTransfer.MoveTo(next, cause)
+	if err != nil {
+		service.log.Error("", Error.Wrap(err))
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
 
-	tokenTransfer, err = service.tokenTransfers.GetByAllParams(ctx, tokenTransfer)
+	err = service.tokenTransfers.Update(ctx, tokenTransfer, transition)
 	if err != nil {
+		// transfer has changed its status since it was read, e.g. user cancelled it meanwhile.
+		if transfers.ErrIllegalTransition.Has(err) {
+			unmatchedEvent.Reason = err.Error()
+			return service.flagUnmatchedEvent(ctx, unmatchedEvent)
+		}
+
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
 
-	tokenTransfer.Status = transfers.StatusConfirming
-	tokenTransfer.TriggeringTx = transactionID
-	err = service.tokenTransfers.Update(ctx, tokenTransfer)
+	usage := limits.Usage{
+		TokenID:            tokenTransfer.TokenID,
+		SenderNetworkID:    networkID,
+		RecipientNetworkID: in.recipientNetworkID,
+		SenderAddress:      in.senderAddress,
+		Amount:             in.amount,
+		At:                 time.Now().UTC(),
+	}
+	request := chains.TokenOutRequest{
+		Amount: in.amount,
+		To:     in.recipientAddress,
+		From: networks.Address{
+			NetworkName: networkName.String(),
+			Address:     in.formattedSenderAddress,
+		},
+		TransactionID: big.NewInt(int64(transactionID)),
+	}
+
+	switch decision.action {
+	case dryrun.ActionHold:
+		return nil
+	case dryrun.ActionDefer:
+		err = service.deferTransfer(ctx, transfers.ID(tokenTransfer.ID), decision.pause)
+	default:
+		err = service.transferOut(ctx, transfers.ID(tokenTransfer.ID), usage, request)
+	}
 	if err != nil {
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, Error.Wrap(err).Error())
@@ -655,22 +1086,189 @@ func (service *Service) eventInReaction(ctx context.Context, eventFund chains.Ev
 	return nil
 }
 
+// transferOut sends bridge out of the confirming transfer. Transfers exceeding limits are held for manual approval
+// instead, their funds stay locked until operator decides. Transfer, which was counted in volumes of limits by its
+// earlier bridge out attempt, is not counted once more.
+func (service *Service) transferOut(ctx context.Context, transferID transfers.ID, usage limits.Usage, request chains.TokenOutRequest) error {
+	counted, err := service.isAttempted(ctx, transferID)
+	if err != nil {
+		return err
+	}
+
+	reserve := service.limiter.Reserve
+	if counted {
+		reserve = service.limiter.CheckCounted
+	}
+
+	violation, err := reserve(ctx, usage)
+	if err != nil {
+		return err
+	}
+	if violation != "" {
+		return service.holdForApproval(ctx, transferID, violation)
+	}
+
+	return service.countedBridgeOut(ctx, transferID, usage, request, counted, false)
+}
+
+// countedBridgeOut sends bridge out of the transfer, which is counted in volumes of limits. Transfer stays counted once
+// its bridge out attempt is recorded, since attempt may pay it out, so usage counted for this bridge out is released
+// only if bridge out fails before that.
+func (service *Service) countedBridgeOut(ctx context.Context, transferID transfers.ID, usage limits.Usage,
+	request chains.TokenOutRequest, counted, unknownVerified bool) error {
+	err := service.bridgeOut(ctx, transferID, usage.RecipientNetworkID, request, unknownVerified)
+	if err == nil || counted {
+		return err
+	}
+
+	attempted, attemptsErr := service.isAttempted(ctx, transferID)
+	if attemptsErr != nil || attempted {
+		return errs.Combine(err, attemptsErr)
+	}
+
+	return errs.Combine(err, service.limiter.Release(ctx, usage))
+}
+
+// isAttempted reports whether bridge out of the transfer was attempted, so transfer is counted in volumes of limits.
+func (service *Service) isAttempted(ctx context.Context, transferID transfers.ID) (bool, error) {
+	attempts, err := service.outboundAttempts.List(ctx, transferID)
+	return len(attempts) > 0, err
+}
+
+// outbound returns usage of limits and bridge out request of the stored confirming transfer.
+func outbound(tokenTransfer transfers.TokenTransfer) (limits.Usage, chains.TokenOutRequest, error) {
+	senderNetworkID := networks.ID(tokenTransfer.SenderNetworkID)
+	senderNetworkName, _ := networks.NameByID(senderNetworkID)
+	senderCodec, err := senderNetworkID.Codec()
+	if err != nil {
+		return limits.Usage{}, chains.TokenOutRequest{}, err
+	}
+
+	formattedSenderAddress, err := senderCodec.FormatAddress(tokenTransfer.SenderAddress)
+	if err != nil {
+		return limits.Usage{}, chains.TokenOutRequest{}, err
+	}
+
+	amount := new(big.Int).Set(&tokenTransfer.Amount)
+	usage := limits.Usage{
+		TokenID:            tokenTransfer.TokenID,
+		SenderNetworkID:    senderNetworkID,
+		RecipientNetworkID: networks.ID(tokenTransfer.RecipientNetworkID),
+		SenderAddress:      tokenTransfer.SenderAddress,
+		Amount:             amount,
+		At:                 time.Now().UTC(),
+	}
+	request := chains.TokenOutRequest{
+		Amount: amount,
+		To:     tokenTransfer.RecipientAddress,
+		From: networks.Address{
+			NetworkName: senderNetworkName.String(),
+			Address:     formattedSenderAddress,
+		},
+		TransactionID: big.NewInt(int64(tokenTransfer.TriggeringTx)),
+	}
+
+	return usage, request, nil
+}
+
+// bridgeOut sends funds to the recipient through the connector of the recipient network. Attempt is recorded before
+// bridge out is broadcast and earlier attempts are checked first, so transfer is not paid out twice. Attempts with unknown
+// outcome are skipped by the check only if operator verified them on chain.
+func (service *Service) bridgeOut(ctx context.Context, transferID transfers.ID, recipientNetworkID networks.ID,
+	request chains.TokenOutRequest, unknownVerified bool) error {
+	if service.dryRun {
+		return dryrun.ErrDryRun.New("bridge out is not sent")
+	}
+
+	token, err := service.networkTokens.Get(ctx, recipientNetworkID, 1) // TODO: add dynamic token id.
+	if err != nil {
+		return err
+	}
+	request.Token = token.ContractAddress
+
+	recipientNetworkName, _ := networks.NameByID(recipientNetworkID)
+	connector, exists := service.connectors[recipientNetworkName]
+	if !exists {
+		return fmt.Errorf("%s connector is not connected", recipientNetworkName)
+	}
+
+	if err = service.checkOutboundAttempts(ctx, transferID, connector, unknownVerified); err != nil {
+		return err
+	}
+
+	attemptID, err := service.outboundAttempts.Create(ctx, transfers.OutboundAttempt{
+		TransferID:  transferID,
+		NetworkID:   recipientNetworkID,
+		AttemptedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// outcome of the attempt stays unknown if connector fails, since transaction may have been broadcast before.
+	bridgeOut, err := connector.BridgeOut(ctx, request)
+	if err != nil {
+		return err
+	}
+	if len(bridgeOut.Txhash) == 0 {
+		return errs.New("couldn't send bridgeOut in network %s", recipientNetworkName)
+	}
+
+	return service.outboundAttempts.SetTxHash(ctx, attemptID, bridgeOut.Txhash)
+}
+
+// checkOutboundAttempts checks that no earlier attempt of bridge out of the transfer may still pay it out, so it is sent
+// again. Broadcast transaction, which is pending or succeeded, may pay transfer out, while dropped or reverted one does
+// not. Attempt with unknown outcome blocks bridge out, unless operator verified it on chain.
+func (service *Service) checkOutboundAttempts(ctx context.Context, transferID transfers.ID, connector Connector, unknownVerified bool) error {
+	attempts, err := service.outboundAttempts.List(ctx, transferID)
+	if err != nil {
+		return err
+	}
+
+	for _, attempt := range attempts {
+		if attempt.IsUnknown() {
+			if unknownVerified {
+				continue
+			}
+			return ErrOutboundInFlight.New("outcome of bridge out of transfer %d attempted at %s is unknown",
+				transferID, attempt.AttemptedAt.Format(time.RFC3339))
+		}
+
+		txStatus, err := connector.TransactionStatus(ctx, attempt.TxHash)
+		if err != nil {
+			return err
+		}
+		if txStatus == chains.TxStatusPending || txStatus == chains.TxStatusSucceeded {
+			return ErrOutboundInFlight.New("bridge out transaction %x of transfer %d is %s", attempt.TxHash, transferID, txStatus)
+		}
+	}
+
+	return nil
+}
+
 // eventOutReaction performs actions after fundOut event.
 func (service *Service) eventOutReaction(ctx context.Context, eventFund chains.EventVariant, networkName networks.Name) error {
-	senderNetworkID, ok := networks.NetworkNameToID[networks.Name(eventFund.EventFundsOut.From.NetworkName)]
+	senderNetworkID, ok := networks.IDByName(networks.Name(eventFund.EventFundsOut.From.NetworkName))
 	if !ok {
 		service.log.Error("", Error.New("network name is invalid"))
 		return status.Error(codes.Internal, Error.New("network name is invalid").Error())
 	}
 
-	senderAddress, err := networks.StringToBytes(senderNetworkID, eventFund.EventFundsOut.From.Address)
+	senderCodec, err := senderNetworkID.Codec()
+	if err != nil {
+		service.log.Error("", Error.Wrap(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	senderAddress, err := senderCodec.ParseAddress(eventFund.EventFundsOut.From.Address)
 	if err != nil {
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, err.Error())
 	}
 
-	recipientNetworkID := networks.NetworkNameToID[networkName]
-	err = service.transactions.Exists(ctx, senderNetworkID, eventFund.EventFundsOut.Tx.Hash)
+	recipientNetworkID, _ := networks.IDByName(networkName)
+	err = service.transactions.Exists(ctx, senderNetworkID, eventFund.EventFundsOut.Tx.Hash, int64(eventFund.EventFundsOut.Tx.LogIndex))
 	if err != nil {
 		if errors.Is(err, ErrTransactionAlreadyExists) {
 			return nil
@@ -684,8 +1282,13 @@ func (service *Service) eventOutReaction(ctx context.Context, eventFund chains.E
 		Sender:      senderAddress,
 		BlockNumber: int64(eventFund.EventFundsOut.Tx.BlockNumber),
 		SeenAt:      time.Now().UTC(),
+		LogIndex:    int64(eventFund.EventFundsOut.Tx.LogIndex),
 	})
 	if err != nil {
+		// event was stored concurrently after existence check.
+		if errors.Is(err, ErrTransactionAlreadyExists) {
+			return nil
+		}
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
@@ -696,28 +1299,65 @@ func (service *Service) eventOutReaction(ctx context.Context, eventFund chains.E
 		return status.Error(codes.Internal, "could not set amount")
 	}
 
-	recipient, err := networks.StringToBytes(recipientNetworkID, hex.EncodeToString(eventFund.EventFundsOut.To))
+	unmatchedEvent := transfers.UnmatchedEvent{
+		Kind:      transfers.EventKindFundsOut,
+		NetworkID: recipientNetworkID,
+		TxHash:    eventFund.EventFundsOut.Tx.Hash,
+		Reference: int64(eventFund.EventFundsOut.TransactionID),
+		Amount:    *amount,
+	}
+
+	// zero transaction id is never passed to bridge out, transfers which were not triggered yet have it.
+	if eventFund.EventFundsOut.TransactionID == 0 {
+		unmatchedEvent.Reason = "empty transaction id"
+		return service.flagUnmatchedEvent(ctx, unmatchedEvent)
+	}
+
+	tokenTransfer, err := service.tokenTransfers.GetByTriggeringTx(ctx, transactions.ID(eventFund.EventFundsOut.TransactionID))
 	if err != nil {
+		if errors.Is(err, ErrNoTokenTransfer) {
+			unmatchedEvent.Reason = "no transfer with such triggering transaction"
+			return service.flagUnmatchedEvent(ctx, unmatchedEvent)
+		}
+
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
 
-	tokenTransfer := transfers.TokenTransfer{
-		TokenID:          1, // todo: dynamically change.
-		Amount:           *amount,
-		SenderAddress:    senderAddress,
-		RecipientAddress: recipient,
+	if tokenTransfer.Status != transfers.StatusConfirming {
+		unmatchedEvent.Reason = fmt.Sprintf("transfer %d has %s status", tokenTransfer.ID, tokenTransfer.Status)
+		return service.flagUnmatchedEvent(ctx, unmatchedEvent)
 	}
 
-	tokenTransfer, err = service.tokenTransfers.GetByAllParams(ctx, tokenTransfer)
+	transition, err := tokenTransfer.MoveTo(transfers.StatusFinished, transfers.CauseFundsOut)
 	if err != nil {
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, Error.Wrap(err).Error())
 	}
 
-	tokenTransfer.Status = transfers.StatusFinished
 	tokenTransfer.OutboundTx = transactionID
-	err = service.tokenTransfers.Update(ctx, tokenTransfer)
+	err = service.tokenTransfers.Update(ctx, tokenTransfer, transition)
+	if err != nil {
+		// operator has cancelled transfer after it was read.
+		if transfers.ErrIllegalTransition.Has(err) {
+			unmatchedEvent.Reason = err.Error()
+			return service.flagUnmatchedEvent(ctx, unmatchedEvent)
+		}
+
+		service.log.Error("", Error.Wrap(err))
+		return status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return nil
+}
+
+// flagUnmatchedEvent stores event that could not be bound to any token transfer for manual review.
+func (service *Service) flagUnmatchedEvent(ctx context.Context, event transfers.UnmatchedEvent) error {
+	service.log.Warn(fmt.Sprintf("%s event from tx %s in network %d requires manual review: %s",
+		event.Kind, hex.EncodeToString(event.TxHash), event.NetworkID, event.Reason))
+
+	event.CreatedAt = time.Now().UTC()
+	err := service.unmatchedEvents.Create(ctx, event)
 	if err != nil {
 		service.log.Error("", Error.Wrap(err))
 		return status.Error(codes.Internal, Error.Wrap(err).Error())
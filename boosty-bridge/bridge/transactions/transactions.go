@@ -11,12 +11,14 @@ import (
 //
 // architecture: DB
 type DB interface {
-	// Create inserts transaction to database.
+	// Create inserts transaction to database, returns ErrTransactionAlreadyExists if event is already stored.
 	Create(ctx context.Context, transaction Transaction) (ID, error)
 	// Get returns transaction by id from database.
 	Get(ctx context.Context, id ID) (Transaction, error)
-	// Exists returns nil if there is a new txHash for specified networkID.
-	Exists(ctx context.Context, networkID networks.ID, txHash []byte) error
+	// Exists returns nil if there is a new event with logIndex in txHash for specified networkID.
+	Exists(ctx context.Context, networkID networks.ID, txHash []byte, logIndex int64) error
+	// GetByEvent returns transaction of the event with logIndex in txHash for specified networkID.
+	GetByEvent(ctx context.Context, networkID networks.ID, txHash []byte, logIndex int64) (Transaction, error)
 }
 
 // ID defines internal transaction id.
@@ -32,4 +34,6 @@ type Transaction struct {
 	Sender      []byte
 	BlockNumber int64
 	SeenAt      time.Time
+	// LogIndex is a position of the bridge event in the transaction, one transaction can emit several bridge events.
+	LogIndex int64
 }
@@ -0,0 +1,206 @@
+package transfers
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+)
+
+// ErrInvalidHistoryRequest indicates that history cursor or sort order is malformed.
+var ErrInvalidHistoryRequest = errs.Class("invalid history request")
+
+// Sort defines order of transfers in history.
+type Sort string
+
+const (
+	// SortNewest orders transfers from the newest to the oldest one.
+	SortNewest Sort = "NEWEST"
+	// SortOldest orders transfers from the oldest to the newest one.
+	SortOldest Sort = "OLDEST"
+)
+
+// Validate validates sort order.
+func (sort Sort) Validate() error {
+	switch sort {
+	case SortNewest, SortOldest:
+		return nil
+	default:
+		return ErrInvalidHistoryRequest.New("unsupported sort order %q", sort)
+	}
+}
+
+// HistoryFilter describes which transfers are returned in history. Empty lists and zero times are not applied.
+type HistoryFilter struct {
+	Statuses          []Status
+	SenderNetworks    []networks.ID
+	RecipientNetworks []networks.ID
+	TokenIDs          []int64
+	CreatedFrom       time.Time // inclusive.
+	CreatedTo         time.Time // exclusive.
+}
+
+// Validate validates statuses of the filter.
+func (filter HistoryFilter) Validate() error {
+	for _, status := range filter.Statuses {
+		if err := status.Validate(); err != nil {
+			return ErrInvalidHistoryRequest.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// Fingerprint returns short digest of the filter, which does not depend on the order of values in the lists.
+func (filter HistoryFilter) Fingerprint() string {
+	statuses := make([]string, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		statuses = append(statuses, string(status))
+	}
+	sort.Strings(statuses)
+
+	ints := func(values []int64) []int64 {
+		sorted := append([]int64(nil), values...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		return sorted
+	}
+	networkIDs := func(ids []networks.ID) []int64 {
+		values := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, int64(id))
+		}
+		return ints(values)
+	}
+	unixNano := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.UnixNano()
+	}
+
+	canonical := fmt.Sprintf("%v|%v|%v|%v|%d|%d", statuses, networkIDs(filter.SenderNetworks),
+		networkIDs(filter.RecipientNetworks), ints(filter.TokenIDs), unixNano(filter.CreatedFrom), unixNano(filter.CreatedTo))
+	digest := sha256.Sum256([]byte(canonical))
+
+	return hex.EncodeToString(digest[:8])
+}
+
+// Cursor points to the last transfer of the history page, the next page starts right after it. Cursor is bound to
+// the sort order and the filter of the page it was issued for. Zero cursor points to the beginning of the history.
+type Cursor struct {
+	ID        int64
+	CreatedAt time.Time
+	Sort      Sort
+	Filter    string // fingerprint of the filter.
+}
+
+// NewCursor is a constructor for cursor, which points to the token transfer in history with sort order and filter.
+func NewCursor(tokenTransfer TokenTransfer, sort Sort, filter HistoryFilter) Cursor {
+	return Cursor{
+		ID:        tokenTransfer.ID,
+		CreatedAt: tokenTransfer.CreatedAt,
+		Sort:      sort,
+		Filter:    filter.Fingerprint(),
+	}
+}
+
+// IsZero reports whether cursor points to the beginning of the history.
+func (cursor Cursor) IsZero() bool {
+	return cursor.ID == 0
+}
+
+// Validate checks that cursor was issued for the same sort order and filter.
+func (cursor Cursor) Validate(sort Sort, filter HistoryFilter) error {
+	if cursor.IsZero() {
+		return nil
+	}
+	if cursor.Sort != sort || cursor.Filter != filter.Fingerprint() {
+		return ErrInvalidHistoryRequest.New("cursor was issued for another sort order or filter")
+	}
+
+	return nil
+}
+
+// String encodes cursor to the opaque string, which is returned to clients.
+func (cursor Cursor) String() string {
+	if cursor.IsZero() {
+		return ""
+	}
+
+	var createdAt int64
+	if !cursor.CreatedAt.IsZero() {
+		createdAt = cursor.CreatedAt.UnixNano()
+	}
+
+	data := fmt.Sprintf("%d:%d:%s:%s", cursor.ID, createdAt, cursor.Sort, cursor.Filter)
+	return base64.RawURLEncoding.EncodeToString([]byte(data))
+}
+
+// ParseCursor decodes cursor from the opaque string, empty string is decoded to zero cursor.
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidHistoryRequest.Wrap(err)
+	}
+
+	parts := strings.Split(string(data), ":")
+	if len(parts) != 4 {
+		return Cursor{}, ErrInvalidHistoryRequest.New("malformed cursor %q", s)
+	}
+
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || id <= 0 {
+		return Cursor{}, ErrInvalidHistoryRequest.New("malformed cursor %q", s)
+	}
+
+	createdAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidHistoryRequest.New("malformed cursor %q", s)
+	}
+
+	cursor := Cursor{ID: id, Sort: Sort(parts[2]), Filter: parts[3]}
+	if createdAt != 0 {
+		cursor.CreatedAt = time.Unix(0, createdAt).UTC()
+	}
+
+	return cursor, nil
+}
+
+// HistoryRequest describes the values needed to get page of user transfer history.
+type HistoryRequest struct {
+	Signature []byte
+	PublicKey []byte
+	NetworkID uint32
+	Filter    HistoryFilter
+	Sort      Sort
+	Cursor    string
+	Limit     uint64
+}
+
+// HistoryQuery describes page of transfers, which user sent or received, in database.
+type HistoryQuery struct {
+	NetworkID   networks.ID
+	UserAddress []byte
+	Filter      HistoryFilter
+	Sort        Sort
+	After       Cursor
+	Limit       uint64
+}
+
+// CursorPage holds page of transfer history, which is paginated by cursor.
+type CursorPage struct {
+	Transfers  []Transfer `json:"transfers"`
+	NextCursor string     `json:"nextCursor"`
+}
@@ -0,0 +1,69 @@
+package transfers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/transfers"
+)
+
+func TestCursor(t *testing.T) {
+	filter := transfers.HistoryFilter{
+		Statuses:    []transfers.Status{transfers.StatusFinished, transfers.StatusExpired},
+		TokenIDs:    []int64{2, 1},
+		CreatedFrom: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	tokenTransfer := transfers.TokenTransfer{ID: 42, CreatedAt: time.Date(2023, 2, 3, 4, 5, 6, 7000, time.UTC)}
+	cursor := transfers.NewCursor(tokenTransfer, transfers.SortOldest, filter)
+
+	parsed, err := transfers.ParseCursor(cursor.String())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, parsed)
+	assert.Equal(t, tokenTransfer.CreatedAt, parsed.CreatedAt)
+
+	t.Run("validate", func(t *testing.T) {
+		assert.NoError(t, parsed.Validate(transfers.SortOldest, filter))
+
+		// order of values in the filter lists does not matter.
+		reordered := filter
+		reordered.Statuses = []transfers.Status{transfers.StatusExpired, transfers.StatusFinished}
+		reordered.TokenIDs = []int64{1, 2}
+		assert.NoError(t, parsed.Validate(transfers.SortOldest, reordered))
+
+		err := parsed.Validate(transfers.SortNewest, filter)
+		assert.True(t, transfers.ErrInvalidHistoryRequest.Has(err))
+
+		changed := filter
+		changed.TokenIDs = []int64{1}
+		err = parsed.Validate(transfers.SortOldest, changed)
+		assert.True(t, transfers.ErrInvalidHistoryRequest.Has(err))
+
+		assert.NoError(t, transfers.Cursor{}.Validate(transfers.SortNewest, changed))
+	})
+
+	parsed, err = transfers.ParseCursor("")
+	require.NoError(t, err)
+	assert.True(t, parsed.IsZero())
+	assert.Empty(t, parsed.String())
+
+	for _, malformed := range []string{"42", "!!", "LTE", "YWJj"} {
+		_, err = transfers.ParseCursor(malformed)
+		require.Error(t, err, malformed)
+		assert.True(t, transfers.ErrInvalidHistoryRequest.Has(err))
+	}
+}
+
+func TestHistoryValidate(t *testing.T) {
+	assert.NoError(t, transfers.SortNewest.Validate())
+	assert.NoError(t, transfers.SortOldest.Validate())
+	assert.True(t, transfers.ErrInvalidHistoryRequest.Has(transfers.Sort("RANDOM").Validate()))
+
+	filter := transfers.HistoryFilter{Statuses: []transfers.Status{transfers.StatusFinished, transfers.StatusExpired}}
+	assert.NoError(t, filter.Validate())
+
+	filter.Statuses = append(filter.Statuses, "UNKNOWN")
+	assert.True(t, transfers.ErrInvalidHistoryRequest.Has(filter.Validate()))
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package transfers
+
+import (
+	"context"
+	"time"
+
+	"tricorn/bridge/networks"
+)
+
+// OutboundAttempts exposes access to the attempts of bridge out of transfers. Attempt is recorded before bridge out is
+// broadcast, so transfer, which may have been paid out already, is not sent again blindly.
+//
+// architecture: DB
+type OutboundAttempts interface {
+	// Create records attempt of bridge out of the transfer and returns its id.
+	Create(ctx context.Context, attempt OutboundAttempt) (int64, error)
+	// SetTxHash records hash of the transaction broadcast by the attempt.
+	SetTxHash(ctx context.Context, id int64, txHash []byte) error
+	// List returns attempts of bridge out of the transfer from the oldest one to the newest one.
+	List(ctx context.Context, transferID ID) ([]OutboundAttempt, error)
+}
+
+// OutboundAttempt describes attempt of bridge out of the transfer. Transaction hash is empty if connector has not
+// confirmed that transaction was broadcast, so outcome of the attempt is unknown.
+type OutboundAttempt struct {
+	ID          int64
+	TransferID  ID
+	NetworkID   networks.ID
+	TxHash      []byte
+	AttemptedAt time.Time
+}
+
+// IsUnknown reports whether it is unknown if transaction of the attempt was broadcast.
+func (attempt OutboundAttempt) IsUnknown() bool {
+	return len(attempt.TxHash) == 0
+}
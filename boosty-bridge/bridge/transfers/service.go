@@ -43,6 +43,12 @@ func (service *Service) History(ctx context.Context, offset, limit uint64, signa
 	return history, Error.Wrap(err)
 }
 
+// HistoryByCursor returns filtered list of transfers, which is paginated by cursor.
+func (service *Service) HistoryByCursor(ctx context.Context, req HistoryRequest) (CursorPage, error) {
+	history, err := service.bridge.HistoryByCursor(ctx, req)
+	return history, Error.Wrap(err)
+}
+
 // BridgeInSignature returns signature for user to send bridgeIn transaction.
 func (service *Service) BridgeInSignature(ctx context.Context, req BridgeInSignatureRequest) (BridgeInSignatureResponse, error) {
 	signature, err := service.bridge.BridgeInSignature(ctx, req)
@@ -54,3 +60,8 @@ func (service *Service) CancelSignature(ctx context.Context, req CancelSignature
 	signature, err := service.bridge.CancelSignature(ctx, req)
 	return signature, Error.Wrap(err)
 }
+
+// Watch sends transfer to updates each time its status changes, until status becomes final or context is cancelled.
+func (service *Service) Watch(ctx context.Context, req WatchRequest, updates chan<- Transfer) error {
+	return Error.Wrap(service.bridge.Watch(ctx, req, updates))
+}
@@ -3,6 +3,7 @@ package transfers
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"tricorn/bridge/networks"
 	"tricorn/bridge/transactions"
@@ -12,22 +13,46 @@ import (
 //
 // architecture: DB
 type TokenTransfers interface {
-	// Create inserts token transfer to database.
+	// Create inserts token transfer to database, its status is recorded as the first transition of the history.
+	// Creation time is set to the current time if transfer has none.
 	Create(ctx context.Context, tokenTransfer TokenTransfer) error
+	// CreateWithNonce atomically reserves next nonce of the sender network and inserts token transfer bound to it.
+	// Nothing is persisted if sign returns an error.
+	CreateWithNonce(ctx context.Context, tokenTransfer TokenTransfer, sign SignFunc) (TokenTransfer, error)
 	// Get returns token transfer by id from database.
 	Get(ctx context.Context, id int64) (TokenTransfer, error)
 	// GetByNetworkAndTx returns token transfer by network and hash from database.
 	GetByNetworkAndTx(ctx context.Context, networkID networks.ID, txHash []byte) (TokenTransfer, error)
+	// GetByNonce returns token transfer by sender network and bridge in signature nonce from database.
+	GetByNonce(ctx context.Context, senderNetworkID networks.ID, nonce int64) (TokenTransfer, error)
+	// GetByTriggeringTx returns token transfer by id of the transaction which triggered it from database.
+	GetByTriggeringTx(ctx context.Context, triggeringTx transactions.ID) (TokenTransfer, error)
 	// GetByAllParams returns transfer by from, to, amount, tokenAddress parameters from the database.
 	GetByAllParams(ctx context.Context, tokenTransfer TokenTransfer) (TokenTransfer, error)
 	// ListByUser returns selected list of token transfers by user address and network id from database.
 	ListByUser(ctx context.Context, offset, limit uint64, userWalletAddress []byte, networkID networks.ID) ([]TokenTransfer, error)
+	// ListByUserAfter returns token transfers, which user sent or received and which match the filter, that follow
+	// the cursor in the sort order.
+	ListByUserAfter(ctx context.Context, query HistoryQuery) ([]TokenTransfer, error)
 	// CountByUser counts total amount of transactions for user in one network.
 	CountByUser(ctx context.Context, networkID networks.ID, userWalletAddress []byte) (amount uint64, err error)
-	// Update updates token transfer in database.
-	Update(ctx context.Context, tokenTransfer TokenTransfer) error
+	// Update updates token transfer in database and records its status transition to the history in the same
+	// transaction. ErrIllegalTransition is returned if transfer is not in the status the transition starts from.
+	Update(ctx context.Context, tokenTransfer TokenTransfer, transition StatusTransition) error
+	// CancelWithNonce atomically reserves next nonce of the network and moves token transfer to cancelled status along
+	// with recording its transition, returns reserved nonce. Nothing is persisted if sign returns an error.
+	CancelWithNonce(ctx context.Context, tokenTransfer TokenTransfer, networkID networks.ID, transition StatusTransition, sign CancelSignFunc) (int64, error)
+	// Expire moves waiting token transfers which deadline has passed more than grace ago to expired status and records
+	// their transitions to the history, returns amount of expired transfers.
+	Expire(ctx context.Context, now time.Time, grace time.Duration) (int64, error)
 }
 
+// SignFunc creates bridge in signature for the reserved nonce and returns its deadline.
+type SignFunc func(nonce int64) (deadline time.Time, err error)
+
+// CancelSignFunc creates cancel signature for the reserved nonce.
+type CancelSignFunc func(nonce int64) error
+
 // TokenTransfer describes a transfer between networks.
 type TokenTransfer struct {
 	ID                 int64
@@ -40,4 +65,7 @@ type TokenTransfer struct {
 	SenderAddress      []byte
 	RecipientNetworkID int64
 	RecipientAddress   []byte
+	Nonce              int64
+	Deadline           time.Time
+	CreatedAt          time.Time
 }
@@ -18,10 +18,14 @@ type Bridge interface {
 	Info(ctx context.Context, txHash string) ([]Transfer, error)
 	// History returns paginated list of transfers.
 	History(ctx context.Context, offset, limit uint64, signature, pubKey []byte, networkID uint32) (Page, error)
+	// HistoryByCursor returns filtered list of transfers, which is paginated by cursor.
+	HistoryByCursor(ctx context.Context, req HistoryRequest) (CursorPage, error)
 	// BridgeInSignature returns signature for user to send bridgeIn transaction.
 	BridgeInSignature(ctx context.Context, req BridgeInSignatureRequest) (BridgeInSignatureResponse, error)
 	// CancelSignature returns signature for user to return funds.
 	CancelSignature(context.Context, CancelSignatureRequest) (CancelSignatureResponse, error)
+	// Watch sends transfer to updates each time its status changes, until status becomes final or context is cancelled.
+	Watch(ctx context.Context, req WatchRequest, updates chan<- Transfer) error
 }
 
 // Transfer hold all information about transferring funds from one network to another.
@@ -34,6 +38,8 @@ type Transfer struct {
 	TriggeringTx StringTxHash     `json:"triggeringTx"`
 	OutboundTx   StringTxHash     `json:"outboundTx"`
 	CreatedAt    time.Time        `json:"createdAt"`
+	// Timeline holds status transitions of the transfer from the oldest one, it is returned only by transfer info.
+	Timeline []StatusTransition `json:"timeline,omitempty"`
 }
 
 // Page holds operator page entity which is used to show listed page of operators.
@@ -59,8 +65,33 @@ const (
 	StatusCancelled Status = "CANCELLED"
 	// StatusFinished indicates that transfer is finished.
 	StatusFinished Status = "FINISHED"
+	// StatusExpired indicates that bridge in signature of the waiting transfer has expired before funds were sent.
+	StatusExpired Status = "EXPIRED"
+	// StatusHeld indicates that funds of the transfer were sent, but its bridge out is withheld, since sender or
+	// recipient address was flagged by screening.
+	StatusHeld Status = "HELD"
 )
 
+// Validate validates transfer status.
+func (status Status) Validate() error {
+	switch status {
+	case StatusWaiting, StatusConfirming, StatusCancelled, StatusFinished, StatusExpired, StatusHeld:
+		return nil
+	default:
+		return Error.New("unknown transfer status %q", status)
+	}
+}
+
+// IsFinal reports whether transfer status is not changed anymore.
+func (status Status) IsFinal() bool {
+	switch status {
+	case StatusCancelled, StatusFinished, StatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
 // StringTxHash stores string representation of tx hash.
 type StringTxHash struct {
 	NetworkName string      `json:"networkName,omitempty"`
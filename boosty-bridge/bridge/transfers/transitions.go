@@ -0,0 +1,95 @@
+package transfers
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrIllegalTransition indicates that transfer is not allowed to move to the requested status.
+var ErrIllegalTransition = errs.Class("illegal transfer status transition")
+
+// StatusHistory exposes access to the recorded status transitions of token transfers.
+//
+// architecture: DB
+type StatusHistory interface {
+	// List returns status transitions of the transfer from the oldest one to the newest one.
+	List(ctx context.Context, transferID ID) ([]StatusTransition, error)
+}
+
+// transitions defines statuses which transfer is allowed to move to from each status, final statuses are never left.
+var transitions = map[Status][]Status{
+	// funds of the waiting transfer are sent, or its bridge in signature is cancelled or expires.
+	StatusWaiting: {StatusConfirming, StatusHeld, StatusCancelled, StatusExpired},
+	// confirming transfer is bridged out, or cancelled by operator.
+	StatusConfirming: {StatusFinished, StatusCancelled},
+	// held transfer is released by operator once it passes screening again, or it is cancelled by operator.
+	StatusHeld: {StatusConfirming, StatusCancelled},
+}
+
+// CanMoveTo reports whether transfer is allowed to move from the status to the next one.
+func (status Status) CanMoveTo(next Status) bool {
+	for _, allowed := range transitions[status] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Cause defines what changed status of the transfer.
+type Cause string
+
+const (
+	// CauseCreated indicates that transfer was created with the status.
+	CauseCreated Cause = "CREATED"
+	// CauseFundsIn indicates that funds of the transfer were sent to the bridge.
+	CauseFundsIn Cause = "FUNDS_IN"
+	// CauseScreening indicates that bridge out of the transfer is withheld, since its address was flagged by screening.
+	CauseScreening Cause = "SCREENING"
+	// CauseFundsOut indicates that funds of the transfer were sent to the recipient.
+	CauseFundsOut Cause = "FUNDS_OUT"
+	// CauseCancelSignature indicates that user requested signature to return funds of the transfer.
+	CauseCancelSignature Cause = "CANCEL_SIGNATURE"
+	// CauseExpiration indicates that bridge in signature of the transfer has expired.
+	CauseExpiration Cause = "EXPIRATION"
+	// CauseRelease indicates that operator released held transfer, which passed screening again.
+	CauseRelease Cause = "RELEASE"
+	// CauseIntervention indicates that operator intervened into the transfer, details are in the audit log.
+	CauseIntervention Cause = "INTERVENTION"
+)
+
+// StatusTransition describes change of the transfer status, From is empty for the transition which created transfer.
+type StatusTransition struct {
+	From      Status    `json:"from,omitempty"`
+	To        Status    `json:"to"`
+	Cause     Cause     `json:"cause"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// Validate checks that transfer is allowed to move from status to the next one.
+func (transition StatusTransition) Validate() error {
+	if !transition.From.CanMoveTo(transition.To) {
+		return ErrIllegalTransition.New("transfer is not allowed to move from %s to %s", transition.From, transition.To)
+	}
+
+	return nil
+}
+
+// MoveTo moves token transfer to the next status and returns the transition, which is recorded when transfer is updated.
+func (tokenTransfer *TokenTransfer) MoveTo(next Status, cause Cause) (StatusTransition, error) {
+	transition := StatusTransition{
+		From:      tokenTransfer.Status,
+		To:        next,
+		Cause:     cause,
+		ChangedAt: time.Now().UTC(),
+	}
+	if err := transition.Validate(); err != nil {
+		return transition, err
+	}
+
+	tokenTransfer.Status = next
+	return transition, nil
+}
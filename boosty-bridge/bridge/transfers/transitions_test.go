@@ -0,0 +1,56 @@
+package transfers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/transfers"
+)
+
+func TestCanMoveTo(t *testing.T) {
+	allowed := []struct{ from, to transfers.Status }{
+		{transfers.StatusWaiting, transfers.StatusConfirming},
+		{transfers.StatusWaiting, transfers.StatusHeld},
+		{transfers.StatusWaiting, transfers.StatusCancelled},
+		{transfers.StatusWaiting, transfers.StatusExpired},
+		{transfers.StatusConfirming, transfers.StatusFinished},
+		{transfers.StatusConfirming, transfers.StatusCancelled},
+		{transfers.StatusHeld, transfers.StatusConfirming},
+		{transfers.StatusHeld, transfers.StatusCancelled},
+	}
+	for _, transition := range allowed {
+		assert.True(t, transition.from.CanMoveTo(transition.to), "%s -> %s", transition.from, transition.to)
+	}
+
+	forbidden := []struct{ from, to transfers.Status }{
+		{transfers.StatusWaiting, transfers.StatusFinished},
+		{transfers.StatusConfirming, transfers.StatusWaiting},
+		{transfers.StatusHeld, transfers.StatusFinished},
+		{transfers.StatusFinished, transfers.StatusCancelled},
+		{transfers.StatusCancelled, transfers.StatusWaiting},
+		{transfers.StatusExpired, transfers.StatusConfirming},
+		{transfers.StatusWaiting, transfers.StatusWaiting},
+	}
+	for _, transition := range forbidden {
+		assert.False(t, transition.from.CanMoveTo(transition.to), "%s -> %s", transition.from, transition.to)
+	}
+}
+
+func TestMoveTo(t *testing.T) {
+	tokenTransfer := transfers.TokenTransfer{Status: transfers.StatusWaiting}
+
+	transition, err := tokenTransfer.MoveTo(transfers.StatusConfirming, transfers.CauseFundsIn)
+	require.NoError(t, err)
+	assert.Equal(t, transfers.StatusWaiting, transition.From)
+	assert.Equal(t, transfers.StatusConfirming, transition.To)
+	assert.Equal(t, transfers.CauseFundsIn, transition.Cause)
+	assert.False(t, transition.ChangedAt.IsZero())
+	assert.Equal(t, transfers.StatusConfirming, tokenTransfer.Status)
+
+	_, err = tokenTransfer.MoveTo(transfers.StatusWaiting, transfers.CauseIntervention)
+	require.Error(t, err)
+	assert.True(t, transfers.ErrIllegalTransition.Has(err))
+	assert.Equal(t, transfers.StatusConfirming, tokenTransfer.Status)
+}
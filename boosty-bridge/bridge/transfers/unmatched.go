@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package transfers
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"tricorn/bridge/networks"
+)
+
+// UnmatchedEvents is exposing access to bridge events which could not be bound to any token transfer and wait for manual review.
+//
+// architecture: DB
+type UnmatchedEvents interface {
+	// Create inserts unmatched event to database.
+	Create(ctx context.Context, event UnmatchedEvent) error
+	// List returns unmatched events which are not reviewed yet from database.
+	List(ctx context.Context) ([]UnmatchedEvent, error)
+	// Resolve marks unmatched event as reviewed.
+	Resolve(ctx context.Context, id int64, resolvedAt time.Time) error
+}
+
+// EventKind defines kind of bridge event.
+type EventKind string
+
+const (
+	// EventKindFundsIn indicates that event was emitted by bridge in method.
+	EventKindFundsIn EventKind = "FUNDS_IN"
+	// EventKindFundsOut indicates that event was emitted by bridge out method.
+	EventKindFundsOut EventKind = "FUNDS_OUT"
+)
+
+// UnmatchedEvent describes bridge event that does not belong to any known token transfer.
+type UnmatchedEvent struct {
+	ID        int64
+	Kind      EventKind
+	NetworkID networks.ID
+	TxHash    []byte
+	// Reference is a nonce for funds in events and a triggering transaction id for funds out events.
+	Reference  int64
+	Amount     big.Int
+	Reason     string
+	CreatedAt  time.Time
+	ResolvedAt time.Time
+}
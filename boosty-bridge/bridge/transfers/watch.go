@@ -0,0 +1,74 @@
+package transfers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+var (
+	// ErrInvalidWatchRequest indicates that watched transfer is not specified or resume point is malformed.
+	ErrInvalidWatchRequest = errs.Class("invalid watch request")
+	// ErrTransferNotFound indicates that watched transfer does not exist.
+	ErrTransferNotFound = errs.Class("transfer not found")
+)
+
+// StatusChanges exposes notifications about created token transfers and changes of their statuses.
+//
+// architecture: DB
+type StatusChanges interface {
+	// Listen sends id of token transfer to changes each time it is created or its status is changed, until context is
+	// cancelled. Zero id is sent if notifications could have been missed, so all watched transfers have to be reloaded.
+	Listen(ctx context.Context, changes chan<- ID) error
+}
+
+// WatchRequest describes transfer which status changes are watched. Transfer is identified either by id or by
+// triggering transaction hash.
+type WatchRequest struct {
+	TransferID  ID
+	NetworkName string
+	TxHash      string
+	// LastStatus is a status which client has already received, so current state is sent only if it differs.
+	LastStatus Status
+}
+
+// Validate validates that watched transfer is specified.
+func (req WatchRequest) Validate() error {
+	if req.TransferID == 0 && (req.NetworkName == "" || req.TxHash == "") {
+		return ErrInvalidWatchRequest.New("either transfer id or network name and tx hash are required")
+	}
+
+	if req.LastStatus != "" {
+		if err := req.LastStatus.Validate(); err != nil {
+			return ErrInvalidWatchRequest.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// EventID returns id of the transfer update, which is used by clients to resume watching.
+func (transfer Transfer) EventID() string {
+	return strconv.FormatUint(uint64(transfer.ID), 10) + ":" + string(transfer.Status)
+}
+
+// ParseEventID parses id of the transfer update, which was last received by client.
+func ParseEventID(eventID string) (ID, Status, error) {
+	id, status, ok := strings.Cut(eventID, ":")
+	if !ok {
+		return 0, "", ErrInvalidWatchRequest.New("malformed event id %q", eventID)
+	}
+
+	transferID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, "", ErrInvalidWatchRequest.New("malformed event id %q", eventID)
+	}
+
+	if err = Status(status).Validate(); err != nil {
+		return 0, "", ErrInvalidWatchRequest.Wrap(err)
+	}
+
+	return ID(transferID), Status(status), nil
+}
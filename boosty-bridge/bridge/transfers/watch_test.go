@@ -0,0 +1,35 @@
+package transfers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/transfers"
+)
+
+func TestEventID(t *testing.T) {
+	transfer := transfers.Transfer{ID: 17, Status: transfers.StatusConfirming}
+	assert.Equal(t, "17:CONFIRMING", transfer.EventID())
+
+	id, status, err := transfers.ParseEventID(transfer.EventID())
+	require.NoError(t, err)
+	assert.Equal(t, transfer.ID, id)
+	assert.Equal(t, transfer.Status, status)
+
+	for _, malformed := range []string{"", "17", "x:FINISHED", "17:UNKNOWN"} {
+		_, _, err = transfers.ParseEventID(malformed)
+		require.Error(t, err, malformed)
+		assert.True(t, transfers.ErrInvalidWatchRequest.Has(err))
+	}
+}
+
+func TestWatchRequestValidate(t *testing.T) {
+	assert.NoError(t, transfers.WatchRequest{TransferID: 17}.Validate())
+	assert.NoError(t, transfers.WatchRequest{NetworkName: "GOERLI", TxHash: "0x01", LastStatus: transfers.StatusWaiting}.Validate())
+
+	assert.True(t, transfers.ErrInvalidWatchRequest.Has(transfers.WatchRequest{}.Validate()))
+	assert.True(t, transfers.ErrInvalidWatchRequest.Has(transfers.WatchRequest{TxHash: "0x01"}.Validate()))
+	assert.True(t, transfers.ErrInvalidWatchRequest.Has(transfers.WatchRequest{TransferID: 17, LastStatus: "UNKNOWN"}.Validate()))
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"tricorn/bridge/transfers"
+	"tricorn/internal/logger"
+)
+
+// statusChangesBuffer defines amount of status changes, which are buffered while subscribers are notified.
+const statusChangesBuffer = 64
+
+// TransferWatcher listens for status changes of token transfers and notifies subscribers of changed transfers.
+//
+// architecture: Service
+type TransferWatcher struct {
+	log logger.Logger
+
+	statusChanges transfers.StatusChanges
+
+	mutex       sync.Mutex
+	subscribers map[transfers.ID]map[chan struct{}]struct{}
+}
+
+// NewTransferWatcher is a constructor for TransferWatcher.
+func NewTransferWatcher(log logger.Logger, statusChanges transfers.StatusChanges) *TransferWatcher {
+	return &TransferWatcher{
+		log:           log,
+		statusChanges: statusChanges,
+		subscribers:   make(map[transfers.ID]map[chan struct{}]struct{}),
+	}
+}
+
+// Run listens for status changes and notifies subscribers until context is cancelled.
+func (watcher *TransferWatcher) Run(ctx context.Context) error {
+	changes := make(chan transfers.ID, statusChangesBuffer)
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		return watcher.statusChanges.Listen(ctx, changes)
+	})
+	group.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case id := <-changes:
+				watcher.notify(id)
+			}
+		}
+	})
+
+	return Error.Wrap(group.Wait())
+}
+
+// Subscribe returns channel, which receives value each time status of the transfer may have changed.
+// Notifications are coalesced, so slow subscriber receives single value for many changes.
+// Unsubscribe has to be called when notifications are not needed anymore.
+func (watcher *TransferWatcher) Subscribe(id transfers.ID) (notifications <-chan struct{}, unsubscribe func()) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	subscriber := make(chan struct{}, 1)
+	if watcher.subscribers[id] == nil {
+		watcher.subscribers[id] = make(map[chan struct{}]struct{})
+	}
+	watcher.subscribers[id][subscriber] = struct{}{}
+
+	return subscriber, func() {
+		watcher.mutex.Lock()
+		defer watcher.mutex.Unlock()
+
+		delete(watcher.subscribers[id], subscriber)
+		if len(watcher.subscribers[id]) == 0 {
+			delete(watcher.subscribers, id)
+		}
+	}
+}
+
+// notify notifies subscribers of the transfer, zero id notifies subscribers of all transfers.
+func (watcher *TransferWatcher) notify(id transfers.ID) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	if id == 0 {
+		watcher.log.Debug("transfer status changes could have been missed, notifying all watchers")
+		for _, subscribers := range watcher.subscribers {
+			notifySubscribers(subscribers)
+		}
+
+		return
+	}
+
+	notifySubscribers(watcher.subscribers[id])
+}
+
+// notifySubscribers sends notification to subscribers, which have not received previous one yet.
+func notifySubscribers(subscribers map[chan struct{}]struct{}) {
+	for subscriber := range subscribers {
+		select {
+		case subscriber <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge"
+	"tricorn/bridge/transfers"
+	"tricorn/internal/logger/zaplog"
+)
+
+// statusChangesMock sends status changes, which are pushed by test.
+type statusChangesMock struct {
+	changes chan transfers.ID
+}
+
+// Listen sends pushed status changes until context is cancelled.
+func (mock *statusChangesMock) Listen(ctx context.Context, changes chan<- transfers.ID) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case id := <-mock.changes:
+			changes <- id
+		}
+	}
+}
+
+func TestTransferWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	statusChanges := &statusChangesMock{changes: make(chan transfers.ID)}
+	watcher := bridge.NewTransferWatcher(zaplog.NewLog(), statusChanges)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	first, unsubscribeFirst := watcher.Subscribe(1)
+	second, unsubscribeSecond := watcher.Subscribe(2)
+	defer unsubscribeSecond()
+
+	notified := func(notifications <-chan struct{}) bool {
+		select {
+		case <-notifications:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}
+
+	statusChanges.changes <- 1
+	require.True(t, notified(first))
+	require.False(t, notified(second))
+
+	// zero id notifies all subscribers as changes could have been missed.
+	statusChanges.changes <- 0
+	require.True(t, notified(first))
+	require.True(t, notified(second))
+
+	unsubscribeFirst()
+	statusChanges.changes <- 1
+	require.False(t, notified(first))
+
+	cancel()
+	require.NoError(t, <-done)
+}
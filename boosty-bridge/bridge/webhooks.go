@@ -0,0 +1,123 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package bridge
+
+import (
+	"context"
+	"time"
+
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+)
+
+// maxWebhookDeliveriesLimit defines maximal amount of listed webhook deliveries, it is used if limit is zero as well.
+const maxWebhookDeliveriesLimit = 100
+
+// ensures that Service provides transfers to webhooks dispatcher.
+var _ webhooks.Transfers = (*Service)(nil)
+
+// CreateWebhookEndpoint registers endpoint of the integrator with generated signing secret.
+func (service *Service) CreateWebhookEndpoint(ctx context.Context, req webhooks.CreateEndpointRequest) (webhooks.Endpoint, error) {
+	if err := req.Validate(); err != nil {
+		return webhooks.Endpoint{}, Error.Wrap(err)
+	}
+
+	secret, err := webhooks.GenerateSecret()
+	if err != nil {
+		return webhooks.Endpoint{}, Error.Wrap(err)
+	}
+
+	endpoint := webhooks.Endpoint{
+		Owner:     req.Owner,
+		URL:       req.URL,
+		Secret:    secret,
+		Statuses:  req.Statuses,
+		CreatedAt: time.Now().UTC(),
+	}
+	if endpoint.Statuses == nil {
+		endpoint.Statuses = make([]transfers.Status, 0)
+	}
+
+	endpoint.ID, err = service.webhookEndpoints.Create(ctx, endpoint)
+	return endpoint, Error.Wrap(err)
+}
+
+// ListWebhookEndpoints returns endpoints of the integrator without their secrets.
+func (service *Service) ListWebhookEndpoints(ctx context.Context, owner string) ([]webhooks.Endpoint, error) {
+	endpoints, err := service.webhookEndpoints.List(ctx, owner)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for i := range endpoints {
+		endpoints[i].Secret = ""
+	}
+
+	return endpoints, nil
+}
+
+// DeleteWebhookEndpoint deletes endpoint of the integrator together with its deliveries.
+func (service *Service) DeleteWebhookEndpoint(ctx context.Context, owner string, id webhooks.EndpointID) error {
+	return Error.Wrap(service.webhookEndpoints.Delete(ctx, owner, id))
+}
+
+// ListWebhookDeliveries returns the newest deliveries of the integrator endpoint.
+func (service *Service) ListWebhookDeliveries(ctx context.Context, req webhooks.DeliveriesRequest) ([]webhooks.Delivery, error) {
+	if req.State != "" {
+		if err := req.State.Validate(); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	if _, err := service.ownedWebhookEndpoint(ctx, req.Owner, req.EndpointID); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxWebhookDeliveriesLimit {
+		limit = maxWebhookDeliveriesLimit
+	}
+
+	deliveries, err := service.webhookOutbox.List(ctx, req.EndpointID, req.State, int(limit))
+	return deliveries, Error.Wrap(err)
+}
+
+// ReplayWebhookDeliveries schedules delivery of the integrator endpoint, or all its dead deliveries if delivery is
+// not specified, to be sent again and returns amount of replayed deliveries.
+func (service *Service) ReplayWebhookDeliveries(ctx context.Context, req webhooks.ReplayRequest) (uint64, error) {
+	if _, err := service.ownedWebhookEndpoint(ctx, req.Owner, req.EndpointID); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	replayed, err := service.webhookOutbox.Replay(ctx, req.EndpointID, req.DeliveryID, time.Now().UTC())
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	if replayed == 0 && req.DeliveryID != 0 {
+		return 0, Error.Wrap(webhooks.ErrDeliveryNotFound.New("%d", req.DeliveryID))
+	}
+
+	return uint64(replayed), nil
+}
+
+// FormatTransfer returns transfer of the snapshot the way it is shown to users.
+func (service *Service) FormatTransfer(snapshot webhooks.TransferSnapshot) transfers.Transfer {
+	return formatTransfer(snapshot.TokenTransfer, snapshot.TriggeringTx, snapshot.OutboundTx)
+}
+
+// ownedWebhookEndpoint returns endpoint if it belongs to the integrator, endpoints of other integrators are reported
+// as not found.
+func (service *Service) ownedWebhookEndpoint(ctx context.Context, owner string, id webhooks.EndpointID) (webhooks.Endpoint, error) {
+	endpoint, err := service.webhookEndpoints.Get(ctx, id)
+	if err != nil {
+		return webhooks.Endpoint{}, err
+	}
+
+	if endpoint.Owner != owner {
+		return webhooks.Endpoint{}, webhooks.ErrEndpointNotFound.New("%d", id)
+	}
+
+	return endpoint, nil
+}
@@ -0,0 +1,250 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transfers"
+	"tricorn/internal/logger"
+)
+
+// ErrDispatcher indicates that there was an error in the webhooks dispatcher.
+var ErrDispatcher = errs.Class("webhooks dispatcher")
+
+// maxResponseLength defines how much of the endpoint response is read and kept as delivery error.
+const maxResponseLength = 512
+
+// DispatcherConfig defines how often and how persistently deliveries are sent.
+type DispatcherConfig struct {
+	// Interval defines how often outbox is checked for due deliveries.
+	Interval time.Duration
+	// RequestTimeout defines how long endpoint may take to accept delivery.
+	RequestTimeout time.Duration
+	// MaxAttempts defines amount of failed attempts after which delivery is moved to dead letters.
+	MaxAttempts uint32
+	// MinRetryDelay defines delay after the first failed attempt, it is doubled after each next one.
+	MinRetryDelay time.Duration
+	// MaxRetryDelay defines maximal delay between attempts.
+	MaxRetryDelay time.Duration
+	// BatchSize defines amount of deliveries, which are sent concurrently.
+	BatchSize int
+	// AllowPrivateAddresses allows deliveries to loopback, private and link-local addresses, e.g. in tests.
+	AllowPrivateAddresses bool
+}
+
+// Dispatcher sends due deliveries from the outbox to integrators' endpoints, retries failed ones with exponential
+// backoff and moves them to dead letters when attempts are exhausted.
+//
+// architecture: Chore
+type Dispatcher struct {
+	log    logger.Logger
+	config DispatcherConfig
+	client *http.Client
+
+	endpoints Endpoints
+	outbox    Outbox
+	transfers Transfers
+}
+
+// NewDispatcher is a constructor for webhooks dispatcher.
+func NewDispatcher(log logger.Logger, config DispatcherConfig, endpoints Endpoints, outbox Outbox, transfers Transfers) *Dispatcher {
+	// address is checked after it is resolved, so endpoint host can not be pointed to internal services later.
+	dialer := &net.Dialer{
+		Control: func(network, address string, _ syscall.RawConn) error {
+			if config.AllowPrivateAddresses {
+				return nil
+			}
+
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return ErrForbiddenAddress.New("%s", host)
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// deliveries are not sent through proxy, which would connect to the address instead of dispatcher.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Dispatcher{
+		log:    log,
+		config: config,
+		client: &http.Client{
+			Transport: transport,
+			// redirects are not followed, endpoint has to accept delivery by itself.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		endpoints: endpoints,
+		outbox:    outbox,
+		transfers: transfers,
+	}
+}
+
+// Run sends due deliveries every interval until context is cancelled.
+func (dispatcher *Dispatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(dispatcher.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		// outbox is drained until there are no due deliveries left.
+		for {
+			sent, err := dispatcher.SendDue(ctx)
+			if err != nil {
+				dispatcher.log.Error("couldn't send webhook deliveries", ErrDispatcher.Wrap(err))
+				break
+			}
+
+			if sent < dispatcher.config.BatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+	}
+}
+
+// SendDue claims single batch of due deliveries, sends them concurrently and returns amount of claimed deliveries.
+func (dispatcher *Dispatcher) SendDue(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	// lease covers loading of the endpoint and the request itself.
+	leaseUntil := now.Add(2 * dispatcher.config.RequestTimeout)
+
+	deliveries, err := dispatcher.outbox.Claim(ctx, now, leaseUntil, dispatcher.config.BatchSize)
+	if err != nil {
+		return 0, ErrDispatcher.Wrap(err)
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery Delivery) {
+			defer wg.Done()
+			dispatcher.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// deliver sends delivery and records the result of the attempt.
+func (dispatcher *Dispatcher) deliver(ctx context.Context, delivery Delivery) {
+	sendErr := dispatcher.send(ctx, delivery)
+	if ctx.Err() != nil {
+		// delivery is claimed again when lease expires.
+		return
+	}
+
+	var err error
+	attempts := delivery.Attempts + 1
+	switch {
+	case sendErr == nil:
+		err = dispatcher.outbox.MarkDelivered(ctx, delivery.ID, time.Now().UTC())
+	case attempts >= dispatcher.config.MaxAttempts:
+		dispatcher.log.Error(fmt.Sprintf("webhook delivery %d is dead after %d attempts", delivery.ID, attempts), sendErr)
+		err = dispatcher.outbox.MarkDead(ctx, delivery.ID, sendErr.Error())
+	default:
+		dispatcher.log.Warn(fmt.Sprintf("webhook delivery %d attempt %d failed: %v", delivery.ID, attempts, sendErr))
+		nextAttemptAt := time.Now().UTC().Add(dispatcher.retryDelay(attempts))
+		err = dispatcher.outbox.MarkRetry(ctx, delivery.ID, nextAttemptAt, sendErr.Error())
+	}
+	if err != nil {
+		dispatcher.log.Error(fmt.Sprintf("couldn't record webhook delivery %d attempt", delivery.ID), ErrDispatcher.Wrap(err))
+	}
+}
+
+// send posts signed event of the delivery to its endpoint.
+func (dispatcher *Dispatcher) send(ctx context.Context, delivery Delivery) error {
+	endpoint, err := dispatcher.endpoints.Get(ctx, delivery.EndpointID)
+	if err != nil {
+		return err
+	}
+
+	var transfer *transfers.Transfer
+	if delivery.Transfer != nil {
+		formatted := dispatcher.transfers.FormatTransfer(*delivery.Transfer)
+		transfer = &formatted
+	}
+
+	body, err := json.Marshal(Event{
+		ID:             delivery.ID,
+		Event:          delivery.Event(),
+		TransferID:     delivery.TransferID,
+		Status:         delivery.Status,
+		PreviousStatus: delivery.PreviousStatus,
+		CreatedAt:      delivery.CreatedAt,
+		Transfer:       transfer,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dispatcher.config.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event())
+	req.Header.Set(DeliveryHeader, fmt.Sprint(delivery.ID))
+	req.Header.Set(SignatureHeader, Sign(endpoint.Secret, time.Now(), body))
+
+	resp, err := dispatcher.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	response, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseLength))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errs.New("endpoint responded with %d: %s", resp.StatusCode, strings.TrimSpace(string(response)))
+	}
+
+	return nil
+}
+
+// retryDelay returns delay before the next attempt, which doubles after each failed one.
+func (dispatcher *Dispatcher) retryDelay(attempts uint32) time.Duration {
+	delay := dispatcher.config.MinRetryDelay
+	for i := uint32(1); i < attempts && delay < dispatcher.config.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > dispatcher.config.MaxRetryDelay {
+		delay = dispatcher.config.MaxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,398 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+	"tricorn/internal/logger/zaplog"
+)
+
+func TestDispatcher(t *testing.T) {
+	ctx := context.Background()
+
+	receiver := &receiver{statusCode: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	secret, err := webhooks.GenerateSecret()
+	require.NoError(t, err)
+
+	endpoints := &endpointsMock{endpoint: webhooks.Endpoint{ID: 1, Owner: "integrator", URL: server.URL, Secret: secret}}
+	outbox := &outboxMock{}
+	transfersMock := &transfersMock{}
+
+	config := webhooks.DispatcherConfig{
+		Interval:       time.Second,
+		RequestTimeout: 5 * time.Second,
+		MaxAttempts:    3,
+		MinRetryDelay:  time.Minute,
+		MaxRetryDelay:  time.Hour,
+		BatchSize:      10,
+	}
+	// receiver listens on loopback address.
+	config.AllowPrivateAddresses = true
+	dispatcher := webhooks.NewDispatcher(zaplog.NewLog(), config, endpoints, outbox, transfersMock)
+
+	t.Run("delivered", func(t *testing.T) {
+		outbox.enqueue(17, transfers.StatusFinished, transfers.StatusConfirming)
+
+		sent, err := dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+
+		requests := receiver.takeRequests()
+		require.Len(t, requests, 1)
+		assert.Equal(t, "transfer.finished", requests[0].header.Get(webhooks.EventHeader))
+		assert.Equal(t, "1", requests[0].header.Get(webhooks.DeliveryHeader))
+		assert.Equal(t, "application/json", requests[0].header.Get("Content-Type"))
+		require.NoError(t, webhooks.Verify(secret, requests[0].header.Get(webhooks.SignatureHeader), requests[0].body, time.Now(), time.Minute))
+
+		var event webhooks.Event
+		require.NoError(t, json.Unmarshal(requests[0].body, &event))
+		assert.Equal(t, webhooks.DeliveryID(1), event.ID)
+		assert.Equal(t, "transfer.finished", event.Event)
+		assert.Equal(t, transfers.ID(17), event.TransferID)
+		assert.Equal(t, transfers.StatusFinished, event.Status)
+		assert.Equal(t, transfers.StatusConfirming, event.PreviousStatus)
+		require.NotNil(t, event.Transfer)
+		assert.Equal(t, "1000", event.Transfer.Amount.String())
+		// transfer is sent as it was at the status change of the event.
+		assert.Equal(t, transfers.StatusFinished, event.Transfer.Status)
+
+		delivery := outbox.delivery(1)
+		assert.Equal(t, webhooks.DeliveryStateDelivered, delivery.State)
+		assert.EqualValues(t, 1, delivery.Attempts)
+		assert.NotNil(t, delivery.DeliveredAt)
+
+		sent, err = dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("retried and dead", func(t *testing.T) {
+		receiver.setStatusCode(http.StatusInternalServerError)
+		outbox.enqueue(18, transfers.StatusConfirming, "")
+
+		startedAt := time.Now().UTC()
+		sent, err := dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+
+		delivery := outbox.delivery(2)
+		assert.Equal(t, webhooks.DeliveryStatePending, delivery.State)
+		assert.EqualValues(t, 1, delivery.Attempts)
+		assert.Contains(t, delivery.LastError, "500")
+		assert.WithinDuration(t, startedAt.Add(config.MinRetryDelay), delivery.NextAttemptAt, 5*time.Second)
+
+		// delivery is not sent again until retry delay passes.
+		sent, err = dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, sent)
+
+		outbox.makeDue(2)
+		_, err = dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+
+		delivery = outbox.delivery(2)
+		assert.Equal(t, webhooks.DeliveryStatePending, delivery.State)
+		assert.WithinDuration(t, time.Now().UTC().Add(2*config.MinRetryDelay), delivery.NextAttemptAt, 5*time.Second)
+
+		outbox.makeDue(2)
+		_, err = dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+
+		delivery = outbox.delivery(2)
+		assert.Equal(t, webhooks.DeliveryStateDead, delivery.State)
+		assert.EqualValues(t, config.MaxAttempts, delivery.Attempts)
+		assert.Len(t, receiver.takeRequests(), int(config.MaxAttempts))
+
+		outbox.makeDue(2)
+		sent, err = dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("replayed", func(t *testing.T) {
+		receiver.setStatusCode(http.StatusNoContent)
+
+		replayed, err := outbox.Replay(ctx, 1, 0, time.Now().UTC())
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, replayed)
+
+		sent, err := dispatcher.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+
+		requests := receiver.takeRequests()
+		require.Len(t, requests, 1)
+		assert.Equal(t, "2", requests[0].header.Get(webhooks.DeliveryHeader))
+		assert.Equal(t, "transfer.confirming", requests[0].header.Get(webhooks.EventHeader))
+
+		delivery := outbox.delivery(2)
+		assert.Equal(t, webhooks.DeliveryStateDelivered, delivery.State)
+		assert.EqualValues(t, 1, delivery.Attempts)
+		assert.Empty(t, delivery.LastError)
+	})
+}
+
+func TestDispatcherForbiddenAddress(t *testing.T) {
+	ctx := context.Background()
+
+	receiver := &receiver{statusCode: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	endpoints := &endpointsMock{endpoint: webhooks.Endpoint{ID: 1, Owner: "integrator", URL: server.URL, Secret: "secret"}}
+	outbox := &outboxMock{}
+
+	config := webhooks.DispatcherConfig{
+		Interval:       time.Second,
+		RequestTimeout: 5 * time.Second,
+		MaxAttempts:    3,
+		MinRetryDelay:  time.Minute,
+		MaxRetryDelay:  time.Hour,
+		BatchSize:      10,
+	}
+	dispatcher := webhooks.NewDispatcher(zaplog.NewLog(), config, endpoints, outbox, &transfersMock{})
+
+	outbox.enqueue(17, transfers.StatusFinished, transfers.StatusConfirming)
+	sent, err := dispatcher.SendDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	// endpoint host may resolve to internal address after it was registered.
+	delivery := outbox.delivery(1)
+	assert.Equal(t, webhooks.DeliveryStatePending, delivery.State)
+	assert.Contains(t, delivery.LastError, "forbidden webhook address")
+	assert.Empty(t, receiver.takeRequests())
+}
+
+// receivedRequest is a webhook request received by the receiver.
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver is a local endpoint of the integrator, which records received requests.
+type receiver struct {
+	mu         sync.Mutex
+	statusCode int
+	requests   []receivedRequest
+}
+
+// ServeHTTP records request and responds with configured status code.
+func (receiver *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	receiver.requests = append(receiver.requests, receivedRequest{header: r.Header.Clone(), body: body})
+	w.WriteHeader(receiver.statusCode)
+}
+
+// setStatusCode sets status code of the next responses.
+func (receiver *receiver) setStatusCode(statusCode int) {
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+	receiver.statusCode = statusCode
+}
+
+// takeRequests returns received requests and forgets them.
+func (receiver *receiver) takeRequests() []receivedRequest {
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	requests := receiver.requests
+	receiver.requests = nil
+	return requests
+}
+
+// endpointsMock is an in-memory webhooks.Endpoints with single endpoint.
+type endpointsMock struct {
+	endpoint webhooks.Endpoint
+}
+
+// Create is not used by dispatcher.
+func (endpointsMock *endpointsMock) Create(ctx context.Context, endpoint webhooks.Endpoint) (webhooks.EndpointID, error) {
+	return endpointsMock.endpoint.ID, nil
+}
+
+// Get returns endpoint by id.
+func (endpointsMock *endpointsMock) Get(ctx context.Context, id webhooks.EndpointID) (webhooks.Endpoint, error) {
+	if id != endpointsMock.endpoint.ID {
+		return webhooks.Endpoint{}, webhooks.ErrEndpointNotFound.New("%d", id)
+	}
+
+	return endpointsMock.endpoint, nil
+}
+
+// List is not used by dispatcher.
+func (endpointsMock *endpointsMock) List(ctx context.Context, owner string) ([]webhooks.Endpoint, error) {
+	return []webhooks.Endpoint{endpointsMock.endpoint}, nil
+}
+
+// Delete is not used by dispatcher.
+func (endpointsMock *endpointsMock) Delete(ctx context.Context, owner string, id webhooks.EndpointID) error {
+	return nil
+}
+
+// outboxMock is an in-memory webhooks.Outbox of the single endpoint.
+type outboxMock struct {
+	mu         sync.Mutex
+	deliveries []webhooks.Delivery
+}
+
+// enqueue adds pending delivery as the database trigger does.
+func (outboxMock *outboxMock) enqueue(transferID transfers.ID, status, previousStatus transfers.Status) {
+	outboxMock.mu.Lock()
+	defer outboxMock.mu.Unlock()
+
+	now := time.Now().UTC()
+	outboxMock.deliveries = append(outboxMock.deliveries, webhooks.Delivery{
+		ID:             webhooks.DeliveryID(len(outboxMock.deliveries) + 1),
+		EndpointID:     1,
+		TransferID:     transferID,
+		Status:         status,
+		PreviousStatus: previousStatus,
+		State:          webhooks.DeliveryStatePending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		Transfer: &webhooks.TransferSnapshot{
+			TokenTransfer: transfers.TokenTransfer{ID: int64(transferID), Amount: *big.NewInt(1000), Status: status},
+		},
+	})
+}
+
+// delivery returns delivery by id.
+func (outboxMock *outboxMock) delivery(id webhooks.DeliveryID) webhooks.Delivery {
+	outboxMock.mu.Lock()
+	defer outboxMock.mu.Unlock()
+	return outboxMock.deliveries[id-1]
+}
+
+// makeDue moves next attempt of the delivery to the past.
+func (outboxMock *outboxMock) makeDue(id webhooks.DeliveryID) {
+	outboxMock.mu.Lock()
+	defer outboxMock.mu.Unlock()
+	outboxMock.deliveries[id-1].NextAttemptAt = time.Now().UTC().Add(-time.Second)
+}
+
+// Claim returns due pending deliveries and postpones them until leaseUntil.
+func (outboxMock *outboxMock) Claim(ctx context.Context, now, leaseUntil time.Time, limit int) ([]webhooks.Delivery, error) {
+	outboxMock.mu.Lock()
+	defer outboxMock.mu.Unlock()
+
+	var claimed []webhooks.Delivery
+	for i, delivery := range outboxMock.deliveries {
+		if len(claimed) == limit {
+			break
+		}
+
+		if delivery.State == webhooks.DeliveryStatePending && !delivery.NextAttemptAt.After(now) {
+			outboxMock.deliveries[i].NextAttemptAt = leaseUntil
+			claimed = append(claimed, delivery)
+		}
+	}
+
+	return claimed, nil
+}
+
+// MarkDelivered records successful attempt of the delivery.
+func (outboxMock *outboxMock) MarkDelivered(ctx context.Context, id webhooks.DeliveryID, deliveredAt time.Time) error {
+	return outboxMock.update(id, func(delivery *webhooks.Delivery) {
+		delivery.State = webhooks.DeliveryStateDelivered
+		delivery.Attempts++
+		delivery.LastError = ""
+		delivery.DeliveredAt = &deliveredAt
+	})
+}
+
+// MarkRetry records failed attempt of the delivery.
+func (outboxMock *outboxMock) MarkRetry(ctx context.Context, id webhooks.DeliveryID, nextAttemptAt time.Time, lastError string) error {
+	return outboxMock.update(id, func(delivery *webhooks.Delivery) {
+		delivery.Attempts++
+		delivery.NextAttemptAt = nextAttemptAt
+		delivery.LastError = lastError
+	})
+}
+
+// MarkDead records failed attempt of the delivery and moves it to dead letters.
+func (outboxMock *outboxMock) MarkDead(ctx context.Context, id webhooks.DeliveryID, lastError string) error {
+	return outboxMock.update(id, func(delivery *webhooks.Delivery) {
+		delivery.State = webhooks.DeliveryStateDead
+		delivery.Attempts++
+		delivery.LastError = lastError
+	})
+}
+
+// List is not used by dispatcher.
+func (outboxMock *outboxMock) List(ctx context.Context, endpointID webhooks.EndpointID, state webhooks.DeliveryState, limit int) ([]webhooks.Delivery, error) {
+	return nil, nil
+}
+
+// Replay makes delivery pending again, all dead deliveries are replayed if delivery id is zero.
+func (outboxMock *outboxMock) Replay(ctx context.Context, endpointID webhooks.EndpointID, deliveryID webhooks.DeliveryID, now time.Time) (int64, error) {
+	outboxMock.mu.Lock()
+	defer outboxMock.mu.Unlock()
+
+	var replayed int64
+	for i, delivery := range outboxMock.deliveries {
+		if delivery.EndpointID != endpointID {
+			continue
+		}
+
+		if delivery.ID == deliveryID || (deliveryID == 0 && delivery.State == webhooks.DeliveryStateDead) {
+			outboxMock.deliveries[i].State = webhooks.DeliveryStatePending
+			outboxMock.deliveries[i].Attempts = 0
+			outboxMock.deliveries[i].NextAttemptAt = now
+			outboxMock.deliveries[i].LastError = ""
+			outboxMock.deliveries[i].DeliveredAt = nil
+			replayed++
+		}
+	}
+
+	return replayed, nil
+}
+
+// update applies change to the delivery.
+func (outboxMock *outboxMock) update(id webhooks.DeliveryID, change func(delivery *webhooks.Delivery)) error {
+	outboxMock.mu.Lock()
+	defer outboxMock.mu.Unlock()
+
+	if int(id) > len(outboxMock.deliveries) {
+		return webhooks.ErrDeliveryNotFound.New("%d", id)
+	}
+
+	change(&outboxMock.deliveries[id-1])
+	return nil
+}
+
+// transfersMock formats transfers of the snapshots.
+type transfersMock struct{}
+
+// FormatTransfer returns transfer of the snapshot.
+func (transfersMock *transfersMock) FormatTransfer(snapshot webhooks.TransferSnapshot) transfers.Transfer {
+	return transfers.Transfer{
+		ID:     transfers.ID(snapshot.TokenTransfer.ID),
+		Amount: snapshot.TokenTransfer.Amount,
+		Sender: networks.Address{
+			NetworkName: "GOERLI",
+			Address:     "0xB7F14E1C560Fc97b08F1327329D59F6db5FD2009",
+		},
+		Status: snapshot.TokenTransfer.Status,
+	}
+}
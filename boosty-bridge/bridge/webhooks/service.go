@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+)
+
+// Error that error was from webhooks service.
+var Error = errs.Class("webhooks service")
+
+// Service contains webhooks specific business rules.
+//
+// architecture: Service
+type Service struct {
+	bridge Bridge
+}
+
+// NewService is a constructor for webhooks service.
+func NewService(bridge Bridge) *Service {
+	return &Service{
+		bridge: bridge,
+	}
+}
+
+// CreateEndpoint registers endpoint of the integrator. Generated signing secret is returned only by this method.
+func (service *Service) CreateEndpoint(ctx context.Context, req CreateEndpointRequest) (Endpoint, error) {
+	if err := req.Validate(); err != nil {
+		return Endpoint{}, Error.Wrap(err)
+	}
+
+	endpoint, err := service.bridge.CreateEndpoint(ctx, req)
+	return endpoint, Error.Wrap(err)
+}
+
+// ListEndpoints returns endpoints of the integrator.
+func (service *Service) ListEndpoints(ctx context.Context, owner string) ([]Endpoint, error) {
+	endpoints, err := service.bridge.ListEndpoints(ctx, owner)
+	return endpoints, Error.Wrap(err)
+}
+
+// DeleteEndpoint deletes endpoint of the integrator together with its deliveries.
+func (service *Service) DeleteEndpoint(ctx context.Context, owner string, id EndpointID) error {
+	return Error.Wrap(service.bridge.DeleteEndpoint(ctx, owner, id))
+}
+
+// ListDeliveries returns the newest deliveries of the integrator endpoint.
+func (service *Service) ListDeliveries(ctx context.Context, req DeliveriesRequest) ([]Delivery, error) {
+	if req.State != "" {
+		if err := req.State.Validate(); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	deliveries, err := service.bridge.ListDeliveries(ctx, req)
+	return deliveries, Error.Wrap(err)
+}
+
+// ReplayDeliveries schedules deliveries of the integrator endpoint to be sent again and returns their amount.
+func (service *Service) ReplayDeliveries(ctx context.Context, req ReplayRequest) (uint64, error) {
+	replayed, err := service.bridge.ReplayDeliveries(ctx, req)
+	return replayed, Error.Wrap(err)
+}
@@ -0,0 +1,89 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrInvalidSignature indicates that webhook signature header is malformed, outdated or does not match the body.
+var ErrInvalidSignature = errs.Class("invalid webhook signature")
+
+const (
+	// SignatureHeader defines header with timestamp and HMAC-SHA256 signature of the webhook request,
+	// formatted as "t=<unix seconds>,v1=<hex signature>".
+	SignatureHeader = "X-Tricorn-Signature"
+	// EventHeader defines header with type of the delivered event.
+	EventHeader = "X-Tricorn-Event"
+	// DeliveryHeader defines header with id of the delivery, which is kept on retries and replays.
+	DeliveryHeader = "X-Tricorn-Delivery"
+
+	// secretLength defines amount of random bytes in generated signing secret.
+	secretLength = 32
+)
+
+// GenerateSecret returns random hex encoded signing secret.
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// Sign returns value of the signature header for the body sent at timestamp. Timestamp is signed together with the
+// body, so receivers can reject replayed requests.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(signature(secret, unix, body))
+}
+
+// Verify verifies value of the signature header against the body, signature older than tolerance is rejected.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var unix, signed string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			signed = value
+		}
+	}
+
+	timestamp, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature.New("malformed timestamp")
+	}
+
+	decoded, err := hex.DecodeString(signed)
+	if err != nil {
+		return ErrInvalidSignature.New("malformed signature")
+	}
+
+	if now.Sub(time.Unix(timestamp, 0)) > tolerance {
+		return ErrInvalidSignature.New("signature is outdated")
+	}
+
+	if !hmac.Equal(decoded, signature(secret, unix, body)) {
+		return ErrInvalidSignature.New("signature mismatch")
+	}
+
+	return nil
+}
+
+// signature returns HMAC-SHA256 of the timestamp and body joined by dot.
+func signature(secret, unix string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,51 @@
+package webhooks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/webhooks"
+)
+
+func TestSignature(t *testing.T) {
+	secret, err := webhooks.GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 64)
+
+	body := []byte(`{"id":1,"event":"transfer.finished"}`)
+	signedAt := time.Unix(1666000000, 0)
+	header := webhooks.Sign(secret, signedAt, body)
+	assert.Regexp(t, `^t=1666000000,v1=[0-9a-f]{64}$`, header)
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, webhooks.Verify(secret, header, body, signedAt.Add(time.Minute), 5*time.Minute))
+	})
+
+	t.Run("tampered body", func(t *testing.T) {
+		err := webhooks.Verify(secret, header, []byte(`{"id":2,"event":"transfer.finished"}`), signedAt, 5*time.Minute)
+		assert.True(t, webhooks.ErrInvalidSignature.Has(err))
+	})
+
+	t.Run("other secret", func(t *testing.T) {
+		otherSecret, err := webhooks.GenerateSecret()
+		require.NoError(t, err)
+
+		err = webhooks.Verify(otherSecret, header, body, signedAt, 5*time.Minute)
+		assert.True(t, webhooks.ErrInvalidSignature.Has(err))
+	})
+
+	t.Run("outdated", func(t *testing.T) {
+		err := webhooks.Verify(secret, header, body, signedAt.Add(time.Hour), 5*time.Minute)
+		assert.True(t, webhooks.ErrInvalidSignature.Has(err))
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		for _, malformed := range []string{"", "t=1666000000", "v1=00", "t=x,v1=00", "t=1666000000,v1=zz"} {
+			err := webhooks.Verify(secret, malformed, body, signedAt, 5*time.Minute)
+			assert.True(t, webhooks.ErrInvalidSignature.Has(err), malformed)
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package webhooks
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/transactions"
+	"tricorn/bridge/transfers"
+)
+
+var (
+	// ErrInvalidEndpoint indicates that registered endpoint or request parameters are malformed.
+	ErrInvalidEndpoint = errs.Class("invalid webhook endpoint")
+	// ErrEndpointNotFound indicates that endpoint does not exist or belongs to other integrator.
+	ErrEndpointNotFound = errs.Class("webhook endpoint not found")
+	// ErrDeliveryNotFound indicates that delivery does not exist or belongs to other endpoint.
+	ErrDeliveryNotFound = errs.Class("webhook delivery not found")
+	// ErrForbiddenAddress indicates that endpoint points to loopback, private or link-local address, so deliveries
+	// could reach internal services of the bridge.
+	ErrForbiddenAddress = errs.Class("forbidden webhook address")
+)
+
+// sharedAddressSpace is a carrier-grade NAT range, which is not routable in public internet as private ranges are.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// eventPrefix defines prefix of the event type, which is followed by lowercase status of the transfer.
+const eventPrefix = "transfer."
+
+// Bridge exposes access to the bridge back-end methods related to webhooks.
+type Bridge interface {
+	// CreateEndpoint registers endpoint of the integrator. Generated signing secret is returned only by this method.
+	CreateEndpoint(ctx context.Context, req CreateEndpointRequest) (Endpoint, error)
+	// ListEndpoints returns endpoints of the integrator.
+	ListEndpoints(ctx context.Context, owner string) ([]Endpoint, error)
+	// DeleteEndpoint deletes endpoint of the integrator together with its deliveries.
+	DeleteEndpoint(ctx context.Context, owner string, id EndpointID) error
+	// ListDeliveries returns the newest deliveries of the integrator endpoint.
+	ListDeliveries(ctx context.Context, req DeliveriesRequest) ([]Delivery, error)
+	// ReplayDeliveries schedules deliveries of the integrator endpoint to be sent again and returns their amount.
+	ReplayDeliveries(ctx context.Context, req ReplayRequest) (uint64, error)
+}
+
+// Endpoints exposes access to the webhook endpoints db.
+//
+// architecture: DB
+type Endpoints interface {
+	// Create inserts endpoint to the database and returns its id.
+	Create(ctx context.Context, endpoint Endpoint) (EndpointID, error)
+	// Get returns endpoint by id from the database.
+	Get(ctx context.Context, id EndpointID) (Endpoint, error)
+	// List returns endpoints of the integrator from the database.
+	List(ctx context.Context, owner string) ([]Endpoint, error)
+	// Delete deletes endpoint of the integrator from the database.
+	Delete(ctx context.Context, owner string, id EndpointID) error
+}
+
+// Outbox exposes access to the webhook deliveries db. Deliveries are enqueued by the database itself in the same
+// transaction which creates token transfer or changes its status.
+//
+// architecture: DB
+type Outbox interface {
+	// Claim returns up to limit pending deliveries which are due at now and postpones them until leaseUntil, so they
+	// are not claimed again while being sent. Delivery is not claimed until earlier pending delivery of the same
+	// transfer to the same endpoint is sent.
+	Claim(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Delivery, error)
+	// MarkDelivered records successful attempt of the delivery.
+	MarkDelivered(ctx context.Context, id DeliveryID, deliveredAt time.Time) error
+	// MarkRetry records failed attempt of the delivery, which is retried at nextAttemptAt.
+	MarkRetry(ctx context.Context, id DeliveryID, nextAttemptAt time.Time, lastError string) error
+	// MarkDead records failed attempt of the delivery and moves it to dead letters.
+	MarkDead(ctx context.Context, id DeliveryID, lastError string) error
+	// List returns the newest deliveries of the endpoint, all states are returned if state is empty.
+	List(ctx context.Context, endpointID EndpointID, state DeliveryState, limit int) ([]Delivery, error)
+	// Replay makes delivery of the endpoint pending again at now, all dead deliveries of the endpoint are replayed if
+	// delivery id is zero. Returns amount of replayed deliveries.
+	Replay(ctx context.Context, endpointID EndpointID, deliveryID DeliveryID, now time.Time) (int64, error)
+}
+
+// Transfers formats state of the transfer, which is sent along with the event.
+type Transfers interface {
+	// FormatTransfer returns transfer of the snapshot the way it is shown to users.
+	FormatTransfer(snapshot TransferSnapshot) transfers.Transfer
+}
+
+// TransferSnapshot is a state of the token transfer, which is stored to the outbox in the same transaction its status
+// changes in, so event is delivered along with the transfer as it was at that moment.
+type TransferSnapshot struct {
+	TokenTransfer transfers.TokenTransfer
+	// TriggeringTx and OutboundTx are nil if transfer had no such transaction.
+	TriggeringTx *transactions.Transaction
+	OutboundTx   *transactions.Transaction
+}
+
+// EndpointID is a type-alias for webhook endpoint id.
+type EndpointID uint64
+
+// Endpoint describes integrator's url, which receives transfer events.
+type Endpoint struct {
+	ID    EndpointID `json:"id"`
+	Owner string     `json:"-"`
+	URL   string     `json:"url"`
+	// Secret is a key of HMAC signature of the deliveries, it is shown to integrator only once.
+	Secret string `json:"secret,omitempty"`
+	// Statuses lists transfer statuses, which events are delivered, empty list subscribes to all of them.
+	Statuses  []transfers.Status `json:"statuses"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+// CreateEndpointRequest describes endpoint to register.
+type CreateEndpointRequest struct {
+	Owner    string             `json:"-"`
+	URL      string             `json:"url"`
+	Statuses []transfers.Status `json:"statuses"`
+}
+
+// Validate validates that endpoint url is absolute http one, which host is not local or internal ip, and statuses are
+// known. Hosts resolving to internal ips are rejected by dispatcher when it connects to them.
+func (req CreateEndpointRequest) Validate() error {
+	if req.Owner == "" {
+		return ErrInvalidEndpoint.New("owner is required")
+	}
+
+	endpointURL, err := url.Parse(req.URL)
+	if err != nil {
+		return ErrInvalidEndpoint.Wrap(err)
+	}
+
+	if (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+		return ErrInvalidEndpoint.New("url has to be absolute http or https one")
+	}
+
+	host := strings.ToLower(endpointURL.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrInvalidEndpoint.Wrap(ErrForbiddenAddress.New("%s", host))
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return ErrInvalidEndpoint.Wrap(ErrForbiddenAddress.New("%s", ip))
+	}
+
+	for _, status := range req.Statuses {
+		if err := status.Validate(); err != nil {
+			return ErrInvalidEndpoint.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// IsPublicIP reports whether ip is public unicast address, which is not loopback, private, link-local or shared one.
+func IsPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// DeliveryID is a type-alias for webhook delivery id.
+type DeliveryID uint64
+
+// DeliveryState describes state of the webhook delivery.
+type DeliveryState string
+
+const (
+	// DeliveryStatePending indicates that delivery is waiting for the next attempt.
+	DeliveryStatePending DeliveryState = "PENDING"
+	// DeliveryStateDelivered indicates that endpoint has accepted delivery.
+	DeliveryStateDelivered DeliveryState = "DELIVERED"
+	// DeliveryStateDead indicates that all attempts failed and delivery is kept as dead letter until it is replayed.
+	DeliveryStateDead DeliveryState = "DEAD"
+)
+
+// Validate validates delivery state.
+func (state DeliveryState) Validate() error {
+	switch state {
+	case DeliveryStatePending, DeliveryStateDelivered, DeliveryStateDead:
+		return nil
+	default:
+		return ErrInvalidEndpoint.New("unknown delivery state %q", state)
+	}
+}
+
+// Delivery is an outbox record of the transfer status change, which is sent to single endpoint.
+type Delivery struct {
+	ID             DeliveryID       `json:"id"`
+	EndpointID     EndpointID       `json:"endpointId"`
+	TransferID     transfers.ID     `json:"transferId"`
+	Status         transfers.Status `json:"status"`
+	PreviousStatus transfers.Status `json:"previousStatus"`
+	State          DeliveryState    `json:"state"`
+	Attempts       uint32           `json:"attempts"`
+	NextAttemptAt  time.Time        `json:"nextAttemptAt"`
+	LastError      string           `json:"lastError"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	DeliveredAt    *time.Time       `json:"deliveredAt"`
+	// Transfer is nil for deliveries enqueued before snapshots of transfers were stored to the outbox.
+	Transfer *TransferSnapshot `json:"-"`
+}
+
+// Event returns type of the event, which is delivered.
+func (delivery Delivery) Event() string {
+	return EventType(delivery.Status)
+}
+
+// EventType returns type of the event, which is sent when transfer gets status.
+func EventType(status transfers.Status) string {
+	return eventPrefix + strings.ToLower(string(status))
+}
+
+// Event is a body of the webhook request. Delivery id is kept on retries and replays, so receivers can use it to
+// deduplicate events. Transfer holds its state at the moment of the status change, so it matches the event status.
+type Event struct {
+	ID             DeliveryID          `json:"id"`
+	Event          string              `json:"event"`
+	TransferID     transfers.ID        `json:"transferId"`
+	Status         transfers.Status    `json:"status"`
+	PreviousStatus transfers.Status    `json:"previousStatus"`
+	CreatedAt      time.Time           `json:"createdAt"`
+	Transfer       *transfers.Transfer `json:"transfer"`
+}
+
+// DeliveriesRequest describes which deliveries of the endpoint are listed.
+type DeliveriesRequest struct {
+	Owner      string
+	EndpointID EndpointID
+	// State filters deliveries by state, all states are listed if it is empty.
+	State DeliveryState
+	Limit uint32
+}
+
+// ReplayRequest describes deliveries of the endpoint to be sent again.
+type ReplayRequest struct {
+	Owner      string
+	EndpointID EndpointID
+	// DeliveryID identifies replayed delivery in any state, all dead deliveries are replayed if it is zero.
+	DeliveryID DeliveryID
+}
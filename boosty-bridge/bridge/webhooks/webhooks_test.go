@@ -0,0 +1,49 @@
+package webhooks_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/webhooks"
+)
+
+func TestCreateEndpointRequest(t *testing.T) {
+	valid := []string{
+		"https://example.com/webhooks",
+		"http://8.8.8.8:8080/webhooks",
+		"https://[2001:4860:4860::8888]/webhooks",
+	}
+	for _, url := range valid {
+		err := webhooks.CreateEndpointRequest{Owner: "integrator", URL: url}.Validate()
+		assert.NoError(t, err, url)
+	}
+
+	forbidden := []string{
+		"http://localhost:8080/webhooks",
+		"http://api.localhost/webhooks",
+		"http://127.0.0.1/webhooks",
+		"http://10.0.0.1/webhooks",
+		"http://192.168.1.1/webhooks",
+		"http://100.64.0.1/webhooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://0.0.0.0/webhooks",
+		"http://[::1]/webhooks",
+		"http://[fd00::1]/webhooks",
+		"http://[::ffff:127.0.0.1]/webhooks",
+	}
+	for _, url := range forbidden {
+		err := webhooks.CreateEndpointRequest{Owner: "integrator", URL: url}.Validate()
+		require.Error(t, err, url)
+		assert.True(t, webhooks.ErrForbiddenAddress.Has(err), url)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	assert.True(t, webhooks.IsPublicIP(net.ParseIP("93.184.216.34")))
+	assert.False(t, webhooks.IsPublicIP(net.ParseIP("172.16.0.1")))
+	assert.False(t, webhooks.IsPublicIP(net.ParseIP("fe80::1")))
+	assert.False(t, webhooks.IsPublicIP(net.ParseIP("224.0.0.1")))
+}
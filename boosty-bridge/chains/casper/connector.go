@@ -6,10 +6,14 @@ package casper
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
+	"github.com/zeebo/errs"
 
 	"tricorn/bridge/networks"
+	"tricorn/chains"
+	"tricorn/pkg/multinode"
 )
 
 // Casper exposes access to the casper sdk methods.
@@ -18,10 +22,25 @@ type Casper interface {
 	PutDeploy(deploy sdk.Deploy) (string, error)
 	// GetBlockNumberByHash returns block number by deploy hash.
 	GetBlockNumberByHash(hash string) (int, error)
-	// GetEventsByBlockNumbers returns events for range of block numbers.
+	// GetEventsByBlockNumbers returns events of deploys which emitted bridge events for range of block numbers.
+	// Every event keeps all transforms of the deploy, so position of bridge event in the deploy is preserved.
 	GetEventsByBlockNumbers(fromBlockNumber uint64, toBlockNumber uint64, bridgeInEventHash string) ([]Event, error)
 	// GetCurrentBlockNumber returns current block number.
 	GetCurrentBlockNumber() (uint64, error)
+	// GetTokenSupply returns balance of the contract holder and total supply of the cep-18 token with specified package hash.
+	GetTokenSupply(ctx context.Context, tokenPackageHash []byte, holder []byte) (chains.TokenSupply, error)
+	// GetDeployExecution returns execution result of the deploy with specified hash.
+	GetDeployExecution(ctx context.Context, hash string) (DeployExecution, error)
+}
+
+// ErrDeployNotFound indicates that node knows nothing about the deploy.
+var ErrDeployNotFound = errs.Class("deploy not found")
+
+// DeployExecution describes execution result of the deploy, deploy which is not executed yet has Executed false.
+type DeployExecution struct {
+	Executed     bool
+	BlockHash    string
+	ErrorMessage string
 }
 
 // Signer exposes access to the signer methods.
@@ -74,12 +93,65 @@ type Config struct {
 	BridgeInPrefix        string        `env:"BRIDGE_IN_PREFIX"`
 	TransferOutPrefix     string        `env:"TRANSFER_OUT_PREFIX"`
 	SignatureValidityTime uint32        `env:"SIGNATURE_VALIDITY_TIME"`
+
+	EventsReconnectDelayInSeconds    uint32 `env:"EVENTS_RECONNECT_DELAY_IN_SECONDS" envDefault:"1"`
+	EventsMaxReconnectDelayInSeconds uint32 `env:"EVENTS_MAX_RECONNECT_DELAY_IN_SECONDS" envDefault:"30"`
+	EventsHeartbeatTimeoutInSeconds  uint32 `env:"EVENTS_HEARTBEAT_TIMEOUT_IN_SECONDS" envDefault:"60"`
+
+	// FallbackRPCNodeAddresses are addresses of rpc nodes, which are used when the node with RPCNodeAddress fails
+	// or lags behind.
+	FallbackRPCNodeAddresses []string `env:"FALLBACK_RPC_NODE_ADDRESSES" envDefault:""`
+	// RPCNodeQuorum is a number of rpc nodes, which have to agree on block height and bridge events. If quorum is
+	// required, event node is used only to learn about new blocks, events are read from blocks agreed by rpc nodes.
+	RPCNodeQuorum int `env:"RPC_NODE_QUORUM" envDefault:"1"`
+	// RPCNodeMaxLagBlocks is a number of blocks rpc node may lag behind the highest node, zero disables lag check.
+	RPCNodeMaxLagBlocks uint64 `env:"RPC_NODE_MAX_LAG_BLOCKS" envDefault:"5"`
+	// RPCNodeHealthCheckIntervalInSeconds defines how often rpc nodes health is checked.
+	RPCNodeHealthCheckIntervalInSeconds uint32 `env:"RPC_NODE_HEALTH_CHECK_INTERVAL_IN_SECONDS" envDefault:"15"`
+
+	// EventsReadingWorkers is a number of blocks fetched concurrently while past events are read.
+	EventsReadingWorkers int `env:"EVENTS_READING_WORKERS" envDefault:"4"`
+	// EventsReadingBatchBlocks is a number of blocks, events of which are delivered together followed by progress,
+	// so reading of past events could be continued from the last batch after restart.
+	EventsReadingBatchBlocks uint64 `env:"EVENTS_READING_BATCH_BLOCKS" envDefault:"100"`
+	// RPCRequestsPerSecond limits rate of requests to every rpc node, zero means no limit.
+	RPCRequestsPerSecond float64 `env:"RPC_REQUESTS_PER_SECOND" envDefault:"0"`
+	// RPCRetries is a number of times rpc request is repeated after transient error.
+	RPCRetries int `env:"RPC_RETRIES" envDefault:"3"`
+}
+
+// RPCNodeAddresses returns addresses of all rpc nodes, the primary node goes first.
+func (config Config) RPCNodeAddresses() []string {
+	return append([]string{config.RPCNodeAddress}, config.FallbackRPCNodeAddresses...)
+}
+
+// RPCNodesConfig returns configuration of rpc nodes pool.
+func (config Config) RPCNodesConfig() multinode.Config {
+	return multinode.Config{
+		Quorum:              config.RPCNodeQuorum,
+		MaxLag:              config.RPCNodeMaxLagBlocks,
+		HealthCheckInterval: time.Duration(config.RPCNodeHealthCheckIntervalInSeconds) * time.Second,
+	}
 }
 
 // Event describes event structure in casper network.
 type (
 	Event struct {
 		DeployProcessed DeployProcessed `json:"DeployProcessed"`
+		BlockAdded      BlockAdded      `json:"BlockAdded"`
+	}
+
+	BlockAdded struct {
+		BlockHash string `json:"block_hash"`
+		Block     Block  `json:"block"`
+	}
+
+	Block struct {
+		Header BlockHeader `json:"header"`
+	}
+
+	BlockHeader struct {
+		Height uint64 `json:"height"`
 	}
 
 	DeployProcessed struct {
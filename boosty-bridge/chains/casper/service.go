@@ -4,13 +4,11 @@
 package casper
 
 import (
-	"bufio"
 	"context"
 	"encoding/hex"
 	"encoding/json"
-	"io"
+	"fmt"
 	"math/big"
-	"net/http"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,6 +25,9 @@ import (
 	"tricorn/chains"
 	"tricorn/internal/eventparsing"
 	"tricorn/internal/logger"
+	"tricorn/pkg/casper-sdk/sse"
+	"tricorn/pkg/codec"
+	signature_lib "tricorn/pkg/signature"
 )
 
 // ensures that Service implement chains.Connector.
@@ -46,7 +47,7 @@ type Service struct {
 	bridge chains.Bridge
 	casper Casper
 	signer Signer
-	events *http.Client
+	events *sse.Client
 
 	mutex            sync.Mutex
 	eventSubscribers []chains.EventSubscriber
@@ -55,11 +56,12 @@ type Service struct {
 
 // NewService is constructor for Service.
 func NewService(gctx context.Context, config Config, log logger.Logger, bridge chains.Bridge, casper Casper, signer Signer) *Service {
-	eventsClient := &http.Client{
-		Transport: &http.Transport{
-			DisableCompression: true,
-		},
-	}
+	eventsClient := sse.New(sse.Config{
+		Address:           config.EventNodeAddress,
+		ReconnectDelay:    time.Duration(config.EventsReconnectDelayInSeconds) * time.Second,
+		MaxReconnectDelay: time.Duration(config.EventsMaxReconnectDelayInSeconds) * time.Second,
+		HeartbeatTimeout:  time.Duration(config.EventsHeartbeatTimeoutInSeconds) * time.Second,
+	})
 
 	return &Service{
 		gctx:   gctx,
@@ -75,10 +77,7 @@ func NewService(gctx context.Context, config Config, log logger.Logger, bridge c
 // Network returns supported by connector network.
 // TODO: place token contract to token.
 func (service *Service) Network(ctx context.Context) networks.Network {
-	id := networks.IDCasperTest
-	if !service.config.IsTestnet {
-		id = networks.IDCasper
-	}
+	id, _ := networks.IDByName(service.GetChainName())
 
 	return networks.Network{
 		ID:             id,
@@ -104,9 +103,9 @@ func (service *Service) BridgeOut(ctx context.Context, req chains.TokenOutReques
 		return nil, ErrConnector.Wrap(err)
 	}
 
-	publicKey := keypair.PublicKey{
-		Tag:        keypair.KeyTagEd25519,
-		PubKeyData: respPubKey,
+	publicKey, err := signature_lib.ParseCasperPublicKey(respPubKey)
+	if err != nil {
+		return nil, ErrConnector.Wrap(err)
 	}
 
 	standardPayment := new(big.Int).SetUint64(service.config.GasLimit)
@@ -165,9 +164,14 @@ func (service *Service) BridgeOut(ctx context.Context, req chains.TokenOutReques
 		return nil, ErrConnector.Wrap(err)
 	}
 
-	// recipient.
+	// recipient, which is addressed by public key or account hash, receives tokens to its account.
+	recipientAccountHash, err := codec.CasperAccountHash(req.To)
+	if err != nil {
+		return nil, ErrConnector.Wrap(err)
+	}
+
 	var recipientHashBytes [32]byte
-	copy(recipientHashBytes[:], req.To)
+	copy(recipientHashBytes[:], recipientAccountHash)
 
 	recipient := types.CLValue{
 		Type: types.CLTypeKey,
@@ -224,7 +228,7 @@ func (service *Service) BridgeOut(ctx context.Context, req chains.TokenOutReques
 	}
 	runtimeArgs := sdk.NewRunTimeArgs(args, keyOrder)
 
-	contractHexBytes, err := hex.DecodeString(service.config.BridgeContractAddress)
+	contractHexBytes, err := codec.Casper.ParseContract(service.config.BridgeContractAddress)
 	if err != nil {
 		return nil, ErrConnector.Wrap(err)
 	}
@@ -235,23 +239,14 @@ func (service *Service) BridgeOut(ctx context.Context, req chains.TokenOutReques
 
 	deploy := sdk.MakeDeploy(deployParams, payment, session)
 
-	reqSign := chains.SignRequest{
-		NetworkId: networks.TypeCasper,
-		Data:      deploy.Hash,
-	}
-	signature, err := service.bridge.Sign(ctx, reqSign)
+	signature, err := service.signDeploy(ctx, publicKey.Tag, deploy.Hash)
 	if err != nil {
 		return nil, ErrConnector.Wrap(err)
 	}
 
-	signatureKeypair := keypair.Signature{
-		Tag:           keypair.KeyTagEd25519,
-		SignatureData: signature,
-	}
-
 	approval := sdk.Approval{
 		Signer:    publicKey,
-		Signature: signatureKeypair,
+		Signature: signature,
 	}
 
 	deploy.Approvals = append(deploy.Approvals, approval)
@@ -261,11 +256,24 @@ func (service *Service) BridgeOut(ctx context.Context, req chains.TokenOutReques
 		return nil, ErrConnector.Wrap(err)
 	}
 
-	txHash, err := hex.DecodeString(hash)
+	txHash, err := codec.Casper.ParseHash(hash)
 
 	return txHash, ErrConnector.Wrap(err)
 }
 
+// signDeploy signs deploy hash with the key of specified algorithm.
+func (service *Service) signDeploy(ctx context.Context, tag keypair.KeyTag, deployHash []byte) (keypair.Signature, error) {
+	signature, err := service.bridge.Sign(ctx, chains.SignRequest{
+		NetworkId: networks.TypeCasper,
+		Data:      signature_lib.CasperDeployDigest(tag, deployHash),
+	})
+	if err != nil {
+		return keypair.Signature{}, err
+	}
+
+	return signature_lib.CasperDeploySignature(tag, signature), nil
+}
+
 // ReadEvents reads real-time events from node and old events from blocks and notifies subscribers.
 func (service *Service) ReadEvents(ctx context.Context, fromBlock uint64) error {
 	service.wg.Add(2)
@@ -283,7 +291,7 @@ func (service *Service) ReadEvents(ctx context.Context, fromBlock uint64) error
 			return
 		}
 
-		err = service.readEventsFromBlock(ctx, fromBlock, currentBlockNumber)
+		err = service.readEventsFromBlock(ctx, fromBlock, currentBlockNumber, service.notifyProgress)
 		if err != nil {
 			service.log.Error("could not read past events", err)
 		}
@@ -303,26 +311,96 @@ func (service *Service) ReadEvents(ctx context.Context, fromBlock uint64) error
 	return nil
 }
 
-// readEventsFromBlock reads node events from blocks and notifies subscribers.
-func (service *Service) readEventsFromBlock(ctx context.Context, fromBlock uint64, toBlock uint64) error {
-	events, err := service.casper.GetEventsByBlockNumbers(fromBlock, toBlock, service.config.BridgeEventsHash)
-	if err != nil {
-		return ErrConnector.Wrap(err)
+// readEventsFromBlock reads node events from blocks and notifies subscribers. Blocks are read in batches,
+// progress is called with the last block of the batch once all its events are delivered.
+func (service *Service) readEventsFromBlock(ctx context.Context, fromBlock uint64, toBlock uint64, progress func(ctx context.Context, block uint64)) error {
+	batchBlocks := service.config.EventsReadingBatchBlocks
+	if batchBlocks == 0 {
+		batchBlocks = toBlock - fromBlock + 1
 	}
 
-	for _, event := range events {
-		eventFunds, err := service.parseEventFromTransform(event, event.DeployProcessed.ExecutionResult.Success.Effect.Transforms[0])
+	for batchFrom := fromBlock; batchFrom <= toBlock; batchFrom += batchBlocks {
+		batchTo := toBlock
+		if toBlock-batchFrom >= batchBlocks {
+			batchTo = batchFrom + batchBlocks - 1
+		}
+
+		events, err := service.eventsOfBlocks(batchFrom, batchTo)
 		if err != nil {
-			return ErrConnector.Wrap(err)
+			return err
+		}
+
+		for _, event := range events {
+			service.Notify(ctx, event)
 		}
 
-		service.Notify(ctx, eventFunds)
+		if progress != nil {
+			progress(ctx, batchTo)
+		}
+
+		select {
+		case <-service.gctx.Done():
+			return nil
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if batchTo == toBlock {
+			return nil
+		}
 	}
 
 	return nil
 }
 
-func (service *Service) parseEventFromTransform(event Event, transform Transform) (chains.EventVariant, error) {
+// EventRange returns bridge events of the blocks range.
+func (service *Service) EventRange(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error) {
+	if err := chains.ValidateEventRange(fromBlock, toBlock); err != nil {
+		return nil, ErrConnector.Wrap(err)
+	}
+
+	return service.eventsOfBlocks(fromBlock, toBlock)
+}
+
+// eventsOfBlocks returns bridge events of the blocks range in order of blocks and deploys.
+func (service *Service) eventsOfBlocks(fromBlock, toBlock uint64) ([]chains.EventVariant, error) {
+	events, err := service.casper.GetEventsByBlockNumbers(fromBlock, toBlock, service.config.BridgeEventsHash)
+	if err != nil {
+		return nil, ErrConnector.Wrap(err)
+	}
+
+	eventsFunds := make([]chains.EventVariant, 0, len(events))
+	for _, event := range events {
+		for index, transform := range event.DeployProcessed.ExecutionResult.Success.Effect.Transforms {
+			if transform.Key != service.config.BridgeEventsHash {
+				continue
+			}
+
+			eventFunds, err := service.parseEventFromTransform(event, transform, index)
+			if err != nil {
+				return nil, ErrConnector.Wrap(err)
+			}
+
+			eventsFunds = append(eventsFunds, eventFunds)
+		}
+	}
+
+	return eventsFunds, nil
+}
+
+// notifyProgress notifies subscribers that events of all blocks up to the block are delivered,
+// so past events reading could be continued from the block after restart.
+func (service *Service) notifyProgress(ctx context.Context, block uint64) {
+	service.log.Debug(fmt.Sprintf("past events are read up to block %d", block))
+	service.Notify(ctx, chains.EventVariant{
+		Type:          chains.EventTypeProgress,
+		EventProgress: chains.EventProgress{BlockNumber: block},
+	})
+}
+
+// parseEventFromTransform parses bridge event from deploy transform, index is a position of transform in the deploy effect.
+func (service *Service) parseEventFromTransform(event Event, transform Transform, index int) (chains.EventVariant, error) {
 	transformMap, ok := transform.Transform.(map[string]interface{})
 	if !ok {
 		return chains.EventVariant{}, ErrConnector.New("couldn't parse map to transform")
@@ -353,6 +431,8 @@ func (service *Service) parseEventFromTransform(event Event, transform Transform
 		chainAddress         string
 		amountStr            string
 		userWalletAddress    []byte
+		nonce                int
+		transactionID        int
 	)
 
 	if eventType == fundInType {
@@ -393,13 +473,10 @@ func (service *Service) parseEventFromTransform(event Event, transform Transform
 		// TODO: add in event later.
 		_ = stableCommissionPercentStr
 
-		nonce, err := eventData.GetNonce()
+		nonce, err = eventData.GetNonce()
 		if err != nil {
 			return chains.EventVariant{}, ErrConnector.Wrap(err)
 		}
-		nonceStr := strconv.Itoa(nonce)
-		// TODO: add in event later.
-		_ = nonceStr
 
 		userWalletAddress, err = hex.DecodeString(eventData.GetUserWalletAddress())
 		if err != nil {
@@ -429,13 +506,10 @@ func (service *Service) parseEventFromTransform(event Event, transform Transform
 		}
 		amountStr = strconv.Itoa(amount)
 
-		transactionID, err := eventData.GetTransactionID()
+		transactionID, err = eventData.GetTransactionID()
 		if err != nil {
 			return chains.EventVariant{}, ErrConnector.Wrap(err)
 		}
-		transactionIDStr := strconv.Itoa(transactionID)
-		// TODO: add in event later.
-		_ = transactionIDStr
 
 		userWalletAddress, err = hex.DecodeString(eventData.GetUserWalletAddress())
 		if err != nil {
@@ -443,12 +517,12 @@ func (service *Service) parseEventFromTransform(event Event, transform Transform
 		}
 	}
 
-	hash, err := hex.DecodeString(event.DeployProcessed.DeployHash)
+	hash, err := codec.Casper.ParseHash(event.DeployProcessed.DeployHash)
 	if err != nil {
 		return chains.EventVariant{}, ErrConnector.Wrap(err)
 	}
 
-	sender, err := hex.DecodeString(event.DeployProcessed.Account)
+	sender, err := codec.Casper.ParsePublicKey(event.DeployProcessed.Account)
 	if err != nil {
 		return chains.EventVariant{}, ErrConnector.Wrap(err)
 	}
@@ -462,6 +536,7 @@ func (service *Service) parseEventFromTransform(event Event, transform Transform
 		Hash:        hash,
 		BlockNumber: uint64(blockNumber),
 		Sender:      sender,
+		LogIndex:    uint64(index),
 	}
 
 	var eventFunds chains.EventVariant
@@ -478,6 +553,7 @@ func (service *Service) parseEventFromTransform(event Event, transform Transform
 				Amount: amountStr,
 				Token:  tokenContractAddress,
 				Tx:     transactionInfo,
+				Nonce:  uint64(nonce),
 			},
 		}
 	case chains.EventTypeOut.Int():
@@ -488,107 +564,120 @@ func (service *Service) parseEventFromTransform(event Event, transform Transform
 					NetworkName: chainName,
 					Address:     chainAddress,
 				},
-				To:     userWalletAddress,
-				Amount: amountStr,
-				Token:  tokenContractAddress,
-				Tx:     transactionInfo,
+				To:            userWalletAddress,
+				Amount:        amountStr,
+				Token:         tokenContractAddress,
+				Tx:            transactionInfo,
+				TransactionID: uint64(transactionID),
 			},
 		}
 	default:
 		return chains.EventVariant{}, ErrConnector.New("invalid event type")
 	}
 
-	tokenIn := hex.EncodeToString(eventFunds.EventFundsIn.Token)
-	eventFunds.EventFundsIn.Token, err = hex.DecodeString(eventparsing.TagHash.String() + tokenIn)
-	if err != nil {
-		return chains.EventVariant{}, ErrConnector.Wrap(err)
-	}
+	return eventFunds, nil
+}
 
-	from := hex.EncodeToString(eventFunds.EventFundsIn.From)
-	eventFunds.EventFundsIn.From, err = hex.DecodeString(eventparsing.TagAccount.String() + from)
-	if err != nil {
-		return chains.EventVariant{}, ErrConnector.Wrap(err)
-	}
+// subscribeEvents is real time events streaming from blockchain to events subscribers.
+func (service *Service) subscribeEvents(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-service.gctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 
-	tokenOut := hex.EncodeToString(eventFunds.EventFundsOut.Token)
-	eventFunds.EventFundsOut.Token, err = hex.DecodeString(eventparsing.TagHash.String() + tokenOut)
+	lastBlock, err := service.casper.GetCurrentBlockNumber()
 	if err != nil {
-		return chains.EventVariant{}, ErrConnector.Wrap(err)
+		return ErrConnector.Wrap(err)
 	}
 
-	to := hex.EncodeToString(eventFunds.EventFundsOut.To)
-	eventFunds.EventFundsOut.To, err = hex.DecodeString(eventparsing.TagAccount.String() + to)
-	if err != nil {
-		return chains.EventVariant{}, ErrConnector.Wrap(err)
+	handler := &streamHandler{
+		service:   service,
+		lastBlock: lastBlock,
 	}
 
-	return eventFunds, nil
+	return ErrConnector.Wrap(service.events.Stream(ctx, handler))
 }
 
-// SubscribeEvents is real time events streaming from blockchain to events subscribers.
-func (service *Service) subscribeEvents(ctx context.Context) error {
-	var body io.Reader
-	req, err := http.NewRequest(http.MethodGet, service.config.EventNodeAddress, body)
-	if err != nil {
-		return ErrConnector.Wrap(err)
-	}
+// streamHandler notifies subscribers with events from node event stream and reads missed events from blocks.
+type streamHandler struct {
+	service *Service
+	// lastBlock is a block from which missed events are read when stream could not be resumed.
+	lastBlock uint64
+}
 
-	resp, err := service.events.Do(req)
+// Event parses bridge events from stream event and notifies subscribers.
+func (handler *streamHandler) Event(ctx context.Context, streamEvent sse.Event) error {
+	var event Event
+	err := json.Unmarshal(streamEvent.Data, &event)
 	if err != nil {
-		return ErrConnector.Wrap(err)
+		// continue execution because event has unsupported structure.
+		return nil
 	}
 
-	for {
-		select {
-		case <-service.gctx.Done():
-			return nil
-		case <-ctx.Done():
-			return nil
-		default:
+	// events are read from blocks agreed by quorum of rpc nodes instead of trusting the single event node.
+	if handler.service.config.RPCNodeQuorum > 1 {
+		if event.BlockAdded.Block.Header.Height > handler.lastBlock {
+			return handler.readBlocks(ctx, handler.lastBlock+1)
 		}
 
-		reader := bufio.NewReader(resp.Body)
-		rawBody, err := reader.ReadBytes('\n')
-		if err != nil {
-			return ErrConnector.Wrap(err)
-		}
+		return nil
+	}
 
-		switch {
-		case string(rawBody) == ":" || len(string(rawBody)) < 5:
+	if event.BlockAdded.Block.Header.Height > handler.lastBlock {
+		handler.lastBlock = event.BlockAdded.Block.Header.Height
+	}
+
+	for index, transform := range event.DeployProcessed.ExecutionResult.Success.Effect.Transforms {
+		if transform.Key != handler.service.config.BridgeEventsHash {
 			continue
-		case len(string(rawBody)) >= 5 && string(rawBody)[:5] == "data:":
-			rawBody = []byte(string(rawBody)[5:])
 		}
 
-		var event Event
-		err = json.Unmarshal(rawBody, &event)
+		eventFunds, err := handler.service.parseEventFromTransform(event, transform, index)
 		if err != nil {
-			// continue execution because event has unsupported structure.
+			handler.service.log.Error("could not parse event from stream", ErrConnector.Wrap(err))
 			continue
 		}
 
-		transforms := event.DeployProcessed.ExecutionResult.Success.Effect.Transforms
-		if len(transforms) == 0 {
-			continue
-		}
+		handler.service.Notify(ctx, eventFunds)
+	}
 
-		for _, transform := range transforms {
-			select {
-			case <-service.gctx.Done():
+	return nil
+}
+
+// Gap reads events, which were missed by event stream, from blocks.
+func (handler *streamHandler) Gap(ctx context.Context) error {
+	return handler.readBlocks(ctx, handler.lastBlock)
+}
+
+// readBlocks reads events from blocks starting from the specified one up to the current block. Reading is retried
+// until it succeeds, otherwise events would be lost.
+func (handler *streamHandler) readBlocks(ctx context.Context, fromBlock uint64) error {
+	for {
+		currentBlock, err := handler.service.casper.GetCurrentBlockNumber()
+		if err == nil {
+			if currentBlock < fromBlock {
 				return nil
-			case <-ctx.Done():
+			}
+
+			err = handler.service.readEventsFromBlock(ctx, fromBlock, currentBlock, nil)
+			if err == nil {
+				handler.lastBlock = currentBlock
 				return nil
-			default:
 			}
+		}
 
-			if transform.Key == service.config.BridgeEventsHash {
-				eventFunds, err := service.parseEventFromTransform(event, transform)
-				if err != nil {
-					return ErrConnector.Wrap(err)
-				}
+		handler.service.log.Error("could not read events from blocks", ErrConnector.Wrap(err))
 
-				service.Notify(ctx, eventFunds)
-			}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Second):
 		}
 	}
 }
@@ -611,12 +700,12 @@ func (service *Service) GetChainName() networks.Name {
 
 // BridgeInSignature returns signature for user to send bridgeIn transaction.
 func (service *Service) BridgeInSignature(ctx context.Context, req chains.BridgeInSignatureRequest) (chains.BridgeInSignatureResponse, error) {
-	bridgeHash, err := hex.DecodeString(service.config.BridgeContractAddress)
+	bridgeHash, err := codec.Casper.ParseContract(service.config.BridgeContractAddress)
 	if err != nil {
 		return chains.BridgeInSignatureResponse{}, ErrConnector.Wrap(err)
 	}
 
-	tokenPackageHashChainBytes, err := hex.DecodeString(req.Token)
+	tokenPackageHashChainBytes, err := codec.Casper.ParseContract(req.Token)
 	if err != nil {
 		return chains.BridgeInSignatureResponse{}, ErrConnector.Wrap(err)
 	}
@@ -653,7 +742,7 @@ func (service *Service) BridgeInSignature(ctx context.Context, req chains.Bridge
 
 // CancelSignature returns signature for user to return funds.
 func (service *Service) CancelSignature(ctx context.Context, req chains.CancelSignatureRequest) (chains.CancelSignatureResponse, error) {
-	bridgeHashBytes, err := hex.DecodeString(service.config.BridgeContractAddress)
+	bridgeHashBytes, err := codec.Casper.ParseContract(service.config.BridgeContractAddress)
 	if err != nil {
 		return chains.CancelSignatureResponse{}, ErrConnector.Wrap(err)
 	}
@@ -676,6 +765,40 @@ func (service *Service) CancelSignature(ctx context.Context, req chains.CancelSi
 	}, nil
 }
 
+// TokenSupply returns balance of the bridge contract and total supply of the token.
+func (service *Service) TokenSupply(ctx context.Context, token []byte) (chains.TokenSupply, error) {
+	bridgeHashBytes, err := codec.Casper.ParseContract(service.config.BridgeContractAddress)
+	if err != nil {
+		return chains.TokenSupply{}, ErrConnector.Wrap(err)
+	}
+
+	supply, err := service.casper.GetTokenSupply(ctx, token, bridgeHashBytes)
+	return supply, ErrConnector.Wrap(err)
+}
+
+// SetPaused pauses or unpauses bridge contract.
+func (service *Service) SetPaused(context.Context, bool) ([]byte, error) {
+	// bridge contract has no pause entry point.
+	return nil, ErrConnector.New("pause is not supported")
+}
+
+// TransactionStatus returns status of the deploy on chain, deploy which is not executed yet is pending.
+func (service *Service) TransactionStatus(ctx context.Context, txHash []byte) (chains.TxStatus, error) {
+	execution, err := service.casper.GetDeployExecution(ctx, hex.EncodeToString(txHash))
+	switch {
+	case ErrDeployNotFound.Has(err):
+		return chains.TxStatusNotFound, nil
+	case err != nil:
+		return "", ErrConnector.Wrap(err)
+	case !execution.Executed:
+		return chains.TxStatusPending, nil
+	case execution.ErrorMessage != "":
+		return chains.TxStatusFailed, nil
+	default:
+		return chains.TxStatusSucceeded, nil
+	}
+}
+
 // AddEventSubscriber adds subscriber to event publisher.
 func (service *Service) AddEventSubscriber() chains.EventSubscriber {
 	subscriber := chains.EventSubscriber{
@@ -0,0 +1,337 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package casper_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/casper-ecosystem/casper-golang-sdk/keypair"
+	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"tricorn/bridge/networks"
+	"tricorn/chains"
+	"tricorn/chains/casper"
+	"tricorn/internal/logger/zaplog"
+	"tricorn/signer"
+)
+
+const bridgeEventsHash = "uref-f2ac1d8e6a3dd0cda8a1ed2cfc1cca29d7b7a2be4da3b2d2a3f8e3b9d8b7a6c5-007"
+
+// casperMock is a fake casper node, which returns predefined events.
+type casperMock struct {
+	events []casper.Event
+	deploy *sdk.Deploy
+}
+
+func (c *casperMock) PutDeploy(deploy sdk.Deploy) (string, error) {
+	c.deploy = &deploy
+	return hex.EncodeToString(deploy.Hash), nil
+}
+
+func (c *casperMock) GetBlockNumberByHash(hash string) (int, error) {
+	return 5, nil
+}
+
+func (c *casperMock) GetEventsByBlockNumbers(fromBlockNumber uint64, toBlockNumber uint64, bridgeEventsHash string) ([]casper.Event, error) {
+	return c.events, nil
+}
+
+func (c *casperMock) GetCurrentBlockNumber() (uint64, error) {
+	return 10, nil
+}
+
+func (c *casperMock) GetTokenSupply(ctx context.Context, tokenPackageHash []byte, holder []byte) (chains.TokenSupply, error) {
+	return chains.TokenSupply{BridgeBalance: big.NewInt(0), TotalSupply: big.NewInt(0)}, nil
+}
+
+func (c *casperMock) GetDeployExecution(ctx context.Context, hash string) (casper.DeployExecution, error) {
+	return casper.DeployExecution{Executed: true}, nil
+}
+
+// fundsInTransform returns transform of the bridge in event with specified nonce.
+func fundsInTransform(nonce uint8) casper.Transform {
+	chainName := hex.EncodeToString([]byte("GOERLI"))
+	chainAddress := hex.EncodeToString([]byte("3095f955da700b96215cffc9bc64ab2e69eb7dab"))
+	bytes := "7c000000" + "00" +
+		"3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23" +
+		"06000000" + chainName +
+		"28000000" + chainAddress +
+		"03a08601" + // amount.
+		"010a" + // gas commission.
+		"0101" + // stable commission percent.
+		fmt.Sprintf("01%02x", nonce) +
+		"00" + "daa2b596e0a496b04933e241e0567f2bcbecc829aa57d88cab096c28fd07dee2"
+
+	return casper.Transform{
+		Key: bridgeEventsHash,
+		Transform: map[string]interface{}{
+			casper.WriteCLValueKey: map[string]interface{}{
+				casper.BytesKey: bytes,
+			},
+		},
+	}
+}
+
+func TestReadEventsSeveralEventsInDeploy(t *testing.T) {
+	deployHash := "d92baa8981a59e0d9143d3b2d51775af65e626aa795229fb438f97e11fed8651"
+	event := casper.Event{
+		DeployProcessed: casper.DeployProcessed{
+			DeployHash: deployHash,
+			Account:    "01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c",
+			BlockHash:  "a48d854d52746d159ecdde76bddea780159d223dc558379931dc4dbd07ea5261",
+			ExecutionResult: casper.ExecutionResult{
+				Success: casper.Success{
+					Effect: casper.Effect{
+						Transforms: []casper.Transform{
+							fundsInTransform(1),
+							{Key: "hash-8cf5e4acf51f54eb59291599187838dc3bc234089c46fc6ca8ad17e762ae4401", Transform: "Identity"},
+							fundsInTransform(2),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// events node closes stream immediately, so events are read from blocks.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := casper.Config{
+		EventNodeAddress: server.URL,
+		BridgeEventsHash: bridgeEventsHash,
+		ChainName:        networks.NameCasperTest,
+	}
+	service := casper.NewService(ctx, config, zaplog.NewLog(), nil, &casperMock{events: []casper.Event{event}}, nil)
+	subscriber := service.AddEventSubscriber()
+
+	done := make(chan error)
+	go func() {
+		done <- service.ReadEvents(ctx, 1)
+	}()
+
+	received := make([]chains.EventVariant, 0, 2)
+	for len(received) < 2 {
+		select {
+		case event := <-subscriber.ReceiveEvents():
+			received = append(received, event)
+		case <-time.After(10 * time.Second):
+			t.Fatal("events were not received")
+		}
+	}
+
+	// events node keeps being reconnected, so reading is stopped explicitly.
+	cancel()
+	for {
+		select {
+		case <-subscriber.ReceiveEvents():
+			continue
+		case err := <-done:
+			require.NoError(t, err)
+		}
+		break
+	}
+
+	expectedHash, err := hex.DecodeString(deployHash)
+	require.NoError(t, err)
+
+	expectedLogIndexes := []uint64{0, 2}
+	for i, event := range received {
+		require.Equal(t, chains.EventTypeIn, event.Type)
+		assert.Equal(t, expectedHash, event.EventFundsIn.Tx.Hash)
+		assert.EqualValues(t, 5, event.EventFundsIn.Tx.BlockNumber)
+		assert.Equal(t, expectedLogIndexes[i], event.EventFundsIn.Tx.LogIndex)
+		assert.EqualValues(t, i+1, event.EventFundsIn.Nonce)
+		assert.EqualValues(t, "100000", event.EventFundsIn.Amount)
+		assert.Equal(t, "GOERLI", event.EventFundsIn.To.NetworkName)
+	}
+}
+
+func TestReadEventsProgress(t *testing.T) {
+	// events node closes stream immediately, so events are read from blocks.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := casper.Config{
+		EventNodeAddress:         server.URL,
+		BridgeEventsHash:         bridgeEventsHash,
+		ChainName:                networks.NameCasperTest,
+		EventsReadingBatchBlocks: 4,
+	}
+	service := casper.NewService(ctx, config, zaplog.NewLog(), nil, &casperMock{}, nil)
+	subscriber := service.AddEventSubscriber()
+
+	done := make(chan error)
+	go func() {
+		done <- service.ReadEvents(ctx, 1)
+	}()
+
+	// past blocks up to the current 10th one are read in batches.
+	progress := make([]uint64, 0, 3)
+	for len(progress) < 3 {
+		select {
+		case event := <-subscriber.ReceiveEvents():
+			require.Equal(t, chains.EventTypeProgress, event.Type)
+			progress = append(progress, event.Block())
+		case <-time.After(10 * time.Second):
+			t.Fatal("progress was not received")
+		}
+	}
+	assert.Equal(t, []uint64{4, 8, 10}, progress)
+
+	cancel()
+	for {
+		select {
+		case <-subscriber.ReceiveEvents():
+			continue
+		case err := <-done:
+			require.NoError(t, err)
+		}
+		break
+	}
+}
+
+// keyStore is an in-memory store of relayer private keys.
+type keyStore struct {
+	keys map[signer.Type]string
+}
+
+func (store *keyStore) Create(ctx context.Context, privateKey signer.PrivateKey) error {
+	store.keys[privateKey.Type] = privateKey.Key
+	return nil
+}
+
+func (store *keyStore) Get(ctx context.Context, networkType networks.Type, keyType signer.Type) (string, error) {
+	key, ok := store.keys[keyType]
+	if !ok {
+		return "", signer.ErrNoPrivateKey
+	}
+
+	return key, nil
+}
+
+func (store *keyStore) List(ctx context.Context, networkType networks.Type, keyType signer.Type) ([]string, error) {
+	key, err := store.Get(ctx, networkType, keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{key}, nil
+}
+
+func (store *keyStore) Update(ctx context.Context, privateKey signer.PrivateKey) error {
+	return store.Create(ctx, privateKey)
+}
+
+// bridgeMock signs data with signer service, as bridge does.
+type bridgeMock struct {
+	signer *signer.Service
+}
+
+func (bridge *bridgeMock) Sign(ctx context.Context, req chains.SignRequest) ([]byte, error) {
+	if len(req.PublicKey) != 0 {
+		return bridge.signer.SignWithKey(ctx, req.NetworkId, req.PublicKey, req.Data)
+	}
+
+	return bridge.signer.Sign(ctx, req.NetworkId, req.Data, signer.TypeDTTransaction)
+}
+
+func (bridge *bridgeMock) PublicKey(ctx context.Context, networkType networks.Type) ([]byte, error) {
+	return bridge.signer.PublicKey(ctx, networkType)
+}
+
+func (bridge *bridgeMock) PublicKeys(ctx context.Context, networkType networks.Type) ([][]byte, error) {
+	publicKeys, err := bridge.signer.PublicKeys(ctx, networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]byte, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		result = append(result, publicKey)
+	}
+
+	return result, nil
+}
+
+func TestBridgeOutRelayerKeys(t *testing.T) {
+	ctx := context.Background()
+
+	bridgeOut := func(t *testing.T, privateKey string) *sdk.Deploy {
+		store := &keyStore{keys: map[signer.Type]string{signer.TypeDTTransaction: privateKey}}
+		node := &casperMock{}
+		config := casper.Config{
+			ChainName:             networks.NameCasperTest,
+			BridgeContractAddress: "3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23",
+			GasLimit:              100000,
+		}
+		bridge := &bridgeMock{signer: signer.NewService(signer.Config{}, store)}
+		service := casper.NewService(ctx, config, zaplog.NewLog(), bridge, node, nil)
+
+		token, err := hex.DecodeString("9060c0820b5156b1620c8e3344d17f9fad5108f5dc2672f2308439e84363c88e")
+		require.NoError(t, err)
+
+		txHash, err := service.BridgeOut(ctx, chains.TokenOutRequest{
+			Amount:        big.NewInt(1000),
+			Token:         token,
+			To:            make([]byte, 32),
+			From:          networks.Address{NetworkName: "GOERLI", Address: "3095f955da700b96215cffc9bc64ab2e69eb7dab"},
+			TransactionID: big.NewInt(1),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, node.deploy)
+		require.Equal(t, []byte(node.deploy.Hash), txHash)
+		require.Len(t, node.deploy.Approvals, 1)
+
+		approval := node.deploy.Approvals[0]
+		require.Equal(t, approval.Signer, node.deploy.Header.Account)
+		require.Equal(t, approval.Signer.Tag, approval.Signature.Tag)
+
+		return node.deploy
+	}
+
+	t.Run("ed25519", func(t *testing.T) {
+		privateKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+
+		deploy := bridgeOut(t, hex.EncodeToString(privateKey))
+		approval := deploy.Approvals[0]
+
+		require.Equal(t, keypair.KeyTagEd25519, approval.Signer.Tag)
+		require.Equal(t, []byte(privateKey.Public().(ed25519.PublicKey)), approval.Signer.PubKeyData)
+		assert.True(t, ed25519.Verify(approval.Signer.PubKeyData, deploy.Hash, approval.Signature.SignatureData))
+	})
+
+	t.Run("secp256k1", func(t *testing.T) {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		deploy := bridgeOut(t, hex.EncodeToString(crypto.FromECDSA(privateKey)))
+		approval := deploy.Approvals[0]
+
+		require.Equal(t, keypair.KeyTagSecp256k1, approval.Signer.Tag)
+		require.Equal(t, crypto.CompressPubkey(&privateKey.PublicKey), approval.Signer.PubKeyData)
+		require.Len(t, approval.Signature.SignatureData, 64)
+		// casper node verifies secp256k1 approvals as signatures of sha256 digest of deploy hash.
+		publicKey := secp256k1.PubKey(approval.Signer.PubKeyData)
+		assert.True(t, publicKey.VerifySignature(deploy.Hash, approval.Signature.SignatureData))
+	})
+}
@@ -5,6 +5,7 @@ package chains
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/google/uuid"
@@ -19,6 +20,8 @@ type Bridge interface {
 	Sign(ctx context.Context, req SignRequest) ([]byte, error)
 	// PublicKey returns public key for specific network.
 	PublicKey(ctx context.Context, networkId networks.Type) ([]byte, error)
+	// PublicKeys returns public keys of all transaction keys for specific network.
+	PublicKeys(ctx context.Context, networkType networks.Type) ([][]byte, error)
 }
 
 // Connector describes behaviour of connector.
@@ -39,6 +42,14 @@ type Connector interface {
 	BridgeInSignature(context.Context, BridgeInSignatureRequest) (BridgeInSignatureResponse, error)
 	// CancelSignature returns signature for user to return funds.
 	CancelSignature(context.Context, CancelSignatureRequest) (CancelSignatureResponse, error)
+	// TokenSupply returns balance of the bridge contract and total supply of the token.
+	TokenSupply(ctx context.Context, token []byte) (TokenSupply, error)
+	// SetPaused pauses or unpauses bridge contract and returns hash of the sent transaction.
+	SetPaused(ctx context.Context, paused bool) ([]byte, error)
+	// EventRange returns bridge events of the blocks range, which is bounded by MaxEventRangeBlocks.
+	EventRange(ctx context.Context, fromBlock, toBlock uint64) ([]EventVariant, error)
+	// TransactionStatus returns status of the transaction on chain.
+	TransactionStatus(ctx context.Context, txHash []byte) (TxStatus, error)
 
 	// TODO: get rid of what is below.
 
@@ -53,11 +64,28 @@ type Connector interface {
 	CloseClient()
 }
 
+// MaxEventRangeBlocks is a maximal number of blocks, events of which are returned by single event range request.
+const MaxEventRangeBlocks = 10000
+
+// ValidateEventRange checks that blocks range is not empty and is not larger than MaxEventRangeBlocks.
+func ValidateEventRange(fromBlock, toBlock uint64) error {
+	if fromBlock > toBlock {
+		return fmt.Errorf("from block %d is greater than to block %d", fromBlock, toBlock)
+	}
+	if toBlock-fromBlock >= MaxEventRangeBlocks {
+		return fmt.Errorf("range of blocks %d-%d is larger than %d blocks", fromBlock, toBlock, MaxEventRangeBlocks)
+	}
+
+	return nil
+}
+
 // SignRequest describes request for data signing.
+// PublicKey selects transaction key to sign with, default key is used when it is empty.
 type SignRequest struct {
 	NetworkId networks.Type
 	Data      []byte
 	DataType  signer.Type
+	PublicKey []byte
 }
 
 // EventVariant describes one out of two event variants.
@@ -65,6 +93,7 @@ type EventVariant struct {
 	Type          EventType
 	EventFundsIn  EventFundsIn
 	EventFundsOut EventFundsOut
+	EventProgress EventProgress
 }
 
 // Block returns block on which event occurred.
@@ -74,6 +103,8 @@ func (e EventVariant) Block() uint64 {
 		return e.EventFundsIn.Tx.BlockNumber
 	case EventTypeOut:
 		return e.EventFundsOut.Tx.BlockNumber
+	case EventTypeProgress:
+		return e.EventProgress.BlockNumber
 	default:
 		return 0
 	}
@@ -86,6 +117,10 @@ type EventFundsIn struct {
 	Amount string
 	Token  []byte
 	Tx     TransactionInfo
+	// Nonce is a bridge in signature nonce, which binds event to the transfer it was signed for.
+	Nonce uint64
+	// Removed indicates that event is removed from the chain due to reorganization.
+	Removed bool
 }
 
 // EventFundsOut describes event of bridge out method in format required by bridge.
@@ -95,6 +130,13 @@ type EventFundsOut struct {
 	Amount string
 	Token  []byte
 	Tx     TransactionInfo
+	// TransactionID is an id of the triggering transaction, passed to the bridge out method.
+	TransactionID uint64
+}
+
+// EventProgress describes progress of events reading, events of all blocks up to the block number are already sent.
+type EventProgress struct {
+	BlockNumber uint64
 }
 
 // EventType defines list of possible event type for our connector.
@@ -105,6 +147,8 @@ const (
 	EventTypeIn EventType = 0
 	// EventTypeOut defines that event type is 1. That is, this event arrived after calling the bridge out method in our contract.
 	EventTypeOut EventType = 1
+	// EventTypeProgress defines that event type is 2. That is, events of all blocks up to the block are sent.
+	EventTypeProgress EventType = 2
 )
 
 // Int returns int value from EventType type.
@@ -117,6 +161,8 @@ type TransactionInfo struct {
 	Hash        []byte
 	BlockNumber uint64
 	Sender      []byte
+	// LogIndex is a position of the event in the transaction, it distinguishes several bridge events emitted by one transaction.
+	LogIndex uint64
 }
 
 // Tokens describes tokens supported by connector.
@@ -158,6 +204,26 @@ type Estimation struct {
 	EstimatedConfirmation uint32
 }
 
+// TxStatus defines status of the transaction on chain.
+type TxStatus string
+
+const (
+	// TxStatusNotFound indicates that node knows nothing about the transaction, it was dropped or never sent.
+	TxStatusNotFound TxStatus = "NOT_FOUND"
+	// TxStatusPending indicates that transaction waits to be included into the block.
+	TxStatusPending TxStatus = "PENDING"
+	// TxStatusSucceeded indicates that transaction is included into the block and succeeded.
+	TxStatusSucceeded TxStatus = "SUCCEEDED"
+	// TxStatusFailed indicates that transaction is included into the block, but reverted, so it changed nothing.
+	TxStatusFailed TxStatus = "FAILED"
+)
+
+// TokenSupply describes amount of token locked in the bridge contract and total supply of the token.
+type TokenSupply struct {
+	BridgeBalance *big.Int
+	TotalSupply   *big.Int
+}
+
 // BridgeInSignatureRequest describes the values needed to generate bridge in signature.
 type BridgeInSignatureRequest struct {
 	User          []byte
@@ -10,7 +10,6 @@ import (
 
 	"github.com/caarlos0/env/v6"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/joho/godotenv"
 	_ "github.com/joho/godotenv/autoload"
 	"golang.org/x/sync/errgroup"
@@ -171,8 +170,8 @@ func EthRun(t *testing.T, test func(ctx context.Context, t *testing.T)) {
 	}
 
 	{ // Eth server setup.
-		// connect client to default http connection node.
-		ethClient, err := ethclient.Dial(config.Config.NodeAddress)
+		// connect client to http nodes, calls fail over to fallback nodes.
+		ethClient, err := evm.DialMultiClient(ctx, config.Config.NodeAddresses(), config.Config.NodesConfig())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -208,6 +207,7 @@ func EthRun(t *testing.T, test func(ctx context.Context, t *testing.T)) {
 			instance,
 			transfer,
 			ethClient,
+			evm.NewWebsocketLogStream(config.Config.WsNodeAddress),
 		)
 	}
 
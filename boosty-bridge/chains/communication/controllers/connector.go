@@ -124,63 +124,15 @@ func (s *Connector) EventStream(req *connectorpb.EventsRequest, stream bridgecon
 					return status.Error(codes.Internal, err.Error())
 				}
 
-				var resp connectorpb.Event
-				switch eventFund.Type {
-				case chains.EventTypeIn:
-					resp = connectorpb.Event{
-						Variant: &connectorpb.Event_FundsIn{
-							FundsIn: &connectorpb.EventFundsIn{
-								From: &connectorpb.Address{
-									Address: eventFund.EventFundsIn.From,
-								},
-								To: &transferspb.StringNetworkAddress{
-									NetworkName: eventFund.EventFundsIn.To.NetworkName,
-									Address:     eventFund.EventFundsIn.To.Address,
-								},
-								Amount: eventFund.EventFundsIn.Amount,
-								Token: &connectorpb.Address{
-									Address: eventFund.EventFundsIn.Token,
-								},
-								Tx: &connectorpb.TransactionInfo{
-									Hash:        eventFund.EventFundsIn.Tx.Hash,
-									Blocknumber: eventFund.EventFundsIn.Tx.BlockNumber,
-									Sender:      eventFund.EventFundsIn.Tx.Sender,
-								},
-							},
-						},
-					}
-				case chains.EventTypeOut:
-					resp = connectorpb.Event{
-						Variant: &connectorpb.Event_FundsOut{
-							FundsOut: &connectorpb.EventFundsOut{
-								From: &transferspb.StringNetworkAddress{
-									NetworkName: eventFund.EventFundsOut.From.NetworkName,
-									Address:     eventFund.EventFundsOut.From.Address,
-								},
-								To: &connectorpb.Address{
-									Address: eventFund.EventFundsOut.To,
-								},
-								Amount: eventFund.EventFundsOut.Amount,
-								Token: &connectorpb.Address{
-									Address: eventFund.EventFundsOut.Token,
-								},
-								Tx: &connectorpb.TransactionInfo{
-									Hash:        eventFund.EventFundsOut.Tx.Hash,
-									Blocknumber: eventFund.EventFundsOut.Tx.BlockNumber,
-									Sender:      eventFund.EventFundsOut.Tx.Sender,
-								},
-							},
-						},
-					}
-				default:
-					err := Error.New("invalid event type")
+				resp, err := toPbEvent(eventFund)
+				if err != nil {
 					s.log.Error("", err)
 					return status.Error(codes.Internal, err.Error())
 				}
 
-				s.logEvent(eventFund.Type, &resp)
+				s.logEvent(eventFund.Type, resp)
 
-				if err := stream.Send(&resp); err != nil {
+				if err := stream.Send(resp); err != nil {
 					s.log.Error("couldn't send event fund", Error.Wrap(err))
 					return status.Error(codes.Internal, Error.Wrap(err).Error())
 				}
@@ -352,6 +304,74 @@ func (s *Connector) CancelSignature(ctx context.Context, req *transferspb.Cancel
 	return &response, nil
 }
 
+// TokenSupply returns balance of the bridge contract and total supply of the token.
+func (s *Connector) TokenSupply(ctx context.Context, req *connectorpb.TokenSupplyRequest) (*connectorpb.TokenSupplyResponse, error) {
+	if len(req.GetToken().GetAddress()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	supply, err := s.connector.TokenSupply(ctx, req.GetToken().GetAddress())
+	if err != nil {
+		s.log.Error("could not get token supply", Error.Wrap(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := connectorpb.TokenSupplyResponse{
+		BridgeBalance: supply.BridgeBalance.String(),
+		TotalSupply:   supply.TotalSupply.String(),
+	}
+	return &response, nil
+}
+
+// SetPaused pauses or unpauses bridge contract.
+func (s *Connector) SetPaused(ctx context.Context, req *connectorpb.SetPausedRequest) (*connectorpb.SetPausedResponse, error) {
+	txHash, err := s.connector.SetPaused(ctx, req.GetPaused())
+	if err != nil {
+		s.log.Error("could not set paused", Error.Wrap(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &connectorpb.SetPausedResponse{Txhash: txHash}, nil
+}
+
+// EventRange returns historical bridge events of the bounded range of blocks.
+func (s *Connector) EventRange(ctx context.Context, req *connectorpb.EventRangeRequest) (*connectorpb.EventRangeResponse, error) {
+	if err := chains.ValidateEventRange(req.GetFromBlock(), req.GetToBlock()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	}
+
+	events, err := s.connector.EventRange(ctx, req.GetFromBlock(), req.GetToBlock())
+	if err != nil {
+		s.log.Error("could not read events range", Error.Wrap(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &connectorpb.EventRangeResponse{Events: make([]*connectorpb.Event, 0, len(events))}
+	for _, event := range events {
+		pbEvent, err := toPbEvent(event)
+		if err != nil {
+			s.log.Error("", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp.Events = append(resp.Events, pbEvent)
+	}
+
+	return resp, nil
+}
+
+// TransactionStatus returns status of the transaction on chain.
+func (s *Connector) TransactionStatus(ctx context.Context, req *connectorpb.TransactionStatusRequest) (*connectorpb.TransactionStatusResponse, error) {
+	txStatus, err := s.connector.TransactionStatus(ctx, req.GetTxhash())
+	if err != nil {
+		s.log.Error("could not get transaction status", Error.Wrap(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbStatus := connectorpb.TransactionStatusResponse_Status_value["STATUS_"+string(txStatus)]
+	return &connectorpb.TransactionStatusResponse{Status: connectorpb.TransactionStatusResponse_Status(pbStatus)}, nil
+}
+
 func (s *Connector) logEvent(eventType chains.EventType, event *connectorpb.Event) {
 	s.log.Debug(fmt.Sprintf("time: %s, send event to bridge with params: ", time.Now().Format(time.RFC1123)))
 	s.log.Debug(fmt.Sprintf("event type: %d", eventType))
@@ -366,6 +386,9 @@ func (s *Connector) logEvent(eventType chains.EventType, event *connectorpb.Even
 		s.log.Debug(fmt.Sprintf("tx hash: %s", hex.EncodeToString(event.GetFundsIn().GetTx().GetHash())))
 		s.log.Debug(fmt.Sprintf("block number: %d", event.GetFundsIn().GetTx().GetBlocknumber()))
 		s.log.Debug(fmt.Sprintf("sender: %s", hex.EncodeToString(event.GetFundsIn().GetTx().GetSender())))
+		s.log.Debug(fmt.Sprintf("log index: %d", event.GetFundsIn().GetTx().GetLogIndex()))
+		s.log.Debug(fmt.Sprintf("nonce: %d", event.GetFundsIn().GetNonce()))
+		s.log.Debug(fmt.Sprintf("removed: %t", event.GetFundsIn().GetRemoved()))
 		s.log.Debug("")
 	case chains.EventTypeOut:
 		s.log.Debug(fmt.Sprintf("from network name: %s", event.GetFundsOut().GetFrom().GetNetworkName()))
@@ -376,6 +399,11 @@ func (s *Connector) logEvent(eventType chains.EventType, event *connectorpb.Even
 		s.log.Debug(fmt.Sprintf("tx hash: %s", hex.EncodeToString(event.GetFundsOut().GetTx().GetHash())))
 		s.log.Debug(fmt.Sprintf("block number: %d", event.GetFundsOut().GetTx().GetBlocknumber()))
 		s.log.Debug(fmt.Sprintf("sender: %s", hex.EncodeToString(event.GetFundsOut().GetTx().GetSender())))
+		s.log.Debug(fmt.Sprintf("log index: %d", event.GetFundsOut().GetTx().GetLogIndex()))
+		s.log.Debug(fmt.Sprintf("transaction id: %d", event.GetFundsOut().GetTransactionId()))
+		s.log.Debug("")
+	case chains.EventTypeProgress:
+		s.log.Debug(fmt.Sprintf("block number: %d", event.GetProgress().GetBlockNumber()))
 		s.log.Debug("")
 	}
 }
@@ -390,3 +418,70 @@ func (s *Connector) logBridgeOut(req *connectorpb.TokenOutRequest) {
 	s.log.Debug(fmt.Sprintf("transaction id: %d", req.GetTransactionId()))
 	s.log.Debug("")
 }
+
+// toPbEvent converts bridge event to its protobuf representation.
+func toPbEvent(eventFund chains.EventVariant) (*connectorpb.Event, error) {
+	switch eventFund.Type {
+	case chains.EventTypeIn:
+		return &connectorpb.Event{
+			Variant: &connectorpb.Event_FundsIn{
+				FundsIn: &connectorpb.EventFundsIn{
+					From: &connectorpb.Address{
+						Address: eventFund.EventFundsIn.From,
+					},
+					To: &transferspb.StringNetworkAddress{
+						NetworkName: eventFund.EventFundsIn.To.NetworkName,
+						Address:     eventFund.EventFundsIn.To.Address,
+					},
+					Amount: eventFund.EventFundsIn.Amount,
+					Token: &connectorpb.Address{
+						Address: eventFund.EventFundsIn.Token,
+					},
+					Tx: &connectorpb.TransactionInfo{
+						Hash:        eventFund.EventFundsIn.Tx.Hash,
+						Blocknumber: eventFund.EventFundsIn.Tx.BlockNumber,
+						Sender:      eventFund.EventFundsIn.Tx.Sender,
+						LogIndex:    eventFund.EventFundsIn.Tx.LogIndex,
+					},
+					Nonce:   eventFund.EventFundsIn.Nonce,
+					Removed: eventFund.EventFundsIn.Removed,
+				},
+			},
+		}, nil
+	case chains.EventTypeOut:
+		return &connectorpb.Event{
+			Variant: &connectorpb.Event_FundsOut{
+				FundsOut: &connectorpb.EventFundsOut{
+					From: &transferspb.StringNetworkAddress{
+						NetworkName: eventFund.EventFundsOut.From.NetworkName,
+						Address:     eventFund.EventFundsOut.From.Address,
+					},
+					To: &connectorpb.Address{
+						Address: eventFund.EventFundsOut.To,
+					},
+					Amount: eventFund.EventFundsOut.Amount,
+					Token: &connectorpb.Address{
+						Address: eventFund.EventFundsOut.Token,
+					},
+					Tx: &connectorpb.TransactionInfo{
+						Hash:        eventFund.EventFundsOut.Tx.Hash,
+						Blocknumber: eventFund.EventFundsOut.Tx.BlockNumber,
+						Sender:      eventFund.EventFundsOut.Tx.Sender,
+						LogIndex:    eventFund.EventFundsOut.Tx.LogIndex,
+					},
+					TransactionId: eventFund.EventFundsOut.TransactionID,
+				},
+			},
+		}, nil
+	case chains.EventTypeProgress:
+		return &connectorpb.Event{
+			Variant: &connectorpb.Event_Progress{
+				Progress: &connectorpb.EventProgress{
+					BlockNumber: eventFund.EventProgress.BlockNumber,
+				},
+			},
+		}, nil
+	default:
+		return nil, Error.New("invalid event type")
+	}
+}
@@ -5,20 +5,18 @@ package evm
 
 import (
 	"context"
-	"errors"
 	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 
 	"tricorn/bridge/networks"
+	"tricorn/pkg/multinode"
 )
 
-// ErrBlockchainRework represents error for events that have been removed due to blockchain rework.
-var ErrBlockchainRework = errors.New("blockchain event removed due to rework")
-
-// listeningLimit defines the limit for listing event.
-const listeningLimit = 2500
-
 // Config contains eth configurable values.
 // TODO: add gasLimit for In and Out.
 type Config struct {
@@ -38,8 +36,114 @@ type Config struct {
 	NumOfSubscribers               int            `env:"NUM_OF_SUBSCRIBERS"`
 	SignatureValidityTime          uint32         `env:"SIGNATURE_VALIDITY_TIME"`
 	EventsReadingIntervalInSeconds uint32         `env:"EVENTS_READING_INTERVAL_IN_SECONDS"`
+
+	// TransactionType defines whether bridge out transactions are legacy or EIP-1559 ones,
+	// legacy type has to be used for chains without London upgrade.
+	TransactionType TransactionType `env:"TRANSACTION_TYPE" envDefault:"LEGACY"`
+	// PriorityFeePercentile defines percentile of priority fees paid in recent blocks, which is used as priority fee.
+	PriorityFeePercentile float64 `env:"PRIORITY_FEE_PERCENTILE" envDefault:"50"`
+	// FeeHistoryBlocks defines number of recent blocks priority fees are taken from.
+	FeeHistoryBlocks uint64 `env:"FEE_HISTORY_BLOCKS" envDefault:"10"`
+	// BaseFeeMultiplier defines how many times base fee may grow before transaction stops being includable.
+	BaseFeeMultiplier float64 `env:"BASE_FEE_MULTIPLIER" envDefault:"2"`
+	// MaxPriorityFeePerGasInWei caps priority fee, zero means no cap.
+	MaxPriorityFeePerGasInWei uint64 `env:"MAX_PRIORITY_FEE_PER_GAS_IN_WEI" envDefault:"0"`
+	// MaxFeePerGasInWei caps total fee per gas, zero means no cap.
+	MaxFeePerGasInWei uint64 `env:"MAX_FEE_PER_GAS_IN_WEI" envDefault:"0"`
+
+	// RelayerSelection defines how outbound transfers are assigned to relayer keys.
+	RelayerSelection RelayerSelection `env:"RELAYER_SELECTION" envDefault:"ROUND_ROBIN"`
+	// StuckTransactionTimeoutInSeconds defines how long relayer transaction may stay not mined before relayer is
+	// not picked for new transfers until it is mined, zero disables the check.
+	StuckTransactionTimeoutInSeconds uint32 `env:"STUCK_TRANSACTION_TIMEOUT_IN_SECONDS" envDefault:"600"`
+
+	// WsNodeAddress is a websocket address of the node, which streams logs in real time. Empty address means
+	// that new events are read by polling only.
+	WsNodeAddress string `env:"WS_NODE_ADDRESS" envDefault:""`
+	// EventsResubscribeIntervalInSeconds defines how long events are polled after log subscription is lost,
+	// before the next subscription attempt.
+	EventsResubscribeIntervalInSeconds uint32 `env:"EVENTS_RESUBSCRIBE_INTERVAL_IN_SECONDS" envDefault:"30"`
+
+	// EventsBlockRange is an initial number of blocks, logs of which are requested at once while past events are read.
+	// Range is halved when node rejects it as too large and grows after successful requests up to EventsMaxBlockRange.
+	EventsBlockRange    uint64 `env:"EVENTS_BLOCK_RANGE" envDefault:"2500"`
+	EventsMaxBlockRange uint64 `env:"EVENTS_MAX_BLOCK_RANGE" envDefault:"10000"`
+	// EventsReadingWorkers is a number of blocks ranges requested concurrently, events are delivered in order of blocks.
+	EventsReadingWorkers int `env:"EVENTS_READING_WORKERS" envDefault:"1"`
+
+	// FallbackNodeAddresses are addresses of nodes, which are used when the node with NodeAddress fails or lags behind.
+	FallbackNodeAddresses []string `env:"FALLBACK_NODE_ADDRESSES" envDefault:""`
+	// NodeQuorum is a number of nodes, which have to agree on block number and logs. Logs streamed over websocket
+	// are not used if quorum is required, since they come from single node.
+	NodeQuorum int `env:"NODE_QUORUM" envDefault:"1"`
+	// NodeMaxLagBlocks is a number of blocks node may lag behind the highest node, zero disables lag check.
+	NodeMaxLagBlocks uint64 `env:"NODE_MAX_LAG_BLOCKS" envDefault:"5"`
+	// NodeHealthCheckIntervalInSeconds defines how often nodes health is checked.
+	NodeHealthCheckIntervalInSeconds uint32 `env:"NODE_HEALTH_CHECK_INTERVAL_IN_SECONDS" envDefault:"15"`
 }
 
+// NodeAddresses returns addresses of all nodes, the primary node goes first.
+func (config Config) NodeAddresses() []string {
+	return append([]string{config.NodeAddress}, config.FallbackNodeAddresses...)
+}
+
+// NodesConfig returns configuration of nodes pool.
+func (config Config) NodesConfig() multinode.Config {
+	return multinode.Config{
+		Quorum:              config.NodeQuorum,
+		MaxLag:              config.NodeMaxLagBlocks,
+		HealthCheckInterval: time.Duration(config.NodeHealthCheckIntervalInSeconds) * time.Second,
+	}
+}
+
+// RelayerSelection defines strategy of relayer selection for outbound transfer.
+type RelayerSelection string
+
+const (
+	// RelayerSelectionRoundRobin assigns transfers to relayers in turn.
+	RelayerSelectionRoundRobin RelayerSelection = "ROUND_ROBIN"
+	// RelayerSelectionLeastPending assigns transfer to relayer with the least number of not mined transactions.
+	RelayerSelectionLeastPending RelayerSelection = "LEAST_PENDING"
+)
+
+// TransactionType defines type of transactions sent by connector.
+type TransactionType string
+
+const (
+	// TransactionTypeLegacy defines legacy transactions with gas price.
+	TransactionTypeLegacy TransactionType = "LEGACY"
+	// TransactionTypeDynamicFee defines EIP-1559 transactions with fee cap and priority fee.
+	TransactionTypeDynamicFee TransactionType = "DYNAMIC_FEE"
+)
+
+// Client exposes access to the evm node methods used by connector.
+type Client interface {
+	bind.ContractBackend
+	// NonceAt returns the account nonce of the given account at the given block, latest block is used when number is nil.
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	// BlockNumber returns the most recent block number.
+	BlockNumber(ctx context.Context) (uint64, error)
+	// FeeHistory returns base fees and priority fees of recent blocks.
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	// TransactionByHash returns the transaction with the given hash and whether it is still pending.
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	// TransactionReceipt returns the receipt of the mined transaction.
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+	// Close closes underlying client connection.
+	Close()
+}
+
+// LogStream exposes access to the node, which streams logs over persistent connection.
+type LogStream interface {
+	// SubscribeFilterLogs streams new logs matching the query until subscription is cancelled or connection is lost.
+	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
+	// Close closes underlying connection.
+	Close()
+}
+
+// DialLogStream connects to the node, which streams logs.
+type DialLogStream func(ctx context.Context) (LogStream, error)
+
 // Transfer exposes access to the evm transfer methods.
 type Transfer interface {
 	// TransferOutSignature generates signature for transfer out transaction.
@@ -0,0 +1,77 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"sort"
+)
+
+// dynamicFees returns fee cap and priority fee for EIP-1559 transaction.
+// Priority fee is a median of the configured percentile of priority fees paid in recent blocks,
+// fee cap leaves room for the base fee growth until transaction is included.
+func (service *Service) dynamicFees(ctx context.Context) (feeCap *big.Int, tipCap *big.Int, err error) {
+	head, err := service.ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if head.BaseFee == nil {
+		return nil, nil, Error.New("chain does not support dynamic fee transactions")
+	}
+
+	tipCap, err = service.priorityFee(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if maxTipCap := new(big.Int).SetUint64(service.config.MaxPriorityFeePerGasInWei); maxTipCap.Sign() > 0 && tipCap.Cmp(maxTipCap) > 0 {
+		tipCap = maxTipCap
+	}
+
+	baseFee := new(big.Float).Mul(new(big.Float).SetInt(head.BaseFee), big.NewFloat(service.config.BaseFeeMultiplier))
+	feeCap, _ = baseFee.Int(nil)
+	feeCap.Add(feeCap, tipCap)
+
+	if maxFeeCap := new(big.Int).SetUint64(service.config.MaxFeePerGasInWei); maxFeeCap.Sign() > 0 && feeCap.Cmp(maxFeeCap) > 0 {
+		if head.BaseFee.Cmp(maxFeeCap) >= 0 {
+			return nil, nil, Error.New("base fee %s exceeds max fee per gas %s", head.BaseFee, maxFeeCap)
+		}
+
+		feeCap = maxFeeCap
+		if tipCap.Cmp(feeCap) > 0 {
+			tipCap = feeCap
+		}
+	}
+
+	return feeCap, tipCap, nil
+}
+
+// priorityFee returns median of the configured percentile of priority fees paid in recent blocks.
+func (service *Service) priorityFee(ctx context.Context) (*big.Int, error) {
+	history, err := service.ethClient.FeeHistory(ctx, service.config.FeeHistoryBlocks, nil, []float64{service.config.PriorityFeePercentile})
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make([]*big.Int, 0, len(history.Reward))
+	for i, reward := range history.Reward {
+		// empty blocks say nothing about priority fees.
+		if len(reward) == 0 || (i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0) {
+			continue
+		}
+
+		rewards = append(rewards, reward[0])
+	}
+
+	if len(rewards) == 0 {
+		return service.ethClient.SuggestGasTipCap(ctx)
+	}
+
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].Cmp(rewards[j]) < 0
+	})
+
+	return new(big.Int).Set(rewards[len(rewards)/2]), nil
+}
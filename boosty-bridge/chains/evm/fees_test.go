@@ -0,0 +1,369 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/networks"
+	"tricorn/chains"
+	"tricorn/chains/evm"
+	"tricorn/internal/contracts/evm/bridge"
+	"tricorn/internal/logger/zaplog"
+)
+
+// simulatedChainID is a chain id of go-ethereum simulated backend.
+const simulatedChainID = 1337
+
+// gwei converts gwei amount to wei.
+func gwei(value int64) *big.Int {
+	return big.NewInt(value * params.GWei)
+}
+
+// simulatedClient adds to simulated backend node methods it lacks.
+type simulatedClient struct {
+	*backends.SimulatedBackend
+
+	mutex sync.Mutex
+	// queued contains transactions with future nonces, which node keeps until previous nonces are sent.
+	queued map[common.Address]map[uint64]*types.Transaction
+}
+
+// SendTransaction queues transactions with future nonces like node transaction pool does,
+// simulated backend accepts only transactions with the next nonce.
+func (client *simulatedClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(simulatedChainID)), tx)
+	if err != nil {
+		return err
+	}
+
+	nonce, err := client.PendingNonceAt(ctx, sender)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() > nonce {
+		if client.queued[sender] == nil {
+			client.queued[sender] = make(map[uint64]*types.Transaction)
+		}
+		client.queued[sender][tx.Nonce()] = tx
+		return nil
+	}
+
+	if err = client.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	for next := tx.Nonce() + 1; ; next++ {
+		queued, ok := client.queued[sender][next]
+		if !ok {
+			return nil
+		}
+		delete(client.queued[sender], next)
+
+		if err = client.SimulatedBackend.SendTransaction(ctx, queued); err != nil {
+			return err
+		}
+	}
+}
+
+func (client *simulatedClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return client.Blockchain().CurrentBlock().NumberU64(), nil
+}
+
+// FeeHistory returns priority fees of recent blocks, all transactions are expected to use the same amount of gas.
+func (client *simulatedClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	last := client.Blockchain().CurrentBlock().NumberU64()
+	if lastBlock != nil {
+		last = lastBlock.Uint64()
+	}
+	oldest := uint64(0)
+	if last+1 > blockCount {
+		oldest = last + 1 - blockCount
+	}
+
+	history := &ethereum.FeeHistory{OldestBlock: new(big.Int).SetUint64(oldest)}
+	for number := oldest; number <= last; number++ {
+		block, err := client.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, err
+		}
+
+		tips := make([]*big.Int, 0, len(block.Transactions()))
+		for _, tx := range block.Transactions() {
+			tip, err := tx.EffectiveGasTip(block.BaseFee())
+			if err != nil {
+				return nil, err
+			}
+			tips = append(tips, tip)
+		}
+		sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+
+		rewards := make([]*big.Int, len(rewardPercentiles))
+		for i, percentile := range rewardPercentiles {
+			rewards[i] = new(big.Int)
+			if len(tips) != 0 {
+				rewards[i] = tips[int(percentile/100*float64(len(tips)-1))]
+			}
+		}
+
+		history.Reward = append(history.Reward, rewards)
+		history.BaseFee = append(history.BaseFee, block.BaseFee())
+		history.GasUsedRatio = append(history.GasUsedRatio, float64(block.GasUsed())/float64(block.GasLimit()))
+	}
+
+	return history, nil
+}
+
+func (client *simulatedClient) Close() {
+	_ = client.SimulatedBackend.Close()
+}
+
+// relayerMock signs transactions with relayer keys, as bridge does.
+type relayerMock struct {
+	keys []*ecdsa.PrivateKey
+}
+
+func (relayer *relayerMock) Sign(ctx context.Context, req chains.SignRequest) ([]byte, error) {
+	for _, key := range relayer.keys {
+		if len(req.PublicKey) == 0 || bytes.Equal(req.PublicKey, publicKey(key)) {
+			return crypto.Sign(req.Data, key)
+		}
+	}
+
+	return nil, errors.New("no relayer key")
+}
+
+func (relayer *relayerMock) PublicKey(ctx context.Context, networkType networks.Type) ([]byte, error) {
+	return publicKey(relayer.keys[0]), nil
+}
+
+func (relayer *relayerMock) PublicKeys(ctx context.Context, networkType networks.Type) ([][]byte, error) {
+	publicKeys := make([][]byte, 0, len(relayer.keys))
+	for _, key := range relayer.keys {
+		publicKeys = append(publicKeys, publicKey(key))
+	}
+
+	return publicKeys, nil
+}
+
+// publicKey returns public key curve coordinates, as signer does.
+func publicKey(key *ecdsa.PrivateKey) []byte {
+	// cut off uncompressed public key prefix.
+	return crypto.FromECDSAPub(&key.PublicKey)[1:]
+}
+
+// simulatedBridge describes bridge contract deployed to simulated chain.
+type simulatedBridge struct {
+	client   *simulatedClient
+	address  common.Address
+	instance *bridge.Bridge
+	owner    *ecdsa.PrivateKey
+	userKey  *ecdsa.PrivateKey
+	user     common.Address
+	token    common.Address
+}
+
+// newSimulatedBridge deploys bridge contract owned by generated key to simulated chain.
+func newSimulatedBridge(t *testing.T) *simulatedBridge {
+	ownerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// token contract returns 1 for any call, so it has balance and successfully transfers it.
+	token := common.HexToAddress("0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69")
+	tokenCode := common.FromHex("0x600160005260206000f3")
+
+	balance := new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+	client := &simulatedClient{SimulatedBackend: backends.NewSimulatedBackend(core.GenesisAlloc{
+		crypto.PubkeyToAddress(ownerKey.PublicKey): {Balance: balance},
+		crypto.PubkeyToAddress(userKey.PublicKey):  {Balance: balance},
+		token: {Code: tokenCode, Balance: new(big.Int)},
+	}, 30_000_000), queued: make(map[common.Address]map[uint64]*types.Transaction)}
+	t.Cleanup(client.Close)
+
+	deployer, err := bind.NewKeyedTransactorWithChainID(ownerKey, big.NewInt(simulatedChainID))
+	require.NoError(t, err)
+	address, _, instance, err := bridge.DeployBridge(deployer, client, crypto.PubkeyToAddress(ownerKey.PublicKey))
+	require.NoError(t, err)
+	client.Commit()
+
+	return &simulatedBridge{
+		client:   client,
+		address:  address,
+		instance: instance,
+		owner:    ownerKey,
+		userKey:  userKey,
+		user:     crypto.PubkeyToAddress(userKey.PublicKey),
+		token:    token,
+	}
+}
+
+// transfer sends transfer transaction of user with specified nonce and priority fee.
+func (simulated *simulatedBridge) transfer(t *testing.T, nonce uint64, tip *big.Int) {
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(simulatedChainID),
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, big.NewInt(10*params.GWei)),
+		Gas:       params.TxGas,
+		To:        &simulated.user,
+		Value:     big.NewInt(1),
+	}), types.LatestSignerForChainID(big.NewInt(simulatedChainID)), simulated.userKey)
+	require.NoError(t, err)
+	require.NoError(t, simulated.client.SendTransaction(context.Background(), tx))
+}
+
+// service returns connector service, which sends transactions with specified relayer keys.
+// Events are streamed with dialLogStream, if it is set, otherwise they are polled.
+func (simulated *simulatedBridge) service(config evm.Config, dialLogStream evm.DialLogStream, relayerKeys ...*ecdsa.PrivateKey) *evm.Service {
+	config.ChainID = simulatedChainID
+	config.BridgeContractAddress = simulated.address
+	config.GasLimitIncreasingCoefficient = 1.2
+	config.GasPriceIncreasingCoefficient = 1
+	if config.TransactionType == "" {
+		config.TransactionType = evm.TransactionTypeLegacy
+	}
+	if config.RelayerSelection == "" {
+		config.RelayerSelection = evm.RelayerSelectionRoundRobin
+	}
+
+	return evm.New(context.Background(), config, zaplog.NewLog(), &relayerMock{keys: relayerKeys}, simulated.instance, nil, simulated.client, dialLogStream)
+}
+
+// bridgeOut sends bridge out transaction of single token to the user.
+func (simulated *simulatedBridge) bridgeOut(ctx context.Context, service *evm.Service) ([]byte, error) {
+	return service.BridgeOut(ctx, chains.TokenOutRequest{
+		Amount:        big.NewInt(1),
+		Token:         simulated.token.Bytes(),
+		To:            simulated.user.Bytes(),
+		From:          networks.Address{NetworkName: "CASPER-TEST", Address: "01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c"},
+		TransactionID: big.NewInt(1),
+	})
+}
+
+// mined returns mined successful transaction by hash.
+func (simulated *simulatedBridge) mined(t *testing.T, txHash []byte) *types.Transaction {
+	ctx := context.Background()
+
+	receipt, err := simulated.client.TransactionReceipt(ctx, common.BytesToHash(txHash))
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	tx, _, err := simulated.client.TransactionByHash(ctx, common.BytesToHash(txHash))
+	require.NoError(t, err)
+
+	return tx
+}
+
+func TestBridgeOutFees(t *testing.T) {
+	ctx := context.Background()
+	simulated := newSimulatedBridge(t)
+	client := simulated.client
+
+	// recent blocks contain transactions with priority fees from 1 to 5 gwei.
+	for tip := int64(1); tip <= 5; tip++ {
+		nonce, err := client.PendingNonceAt(ctx, simulated.user)
+		require.NoError(t, err)
+
+		simulated.transfer(t, nonce, big.NewInt(tip*params.GWei))
+		client.Commit()
+	}
+
+	bridgeOut := func(t *testing.T, config evm.Config) (*types.Transaction, *big.Int, error) {
+		config.PriorityFeePercentile = 50
+		config.FeeHistoryBlocks = 5
+		config.BaseFeeMultiplier = 2
+
+		head, err := client.HeaderByNumber(ctx, nil)
+		require.NoError(t, err)
+
+		txHash, err := simulated.bridgeOut(ctx, simulated.service(config, nil, simulated.owner))
+		if err != nil {
+			return nil, head.BaseFee, err
+		}
+		client.Commit()
+
+		return simulated.mined(t, txHash), head.BaseFee, nil
+	}
+
+	t.Run("Dynamic fee", func(t *testing.T) {
+		tx, baseFee, err := bridgeOut(t, evm.Config{TransactionType: evm.TransactionTypeDynamicFee})
+		require.NoError(t, err)
+
+		assert.EqualValues(t, types.DynamicFeeTxType, tx.Type())
+		assert.Equal(t, gwei(3), tx.GasTipCap())
+		assert.Equal(t, new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), gwei(3)), tx.GasFeeCap())
+	})
+
+	t.Run("Priority fee cap", func(t *testing.T) {
+		tx, baseFee, err := bridgeOut(t, evm.Config{
+			TransactionType:           evm.TransactionTypeDynamicFee,
+			MaxPriorityFeePerGasInWei: gwei(2).Uint64(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, gwei(2), tx.GasTipCap())
+		assert.Equal(t, new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), gwei(2)), tx.GasFeeCap())
+	})
+
+	t.Run("Fee cap", func(t *testing.T) {
+		head, err := client.HeaderByNumber(ctx, nil)
+		require.NoError(t, err)
+		maxFee := new(big.Int).Add(head.BaseFee, gwei(1))
+
+		tx, _, err := bridgeOut(t, evm.Config{
+			TransactionType:   evm.TransactionTypeDynamicFee,
+			MaxFeePerGasInWei: maxFee.Uint64(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, maxFee, tx.GasFeeCap())
+		assert.Equal(t, maxFee, tx.GasTipCap())
+	})
+
+	t.Run("Negative base fee exceeds fee cap", func(t *testing.T) {
+		head, err := client.HeaderByNumber(ctx, nil)
+		require.NoError(t, err)
+
+		_, _, err = bridgeOut(t, evm.Config{
+			TransactionType:   evm.TransactionTypeDynamicFee,
+			MaxFeePerGasInWei: head.BaseFee.Uint64(),
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("Legacy", func(t *testing.T) {
+		tx, _, err := bridgeOut(t, evm.Config{TransactionType: evm.TransactionTypeLegacy})
+		require.NoError(t, err)
+
+		assert.EqualValues(t, types.LegacyTxType, tx.Type())
+	})
+
+	t.Run("Negative unsupported transaction type", func(t *testing.T) {
+		_, _, err := bridgeOut(t, evm.Config{TransactionType: "UNKNOWN"})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/zeebo/errs"
+
+	"tricorn/pkg/multinode"
+)
+
+// ensures that MultiClient implements Client.
+var _ Client = (*MultiClient)(nil)
+
+// MultiClient is a Client, which spreads calls between several nodes. Calls fail over to the next healthy node,
+// block number and logs, which trigger outbound transfers, are read from quorum of nodes if it is configured.
+type MultiClient struct {
+	clients []Client
+	pool    *multinode.Pool
+}
+
+// NewMultiClient is a constructor for MultiClient, the first client is the primary one.
+func NewMultiClient(clients []Client, config multinode.Config) *MultiClient {
+	return &MultiClient{
+		clients: clients,
+		pool: multinode.New(config, len(clients), func(ctx context.Context, node int) (uint64, error) {
+			return clients[node].BlockNumber(ctx)
+		}),
+	}
+}
+
+// DialMultiClient connects to nodes with specified addresses.
+func DialMultiClient(ctx context.Context, addresses []string, config multinode.Config) (*MultiClient, error) {
+	clients := make([]Client, 0, len(addresses))
+	for _, address := range addresses {
+		client, err := ethclient.DialContext(ctx, address)
+		if err != nil {
+			for _, client := range clients {
+				client.Close()
+			}
+
+			return nil, Error.Wrap(err)
+		}
+
+		clients = append(clients, client)
+	}
+
+	return NewMultiClient(clients, config), nil
+}
+
+// nodeError marks errors returned by node for the request itself, e.g. reverted calls, as permanent,
+// so they are not retried with other nodes.
+func nodeError(err error) error {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return multinode.Permanent(err)
+	}
+
+	return err
+}
+
+// CodeAt returns the code of the given account.
+func (client *MultiClient) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		code, err = client.clients[node].CodeAt(ctx, contract, blockNumber)
+		return nodeError(err)
+	})
+
+	return code, err
+}
+
+// CallContract executes an Ethereum contract call with the specified data as the input.
+func (client *MultiClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		result, err = client.clients[node].CallContract(ctx, call, blockNumber)
+		return nodeError(err)
+	})
+
+	return result, err
+}
+
+// HeaderByNumber returns a block header from the current canonical chain.
+func (client *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		header, err = client.clients[node].HeaderByNumber(ctx, number)
+		return nodeError(err)
+	})
+
+	return header, err
+}
+
+// PendingCodeAt returns the code of the given account in the pending state.
+func (client *MultiClient) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		code, err = client.clients[node].PendingCodeAt(ctx, account)
+		return nodeError(err)
+	})
+
+	return code, err
+}
+
+// PendingNonceAt returns the account nonce of the given account in the pending state.
+func (client *MultiClient) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		nonce, err = client.clients[node].PendingNonceAt(ctx, account)
+		return nodeError(err)
+	})
+
+	return nonce, err
+}
+
+// NonceAt returns the account nonce of the given account at the given block.
+func (client *MultiClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		nonce, err = client.clients[node].NonceAt(ctx, account, blockNumber)
+		return nodeError(err)
+	})
+
+	return nonce, err
+}
+
+// SuggestGasPrice retrieves the currently suggested gas price.
+func (client *MultiClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		price, err = client.clients[node].SuggestGasPrice(ctx)
+		return nodeError(err)
+	})
+
+	return price, err
+}
+
+// SuggestGasTipCap retrieves the currently suggested gas tip cap.
+func (client *MultiClient) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		tip, err = client.clients[node].SuggestGasTipCap(ctx)
+		return nodeError(err)
+	})
+
+	return tip, err
+}
+
+// EstimateGas tries to estimate the gas needed to execute a specific transaction.
+func (client *MultiClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		gas, err = client.clients[node].EstimateGas(ctx, call)
+		return nodeError(err)
+	})
+
+	return gas, err
+}
+
+// SendTransaction injects the transaction into the pending pool for execution.
+func (client *MultiClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return client.pool.Do(ctx, func(node int) error {
+		return nodeError(client.clients[node].SendTransaction(ctx, tx))
+	})
+}
+
+// FeeHistory returns base fees and priority fees of recent blocks.
+func (client *MultiClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (history *ethereum.FeeHistory, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		history, err = client.clients[node].FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+		return nodeError(err)
+	})
+
+	return history, err
+}
+
+// TransactionByHash returns the transaction with the given hash and whether it is still pending. Pending transaction
+// may not have reached all nodes yet, so it is not found only if every node answers so.
+func (client *MultiClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	var group errs.Group
+	for _, node := range client.clients {
+		tx, pending, err := node.TransactionByHash(ctx, hash)
+		if err == nil {
+			return tx, pending, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			group.Add(err)
+		}
+	}
+
+	if err := group.Err(); err != nil {
+		return nil, false, err
+	}
+
+	return nil, false, ethereum.NotFound
+}
+
+// TransactionReceipt returns the receipt of the mined transaction.
+func (client *MultiClient) TransactionReceipt(ctx context.Context, hash common.Hash) (receipt *types.Receipt, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		receipt, err = client.clients[node].TransactionReceipt(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			return multinode.Permanent(err)
+		}
+		return nodeError(err)
+	})
+
+	return receipt, err
+}
+
+// BlockNumber returns the most recent block number reached by quorum of nodes.
+func (client *MultiClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return client.pool.Height(ctx)
+}
+
+// FilterLogs executes a filter query on quorum of nodes.
+func (client *MultiClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := client.pool.Quorum(ctx, func(node int) (interface{}, error) {
+		return client.clients[node].FilterLogs(ctx, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return logs.([]types.Log), nil
+}
+
+// SubscribeFilterLogs subscribes to the results of a streaming filter query on a single node.
+func (client *MultiClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (subscription ethereum.Subscription, err error) {
+	err = client.pool.Do(ctx, func(node int) (err error) {
+		subscription, err = client.clients[node].SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+
+	return subscription, err
+}
+
+// Close closes connections of all nodes.
+func (client *MultiClient) Close() {
+	for _, client := range client.clients {
+		client.Close()
+	}
+}
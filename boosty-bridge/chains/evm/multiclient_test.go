@@ -0,0 +1,214 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/chains/evm"
+	"tricorn/pkg/multinode"
+)
+
+// fakeNode is a fake evm json-rpc node with configurable height and logs.
+type fakeNode struct {
+	mutex    sync.Mutex
+	height   uint64
+	logs     []types.Log
+	gasPrice int64
+	down     bool
+	calls    map[string]int
+}
+
+func newFakeNode(height uint64, logs []types.Log) *fakeNode {
+	return &fakeNode{height: height, logs: logs, gasPrice: 1, calls: make(map[string]int)}
+}
+
+func (node *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.calls[req.Method]++
+	if node.down {
+		http.Error(w, "node is down", http.StatusBadGateway)
+		return
+	}
+
+	response := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	switch req.Method {
+	case "eth_blockNumber":
+		response["result"] = hexutil.Uint64(node.height)
+	case "eth_getLogs":
+		response["result"] = node.logs
+	case "eth_gasPrice":
+		response["result"] = hexutil.EncodeBig(big.NewInt(node.gasPrice))
+	case "eth_estimateGas":
+		response["error"] = map[string]interface{}{"code": 3, "message": "execution reverted"}
+	default:
+		response["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// callsOf returns number of calls of the method.
+func (node *fakeNode) callsOf(method string) int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	return node.calls[method]
+}
+
+// dialFakeNodes runs fake nodes and connects multi client to them.
+func dialFakeNodes(t *testing.T, config multinode.Config, nodes ...*fakeNode) *evm.MultiClient {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		server := httptest.NewServer(node)
+		t.Cleanup(server.Close)
+		addresses = append(addresses, server.URL)
+	}
+
+	client, err := evm.DialMultiClient(context.Background(), addresses, config)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+func TestMultiClient(t *testing.T) {
+	ctx := context.Background()
+
+	fundsLog := func(txHash string, logIndex uint) types.Log {
+		return types.Log{
+			Address:     common.HexToAddress("0x9744bC7A2D91928017E1DEdf98Ff7d912d6Cd263"),
+			Topics:      []common.Hash{common.HexToHash("0x01")},
+			Data:        []byte{1},
+			BlockNumber: 90,
+			TxHash:      common.HexToHash(txHash),
+			Index:       logIndex,
+		}
+	}
+	honestLogs := []types.Log{fundsLog("0xaa", 0), fundsLog("0xbb", 1)}
+	// fake deposit injected by malicious provider.
+	forgedLogs := append([]types.Log{fundsLog("0xff", 0)}, honestLogs...)
+
+	t.Run("Failover to the next node", func(t *testing.T) {
+		primary := newFakeNode(100, nil)
+		fallback := newFakeNode(100, nil)
+		fallback.gasPrice = 7
+		client := dialFakeNodes(t, multinode.Config{}, primary, fallback)
+
+		// nodes are healthy during the first health check.
+		_, err := client.BlockNumber(ctx)
+		require.NoError(t, err)
+
+		primary.mutex.Lock()
+		primary.down = true
+		primary.mutex.Unlock()
+
+		price, err := client.SuggestGasPrice(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 7, price.Int64())
+
+		// failed node is not called until it is healthy again.
+		_, err = client.SuggestGasPrice(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, primary.callsOf("eth_gasPrice"))
+	})
+
+	t.Run("Lagging node is not preferred", func(t *testing.T) {
+		lagging := newFakeNode(10, nil)
+		synced := newFakeNode(100, nil)
+		synced.gasPrice = 7
+		client := dialFakeNodes(t, multinode.Config{MaxLag: 5}, lagging, synced)
+
+		number, err := client.BlockNumber(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 100, number)
+
+		price, err := client.SuggestGasPrice(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 7, price.Int64())
+		assert.Equal(t, 0, lagging.callsOf("eth_gasPrice"))
+	})
+
+	t.Run("Request errors are not failed over", func(t *testing.T) {
+		primary := newFakeNode(100, nil)
+		fallback := newFakeNode(100, nil)
+		client := dialFakeNodes(t, multinode.Config{}, primary, fallback)
+
+		_, err := client.EstimateGas(ctx, ethereum.CallMsg{})
+		require.Error(t, err)
+		assert.Equal(t, 0, fallback.callsOf("eth_estimateGas"))
+	})
+
+	t.Run("Quorum logs", func(t *testing.T) {
+		malicious := newFakeNode(100, forgedLogs)
+		client := dialFakeNodes(t, multinode.Config{Quorum: 2}, malicious, newFakeNode(100, honestLogs), newFakeNode(100, honestLogs))
+
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{})
+		require.NoError(t, err)
+		require.Len(t, logs, len(honestLogs))
+		for i := range logs {
+			assert.Equal(t, honestLogs[i].TxHash, logs[i].TxHash)
+		}
+
+		// node, which returned different logs, is not trusted anymore.
+		price, err := client.SuggestGasPrice(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, price)
+		assert.Equal(t, 0, malicious.callsOf("eth_gasPrice"))
+	})
+
+	t.Run("Negative no quorum", func(t *testing.T) {
+		down := newFakeNode(100, nil)
+		down.down = true
+		client := dialFakeNodes(t, multinode.Config{Quorum: 2}, newFakeNode(100, forgedLogs), newFakeNode(100, honestLogs), down)
+
+		_, err := client.FilterLogs(ctx, ethereum.FilterQuery{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, multinode.ErrNoQuorum))
+	})
+
+	t.Run("Quorum block number", func(t *testing.T) {
+		client := dialFakeNodes(t, multinode.Config{Quorum: 2}, newFakeNode(100, nil), newFakeNode(98, nil), newFakeNode(50, nil))
+
+		number, err := client.BlockNumber(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 98, number)
+	})
+
+	t.Run("Negative quorum block number", func(t *testing.T) {
+		down := newFakeNode(100, nil)
+		down.down = true
+		client := dialFakeNodes(t, multinode.Config{Quorum: 2}, newFakeNode(100, nil), down)
+
+		_, err := client.BlockNumber(ctx)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, multinode.ErrNoQuorum))
+	})
+}
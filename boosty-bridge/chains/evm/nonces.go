@@ -0,0 +1,162 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceManager hands out nonces of relayer transactions, so concurrent transactions of the same relayer do not collide.
+// Nonces are tracked locally, node is used to skip nonces of transactions sent by someone else. Node could lag behind
+// sent transactions, so only nonces which were explicitly released are reused.
+type NonceManager struct {
+	client  Client
+	address common.Address
+
+	mutex    sync.Mutex
+	next     uint64
+	inFlight map[uint64]struct{}
+	sent     map[uint64]struct{}
+	released map[uint64]struct{}
+	// sentAt keeps time transactions were sent at until they are mined, node drops them from pending nonce earlier.
+	sentAt map[uint64]time.Time
+}
+
+// NewNonceManager is a constructor for NonceManager.
+func NewNonceManager(client Client, address common.Address) *NonceManager {
+	return &NonceManager{
+		client:   client,
+		address:  address,
+		inFlight: make(map[uint64]struct{}),
+		sent:     make(map[uint64]struct{}),
+		released: make(map[uint64]struct{}),
+		sentAt:   make(map[uint64]time.Time),
+	}
+}
+
+// Next reserves nonce for new transaction. Reserved nonce has to be either marked as sent or released.
+func (manager *NonceManager) Next(ctx context.Context) (uint64, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	pendingNonce, err := manager.client.PendingNonceAt(ctx, manager.address)
+	if err != nil {
+		return 0, err
+	}
+
+	// transactions were sent by someone else or local state is not initialized yet.
+	if pendingNonce > manager.next {
+		manager.next = pendingNonce
+	}
+
+	// nonces passed by the node are used, so they are neither tracked as sent nor reused.
+	for nonce := range manager.sent {
+		if nonce < pendingNonce {
+			delete(manager.sent, nonce)
+		}
+	}
+	for nonce := range manager.released {
+		if nonce < pendingNonce {
+			delete(manager.released, nonce)
+		}
+	}
+
+	// released nonce in the middle has to be filled, otherwise all following transactions get stuck.
+	for nonce := pendingNonce; nonce < manager.next; nonce++ {
+		if _, ok := manager.released[nonce]; ok {
+			delete(manager.released, nonce)
+			manager.inFlight[nonce] = struct{}{}
+			return nonce, nil
+		}
+	}
+
+	nonce := manager.next
+	manager.inFlight[nonce] = struct{}{}
+	manager.next++
+
+	return nonce, nil
+}
+
+// Sent marks nonce as used by transaction which was accepted by node, it is never reused even if node lags behind.
+func (manager *NonceManager) Sent(nonce uint64) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	delete(manager.inFlight, nonce)
+	manager.sent[nonce] = struct{}{}
+	manager.sentAt[nonce] = time.Now()
+}
+
+// Release returns nonce of the transaction which was not sent, so it is reused by the next transaction.
+func (manager *NonceManager) Release(nonce uint64) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if _, ok := manager.sent[nonce]; ok {
+		return
+	}
+
+	delete(manager.inFlight, nonce)
+	if nonce != manager.next-1 {
+		manager.released[nonce] = struct{}{}
+		return
+	}
+
+	manager.next--
+	// released nonces at the end are handed out in order again.
+	for manager.next > 0 {
+		if _, ok := manager.released[manager.next-1]; !ok {
+			return
+		}
+		delete(manager.released, manager.next-1)
+		manager.next--
+	}
+}
+
+// Pending returns number of relayer transactions which are not mined yet, including ones being sent.
+func (manager *NonceManager) Pending(ctx context.Context) (uint64, error) {
+	nonce, err := manager.client.NonceAt(ctx, manager.address, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if manager.next <= nonce {
+		return uint64(len(manager.inFlight)), nil
+	}
+
+	return manager.next - nonce, nil
+}
+
+// Stuck reports whether any sent relayer transaction is not mined for longer than timeout.
+func (manager *NonceManager) Stuck(ctx context.Context, timeout time.Duration) (bool, error) {
+	nonce, err := manager.client.NonceAt(ctx, manager.address, nil)
+	if err != nil {
+		return false, err
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	stuck := false
+	for sentNonce, sentAt := range manager.sentAt {
+		// mined transactions are not tracked anymore.
+		if sentNonce < nonce {
+			delete(manager.sentAt, sentNonce)
+			continue
+		}
+
+		if time.Since(sentAt) > timeout {
+			stuck = true
+		}
+	}
+
+	return stuck, nil
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"tricorn/bridge/networks"
+	"tricorn/chains"
+	"tricorn/internal/contracts/evm"
+	"tricorn/signer"
+)
+
+// SetPaused pauses or unpauses bridge contract, transaction is signed by the key of the contract owner.
+func (service *Service) SetPaused(ctx context.Context, paused bool) ([]byte, error) {
+	publicKey, err := service.bridge.PublicKey(ctx, networks.TypeEVM)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	owner, err := publicKeyToAddress(publicKey)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sign := func(data []byte, dataType signer.Type) ([]byte, error) {
+		return service.bridge.Sign(ctx, chains.SignRequest{
+			NetworkId: networks.TypeEVM,
+			Data:      data,
+			DataType:  dataType,
+		})
+	}
+
+	auth, err := evm.NewKeyedTransactorWithChainID(ctx, owner, big.NewInt(int64(service.config.ChainID)), sign)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var tx *types.Transaction
+	if paused {
+		tx, err = service.instance.Pause(auth)
+	} else {
+		tx, err = service.instance.Unpause(auth)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return tx.Hash().Bytes(), nil
+}
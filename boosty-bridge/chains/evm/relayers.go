@@ -0,0 +1,137 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Relayer describes transaction key which sends outbound transactions.
+type Relayer struct {
+	PublicKey []byte
+	Address   common.Address
+	Nonces    *NonceManager
+}
+
+// RelayerPool assigns outbound transfers to relayer keys. Relayers with transaction which is not mined longer than
+// stuck timeout are not picked until it is mined, so new transfers are not queued behind it.
+type RelayerPool struct {
+	client       Client
+	selection    RelayerSelection
+	stuckTimeout time.Duration
+
+	mutex     sync.Mutex
+	relayers  map[common.Address]*Relayer
+	nextIndex int
+}
+
+// NewRelayerPool is a constructor for RelayerPool, zero stuck timeout disables stuck transactions check.
+func NewRelayerPool(client Client, selection RelayerSelection, stuckTimeout time.Duration) *RelayerPool {
+	return &RelayerPool{
+		client:       client,
+		selection:    selection,
+		stuckTimeout: stuckTimeout,
+		relayers:     make(map[common.Address]*Relayer),
+	}
+}
+
+// Pick returns relayer for the next outbound transfer out of relayers with specified public keys.
+// Nonce state of relayers is kept between calls, so public keys could be re-read from signer every time.
+func (pool *RelayerPool) Pick(ctx context.Context, publicKeys [][]byte) (*Relayer, error) {
+	if len(publicKeys) == 0 {
+		return nil, Error.New("no relayer keys")
+	}
+
+	relayers := make([]*Relayer, 0, len(publicKeys))
+
+	pool.mutex.Lock()
+	for _, publicKey := range publicKeys {
+		address, err := publicKeyToAddress(publicKey)
+		if err != nil {
+			pool.mutex.Unlock()
+			return nil, err
+		}
+
+		r, ok := pool.relayers[address]
+		if !ok {
+			r = &Relayer{
+				PublicKey: publicKey,
+				Address:   address,
+				Nonces:    NewNonceManager(pool.client, address),
+			}
+			pool.relayers[address] = r
+		}
+
+		relayers = append(relayers, r)
+	}
+
+	index := pool.nextIndex % len(relayers)
+	pool.nextIndex = index + 1
+	pool.mutex.Unlock()
+
+	if pool.selection != RelayerSelectionRoundRobin && pool.selection != RelayerSelectionLeastPending {
+		return nil, Error.New("unsupported relayer selection %q", pool.selection)
+	}
+
+	// starting from round-robin candidate spreads transfers between equally loaded relayers.
+	var (
+		best        *Relayer
+		bestPending uint64
+	)
+	for i := range relayers {
+		candidate := relayers[(index+i)%len(relayers)]
+
+		if pool.stuckTimeout > 0 {
+			stuck, err := candidate.Nonces.Stuck(ctx, pool.stuckTimeout)
+			if err != nil {
+				return nil, err
+			}
+			if stuck {
+				continue
+			}
+		}
+
+		if pool.selection == RelayerSelectionRoundRobin {
+			return candidate, nil
+		}
+
+		pending, err := candidate.Nonces.Pending(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if best == nil || pending < bestPending {
+			best, bestPending = candidate, pending
+		}
+	}
+
+	if best == nil {
+		return nil, Error.New("all relayers have stuck transactions")
+	}
+
+	return best, nil
+}
+
+// publicKeyToAddress converts public key curve coordinates to evm address.
+func publicKeyToAddress(publicKey []byte) (common.Address, error) {
+	if len(publicKey) < CurveCoordinatesSize {
+		return common.Address{}, Error.New("invalid public key curve coordinates")
+	}
+
+	publicKeyECDSA := ecdsa.PublicKey{
+		Curve: btcec.S256(),
+		X:     new(big.Int).SetBytes(publicKey[:32]),
+		Y:     new(big.Int).SetBytes(publicKey[32:]),
+	}
+
+	return crypto.PubkeyToAddress(publicKeyECDSA), nil
+}
@@ -0,0 +1,265 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/chains/evm"
+)
+
+func TestNonceManager(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Sequential and released nonces", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		nonces := evm.NewNonceManager(simulated.client, simulated.user)
+
+		for expected := uint64(0); expected < 3; expected++ {
+			nonce, err := nonces.Next(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, expected, nonce)
+		}
+
+		pending, err := nonces.Pending(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, pending)
+
+		nonces.Release(2)
+		nonce, err := nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, nonce)
+
+		// released nonce in the middle is reused before new ones.
+		nonces.Release(1)
+		nonce, err = nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, nonce)
+
+		nonce, err = nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, nonce)
+	})
+
+	t.Run("Sent nonce is not reused while node lags behind", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		nonces := evm.NewNonceManager(simulated.client, simulated.user)
+
+		reserved := make([]uint64, 3)
+		for i := range reserved {
+			var err error
+			reserved[i], err = nonces.Next(ctx)
+			require.NoError(t, err)
+		}
+
+		// transaction with nonce 1 is accepted, but node does not see it yet.
+		for _, nonce := range reserved {
+			if nonce != 1 {
+				simulated.transfer(t, nonce, gwei(1))
+			}
+			nonces.Sent(nonce)
+		}
+		simulated.client.Commit()
+
+		nonce, err := nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, nonce)
+
+		// releasing sent nonce does not make it reusable.
+		nonces.Release(1)
+		nonces.Release(nonce)
+
+		simulated.transfer(t, 1, gwei(1))
+		simulated.client.Commit()
+
+		nonce, err = nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, nonce)
+	})
+
+	t.Run("Released nonce behind sent one is reused", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		nonces := evm.NewNonceManager(simulated.client, simulated.user)
+
+		reserved := make([]uint64, 3)
+		for i := range reserved {
+			var err error
+			reserved[i], err = nonces.Next(ctx)
+			require.NoError(t, err)
+		}
+
+		simulated.transfer(t, reserved[0], gwei(1))
+		nonces.Sent(reserved[0])
+		nonces.Release(reserved[1])
+		nonces.Sent(reserved[2])
+		simulated.client.Commit()
+
+		nonce, err := nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, nonce)
+
+		nonce, err = nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, nonce)
+	})
+
+	t.Run("External transactions", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		nonces := evm.NewNonceManager(simulated.client, simulated.user)
+
+		for nonce := uint64(0); nonce < 2; nonce++ {
+			simulated.transfer(t, nonce, gwei(1))
+		}
+		simulated.client.Commit()
+
+		nonce, err := nonces.Next(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, nonce)
+
+		simulated.transfer(t, nonce, gwei(1))
+		nonces.Sent(nonce)
+
+		pending, err := nonces.Pending(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, pending)
+
+		simulated.client.Commit()
+
+		pending, err = nonces.Pending(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, pending)
+	})
+}
+
+func TestRelayerPool(t *testing.T) {
+	ctx := context.Background()
+	simulated := newSimulatedBridge(t)
+
+	keys := make([]*ecdsa.PrivateKey, 3)
+	publicKeys := make([][]byte, len(keys))
+	for i := range keys {
+		var err error
+		keys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		publicKeys[i] = publicKey(keys[i])
+	}
+	address := func(i int) interface{} {
+		return crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+
+	t.Run("Round robin", func(t *testing.T) {
+		pool := evm.NewRelayerPool(simulated.client, evm.RelayerSelectionRoundRobin, 0)
+
+		for _, expected := range []int{0, 1, 2, 0} {
+			relayer, err := pool.Pick(ctx, publicKeys)
+			require.NoError(t, err)
+			assert.Equal(t, address(expected), relayer.Address)
+		}
+	})
+
+	t.Run("Least pending", func(t *testing.T) {
+		pool := evm.NewRelayerPool(simulated.client, evm.RelayerSelectionLeastPending, 0)
+
+		// reserve two nonces of the first relayer and one of the second.
+		for _, pending := range []int{2, 1} {
+			relayer, err := pool.Pick(ctx, publicKeys)
+			require.NoError(t, err)
+			for i := 0; i < pending; i++ {
+				_, err = relayer.Nonces.Next(ctx)
+				require.NoError(t, err)
+			}
+		}
+
+		relayer, err := pool.Pick(ctx, publicKeys)
+		require.NoError(t, err)
+		assert.Equal(t, address(2), relayer.Address)
+		_, err = relayer.Nonces.Next(ctx)
+		require.NoError(t, err)
+
+		// equally loaded relayers are picked in round-robin order.
+		relayer, err = pool.Pick(ctx, publicKeys)
+		require.NoError(t, err)
+		assert.Equal(t, address(1), relayer.Address)
+	})
+
+	t.Run("Relayers with stuck transactions are skipped", func(t *testing.T) {
+		pool := evm.NewRelayerPool(simulated.client, evm.RelayerSelectionRoundRobin, time.Nanosecond)
+
+		// sent transactions of the first and the second relayers are never mined.
+		for _, expected := range []int{0, 1} {
+			relayer, err := pool.Pick(ctx, publicKeys)
+			require.NoError(t, err)
+			assert.Equal(t, address(expected), relayer.Address)
+
+			nonce, err := relayer.Nonces.Next(ctx)
+			require.NoError(t, err)
+			relayer.Nonces.Sent(nonce)
+		}
+		time.Sleep(time.Millisecond)
+
+		for _, expected := range []int{2, 2} {
+			relayer, err := pool.Pick(ctx, publicKeys)
+			require.NoError(t, err)
+			assert.Equal(t, address(expected), relayer.Address)
+		}
+
+		_, err := pool.Pick(ctx, publicKeys[:2])
+		require.Error(t, err)
+	})
+
+	t.Run("Negative invalid public key", func(t *testing.T) {
+		pool := evm.NewRelayerPool(simulated.client, evm.RelayerSelectionRoundRobin, 0)
+
+		_, err := pool.Pick(ctx, [][]byte{{1, 2, 3}})
+		require.Error(t, err)
+
+		_, err = pool.Pick(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Negative unsupported selection", func(t *testing.T) {
+		pool := evm.NewRelayerPool(simulated.client, "UNKNOWN", 0)
+
+		_, err := pool.Pick(ctx, publicKeys)
+		require.Error(t, err)
+	})
+}
+
+func TestBridgeOutConcurrent(t *testing.T) {
+	const transfers = 5
+
+	ctx := context.Background()
+	simulated := newSimulatedBridge(t)
+	service := simulated.service(evm.Config{}, nil, simulated.owner)
+
+	var group sync.WaitGroup
+	txHashes := make([][]byte, transfers)
+	errors := make([]error, transfers)
+	for i := 0; i < transfers; i++ {
+		group.Add(1)
+		go func(i int) {
+			defer group.Done()
+			txHashes[i], errors[i] = simulated.bridgeOut(ctx, service)
+		}(i)
+	}
+	group.Wait()
+	simulated.client.Commit()
+
+	nonces := make(map[uint64]struct{}, transfers)
+	for i := 0; i < transfers; i++ {
+		require.NoError(t, errors[i])
+
+		tx := simulated.mined(t, txHashes[i])
+		nonces[tx.Nonce()] = struct{}{}
+	}
+	assert.Len(t, nonces, transfers)
+}
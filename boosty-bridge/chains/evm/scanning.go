@@ -0,0 +1,278 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"tricorn/chains"
+)
+
+// limitExceededCode is a json-rpc error code, which providers return when request exceeds their limits.
+const limitExceededCode = -32005
+
+// rangeLimitMessages are parts of error messages, which providers return when logs range is too large.
+var rangeLimitMessages = []string{
+	"query returned more than",
+	"block range",
+	"range is too large",
+	"range too large",
+	"too many blocks",
+	"limit exceeded",
+	"response size exceeded",
+}
+
+// isRangeLimitError checks whether node rejected logs request because of too large blocks range.
+func isRangeLimitError(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == limitExceededCode {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, limitMessage := range rangeLimitMessages {
+		if strings.Contains(message, limitMessage) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// blockRange is a number of blocks, logs of which are requested at once. It adapts to limits of the node:
+// range is halved when node rejects it and grows back after successful requests.
+type blockRange struct {
+	mutex sync.Mutex
+	size  uint64
+	max   uint64
+}
+
+// newBlockRange is a constructor for blockRange.
+func newBlockRange(initial, max uint64) *blockRange {
+	if initial == 0 {
+		initial = 1
+	}
+	if max < initial {
+		max = initial
+	}
+
+	return &blockRange{size: initial, max: max}
+}
+
+// Size returns current number of blocks requested at once.
+func (r *blockRange) Size() uint64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.size
+}
+
+// shrink halves range after node rejected the range of rejected blocks.
+func (r *blockRange) shrink(rejected uint64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	size := rejected / 2
+	if size == 0 {
+		size = 1
+	}
+	if size < r.size {
+		r.size = size
+	}
+}
+
+// grow increases range by half after node returned logs of succeeded blocks. Smaller ranges, e.g. the last one
+// of the scan, do not prove that node handles larger ranges, so they do not affect the size.
+func (r *blockRange) grow(succeeded uint64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if succeeded < r.size {
+		return
+	}
+
+	step := r.size / 2
+	if step == 0 {
+		step = 1
+	}
+
+	r.size += step
+	if r.size > r.max {
+		r.size = r.max
+	}
+}
+
+// logsChunk describes range of blocks, logs of which are fetched concurrently with other chunks.
+type logsChunk struct {
+	fromBlock uint64
+	toBlock   uint64
+
+	logs []types.Log
+	err  error
+	done chan struct{}
+}
+
+// readEventsFromBlock reads node events in a given interval of blocks and notifies subscribers.
+// Interval is split into chunks of adaptive size, which are fetched by several workers. Events are delivered
+// in order of blocks, progress is called with the last block of the chunk once all its events are delivered.
+func (service *Service) readEventsFromBlock(ctx context.Context, fromBlock, toBlock uint64, progress func(ctx context.Context, block uint64)) error {
+	if fromBlock > toBlock {
+		return Error.New("from block %d is greater than to block %d", fromBlock, toBlock)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := service.config.EventsReadingWorkers
+	if workers < 1 {
+		workers = 1
+	}
+
+	// workers slots are taken by chunks, which are fetched or wait for delivery, so range of the next chunk
+	// is chosen once the previous chunks are fetched as long as there is single worker.
+	slots := make(chan struct{}, workers)
+	chunks := make(chan *logsChunk, workers)
+	go func() {
+		defer close(chunks)
+
+		for from := fromBlock; from <= toBlock; {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			to := toBlock
+			if size := service.blockRange.Size(); toBlock-from >= size {
+				to = from + size - 1
+			}
+
+			chunk := &logsChunk{fromBlock: from, toBlock: to, done: make(chan struct{})}
+			go func() {
+				defer close(chunk.done)
+				chunk.logs, chunk.err = service.fetchLogs(ctx, chunk.fromBlock, chunk.toBlock)
+			}()
+			chunks <- chunk
+
+			if to == toBlock {
+				return
+			}
+			from = to + 1
+		}
+	}()
+
+	for chunk := range chunks {
+		select {
+		case <-service.gctx.Done():
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-chunk.done:
+		}
+
+		if chunk.err != nil {
+			return Error.Wrap(chunk.err)
+		}
+
+		for _, log := range chunk.logs {
+			// check is func need to be closed because of app/stream context.
+			select {
+			case <-service.gctx.Done():
+				return nil
+			case <-ctx.Done():
+				return nil
+			default:
+			}
+
+			if err := service.notifyLog(ctx, log); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+
+		if progress != nil {
+			progress(ctx, chunk.toBlock)
+		}
+
+		<-slots
+	}
+
+	return nil
+}
+
+// EventRange returns bridge events of the blocks range, logs removed due to chain reorganization are skipped.
+func (service *Service) EventRange(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error) {
+	if err := chains.ValidateEventRange(fromBlock, toBlock); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	logs, err := service.fetchLogs(ctx, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]chains.EventVariant, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+
+		event, err := parseLog(service.instance, log, service.config.EventsFundIn, service.config.EventsFundOut)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// notifyProgress notifies subscribers that events of all blocks up to the block are delivered,
+// so past events reading could be continued from the block after restart.
+func (service *Service) notifyProgress(ctx context.Context, block uint64) {
+	service.Notify(ctx, chains.EventVariant{
+		Type:          chains.EventTypeProgress,
+		EventProgress: chains.EventProgress{BlockNumber: block},
+	})
+}
+
+// fetchLogs returns bridge contract logs of the blocks range. Range, which is rejected by node as too large,
+// is split in halves.
+func (service *Service) fetchLogs(ctx context.Context, fromBlock, toBlock uint64) ([]types.Log, error) {
+	query := service.logsQuery()
+	query.FromBlock = new(big.Int).SetUint64(fromBlock)
+	query.ToBlock = new(big.Int).SetUint64(toBlock)
+
+	blocks := toBlock - fromBlock + 1
+	logs, err := service.ethClient.FilterLogs(ctx, query)
+	if err == nil {
+		service.blockRange.grow(blocks)
+		return logs, nil
+	}
+	if fromBlock == toBlock || !isRangeLimitError(err) {
+		return nil, Error.Wrap(err)
+	}
+
+	service.blockRange.shrink(blocks)
+
+	middle := fromBlock + (toBlock-fromBlock)/2
+	logs, err = service.fetchLogs(ctx, fromBlock, middle)
+	if err != nil {
+		return nil, err
+	}
+
+	rest, err := service.fetchLogs(ctx, middle+1, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(logs, rest...), nil
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/chains"
+	"tricorn/chains/evm"
+	"tricorn/internal/contracts/evm/bridge"
+	"tricorn/internal/logger/zaplog"
+)
+
+// rangeLimitedClient is a simulated chain client, which rejects logs requests of too large blocks range.
+type rangeLimitedClient struct {
+	*simulatedClient
+	maxRange uint64
+
+	mutex     sync.Mutex
+	requested []uint64
+	rejected  int
+}
+
+func (client *rangeLimitedClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	blocks := query.ToBlock.Uint64() - query.FromBlock.Uint64() + 1
+
+	client.mutex.Lock()
+	client.requested = append(client.requested, blocks)
+	if blocks > client.maxRange {
+		client.rejected++
+		client.mutex.Unlock()
+		return nil, fmt.Errorf("query returned more than 10000 results")
+	}
+	client.mutex.Unlock()
+
+	return client.simulatedClient.FilterLogs(ctx, query)
+}
+
+// largestSucceeded returns the largest blocks range, logs of which were returned.
+func (client *rangeLimitedClient) largestSucceeded() uint64 {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	var largest uint64
+	for _, blocks := range client.requested {
+		if blocks > largest && blocks <= client.maxRange {
+			largest = blocks
+		}
+	}
+
+	return largest
+}
+
+func TestReadPastEvents(t *testing.T) {
+	bridgeABI, err := bridge.BridgeMetaData.GetAbi()
+	require.NoError(t, err)
+
+	// simulatedEvents mines bridge out transactions separated by empty blocks and returns their blocks.
+	simulatedEvents := func(t *testing.T, simulated *simulatedBridge, events, emptyBlocks int) []uint64 {
+		ctx := context.Background()
+		service := simulated.service(evm.Config{}, nil, simulated.owner)
+
+		blocks := make([]uint64, 0, events)
+		for i := 0; i < events; i++ {
+			for j := 0; j < emptyBlocks; j++ {
+				simulated.client.Commit()
+			}
+
+			_, err := simulated.bridgeOut(ctx, service)
+			require.NoError(t, err)
+			simulated.client.Commit()
+
+			blockNumber, err := simulated.client.BlockNumber(ctx)
+			require.NoError(t, err)
+			blocks = append(blocks, blockNumber)
+		}
+
+		// the latest blocks are read by real time events reading, so they are left empty.
+		simulated.client.Commit()
+		simulated.client.Commit()
+
+		return blocks
+	}
+
+	// readPastEvents reads events from the first block till the last one and returns received events and progress.
+	readPastEvents := func(t *testing.T, simulated *simulatedBridge, client evm.Client, config evm.Config) (events []uint64, progress []uint64) {
+		config.ChainID = simulatedChainID
+		config.BridgeContractAddress = simulated.address
+		config.EventsFundIn = bridgeABI.Events["BridgeFundsIn"].ID
+		config.EventsFundOut = bridgeABI.Events["BridgeFundsOut"].ID
+		config.EventsReadingIntervalInSeconds = 3600
+		service := evm.New(context.Background(), config, zaplog.NewLog(), &relayerMock{}, simulated.instance, nil, client, nil)
+		subscriber := service.AddEventSubscriber()
+
+		lastBlock, err := client.BlockNumber(context.Background())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- service.ReadEvents(ctx, 1)
+		}()
+		defer func() {
+			cancel()
+			for {
+				select {
+				case <-subscriber.ReceiveEvents():
+					continue
+				case err := <-done:
+					require.NoError(t, err)
+				}
+				break
+			}
+		}()
+
+		for {
+			select {
+			case event := <-subscriber.ReceiveEvents():
+				switch event.Type {
+				case chains.EventTypeProgress:
+					progress = append(progress, event.Block())
+					if event.Block() == lastBlock {
+						return events, progress
+					}
+				case chains.EventTypeOut:
+					// events of the range are delivered before its progress.
+					if len(progress) > 0 {
+						require.Greater(t, event.Block(), progress[len(progress)-1])
+					}
+					events = append(events, event.Block())
+				}
+			case <-time.After(10 * time.Second):
+				t.Fatal("past events were not read")
+				return nil, nil
+			}
+		}
+	}
+
+	t.Run("Range is halved on provider limit", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		blocks := simulatedEvents(t, simulated, 5, 10)
+		client := &rangeLimitedClient{simulatedClient: simulated.client, maxRange: 10}
+
+		events, progress := readPastEvents(t, simulated, client, evm.Config{EventsBlockRange: 32, EventsMaxBlockRange: 32, EventsReadingWorkers: 1})
+		assert.Equal(t, blocks, events)
+		assert.True(t, client.rejected > 0)
+		// range does not collapse after rejection.
+		assert.Greater(t, client.largestSucceeded(), uint64(5))
+
+		for i := 1; i < len(progress); i++ {
+			assert.Greater(t, progress[i], progress[i-1])
+		}
+	})
+
+	t.Run("Range grows on success", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		blocks := simulatedEvents(t, simulated, 4, 20)
+		client := &rangeLimitedClient{simulatedClient: simulated.client, maxRange: 1000}
+
+		events, _ := readPastEvents(t, simulated, client, evm.Config{EventsBlockRange: 2, EventsMaxBlockRange: 16, EventsReadingWorkers: 1})
+		assert.Equal(t, blocks, events)
+		assert.Equal(t, 0, client.rejected)
+		assert.Equal(t, uint64(16), client.largestSucceeded())
+	})
+
+	t.Run("Parallel ranges are delivered in order", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		blocks := simulatedEvents(t, simulated, 10, 3)
+		client := &rangeLimitedClient{simulatedClient: simulated.client, maxRange: 5}
+
+		events, progress := readPastEvents(t, simulated, client, evm.Config{EventsBlockRange: 4, EventsMaxBlockRange: 8, EventsReadingWorkers: 4})
+		assert.Equal(t, blocks, events)
+		for i := 1; i < len(progress); i++ {
+			assert.Greater(t, progress[i], progress[i-1])
+		}
+	})
+}
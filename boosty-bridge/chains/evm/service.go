@@ -5,18 +5,14 @@ package evm
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"errors"
 	"math/big"
 	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/ethereum/go-ethereum/log"
 	"github.com/google/uuid"
 	"github.com/zeebo/errs"
 
@@ -25,7 +21,7 @@ import (
 	"tricorn/internal/contracts/evm"
 	"tricorn/internal/contracts/evm/bridge"
 	"tricorn/internal/logger"
-	"tricorn/internal/math"
+	"tricorn/pkg/codec"
 	"tricorn/signer"
 )
 
@@ -53,10 +49,13 @@ type Service struct {
 	mutex            sync.Mutex
 	eventSubscribers []chains.EventSubscriber
 
-	ethClient *ethclient.Client
+	ethClient     Client
+	dialLogStream DialLogStream // nil if events are polled only.
+	blockRange    *blockRange   // number of blocks, logs of which are requested at once.
 
 	instance *bridge.Bridge // contract instance.
 	transfer Transfer       // bridge contract client.
+	relayers *RelayerPool   // relayer keys of outbound transactions.
 
 	bridge chains.Bridge
 
@@ -65,7 +64,12 @@ type Service struct {
 
 // New is Service constructor.
 func New(gctx context.Context, config Config, log logger.Logger, bridge chains.Bridge, instance *bridge.Bridge, transfer Transfer,
-	ethClient *ethclient.Client) *Service {
+	ethClient Client, dialLogStream DialLogStream) *Service {
+	if dialLogStream != nil && config.NodeQuorum > 1 {
+		log.Warn("websocket log subscription is disabled, since logs have to be confirmed by quorum of nodes")
+		dialLogStream = nil
+	}
+
 	return &Service{
 		gctx:             gctx,
 		config:           config,
@@ -74,31 +78,16 @@ func New(gctx context.Context, config Config, log logger.Logger, bridge chains.B
 		bridge:           bridge,
 		instance:         instance,
 		transfer:         transfer,
+		relayers:         NewRelayerPool(ethClient, config.RelayerSelection, time.Duration(config.StuckTransactionTimeoutInSeconds)*time.Second),
 		ethClient:        ethClient,
+		dialLogStream:    dialLogStream,
+		blockRange:       newBlockRange(config.EventsBlockRange, config.EventsMaxBlockRange),
 	}
 }
 
 // Network returns supported by connector network.
 func (service *Service) Network(ctx context.Context) networks.Network {
-	var id networks.ID
-	switch {
-	case networks.NameEth == service.GetChainName():
-		id = networks.IDEth
-	case networks.NamePolygon == service.GetChainName():
-		id = networks.IDPolygon
-	case networks.NameGoerli == service.GetChainName():
-		id = networks.IDGoerli
-	case networks.NameMumbai == service.GetChainName():
-		id = networks.IDMumbai
-	case networks.NameBNB == service.GetChainName():
-		id = networks.IDBNB
-	case networks.NameBNBTest == service.GetChainName():
-		id = networks.IDBNBTest
-	case networks.NameAvalanche == service.GetChainName():
-		id = networks.IDAvalanche
-	case networks.NameAvalancheTest == service.GetChainName():
-		id = networks.IDAvalancheTest
-	}
+	id, _ := networks.IDByName(service.GetChainName())
 
 	return networks.Network{
 		ID:             id,
@@ -120,23 +109,15 @@ func (service *Service) KnownTokens(ctx context.Context) chains.Tokens {
 
 // BridgeOut initiates transfer.
 func (service *Service) BridgeOut(ctx context.Context, transfer chains.TokenOutRequest) ([]byte, error) {
-	publicKeyByte, err := service.bridge.PublicKey(ctx, networks.TypeEVM)
+	publicKeys, err := service.bridge.PublicKeys(ctx, networks.TypeEVM)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	if len(publicKeyByte) < CurveCoordinatesSize {
-		return nil, Error.New("invalid public key curve coordinates")
-	}
-
-	x := big.NewInt(0).SetBytes(publicKeyByte[:32])
-	y := big.NewInt(0).SetBytes(publicKeyByte[32:])
-	publicKeyECDSA := ecdsa.PublicKey{
-		Curve: btcec.S256(),
-		X:     x,
-		Y:     y,
+	relayer, err := service.relayers.Pick(ctx, publicKeys)
+	if err != nil {
+		return nil, Error.Wrap(err)
 	}
-	ownerAddress := crypto.PubkeyToAddress(publicKeyECDSA)
 
 	sign := func(data []byte, dataType signer.Type) ([]byte, error) {
 		singIn := chains.SignRequest{
@@ -144,19 +125,45 @@ func (service *Service) BridgeOut(ctx context.Context, transfer chains.TokenOutR
 			NetworkId: networks.TypeEVM,
 			Data:      data,
 			DataType:  dataType,
+			PublicKey: relayer.PublicKey,
 		}
 
 		return service.bridge.Sign(ctx, singIn)
 	}
 
-	auth, err := evm.NewKeyedTransactorWithChainID(ctx, ownerAddress, big.NewInt(int64(service.config.ChainID)), sign)
+	auth, err := evm.NewKeyedTransactorWithChainID(ctx, relayer.Address, big.NewInt(int64(service.config.ChainID)), sign)
 	if err != nil {
 		return nil, err
 	}
 
+	nonce, err := relayer.Nonces.Next(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	sent := false
+	defer func() {
+		// nonce of not sent transaction is reused by the next one, otherwise it blocks all following transactions.
+		if !sent {
+			relayer.Nonces.Release(nonce)
+		}
+	}()
+
+	auth.Nonce = new(big.Int).SetUint64(nonce)
 	auth.Value = big.NewInt(0)
 	auth.NoSend = true
 
+	switch service.config.TransactionType {
+	case TransactionTypeLegacy:
+		// gas price is set according to the estimation below.
+	case TransactionTypeDynamicFee:
+		auth.GasFeeCap, auth.GasTipCap, err = service.dynamicFees(ctx)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	default:
+		return nil, Error.New("unsupported transaction type %q", service.config.TransactionType)
+	}
+
 	estimationTr, err := service.instance.BridgeOut(auth, common.BytesToAddress(transfer.Token), common.BytesToAddress(transfer.To),
 		transfer.Amount, transfer.TransactionID, transfer.From.NetworkName, transfer.From.Address)
 	if err != nil {
@@ -165,7 +172,9 @@ func (service *Service) BridgeOut(ctx context.Context, transfer chains.TokenOutR
 
 	gasLimit := new(big.Float).SetUint64(estimationTr.Gas())
 	auth.GasLimit, _ = gasLimit.Mul(gasLimit, new(big.Float).SetFloat64(service.config.GasLimitIncreasingCoefficient)).Uint64()
-	auth.GasPrice = estimationTr.GasPrice().Mul(estimationTr.GasPrice(), new(big.Int).SetUint64(service.config.GasPriceIncreasingCoefficient))
+	if service.config.TransactionType == TransactionTypeLegacy {
+		auth.GasPrice = estimationTr.GasPrice().Mul(estimationTr.GasPrice(), new(big.Int).SetUint64(service.config.GasPriceIncreasingCoefficient))
+	}
 	auth.NoSend = false
 
 	tr, err := service.instance.BridgeOut(auth, common.BytesToAddress(transfer.Token), common.BytesToAddress(transfer.To),
@@ -174,9 +183,38 @@ func (service *Service) BridgeOut(ctx context.Context, transfer chains.TokenOutR
 		return nil, err
 	}
 
+	sent = true
+	relayer.Nonces.Sent(nonce)
+
 	return tr.Hash().Bytes(), nil
 }
 
+// TransactionStatus returns status of the transaction on chain, transaction which no node knows was dropped or was never
+// sent.
+func (service *Service) TransactionStatus(ctx context.Context, txHash []byte) (chains.TxStatus, error) {
+	hash := common.BytesToHash(txHash)
+	_, pending, err := service.ethClient.TransactionByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return chains.TxStatusNotFound, nil
+		}
+		return "", Error.Wrap(err)
+	}
+	if pending {
+		return chains.TxStatusPending, nil
+	}
+
+	receipt, err := service.ethClient.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return chains.TxStatusFailed, nil
+	}
+
+	return chains.TxStatusSucceeded, nil
+}
+
 // EstimateTransfer estimates transfer fee and time.
 func (service *Service) EstimateTransfer(ctx context.Context) (chains.Estimation, error) {
 	gasPrice, err := service.ethClient.SuggestGasPrice(ctx)
@@ -199,74 +237,12 @@ func (service *Service) EstimateTransfer(ctx context.Context) (chains.Estimation
 	return estimation, nil
 }
 
-// readEventsFromBlock reads node events in a given interval of blocks and notifies subscribers.
-func (service *Service) readEventsFromBlock(ctx context.Context, fromBlock, toBlock uint64) error {
-	// if we start from scratch and client does not have any events it will be sufficient to read batch
-	// of events from 0 to last block. On the other hand if client already has some events.
-	// Reading small chunks of events would be more appropriate, because logs reading/parsing
-	// is time-consuming operation.
-
-	splittedRange, err := math.SplitRange(math.Range{
-		From: fromBlock,
-		To:   toBlock,
-	}, listeningLimit)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	for _, rangeValues := range splittedRange {
-		err := service.readOldEvents(ctx, rangeValues.From, rangeValues.To)
-		if err != nil {
-			return Error.Wrap(err)
-		}
-	}
-
-	return nil
-}
-
-func (service *Service) readOldEvents(ctx context.Context, fromBlock, toBlock uint64) error {
-	topics := make([]common.Hash, 0)
-	topics = append(topics, service.config.EventsFundIn, service.config.EventsFundOut)
-
-	from := new(big.Int).SetUint64(fromBlock)
-	to := new(big.Int).SetUint64(toBlock)
-
-	query := ethereum.FilterQuery{
-		FromBlock: from,
-		ToBlock:   to,
-		Addresses: []common.Address{service.config.BridgeContractAddress},
-		Topics:    [][]common.Hash{topics},
-	}
-
-	logs, err := service.ethClient.FilterLogs(ctx, query)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	for _, log := range logs {
-		// check is func need to be closed because of app/stream context.
-		select {
-		case <-service.gctx.Done():
-			return nil
-		case <-ctx.Done():
-			return nil
-		default:
-		}
-
-		event, err := parseLog(service.instance, log, service.config.EventsFundIn, service.config.EventsFundOut)
-		if err != nil {
-			return Error.Wrap(err)
-		}
-
-		service.Notify(ctx, event)
-	}
-
-	return nil
-}
-
 // subscribeEvents is real time events streaming from blockchain to events subscribers.
+// Events are streamed by websocket node if it is configured, otherwise and while there is no subscription
+// new blocks are polled.
 func (service *Service) subscribeEvents(ctx context.Context) error {
 	ticker := time.NewTicker(time.Duration(service.config.EventsReadingIntervalInSeconds) * time.Second)
+	defer ticker.Stop()
 
 	// startBlockNumber stores block number from which we start reading when connector is connected with bridge.
 	startBlockNumber, err := service.ethClient.BlockNumber(ctx)
@@ -275,49 +251,67 @@ func (service *Service) subscribeEvents(ctx context.Context) error {
 	}
 
 	previousBlockNumber := startBlockNumber - 1
-	err = service.readOldEvents(ctx, previousBlockNumber, startBlockNumber)
+	err = service.readEventsFromBlock(ctx, previousBlockNumber, startBlockNumber, nil)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	for range ticker.C {
-		select {
-		case <-service.gctx.Done():
-			return nil
-		case <-ctx.Done():
-			return nil
-		default:
-		}
-
-		currentBlockNumber, err := service.ethClient.BlockNumber(ctx)
-		if err != nil {
-			log.Error("could not get current block number", Error.Wrap(err))
-			continue
-		}
-
-		if startBlockNumber >= currentBlockNumber {
-			continue
+	// processedBlockNumber stores the last block, events of which were delivered to subscribers.
+	processedBlockNumber := startBlockNumber
+	resubscribeInterval := time.Duration(service.config.EventsResubscribeIntervalInSeconds) * time.Second
+
+	for {
+		var resubscribe <-chan time.Time
+		if service.dialLogStream != nil {
+			err = service.streamLogs(ctx, &processedBlockNumber)
+
+			select {
+			case <-service.gctx.Done():
+				return nil
+			case <-ctx.Done():
+				return nil
+			default:
+			}
+
+			service.log.Error("log subscription is lost, polling events", Error.Wrap(err))
+			resubscribe = time.After(resubscribeInterval)
 		}
 
-		err = service.readOldEvents(ctx, startBlockNumber, currentBlockNumber)
-		if err != nil {
-			log.Error("could not read old events", Error.Wrap(err))
-			continue
+	polling:
+		for {
+			select {
+			case <-service.gctx.Done():
+				return nil
+			case <-ctx.Done():
+				return nil
+			case <-resubscribe:
+				break polling
+			case <-ticker.C:
+			}
+
+			currentBlockNumber, err := service.ethClient.BlockNumber(ctx)
+			if err != nil {
+				service.log.Error("could not get current block number", Error.Wrap(err))
+				continue
+			}
+
+			if processedBlockNumber >= currentBlockNumber {
+				continue
+			}
+
+			err = service.readEventsFromBlock(ctx, processedBlockNumber+1, currentBlockNumber, nil)
+			if err != nil {
+				service.log.Error("could not read old events", Error.Wrap(err))
+				continue
+			}
+
+			processedBlockNumber = currentBlockNumber
 		}
-
-		startBlockNumber = currentBlockNumber
 	}
-
-	return nil
 }
 
 // parseLog parses log data to internal object by contract instance.
 func parseLog(instance *bridge.Bridge, log types.Log, fundInEventHash, fundOutEventHash common.Hash) (chains.EventVariant, error) {
-	// handle blockchain rework.
-	if log.Removed {
-		return chains.EventVariant{}, Error.Wrap(ErrBlockchainRework)
-	}
-
 	switch log.Topics[0] {
 	case fundInEventHash:
 		fundIn, err := instance.ParseBridgeFundsIn(log)
@@ -329,6 +323,7 @@ func parseLog(instance *bridge.Bridge, log types.Log, fundInEventHash, fundOutEv
 			Hash:        fundIn.Raw.TxHash.Bytes(),
 			BlockNumber: fundIn.Raw.BlockNumber,
 			Sender:      fundIn.Raw.Address.Bytes(),
+			LogIndex:    uint64(fundIn.Raw.Index),
 		}
 
 		event := chains.EventVariant{
@@ -339,9 +334,11 @@ func parseLog(instance *bridge.Bridge, log types.Log, fundInEventHash, fundOutEv
 					NetworkName: fundIn.DestinationChain,
 					Address:     fundIn.DestinationAddress,
 				},
-				Amount: fundIn.Amount.String(),
-				Token:  fundIn.Token.Bytes(),
-				Tx:     txInfo,
+				Amount:  fundIn.Amount.String(),
+				Token:   fundIn.Token.Bytes(),
+				Tx:      txInfo,
+				Nonce:   fundIn.Nonce.Uint64(),
+				Removed: log.Removed,
 			},
 		}
 
@@ -356,6 +353,7 @@ func parseLog(instance *bridge.Bridge, log types.Log, fundInEventHash, fundOutEv
 			Hash:        fundOut.Raw.TxHash.Bytes(),
 			BlockNumber: fundOut.Raw.BlockNumber,
 			Sender:      fundOut.Raw.Address.Bytes(),
+			LogIndex:    uint64(fundOut.Raw.Index),
 		}
 
 		event := chains.EventVariant{
@@ -365,10 +363,11 @@ func parseLog(instance *bridge.Bridge, log types.Log, fundInEventHash, fundOutEv
 					NetworkName: fundOut.SourceChain,
 					Address:     fundOut.SourceAddress,
 				},
-				To:     fundOut.Recipient.Bytes(),
-				Amount: fundOut.Amount.String(),
-				Token:  fundOut.Token.Bytes(),
-				Tx:     txInfo,
+				To:            fundOut.Recipient.Bytes(),
+				Amount:        fundOut.Amount.String(),
+				Token:         fundOut.Token.Bytes(),
+				Tx:            txInfo,
+				TransactionID: fundOut.TransactionId.Uint64(),
 			},
 		}
 
@@ -395,7 +394,7 @@ func (service *Service) ReadEvents(ctx context.Context, fromBlock uint64) error
 			return
 		}
 
-		err = service.readEventsFromBlock(ctx, fromBlock, blockNum)
+		err = service.readEventsFromBlock(ctx, fromBlock, blockNum, service.notifyProgress)
 		if err != nil {
 			service.log.Error("could not read past events", err)
 		}
@@ -424,12 +423,17 @@ func (service *Service) BridgeInSignature(ctx context.Context, req chains.Bridge
 		return chains.BridgeInSignatureResponse{}, Error.New("the amount must be greater than the gas commission")
 	}
 
+	token, err := codec.EVM.ParseContract(req.Token)
+	if err != nil {
+		return chains.BridgeInSignatureResponse{}, Error.Wrap(err)
+	}
+
 	deadlineTime := time.Now().UTC().Add(time.Second * time.Duration(service.config.SignatureValidityTime)).Unix()
 	deadline := big.NewInt(0).SetInt64(deadlineTime)
 
 	bridgeIn := GetBridgeInSignatureRequest{
 		User:               common.BytesToAddress(req.User),
-		Token:              common.HexToAddress(req.Token),
+		Token:              common.BytesToAddress(token),
 		Amount:             req.Amount,
 		GasCommission:      req.GasCommission,
 		DestinationChain:   req.Destination.NetworkName,
@@ -0,0 +1,350 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/bridge/networks"
+	"tricorn/chains"
+	"tricorn/chains/evm"
+	"tricorn/internal/contracts/evm/bridge"
+	"tricorn/internal/logger/zaplog"
+)
+
+func TestReadEventsSeveralEventsInTransaction(t *testing.T) {
+	bridgeABI, err := bridge.BridgeMetaData.GetAbi()
+	require.NoError(t, err)
+
+	fundsIn := bridgeABI.Events["BridgeFundsIn"]
+	fundsOut := bridgeABI.Events["BridgeFundsOut"]
+	contractAddress := common.HexToAddress("0x9744bC7A2D91928017E1DEdf98Ff7d912d6Cd263")
+	sender := common.HexToAddress("0x3095f955da700b96215cffc9bc64ab2e69eb7dab")
+	token := common.HexToAddress("0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69")
+	txHash := common.HexToHash("0xbf4d685afb739d609924b9c316c841a6a4d996e86b363b5cfb4386c9554144a6")
+
+	fundsInLog := func(nonce int64, logIndex uint) types.Log {
+		data, err := fundsIn.Inputs.NonIndexed().Pack(token, big.NewInt(1000), big.NewInt(1), big.NewInt(10), "CASPER-TEST",
+			"01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
+		require.NoError(t, err)
+
+		return types.Log{
+			Address:     contractAddress,
+			Topics:      []common.Hash{fundsIn.ID, common.BytesToHash(sender.Bytes()), common.BigToHash(big.NewInt(nonce))},
+			Data:        data,
+			BlockNumber: 16,
+			TxHash:      txHash,
+			Index:       logIndex,
+		}
+	}
+	logs := []types.Log{fundsInLog(1, 3), fundsInLog(2, 4)}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var result interface{}
+		switch req.Method {
+		case "eth_blockNumber":
+			result = "0x10"
+		case "eth_getLogs":
+			result = logs
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ethClient, err := ethclient.Dial(server.URL)
+	require.NoError(t, err)
+	defer ethClient.Close()
+
+	instance, err := bridge.NewBridge(contractAddress, ethClient)
+	require.NoError(t, err)
+
+	config := evm.Config{
+		ChainName:                      networks.NameGoerli,
+		BridgeContractAddress:          contractAddress,
+		EventsFundIn:                   fundsIn.ID,
+		EventsFundOut:                  fundsOut.ID,
+		EventsReadingIntervalInSeconds: 1,
+	}
+	service := evm.New(ctx, config, zaplog.NewLog(), nil, instance, nil, ethClient, nil)
+	subscriber := service.AddEventSubscriber()
+
+	done := make(chan error)
+	go func() {
+		done <- service.ReadEvents(ctx, 0)
+	}()
+
+	received := make([]chains.EventVariant, 0, len(logs))
+	for len(received) < len(logs) {
+		select {
+		case event := <-subscriber.ReceiveEvents():
+			received = append(received, event)
+		case <-time.After(10 * time.Second):
+			t.Fatal("events were not received")
+		}
+	}
+
+	cancel()
+	for {
+		select {
+		case <-subscriber.ReceiveEvents():
+			continue
+		case err := <-done:
+			require.NoError(t, err)
+		}
+		break
+	}
+
+	for i, event := range received {
+		require.Equal(t, chains.EventTypeIn, event.Type)
+		assert.Equal(t, txHash.Bytes(), event.EventFundsIn.Tx.Hash)
+		assert.EqualValues(t, logs[i].Index, event.EventFundsIn.Tx.LogIndex)
+		assert.EqualValues(t, i+1, event.EventFundsIn.Nonce)
+	}
+	assert.NotEqual(t, received[0].EventFundsIn.Tx.LogIndex, received[1].EventFundsIn.Tx.LogIndex)
+}
+
+// logStream streams logs of simulated chain. Subscriptions could be dropped, logs could be injected into them.
+type logStream struct {
+	client *simulatedClient
+
+	mutex         sync.Mutex
+	fail          bool
+	dials         int
+	subscriptions []*logSubscription
+	subscribed    chan *logSubscription
+}
+
+func newLogStream(client *simulatedClient) *logStream {
+	return &logStream{client: client, subscribed: make(chan *logSubscription, 10)}
+}
+
+func (stream *logStream) dial(ctx context.Context) (evm.LogStream, error) {
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+
+	stream.dials++
+	if stream.fail {
+		return nil, errors.New("connection refused")
+	}
+
+	return stream, nil
+}
+
+func (stream *logStream) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	subscription, err := stream.client.SubscribeFilterLogs(ctx, query, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	logSubscription := &logSubscription{Subscription: subscription, logs: ch, err: make(chan error, 1)}
+	stream.subscribed <- logSubscription
+
+	return logSubscription, nil
+}
+
+func (stream *logStream) Close() {}
+
+// logSubscription is a subscription of simulated chain logs, which could be dropped like lost connection.
+type logSubscription struct {
+	ethereum.Subscription
+	logs chan<- types.Log
+	err  chan error
+}
+
+func (subscription *logSubscription) Err() <-chan error {
+	return subscription.err
+}
+
+func (subscription *logSubscription) drop() {
+	subscription.Unsubscribe()
+	subscription.err <- errors.New("connection lost")
+}
+
+func TestReadEventsSubscription(t *testing.T) {
+	bridgeABI, err := bridge.BridgeMetaData.GetAbi()
+	require.NoError(t, err)
+
+	// readEvents runs events reading and returns function, which waits for fund out event of the transaction.
+	readEvents := func(t *testing.T, simulated *simulatedBridge, config evm.Config, dialLogStream evm.DialLogStream) (*evm.Service, chains.EventSubscriber, func(txHash []byte)) {
+		config.EventsFundIn = bridgeABI.Events["BridgeFundsIn"].ID
+		config.EventsFundOut = bridgeABI.Events["BridgeFundsOut"].ID
+		service := simulated.service(config, dialLogStream, simulated.owner)
+		subscriber := service.AddEventSubscriber()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- service.ReadEvents(ctx, 0)
+		}()
+		t.Cleanup(func() {
+			cancel()
+			for {
+				select {
+				case <-subscriber.ReceiveEvents():
+					continue
+				case err := <-done:
+					require.NoError(t, err)
+				}
+				break
+			}
+		})
+
+		return service, subscriber, func(txHash []byte) {
+			for {
+				select {
+				case event := <-subscriber.ReceiveEvents():
+					// events could be delivered more than once after resubscription.
+					if bytes.Equal(event.EventFundsOut.Tx.Hash, txHash) {
+						return
+					}
+				case <-time.After(10 * time.Second):
+					t.Fatal("event was not received")
+					return
+				}
+			}
+		}
+	}
+
+	// bridgeOut mines bridge out transaction and returns its hash.
+	bridgeOut := func(t *testing.T, simulated *simulatedBridge, service *evm.Service) []byte {
+		txHash, err := simulated.bridgeOut(context.Background(), service)
+		require.NoError(t, err)
+		simulated.client.Commit()
+
+		return txHash
+	}
+
+	// subscription waits until events reading subscribes to logs.
+	subscription := func(t *testing.T, stream *logStream) *logSubscription {
+		select {
+		case subscription := <-stream.subscribed:
+			return subscription
+		case <-time.After(10 * time.Second):
+			t.Fatal("logs were not subscribed")
+			return nil
+		}
+	}
+
+	// polling interval is long enough to ensure events are received from subscription.
+	config := evm.Config{EventsReadingIntervalInSeconds: 3600, EventsResubscribeIntervalInSeconds: 1}
+
+	t.Run("Subscription", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		stream := newLogStream(simulated.client)
+		service, subscriber, _ := readEvents(t, simulated, config, stream.dial)
+		subscription(t, stream)
+
+		txHash := bridgeOut(t, simulated, service)
+		event := <-subscriber.ReceiveEvents()
+		require.Equal(t, chains.EventTypeOut, event.Type)
+		assert.Equal(t, txHash, event.EventFundsOut.Tx.Hash)
+		assert.Equal(t, simulated.user.Bytes(), event.EventFundsOut.To)
+	})
+
+	t.Run("Backfill after resubscription", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		stream := newLogStream(simulated.client)
+		service, _, receive := readEvents(t, simulated, config, stream.dial)
+
+		receive(bridgeOut(t, simulated, service))
+
+		subscription(t, stream).drop()
+		missed := bridgeOut(t, simulated, service)
+
+		subscription(t, stream)
+		receive(missed)
+		receive(bridgeOut(t, simulated, service))
+
+		stream.mutex.Lock()
+		defer stream.mutex.Unlock()
+		assert.Equal(t, 2, stream.dials)
+	})
+
+	t.Run("Removed logs are skipped", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		stream := newLogStream(simulated.client)
+		service, subscriber, _ := readEvents(t, simulated, config, stream.dial)
+		subscription := subscription(t, stream)
+
+		removedTxHash := bridgeOut(t, simulated, service)
+		<-subscriber.ReceiveEvents()
+
+		receipt, err := simulated.client.TransactionReceipt(context.Background(), common.BytesToHash(removedTxHash))
+		require.NoError(t, err)
+		removed := *receipt.Logs[0]
+		removed.Removed = true
+		subscription.logs <- removed
+
+		txHash := bridgeOut(t, simulated, service)
+		event := <-subscriber.ReceiveEvents()
+		assert.Equal(t, txHash, event.EventFundsOut.Tx.Hash)
+	})
+
+	t.Run("Removed funds in logs are delivered for review", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		stream := newLogStream(simulated.client)
+		_, subscriber, _ := readEvents(t, simulated, config, stream.dial)
+		subscription := subscription(t, stream)
+
+		fundsIn := bridgeABI.Events["BridgeFundsIn"]
+		data, err := fundsIn.Inputs.NonIndexed().Pack(common.HexToAddress("0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69"),
+			big.NewInt(1000), big.NewInt(1), big.NewInt(10), "CASPER-TEST", "01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
+		require.NoError(t, err)
+
+		removed := types.Log{
+			Topics:      []common.Hash{fundsIn.ID, common.BytesToHash(simulated.user.Bytes()), common.BigToHash(big.NewInt(7))},
+			Data:        data,
+			BlockNumber: 1,
+			TxHash:      common.HexToHash("0xbf4d685afb739d609924b9c316c841a6a4d996e86b363b5cfb4386c9554144a6"),
+			Removed:     true,
+		}
+		subscription.logs <- removed
+
+		event := <-subscriber.ReceiveEvents()
+		require.Equal(t, chains.EventTypeIn, event.Type)
+		assert.True(t, event.EventFundsIn.Removed)
+		assert.Equal(t, removed.TxHash.Bytes(), event.EventFundsIn.Tx.Hash)
+		assert.EqualValues(t, 7, event.EventFundsIn.Nonce)
+	})
+
+	t.Run("Polling while subscription is not available", func(t *testing.T) {
+		simulated := newSimulatedBridge(t)
+		stream := newLogStream(simulated.client)
+		stream.fail = true
+
+		service, _, receive := readEvents(t, simulated, evm.Config{EventsReadingIntervalInSeconds: 1, EventsResubscribeIntervalInSeconds: 3600}, stream.dial)
+
+		receive(bridgeOut(t, simulated, service))
+	})
+}
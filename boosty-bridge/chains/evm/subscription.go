@@ -0,0 +1,121 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// logsBufferSize defines number of streamed logs, which could wait for processing.
+const logsBufferSize = 128
+
+// NewWebsocketLogStream returns dialer of the websocket node. Nil is returned for empty address,
+// so new events are polled only.
+func NewWebsocketLogStream(address string) DialLogStream {
+	if address == "" {
+		return nil
+	}
+
+	return func(ctx context.Context) (LogStream, error) {
+		return ethclient.DialContext(ctx, address)
+	}
+}
+
+// logsQuery returns filter of bridge contract events.
+func (service *Service) logsQuery() ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{service.config.BridgeContractAddress},
+		Topics:    [][]common.Hash{{service.config.EventsFundIn, service.config.EventsFundOut}},
+	}
+}
+
+// streamLogs notifies subscribers with events streamed by the node until subscription is lost or context is cancelled.
+// processed is the last block, events of which were delivered. Blocks after it are read from the node once subscription
+// is established, so events produced while there was no subscription are not lost. Events which are delivered twice
+// because of that are deduplicated by bridge.
+func (service *Service) streamLogs(ctx context.Context, processed *uint64) error {
+	stream, err := service.dialLogStream(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer stream.Close()
+
+	logs := make(chan types.Log, logsBufferSize)
+	subscription, err := stream.SubscribeFilterLogs(ctx, service.logsQuery(), logs)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer subscription.Unsubscribe()
+
+	currentBlockNumber, err := service.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if currentBlockNumber > *processed {
+		if err = service.readEventsFromBlock(ctx, *processed+1, currentBlockNumber, nil); err != nil {
+			return Error.Wrap(err)
+		}
+		*processed = currentBlockNumber
+	}
+
+	for {
+		select {
+		case <-service.gctx.Done():
+			return nil
+		case <-ctx.Done():
+			return nil
+		case err := <-subscription.Err():
+			if err == nil {
+				return Error.New("log subscription closed")
+			}
+
+			return Error.Wrap(err)
+		case log := <-logs:
+			if log.Removed && log.BlockNumber > 0 && log.BlockNumber <= *processed {
+				// block is not final anymore, so it is read again after resubscription.
+				*processed = log.BlockNumber - 1
+			}
+
+			if err := service.notifyLog(ctx, log); err != nil {
+				return Error.Wrap(err)
+			}
+
+			// more logs of the same block may still be coming, so only previous blocks are completely processed.
+			if !log.Removed && log.BlockNumber > *processed+1 {
+				*processed = log.BlockNumber - 1
+			}
+		}
+	}
+}
+
+// notifyLog notifies subscribers with event of the log. Funds in logs removed due to chain reorganization are
+// delivered marked as removed, since transfer could be already bridged out on the strength of them, so bridge sends
+// them to manual review. Other removed logs are skipped, since event of the transaction is received again once
+// transaction is included into the new chain.
+func (service *Service) notifyLog(ctx context.Context, log types.Log) error {
+	if log.Removed {
+		service.log.Warn(fmt.Sprintf("event of transaction %s in block %d is removed due to chain reorganization",
+			log.TxHash.Hex(), log.BlockNumber))
+
+		if len(log.Topics) == 0 || log.Topics[0] != service.config.EventsFundIn {
+			return nil
+		}
+	}
+
+	event, err := parseLog(service.instance, log, service.config.EventsFundIn, service.config.EventsFundOut)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.Notify(ctx, event)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"tricorn/chains"
+)
+
+// erc20ABI is a part of erc20 token abi, which is required to read balances and total supply.
+const erc20ABI = `[
+	{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+// TokenSupply returns balance of the bridge contract and total supply of the token.
+func (service *Service) TokenSupply(ctx context.Context, token []byte) (chains.TokenSupply, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(erc20ABI))
+	if err != nil {
+		return chains.TokenSupply{}, Error.Wrap(err)
+	}
+
+	contract := bind.NewBoundContract(common.BytesToAddress(token), parsedABI, service.ethClient, service.ethClient, service.ethClient)
+	opts := &bind.CallOpts{Context: ctx}
+
+	var balance []interface{}
+	if err = contract.Call(opts, &balance, "balanceOf", service.config.BridgeContractAddress); err != nil {
+		return chains.TokenSupply{}, Error.Wrap(err)
+	}
+
+	var totalSupply []interface{}
+	if err = contract.Call(opts, &totalSupply, "totalSupply"); err != nil {
+		return chains.TokenSupply{}, Error.Wrap(err)
+	}
+
+	return chains.TokenSupply{
+		BridgeBalance: *abi.ConvertType(balance[0], new(*big.Int)).(**big.Int),
+		TotalSupply:   *abi.ConvertType(totalSupply[0], new(*big.Int)).(**big.Int),
+	}, nil
+}
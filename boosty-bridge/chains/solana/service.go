@@ -52,10 +52,7 @@ func NewService(gctx context.Context, config Config, log logger.Logger, bridge c
 // Network returns supported by connector network.
 // TODO: place token contract to token.
 func (service *Service) Network(ctx context.Context) networks.Network {
-	id := networks.IDSolanaTest
-	if !service.config.IsTestnet {
-		id = networks.IDSolana
-	}
+	id, _ := networks.IDByName(service.GetChainName())
 
 	return networks.Network{
 		ID: id,
@@ -103,6 +100,30 @@ func (service *Service) CancelSignature(context.Context, chains.CancelSignatureR
 	return chains.CancelSignatureResponse{}, nil
 }
 
+// TokenSupply returns balance of the bridge contract and total supply of the token.
+func (service *Service) TokenSupply(context.Context, []byte) (chains.TokenSupply, error) {
+	// TODO: implement.
+	return chains.TokenSupply{}, ErrConnector.New("token supply is not supported")
+}
+
+// SetPaused pauses or unpauses bridge contract.
+func (service *Service) SetPaused(context.Context, bool) ([]byte, error) {
+	// TODO: implement.
+	return nil, ErrConnector.New("pause is not supported")
+}
+
+// EventRange returns bridge events of the blocks range.
+func (service *Service) EventRange(context.Context, uint64, uint64) ([]chains.EventVariant, error) {
+	// TODO: implement.
+	return nil, ErrConnector.New("event range is not supported")
+}
+
+// TransactionStatus returns status of the transaction on chain.
+func (service *Service) TransactionStatus(context.Context, []byte) (chains.TxStatus, error) {
+	// TODO: implement.
+	return "", ErrConnector.New("transaction status is not supported")
+}
+
 // AddEventSubscriber adds subscriber to event publisher.
 func (service *Service) AddEventSubscriber() chains.EventSubscriber {
 	subscriber := chains.EventSubscriber{
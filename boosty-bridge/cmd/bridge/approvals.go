@@ -0,0 +1,144 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge"
+	"tricorn/bridge/database"
+	"tricorn/bridge/limits"
+	"tricorn/bridge/networks"
+	"tricorn/bridge/transfers"
+)
+
+// approvals commands.
+var (
+	approvalsCmd = &cobra.Command{
+		Use:   "approvals",
+		Short: "manages transfers which exceeded limits and wait for manual approval",
+	}
+	approvalsListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "lists transfer approvals",
+		Args:  cobra.NoArgs,
+		RunE:  cmdApprovalsList,
+	}
+	approvalsApproveCmd = &cobra.Command{
+		Use:   "approve <transfer-id>",
+		Short: "approves pending transfer, running bridge sends its bridge out",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return decideApproval(cmd.Context(), args[0], limits.ApprovalApproved)
+		},
+	}
+	approvalsRejectCmd = &cobra.Command{
+		Use:   "reject <transfer-id>",
+		Short: "rejects pending transfer, its funds stay locked on the sender network",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return decideApproval(cmd.Context(), args[0], limits.ApprovalRejected)
+		},
+	}
+
+	approvalsStatus   string
+	approvalsOperator string
+)
+
+func init() {
+	approvalsListCmd.Flags().StringVar(&approvalsStatus, "status", string(limits.ApprovalPending), "status of listed approvals, empty lists all of them")
+	for _, cmd := range []*cobra.Command{approvalsApproveCmd, approvalsRejectCmd} {
+		cmd.Flags().StringVar(&approvalsOperator, "operator", "", "identity of the operator who decides on the transfer")
+		_ = cmd.MarkFlagRequired("operator")
+	}
+
+	approvalsCmd.AddCommand(approvalsListCmd, approvalsApproveCmd, approvalsRejectCmd)
+	rootCmd.AddCommand(approvalsCmd)
+}
+
+// cmdApprovalsList prints transfer approvals as json.
+func cmdApprovalsList(cmd *cobra.Command, args []string) (err error) {
+	status := limits.ApprovalStatus(approvalsStatus)
+	if status != "" {
+		if err = status.Validate(); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	approvals, err := db.TransferApprovals().List(cmd.Context(), status)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return Error.Wrap(encoder.Encode(approvals))
+}
+
+// decideApproval moves pending approval of the transfer to the specified status.
+func decideApproval(ctx context.Context, transferID string, to limits.ApprovalStatus) (err error) {
+	id, err := strconv.ParseUint(transferID, 10, 64)
+	if err != nil {
+		return Error.New("invalid transfer id %q", transferID)
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	err = db.TransferApprovals().Decide(ctx, limits.Decision{
+		TransferID: transfers.ID(id),
+		From:       limits.ApprovalPending,
+		To:         to,
+		Operator:   approvalsOperator,
+		DecidedAt:  time.Now().UTC(),
+	})
+	return Error.Wrap(err)
+}
+
+// openDatabase connects to the bridge database from the config.
+func openDatabase() (bridge.DB, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return database.New(config.Database)
+}
+
+// loadConfig reads bridge config from the env file and environment, configured networks are registered, so
+// commands accept the same networks as running bridge.
+func loadConfig() (*Config, error) {
+	if err := godotenv.Overload("./configs/.bridge.env"); err != nil {
+		return nil, err
+	}
+
+	config := new(Config)
+	if err := env.Parse(config); err != nil {
+		return nil, err
+	}
+
+	return config, networks.RegisterFile(config.NetworksFile)
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/dryrun"
+)
+
+// dry run commands.
+var (
+	dryRunCmd = &cobra.Command{
+		Use:   "dryrun",
+		Short: "inspects decisions of the bridge running in dry run mode",
+	}
+	dryRunReportCmd = &cobra.Command{
+		Use:   "report",
+		Short: "compares decisions of the dry run with the live bridge and prints where they diverge",
+		Args:  cobra.NoArgs,
+		RunE:  cmdDryRunReport,
+	}
+)
+
+func init() {
+	dryRunCmd.AddCommand(dryRunReportCmd)
+	rootCmd.AddCommand(dryRunCmd)
+}
+
+// cmdDryRunReport prints comparison report of the dry run as json.
+func cmdDryRunReport(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	report, err := dryrun.NewReport(cmd.Context(), db.DryRunDecisions(), db.DryRunOutcomes())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(printJSON(report))
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/transfers"
+	"tricorn/communication"
+	"tricorn/communication/rpc"
+	"tricorn/internal/logger/zaplog"
+)
+
+// interventions commands.
+var (
+	transferCmd = &cobra.Command{
+		Use:   "transfer",
+		Short: "intervenes into stuck transfers through running bridge, every intervention is recorded to the audit log",
+	}
+	transferRetryCmd = &cobra.Command{
+		Use:   "retry <transfer-id>",
+		Short: "sends bridge out of the confirming transfer again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return intervene(cmd.Context(), args[0], interventions.KindRetry)
+		},
+	}
+	transferAttachTxCmd = &cobra.Command{
+		Use:   "attach-tx <transfer-id>",
+		Short: "finishes confirming transfer by bridge out transaction sent outside of the bridge, transaction is verified on chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return intervene(cmd.Context(), args[0], interventions.KindAttachOutboundTx)
+		},
+	}
+	transferReleaseCmd = &cobra.Command{
+		Use:   "release <transfer-id>",
+		Short: "screens addresses of the held transfer again and sends its bridge out if they pass",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return intervene(cmd.Context(), args[0], interventions.KindRelease)
+		},
+	}
+	transferCancelCmd = &cobra.Command{
+		Use:   "cancel <transfer-id>",
+		Short: "cancels transfer, which is not finished yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return intervene(cmd.Context(), args[0], interventions.KindForceCancel)
+		},
+	}
+	transferAnnotateCmd = &cobra.Command{
+		Use:   "annotate <transfer-id>",
+		Short: "adds note to the transfer without changing it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return intervene(cmd.Context(), args[0], interventions.KindAnnotate)
+		},
+	}
+	transferAuditLogCmd = &cobra.Command{
+		Use:   "audit-log <transfer-id>",
+		Short: "prints audit log of the transfer",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdTransferAuditLog,
+	}
+
+	interventionOperator    string
+	interventionReason      string
+	interventionTxHash      string
+	interventionBlockNumber uint64
+	interventionNote        string
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{transferRetryCmd, transferAttachTxCmd, transferReleaseCmd, transferCancelCmd, transferAnnotateCmd} {
+		cmd.Flags().StringVar(&interventionOperator, "operator", "", "identity of the operator who intervenes")
+		cmd.Flags().StringVar(&interventionReason, "reason", "", "reason of the intervention")
+		_ = cmd.MarkFlagRequired("operator")
+		_ = cmd.MarkFlagRequired("reason")
+	}
+	transferAttachTxCmd.Flags().StringVar(&interventionTxHash, "tx", "", "hash of the bridge out transaction")
+	transferAttachTxCmd.Flags().Uint64Var(&interventionBlockNumber, "block", 0, "number of the block of the bridge out transaction")
+	_ = transferAttachTxCmd.MarkFlagRequired("tx")
+	_ = transferAttachTxCmd.MarkFlagRequired("block")
+	transferAnnotateCmd.Flags().StringVar(&interventionNote, "note", "", "text of the annotation")
+	_ = transferAnnotateCmd.MarkFlagRequired("note")
+
+	transferCmd.AddCommand(transferRetryCmd, transferAttachTxCmd, transferReleaseCmd, transferCancelCmd, transferAnnotateCmd,
+		transferAuditLogCmd)
+	rootCmd.AddCommand(transferCmd)
+}
+
+// intervene asks running bridge to perform intervention into the transfer and prints its audit log entry as json.
+func intervene(ctx context.Context, transferID string, kind interventions.Kind) (err error) {
+	id, err := strconv.ParseUint(transferID, 10, 64)
+	if err != nil {
+		return Error.New("invalid transfer id %q", transferID)
+	}
+
+	req := interventions.Request{
+		TransferID:  transfers.ID(id),
+		Kind:        kind,
+		Operator:    interventionOperator,
+		Reason:      interventionReason,
+		TxHash:      interventionTxHash,
+		BlockNumber: interventionBlockNumber,
+		Note:        interventionNote,
+	}
+	if err = req.Validate(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	comm, err := dialGateway()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, comm.Close())
+	}()
+
+	entry, err := comm.Interventions().Intervene(ctx, req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(printJSON(entry))
+}
+
+// cmdTransferAuditLog prints audit log of the transfer as json.
+func cmdTransferAuditLog(cmd *cobra.Command, args []string) (err error) {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return Error.New("invalid transfer id %q", args[0])
+	}
+
+	comm, err := dialGateway()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, comm.Close())
+	}()
+
+	entries, err := comm.Interventions().AuditLog(cmd.Context(), transfers.ID(id))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(printJSON(entries))
+}
+
+// dialGateway connects to the gateway grpc server of the running bridge.
+func dialGateway() (communication.Communication, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	config.DialConfig.ServerAddress = config.GatewayGrpcServerAddress
+	return rpc.New(config.DialConfig, zaplog.NewLog(), false)
+}
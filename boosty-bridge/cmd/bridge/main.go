@@ -6,9 +6,11 @@ package main
 import (
 	"context"
 	"errors"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 	"github.com/joho/godotenv"
@@ -22,8 +24,12 @@ import (
 
 	"tricorn/bridge"
 	"tricorn/bridge/database"
+	"tricorn/bridge/limits"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/reconciliation"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/server/controllers"
+	"tricorn/bridge/webhooks"
 	"tricorn/communication"
 	"tricorn/communication/mockcommunication"
 	"tricorn/communication/rpc"
@@ -31,6 +37,7 @@ import (
 	"tricorn/internal/logger/zaplog"
 	"tricorn/internal/server"
 	grpc_server "tricorn/internal/server/grpc"
+	"tricorn/pkg/codec"
 )
 
 // Error is a default error type for bridge cli.
@@ -46,6 +53,40 @@ type Config struct {
 	BridgeGrpcServerAddress  string             `env:"BRIDGE_GRPC_SERVER_ADDRESS"`
 	CommunicationMode        communication.Mode `env:"COMMUNICATION_MODE"`
 
+	TransfersExpirationIntervalInSeconds uint32 `env:"TRANSFERS_EXPIRATION_INTERVAL_IN_SECONDS" envDefault:"60"`
+	// TransfersExpirationGraceInSeconds has to exceed max lag of events indexing, so paid transfers are not expired.
+	TransfersExpirationGraceInSeconds uint32 `env:"TRANSFERS_EXPIRATION_GRACE_IN_SECONDS" envDefault:"900"`
+
+	WebhookDeliveryIntervalInSeconds uint32 `env:"WEBHOOK_DELIVERY_INTERVAL_IN_SECONDS" envDefault:"5"`
+	WebhookRequestTimeoutInSeconds   uint32 `env:"WEBHOOK_REQUEST_TIMEOUT_IN_SECONDS" envDefault:"10"`
+	WebhookMaxAttempts               uint32 `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"10"`
+	WebhookMinRetryDelayInSeconds    uint32 `env:"WEBHOOK_MIN_RETRY_DELAY_IN_SECONDS" envDefault:"10"`
+	WebhookMaxRetryDelayInSeconds    uint32 `env:"WEBHOOK_MAX_RETRY_DELAY_IN_SECONDS" envDefault:"3600"`
+	WebhookDeliveryBatchSize         int    `env:"WEBHOOK_DELIVERY_BATCH_SIZE" envDefault:"16"`
+
+	ReconciliationIntervalInSeconds      uint32 `env:"RECONCILIATION_INTERVAL_IN_SECONDS" envDefault:"300"`
+	ReconciliationConfirmingSLAInSeconds uint32 `env:"RECONCILIATION_CONFIRMING_SLA_IN_SECONDS" envDefault:"3600"`
+	// ReconciliationServerAddress is an address reconciliation report and metrics are served on, empty one disables it.
+	ReconciliationServerAddress string `env:"RECONCILIATION_SERVER_ADDRESS" envDefault:""`
+
+	NetworksFile string `env:"NETWORKS_FILE" envDefault:""`
+
+	// DryRun defines whether bridge only records decisions on connector events, it sends no bridge out and never
+	// calls signer, so it runs along with the live bridge against the same database.
+	DryRun bool `env:"DRY_RUN" envDefault:"false"`
+
+	// LimitsFile is a json file with limits of transfers, empty one disables limits.
+	LimitsFile                 string `env:"LIMITS_FILE" envDefault:""`
+	ApprovalsIntervalInSeconds uint32 `env:"APPROVALS_INTERVAL_IN_SECONDS" envDefault:"10"`
+
+	PauseBacklogIntervalInSeconds uint32 `env:"PAUSE_BACKLOG_INTERVAL_IN_SECONDS" envDefault:"10"`
+
+	// ScreeningProviderURL is an url of the http screening provider, empty one screens addresses by denylist only.
+	ScreeningProviderURL              string `env:"SCREENING_PROVIDER_URL" envDefault:""`
+	ScreeningProviderName             string `env:"SCREENING_PROVIDER_NAME" envDefault:"provider"`
+	ScreeningProviderToken            string `env:"SCREENING_PROVIDER_TOKEN" envDefault:""`
+	ScreeningProviderTimeoutInSeconds uint32 `env:"SCREENING_PROVIDER_TIMEOUT_IN_SECONDS" envDefault:"10"`
+
 	CasperTokenAddress    string `env:"CASPER_TOKEN_CONTRACT"`
 	EthTokenAddress       string `env:"ETH_TOKEN_CONTRACT"`
 	PolygonTokenAddress   string `env:"POLYGON_TOKEN_CONTRACT"`
@@ -89,6 +130,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	var (
 		connectorBridgeServer server.Server
 		gatewayBridgeServer   server.Server
+		reconciliationServer  server.Server
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -121,6 +163,13 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, db.Close())
 	}()
 
+	// networks from file are registered before schema creation, since migrations select networks by type.
+	err = networks.RegisterFile(config.NetworksFile)
+	if err != nil {
+		log.Error("could not load networks", Error.Wrap(err))
+		return Error.Wrap(err)
+	}
+
 	// TODO: replace with migrations.
 	err = db.CreateSchema(ctx)
 	if err != nil {
@@ -128,8 +177,22 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return Error.Wrap(err)
 	}
 
+	{ // networks registry setup, definitions from db override the ones from file.
+		definitions, err := db.Networks().List(ctx)
+		if err != nil {
+			log.Error("could not list networks", Error.Wrap(err))
+			return Error.Wrap(err)
+		}
+
+		err = networks.Register(definitions...)
+		if err != nil {
+			log.Error("could not register networks", Error.Wrap(err))
+			return Error.Wrap(err)
+		}
+	}
+
 	var signer bridge.Signer
-	{ // communication setup.
+	if !config.DryRun { // communication setup.
 		switch config.CommunicationMode {
 		case communication.ModeGRPC:
 			config.DialConfig.ServerAddress = config.SignerServerAddress
@@ -145,6 +208,30 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		}
 	}
 
+	transferWatcher := bridge.NewTransferWatcher(log, db.TransferStatusChanges())
+
+	limitRules, err := limits.LoadFile(config.LimitsFile)
+	if err != nil {
+		log.Error("could not load limits", Error.Wrap(err))
+		return Error.Wrap(err)
+	}
+
+	limiter, err := limits.NewLimiter(limitRules, db.LimitCounters())
+	if err != nil {
+		log.Error("invalid limits", Error.Wrap(err))
+		return Error.Wrap(err)
+	}
+
+	screeners := screening.Screeners{screening.NewDenylistScreener(db.Denylist())}
+	if config.ScreeningProviderURL != "" {
+		screeners = append(screeners, screening.NewProviderScreener(screening.ProviderConfig{
+			Name:    config.ScreeningProviderName,
+			URL:     config.ScreeningProviderURL,
+			Token:   config.ScreeningProviderToken,
+			Timeout: time.Duration(config.ScreeningProviderTimeoutInSeconds) * time.Second,
+		}))
+	}
+
 	service := bridge.New(
 		log,
 		signer,
@@ -154,11 +241,33 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		db.Transactions(),
 		db.TokenTransfers(),
 		db.NetworkBlocks(),
+		db.UnmatchedEvents(),
+		transferWatcher,
+		db.WebhookEndpoints(),
+		db.WebhookOutbox(),
+		limiter,
+		db.TransferApprovals(),
+		db.Pauses(),
+		db.PausedTransfers(),
+		screeners,
+		db.ScreeningHolds(),
+		db.AuditLog(),
+		db.TransferStatusHistory(),
+		db.OutboundAttempts(),
+		db.DryRunDecisions(),
+		config.DryRun,
 	)
 
 	// connects to connectors.
 	go connectorsConnect(ctx, log, service, *config)
 
+	if config.DryRun {
+		// servers and chores change state of the transfers, so they are left to the live bridge.
+		log.Debug("bridge is running in dry run mode")
+		<-ctx.Done()
+		return nil
+	}
+
 	{ // connector-bridge server initialization.
 		controller := controllers.NewSigner(service)
 
@@ -181,6 +290,22 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		gatewayBridgeServer = grpc_server.NewServer(log, registerServer, serverName, config.GatewayGrpcServerAddress)
 	}
 
+	reconciliationConfig := reconciliation.Config{
+		Interval:      time.Duration(config.ReconciliationIntervalInSeconds) * time.Second,
+		ConfirmingSLA: time.Duration(config.ReconciliationConfirmingSLAInSeconds) * time.Second,
+	}
+	auditor := reconciliation.NewAuditor(log, reconciliationConfig, db.ReconciliationLedger(), db.ReconciliationBaselines(), service)
+
+	if config.ReconciliationServerAddress != "" { // reconciliation server initialization.
+		listener, err := net.Listen("tcp", config.ReconciliationServerAddress)
+		if err != nil {
+			log.Error("could not listen reconciliation server address", Error.Wrap(err))
+			return Error.Wrap(err)
+		}
+
+		reconciliationServer = reconciliation.NewServer(log, listener, auditor)
+	}
+
 	group, ctx := errgroup.WithContext(ctx)
 
 	group.Go(func() error {
@@ -189,10 +314,50 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	group.Go(func() error {
 		return gatewayBridgeServer.Run(ctx)
 	})
+	group.Go(func() error {
+		return transferWatcher.Run(ctx)
+	})
+	group.Go(func() error {
+		interval := time.Duration(config.TransfersExpirationIntervalInSeconds) * time.Second
+		grace := time.Duration(config.TransfersExpirationGraceInSeconds) * time.Second
+		return bridge.NewExpirationChore(log, db.TokenTransfers(), interval, grace).Run(ctx)
+	})
+	group.Go(func() error {
+		interval := time.Duration(config.ApprovalsIntervalInSeconds) * time.Second
+		return bridge.NewApprovalChore(log, service, interval).Run(ctx)
+	})
+	group.Go(func() error {
+		interval := time.Duration(config.PauseBacklogIntervalInSeconds) * time.Second
+		return bridge.NewBacklogChore(log, service, interval).Run(ctx)
+	})
+	group.Go(func() error {
+		dispatcherConfig := webhooks.DispatcherConfig{
+			Interval:       time.Duration(config.WebhookDeliveryIntervalInSeconds) * time.Second,
+			RequestTimeout: time.Duration(config.WebhookRequestTimeoutInSeconds) * time.Second,
+			MaxAttempts:    config.WebhookMaxAttempts,
+			MinRetryDelay:  time.Duration(config.WebhookMinRetryDelayInSeconds) * time.Second,
+			MaxRetryDelay:  time.Duration(config.WebhookMaxRetryDelayInSeconds) * time.Second,
+			BatchSize:      config.WebhookDeliveryBatchSize,
+		}
+		return webhooks.NewDispatcher(log, dispatcherConfig, db.WebhookEndpoints(), db.WebhookOutbox(), service).Run(ctx)
+	})
+	group.Go(func() error {
+		return auditor.Run(ctx)
+	})
+	if reconciliationServer != nil {
+		group.Go(func() error {
+			return reconciliationServer.Run(ctx)
+		})
+	}
+
+	err = group.Wait()
+	if reconciliationServer != nil {
+		err = errs.Combine(err, reconciliationServer.Close())
+	}
 
 	return ignoreContextCancellationError(
 		errs.Combine(
-			group.Wait(),
+			err,
 			connectorBridgeServer.Close(),
 			gatewayBridgeServer.Close(),
 		),
@@ -240,13 +405,13 @@ func cmdSeed(cmd *cobra.Command, args []string) (err error) {
 		return Error.Wrap(err)
 	}
 
-	casperContractAddress, err := networks.StringToBytes(networks.IDCasperTest, config.CasperTokenAddress)
+	casperContractAddress, err := codec.Casper.ParseContract(config.CasperTokenAddress)
 	if err != nil {
 		log.Error("could not decode casper contract address", Error.Wrap(err))
 		return Error.Wrap(err)
 	}
 
-	ethContractAddress, err := networks.StringToBytes(networks.IDGoerli, config.EthTokenAddress)
+	ethContractAddress, err := codec.EVM.ParseContract(config.EthTokenAddress)
 	if err != nil {
 		log.Error("could not decode ethereum contract address", Error.Wrap(err))
 		return Error.Wrap(err)
@@ -0,0 +1,205 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/communication/rpc"
+	"tricorn/internal/logger/zaplog"
+)
+
+// pause commands.
+var (
+	pauseCmd = &cobra.Command{
+		Use:   "pause",
+		Short: "pauses the whole bridge or single network, bridge stops signing bridge in and sending bridge out",
+		Args:  cobra.NoArgs,
+		RunE:  cmdPause,
+	}
+	resumeCmd = &cobra.Command{
+		Use:   "resume",
+		Short: "resumes the whole bridge or single network, deferred bridge outs are sent by running bridge",
+		Args:  cobra.NoArgs,
+		RunE:  cmdResume,
+	}
+	pausesCmd = &cobra.Command{
+		Use:   "pauses",
+		Short: "lists active pauses",
+		Args:  cobra.NoArgs,
+		RunE:  cmdPauses,
+	}
+
+	pauseNetwork  string
+	pauseReason   string
+	pauseOperator string
+	pauseOnChain  bool
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{pauseCmd, resumeCmd} {
+		cmd.Flags().StringVar(&pauseNetwork, "network", "", "paused network, empty one means the whole bridge")
+		cmd.Flags().BoolVar(&pauseOnChain, "on-chain", false, "also pause bridge contracts of the networks, which support it")
+	}
+	pauseCmd.Flags().StringVar(&pauseReason, "reason", "", "reason of the pause")
+	pauseCmd.Flags().StringVar(&pauseOperator, "operator", "", "identity of the operator who pauses the bridge")
+	_ = pauseCmd.MarkFlagRequired("reason")
+	_ = pauseCmd.MarkFlagRequired("operator")
+
+	rootCmd.AddCommand(pauseCmd, resumeCmd, pausesCmd)
+}
+
+// cmdPause pauses bridge or network in the database and on chain if requested.
+func cmdPause(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	network, err := parsePauseNetwork()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = db.Pauses().Pause(cmd.Context(), pause.Pause{
+		Network:  network,
+		Reason:   pauseReason,
+		Operator: pauseOperator,
+		PausedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if pauseOnChain {
+		return Error.Wrap(setPausedOnChain(cmd.Context(), network, true))
+	}
+
+	return nil
+}
+
+// cmdResume resumes bridge or network in the database and on chain if requested.
+func cmdResume(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	network, err := parsePauseNetwork()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err = db.Pauses().Resume(cmd.Context(), network); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if pauseOnChain {
+		return Error.Wrap(setPausedOnChain(cmd.Context(), network, false))
+	}
+
+	return nil
+}
+
+// cmdPauses prints active pauses as json.
+func cmdPauses(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	pauses, err := db.Pauses().List(cmd.Context())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return Error.Wrap(encoder.Encode(pauses))
+}
+
+// parsePauseNetwork validates network flag, empty network means the whole bridge.
+func parsePauseNetwork() (networks.Name, error) {
+	network := networks.Name(pauseNetwork)
+	if network == "" {
+		return "", nil
+	}
+
+	if _, ok := networks.IDByName(network); !ok {
+		return "", Error.New("unknown network %s", network)
+	}
+
+	return network, nil
+}
+
+// setPausedOnChain pauses or unpauses bridge contracts through connectors, contracts of all connected networks are
+// changed if network is empty.
+func setPausedOnChain(ctx context.Context, network networks.Name, paused bool) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	connectors := map[networks.Name]string{
+		networks.NameGoerli:     config.EthServerAddress,
+		networks.NameCasperTest: config.CasperServerAddress,
+	}
+
+	var group errs.Group
+	for name, address := range connectors {
+		if network != "" && network != name {
+			continue
+		}
+
+		dialConfig := config.DialConfig
+		dialConfig.ServerAddress = address
+		group.Add(setPausedByConnector(ctx, name, dialConfig, paused))
+	}
+
+	if network != "" {
+		if _, ok := connectors[network]; !ok {
+			group.Add(Error.New("there is no connector of network %s", network))
+		}
+	}
+
+	return group.Err()
+}
+
+// setPausedByConnector sends pause or unpause transaction of the bridge contract of the network.
+func setPausedByConnector(ctx context.Context, network networks.Name, dialConfig rpc.Config, paused bool) (err error) {
+	comm, err := rpc.New(dialConfig, zaplog.NewLog(), false)
+	if err != nil {
+		return Error.New("couldn't connect to %s connector: %v", network, err)
+	}
+	defer func() {
+		err = errs.Combine(err, comm.Close())
+	}()
+
+	txHash, err := comm.Connector(ctx).SetPaused(ctx, paused)
+	if err != nil {
+		return Error.New("couldn't set paused %t on %s: %v", paused, network, err)
+	}
+
+	fmt.Printf("%s: paused %t by transaction 0x%s\n", network, paused, hex.EncodeToString(txHash))
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/rescan"
+	"tricorn/bridge/transfers"
+)
+
+// rescan command.
+var (
+	rescanCmd = &cobra.Command{
+		Use:   "rescan",
+		Short: "processes bridge events of the range of blocks of the network again through running bridge",
+		Args:  cobra.NoArgs,
+		RunE:  cmdRescan,
+	}
+
+	rescanNetwork   string
+	rescanFromBlock uint64
+	rescanToBlock   uint64
+)
+
+func init() {
+	rescanCmd.Flags().StringVar(&rescanNetwork, "network", "", "rescanned network")
+	rescanCmd.Flags().Uint64Var(&rescanFromBlock, "from", 0, "first block of the range")
+	rescanCmd.Flags().Uint64Var(&rescanToBlock, "to", 0, "last block of the range, inclusive")
+	_ = rescanCmd.MarkFlagRequired("network")
+	_ = rescanCmd.MarkFlagRequired("from")
+	_ = rescanCmd.MarkFlagRequired("to")
+
+	rootCmd.AddCommand(rescanCmd)
+}
+
+// rescanEvent is the printed form of rescan.Event with formatted transaction hash.
+type rescanEvent struct {
+	Kind        transfers.EventKind `json:"kind"`
+	TxHash      string              `json:"txHash"`
+	LogIndex    uint64              `json:"logIndex"`
+	BlockNumber uint64              `json:"blockNumber"`
+}
+
+// cmdRescan asks running bridge to rescan range of blocks and prints report as json.
+func cmdRescan(cmd *cobra.Command, args []string) (err error) {
+	networkID, ok := networks.IDByName(networks.Name(rescanNetwork))
+	if !ok {
+		return Error.New("unknown network %s", rescanNetwork)
+	}
+
+	req := rescan.Request{
+		NetworkID: networkID,
+		FromBlock: rescanFromBlock,
+		ToBlock:   rescanToBlock,
+	}
+	if err = req.Validate(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	comm, err := dialGateway()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, comm.Close())
+	}()
+
+	report, err := comm.Rescan().Rescan(cmd.Context(), req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	processed, err := formatRescanEvents(networkID, report.Processed)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	known, err := formatRescanEvents(networkID, report.Known)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(printJSON(struct {
+		Network   networks.Name `json:"network"`
+		Processed []rescanEvent `json:"processed"`
+		Known     []rescanEvent `json:"known"`
+	}{
+		Network:   networks.Name(rescanNetwork),
+		Processed: processed,
+		Known:     known,
+	}))
+}
+
+// formatRescanEvents formats transaction hashes of the events by codec of the network.
+func formatRescanEvents(networkID networks.ID, events []rescan.Event) ([]rescanEvent, error) {
+	networkCodec, err := networkID.Codec()
+	if err != nil {
+		return nil, err
+	}
+
+	formatted := make([]rescanEvent, 0, len(events))
+	for _, event := range events {
+		txHash, err := networkCodec.FormatHash(event.TxHash)
+		if err != nil {
+			return nil, err
+		}
+
+		formatted = append(formatted, rescanEvent{
+			Kind:        event.Kind,
+			TxHash:      txHash,
+			LogIndex:    event.LogIndex,
+			BlockNumber: event.BlockNumber,
+		})
+	}
+
+	return formatted, nil
+}
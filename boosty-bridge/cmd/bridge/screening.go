@@ -0,0 +1,236 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+	"tricorn/bridge/screening"
+	"tricorn/bridge/transfers"
+)
+
+// screening commands.
+var (
+	screeningCmd = &cobra.Command{
+		Use:   "screening",
+		Short: "manages denylist of addresses and lists transfers held by screening",
+	}
+	screeningDenyCmd = &cobra.Command{
+		Use:   "deny <network> <address>",
+		Short: "adds address to the denylist, its bridge in is refused and bridge out is held",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdScreeningDeny,
+	}
+	screeningAllowCmd = &cobra.Command{
+		Use:   "allow <network> <address>",
+		Short: "removes address from the denylist",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdScreeningAllow,
+	}
+	screeningDenylistCmd = &cobra.Command{
+		Use:   "denylist",
+		Short: "lists denied addresses",
+		Args:  cobra.NoArgs,
+		RunE:  cmdScreeningDenylist,
+	}
+	screeningHoldsCmd = &cobra.Command{
+		Use:   "holds",
+		Short: "lists transfers held by screening",
+		Args:  cobra.NoArgs,
+		RunE:  cmdScreeningHolds,
+	}
+
+	screeningReason   string
+	screeningOperator string
+)
+
+func init() {
+	screeningDenyCmd.Flags().StringVar(&screeningReason, "reason", "", "reason of the denial")
+	screeningDenyCmd.Flags().StringVar(&screeningOperator, "operator", "", "identity of the operator who denies the address")
+	_ = screeningDenyCmd.MarkFlagRequired("reason")
+	_ = screeningDenyCmd.MarkFlagRequired("operator")
+
+	screeningCmd.AddCommand(screeningDenyCmd, screeningAllowCmd, screeningDenylistCmd, screeningHoldsCmd)
+	rootCmd.AddCommand(screeningCmd)
+}
+
+// screenedAddress describes screened address in the commands output.
+type screenedAddress struct {
+	Network networks.Name `json:"network"`
+	Address string        `json:"address"`
+}
+
+// cmdScreeningDeny adds address to the denylist.
+func cmdScreeningDeny(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	subject, err := parseSubject(args[0], args[1])
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = db.Denylist().Add(cmd.Context(), screening.Entry{
+		Subject:   subject,
+		Reason:    screeningReason,
+		Operator:  screeningOperator,
+		CreatedAt: time.Now().UTC(),
+	})
+	return Error.Wrap(err)
+}
+
+// cmdScreeningAllow removes address from the denylist.
+func cmdScreeningAllow(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	subject, err := parseSubject(args[0], args[1])
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(db.Denylist().Remove(cmd.Context(), subject))
+}
+
+// cmdScreeningDenylist prints denied addresses as json.
+func cmdScreeningDenylist(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	entries, err := db.Denylist().List(cmd.Context())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	type deniedAddress struct {
+		screenedAddress
+		Reason    string    `json:"reason"`
+		Operator  string    `json:"operator"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	denied := make([]deniedAddress, 0, len(entries))
+	for _, entry := range entries {
+		address, err := formatSubject(entry.Subject)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		denied = append(denied, deniedAddress{
+			screenedAddress: address,
+			Reason:          entry.Reason,
+			Operator:        entry.Operator,
+			CreatedAt:       entry.CreatedAt,
+		})
+	}
+
+	return Error.Wrap(printJSON(denied))
+}
+
+// cmdScreeningHolds prints transfers held by screening as json.
+func cmdScreeningHolds(cmd *cobra.Command, args []string) (err error) {
+	db, err := openDatabase()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	holds, err := db.ScreeningHolds().List(cmd.Context())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	type heldTransfer struct {
+		TransferID transfers.ID    `json:"transferId"`
+		Flagged    screenedAddress `json:"flagged"`
+		Provider   string          `json:"provider"`
+		Reason     string          `json:"reason"`
+		CreatedAt  time.Time       `json:"createdAt"`
+	}
+
+	held := make([]heldTransfer, 0, len(holds))
+	for _, hold := range holds {
+		address, err := formatSubject(screening.Subject{NetworkID: hold.NetworkID, Address: hold.Address})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		held = append(held, heldTransfer{
+			TransferID: hold.TransferID,
+			Flagged:    address,
+			Provider:   hold.Provider,
+			Reason:     hold.Reason,
+			CreatedAt:  hold.CreatedAt,
+		})
+	}
+
+	return Error.Wrap(printJSON(held))
+}
+
+// parseSubject parses address of the network.
+func parseSubject(network, address string) (screening.Subject, error) {
+	networkID, ok := networks.IDByName(networks.Name(network))
+	if !ok {
+		return screening.Subject{}, Error.New("unknown network %s", network)
+	}
+
+	networkCodec, err := networkID.Codec()
+	if err != nil {
+		return screening.Subject{}, err
+	}
+
+	parsed, err := networkCodec.ParseAddress(address)
+	if err != nil {
+		return screening.Subject{}, err
+	}
+
+	return screening.Subject{NetworkID: networkID, Address: parsed}, nil
+}
+
+// formatSubject formats screened address by the codec of its network.
+func formatSubject(subject screening.Subject) (screenedAddress, error) {
+	name, _ := networks.NameByID(subject.NetworkID)
+
+	networkCodec, err := subject.NetworkID.Codec()
+	if err != nil {
+		return screenedAddress{}, err
+	}
+
+	address, err := networkCodec.FormatAddress(subject.Address)
+	if err != nil {
+		return screenedAddress{}, err
+	}
+
+	return screenedAddress{Network: name, Address: address}, nil
+}
+
+// printJSON prints value as indented json.
+func printJSON(value interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
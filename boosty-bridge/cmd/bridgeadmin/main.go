@@ -0,0 +1,308 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/caarlos0/env/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge"
+	"tricorn/bridge/networks"
+	"tricorn/chains/evm"
+	"tricorn/communication/rpc"
+	"tricorn/internal/contracts/admin"
+	"tricorn/internal/logger/zaplog"
+	"tricorn/pkg/casper-sdk/client"
+	signature_lib "tricorn/pkg/signature"
+	"tricorn/signer"
+)
+
+// Error is a default error type for bridge admin cli.
+var Error = errs.Class("bridge admin cli")
+
+// Config is the global configuration to administrate bridge contracts.
+type Config struct {
+	DialConfig          rpc.Config
+	SignerServerAddress string `env:"SIGNER_SERVER_ADDRESS"`
+	NetworksFile        string `env:"NETWORKS_FILE" envDefault:""`
+	ContractsFile       string `env:"CONTRACTS_FILE"`
+
+	ConfirmationTimeoutInSeconds      uint32 `env:"CONFIRMATION_TIMEOUT_IN_SECONDS" envDefault:"600"`
+	ConfirmationPollIntervalInSeconds uint32 `env:"CONFIRMATION_POLL_INTERVAL_IN_SECONDS" envDefault:"5"`
+}
+
+// contractConfig describes bridge contract of the network in the contracts file.
+type contractConfig struct {
+	Network        networks.Name `json:"network"`
+	NodeAddress    string        `json:"nodeAddress"`
+	BridgeContract string        `json:"bridgeContract"`
+	// StandardPayment is a payment of casper deploys in motes.
+	StandardPayment int64 `json:"standardPayment,omitempty"`
+}
+
+// commands.
+var (
+	rootCmd = &cobra.Command{
+		Use:   "bridgeadmin",
+		Short: "cli for administration of bridge contracts",
+	}
+	pauseCmd = &cobra.Command{
+		Use:   "pause",
+		Short: "pauses bridge contract",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return execute(cmd.Context(), admin.Operation{Kind: admin.KindPause})
+		},
+	}
+	unpauseCmd = &cobra.Command{
+		Use:   "unpause",
+		Short: "unpauses bridge contract",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return execute(cmd.Context(), admin.Operation{Kind: admin.KindUnpause})
+		},
+	}
+	setCommissionCmd = &cobra.Command{
+		Use:   "set-commission <percent>",
+		Short: "sets stable commission percent of bridge contract",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdSetCommission,
+	}
+	withdrawCommissionCmd = &cobra.Command{
+		Use:   "withdraw-commission <token> <amount>",
+		Short: "withdraws collected commission of the token from bridge contract",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdWithdrawCommission,
+	}
+	transferOwnershipCmd = &cobra.Command{
+		Use:   "transfer-ownership <owner>",
+		Short: "transfers ownership of bridge contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return execute(cmd.Context(), admin.Operation{Kind: admin.KindTransferOwnership, Owner: args[0]})
+		},
+	}
+	setSignerCmd = &cobra.Command{
+		Use:   "set-signer <signer>",
+		Short: "sets public key which bridge contract verifies signatures with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return execute(cmd.Context(), admin.Operation{Kind: admin.KindSetSigner, Signer: args[0]})
+		},
+	}
+
+	network string
+	dryRun  bool
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&network, "network", "", "name of the network, which bridge contract is administrated")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "prints unsigned transaction or deploy without sending it")
+	_ = rootCmd.MarkPersistentFlagRequired("network")
+
+	rootCmd.AddCommand(pauseCmd, unpauseCmd, setCommissionCmd, withdrawCommissionCmd, transferOwnershipCmd, setSignerCmd)
+}
+
+func main() {
+	err := rootCmd.Execute()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// cmdSetCommission sets stable commission percent.
+func cmdSetCommission(cmd *cobra.Command, args []string) error {
+	percent, ok := new(big.Int).SetString(args[0], 10)
+	if !ok {
+		return Error.New("invalid percent %s", args[0])
+	}
+
+	return execute(cmd.Context(), admin.Operation{Kind: admin.KindSetStableCommissionPercent, Percent: percent})
+}
+
+// cmdWithdrawCommission withdraws collected commission.
+func cmdWithdrawCommission(cmd *cobra.Command, args []string) error {
+	amount, ok := new(big.Int).SetString(args[1], 10)
+	if !ok {
+		return Error.New("invalid amount %s", args[1])
+	}
+
+	return execute(cmd.Context(), admin.Operation{Kind: admin.KindWithdrawCommission, Token: args[0], Amount: amount})
+}
+
+// execute performs operation on bridge contract of the network and prints its result as json.
+func execute(ctx context.Context, operation admin.Operation) error {
+	if err := operation.Validate(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(config.ConfirmationTimeoutInSeconds)*time.Second)
+	defer cancel()
+
+	contract, err := dialContract(ctx, config)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	result, err := contract.Execute(ctx, operation, dryRun)
+	if result.Hash != "" || len(result.Unsigned) != 0 {
+		err = errs.Combine(err, printJSON(result))
+	}
+
+	return Error.Wrap(err)
+}
+
+// loadConfig loads configuration of the command and registers configured networks.
+func loadConfig() (*Config, error) {
+	if err := godotenv.Overload("./configs/.bridgeadmin.env"); err != nil {
+		return nil, err
+	}
+
+	config := new(Config)
+	if err := env.Parse(config); err != nil {
+		return nil, err
+	}
+
+	return config, networks.RegisterFile(config.NetworksFile)
+}
+
+// dialContract connects to the signer and the node of the network and returns its bridge contract.
+func dialContract(ctx context.Context, config *Config) (admin.Contract, error) {
+	definition, ok := networks.ByName(networks.Name(network))
+	if !ok {
+		return nil, Error.New("unknown network %s", network)
+	}
+
+	contractConfig, err := findContract(config.ContractsFile, definition.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	config.DialConfig.ServerAddress = config.SignerServerAddress
+	comm, err := rpc.New(config.DialConfig, zaplog.NewLog(), true)
+	if err != nil {
+		return nil, err
+	}
+	signerClient := comm.Signer()
+
+	switch definition.Type {
+	case networks.TypeEVM:
+		return dialEVMContract(ctx, definition, contractConfig, signerClient)
+	case networks.TypeCasper:
+		return dialCasperContract(ctx, config, definition, contractConfig, signerClient)
+	default:
+		return nil, Error.New("unsupported network type %s", definition.Type)
+	}
+}
+
+// dialEVMContract returns bridge contract of evm network, transactions are sent from the address of the signer key.
+func dialEVMContract(ctx context.Context, definition networks.Definition, contractConfig contractConfig, signerClient bridge.Signer) (admin.Contract, error) {
+	if !common.IsHexAddress(contractConfig.BridgeContract) {
+		return nil, Error.New("invalid bridge contract address %s", contractConfig.BridgeContract)
+	}
+
+	publicKey, err := signerClient.PublicKey(ctx, networks.TypeEVM)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(publicKey) < evm.CurveCoordinatesSize {
+		return nil, Error.New("invalid public key curve coordinates")
+	}
+
+	from := crypto.PubkeyToAddress(ecdsa.PublicKey{
+		Curve: btcec.S256(),
+		X:     new(big.Int).SetBytes(publicKey[:32]),
+		Y:     new(big.Int).SetBytes(publicKey[32:]),
+	})
+
+	ethClient, err := ethclient.DialContext(ctx, contractConfig.NodeAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := new(big.Int).SetUint64(definition.ChainID)
+	if definition.ChainID == 0 {
+		if chainID, err = ethClient.ChainID(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	sign := func(data []byte) ([]byte, error) {
+		return signerClient.Sign(ctx, networks.TypeEVM, data, signer.TypeDTTransaction)
+	}
+
+	return admin.NewEVMContract(definition.Name, ethClient, common.HexToAddress(contractConfig.BridgeContract), chainID, from, sign)
+}
+
+// dialCasperContract returns bridge contract of casper network, deploys are sent from the account of the signer key.
+func dialCasperContract(ctx context.Context, config *Config, definition networks.Definition, contractConfig contractConfig, signerClient bridge.Signer) (admin.Contract, error) {
+	publicKey, err := signerClient.PublicKey(ctx, networks.TypeCasper)
+	if err != nil {
+		return nil, err
+	}
+
+	taggedPublicKey, err := signature_lib.ParseCasperPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sign := func(data []byte) ([]byte, error) {
+		return signerClient.Sign(ctx, networks.TypeCasper, data, signer.TypeDTTransaction)
+	}
+
+	casperConfig := admin.CasperContractConfig{
+		Network:                   definition.Name,
+		StandardPayment:           contractConfig.StandardPayment,
+		BridgeContractPackageHash: contractConfig.BridgeContract,
+		PollInterval:              time.Duration(config.ConfirmationPollIntervalInSeconds) * time.Second,
+	}
+	return admin.NewCasperContract(casperConfig, client.New(contractConfig.NodeAddress, client.FetcherConfig{}), taggedPublicKey, sign)
+}
+
+// findContract returns configuration of bridge contract of the network from the contracts file.
+func findContract(path string, name networks.Name) (contractConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return contractConfig{}, err
+	}
+
+	var contracts []contractConfig
+	if err = json.Unmarshal(data, &contracts); err != nil {
+		return contractConfig{}, err
+	}
+
+	for _, contract := range contracts {
+		if contract.Network == name {
+			return contract, nil
+		}
+	}
+
+	return contractConfig{}, Error.New("bridge contract of %s is not configured", name)
+}
+
+// printJSON prints value as indented json.
+func printJSON(value interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
@@ -46,6 +46,7 @@ type Config struct {
 	Communication     rpc.Config
 	CommunicationMode communication.Mode `env:"COMMUNICATION_MODE"`
 	ServerName        string             `env:"SERVER_NAME"`
+	NetworksFile      string             `env:"NETWORKS_FILE" envDefault:""`
 }
 
 // commands.
@@ -109,6 +110,12 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return Error.Wrap(err)
 	}
 
+	err = networks.RegisterFile(config.NetworksFile)
+	if err != nil {
+		log.Error("could not load networks: %v", Error.Wrap(err))
+		return Error.Wrap(err)
+	}
+
 	{ // Communication setup.
 		switch config.CommunicationMode {
 		case communication.ModeGRPC:
@@ -117,7 +124,11 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 				return Error.Wrap(err)
 			}
 
-			casperClient = client.New(config.Config.RPCNodeAddress)
+			casperClient = client.NewMulti(config.Config.RPCNodeAddresses(), config.Config.RPCNodesConfig(), client.FetcherConfig{
+				Workers:           config.Config.EventsReadingWorkers,
+				RequestsPerSecond: config.Config.RPCRequestsPerSecond,
+				Retries:           config.Config.RPCRetries,
+			})
 		default:
 			comm = mockcommunication.New()
 			casperClient = mock.New()
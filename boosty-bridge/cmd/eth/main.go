@@ -16,7 +16,6 @@ import (
 	"github.com/caarlos0/env/v6"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/joho/godotenv"
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/spf13/cobra"
@@ -53,6 +52,7 @@ type Config struct {
 	CommunicationMode communication.Mode `env:"COMMUNICATION_MODE"`
 	ServerName        string             `env:"SERVER_NAME"`
 	Bridge            client.Config
+	NetworksFile      string `env:"NETWORKS_FILE" envDefault:""`
 }
 
 // commands.
@@ -116,6 +116,12 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return Error.Wrap(err)
 	}
 
+	err = networks.RegisterFile(config.NetworksFile)
+	if err != nil {
+		log.Error("could not load networks: %v", Error.Wrap(err))
+		return Error.Wrap(err)
+	}
+
 	{ // Communication setup.
 		switch config.CommunicationMode {
 		case communication.ModeGRPC:
@@ -150,8 +156,8 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	{ // Eth server setup.
-		// connect client to default http connection node.
-		ethClient, err := ethclient.Dial(config.Service.NodeAddress)
+		// connect client to http nodes, calls fail over to fallback nodes.
+		ethClient, err := evm.DialMultiClient(ctx, config.Service.NodeAddresses(), config.Service.NodesConfig())
 		if err != nil {
 			return Error.Wrap(err)
 		}
@@ -185,6 +191,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 			instance,
 			transfer,
 			ethClient,
+			evm.NewWebsocketLogStream(config.Service.WsNodeAddress),
 		)
 	}
 
@@ -14,8 +14,10 @@ import (
 
 	"tricorn"
 	"tricorn/bridge/gateway"
+	"tricorn/bridge/interventions"
 	"tricorn/bridge/networks"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/communication"
 	"tricorn/communication/mockcommunication"
 	"tricorn/communication/rpc"
@@ -33,6 +35,7 @@ type Config struct {
 	Communication     rpc.Config
 	CommunicationMode communication.Mode `env:"COMMUNICATION_MODE"`
 	ServerName        string             `env:"SERVER_NAME"`
+	NetworksFile      string             `env:"NETWORKS_FILE" envDefault:""`
 }
 
 // commands.
@@ -83,6 +86,12 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return Error.Wrap(err)
 	}
 
+	err = networks.RegisterFile(config.NetworksFile)
+	if err != nil {
+		log.Error("could not load networks: %v", Error.Wrap(err))
+		return Error.Wrap(err)
+	}
+
 	g := struct {
 		communication communication.Communication
 
@@ -92,6 +101,12 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		// declares all transfers specific modules.
 		transfers *transfers.Service
 
+		// declares all webhooks specific modules.
+		webhooks *webhooks.Service
+
+		// declares all interventions specific modules.
+		interventions *interventions.Service
+
 		// declares all gateway server specific modules.
 		listener net.Listener
 		server   *gateway.Server
@@ -121,6 +136,18 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		)
 	}
 
+	{ // webhooks setup.
+		g.webhooks = webhooks.NewService(
+			g.communication.Webhooks(),
+		)
+	}
+
+	{ // interventions setup.
+		g.interventions = interventions.NewService(
+			g.communication.Interventions(),
+		)
+	}
+
 	{ // server setup.
 		g.listener, err = net.Listen("tcp", config.Server.Address)
 		if err != nil {
@@ -133,6 +160,8 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 			g.listener,
 			g.networks,
 			g.transfers,
+			g.webhooks,
+			g.interventions,
 		)
 	}
 
@@ -8,8 +8,11 @@ import (
 	"errors"
 
 	"tricorn/bridge"
+	"tricorn/bridge/interventions"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/rescan"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/chains"
 )
 
@@ -24,6 +27,15 @@ type Communication interface {
 	// Transfers provides access to the transfers.Bridge rpc methods.
 	Transfers() transfers.Bridge
 
+	// Webhooks provides access to the webhooks.Bridge rpc methods.
+	Webhooks() webhooks.Bridge
+
+	// Interventions provides access to the interventions.Bridge rpc methods.
+	Interventions() interventions.Bridge
+
+	// Rescan provides access to the rescan.Bridge rpc methods.
+	Rescan() rescan.Bridge
+
 	// Bridge provides access to the chains.Bridge rpc methods.
 	Bridge() chains.Bridge
 
@@ -12,8 +12,11 @@ import (
 	"github.com/google/uuid"
 
 	"tricorn/bridge"
+	"tricorn/bridge/interventions"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/rescan"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/chains"
 	"tricorn/communication"
 	"tricorn/currencyrates"
@@ -40,6 +43,9 @@ func (rpc *MockCommunication) Bridge() chains.Bridge {
 		publicKeyImpl: func(ctx context.Context, networkId networks.Type) ([]byte, error) {
 			return []byte{}, nil
 		},
+		publicKeysImpl: func(ctx context.Context, networkType networks.Type) ([][]byte, error) {
+			return [][]byte{}, nil
+		},
 	}
 }
 
@@ -48,8 +54,9 @@ var _ chains.Bridge = (*BridgeMock)(nil)
 
 // BridgeMock provides access to the chains.Bridge.
 type BridgeMock struct {
-	signImpl      func(ctx context.Context, req chains.SignRequest) ([]byte, error)
-	publicKeyImpl func(ctx context.Context, networkId networks.Type) ([]byte, error)
+	signImpl       func(ctx context.Context, req chains.SignRequest) ([]byte, error)
+	publicKeyImpl  func(ctx context.Context, networkId networks.Type) ([]byte, error)
+	publicKeysImpl func(ctx context.Context, networkType networks.Type) ([][]byte, error)
 }
 
 // Sign returns signed data for specific network.
@@ -62,6 +69,11 @@ func (bridgeMock *BridgeMock) PublicKey(ctx context.Context, networkId networks.
 	return bridgeMock.publicKeyImpl(ctx, networkId)
 }
 
+// PublicKeys returns public keys of all transaction keys for specific network.
+func (bridgeMock *BridgeMock) PublicKeys(ctx context.Context, networkType networks.Type) ([][]byte, error) {
+	return bridgeMock.publicKeysImpl(ctx, networkType)
+}
+
 // Networks provides access to the networks.Bridge rpc methods.
 func (rpc *MockCommunication) Networks() networks.Bridge {
 	return &NetworksMock{
@@ -205,12 +217,91 @@ func (rpc *MockCommunication) Transfers() transfers.Bridge {
 				TotalCount: 1,
 			}, nil
 		},
+		historyByCursorImpl: func(ctx context.Context, req transfers.HistoryRequest) (transfers.CursorPage, error) {
+			amount := new(big.Int)
+			amount, ok := amount.SetString("1000000000000000000", 10)
+			if !ok {
+				return transfers.CursorPage{}, nil
+			}
+
+			return transfers.CursorPage{
+				Transfers: []transfers.Transfer{
+					{
+						ID:     17,
+						Amount: *amount,
+						Sender: networks.Address{
+							NetworkName: "GOERLI",
+							Address:     "0xB7F14E1C560Fc97b08F1327329D59F6db5FD2009",
+						},
+						Recipient: networks.Address{
+							NetworkName: "CASPER-TESTNET",
+							Address:     "account-hash-3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23",
+						},
+						Status: transfers.StatusFinished,
+						TriggeringTx: transfers.StringTxHash{
+							NetworkName: "GOERLI",
+							Hash:        common.HexToHash("0x879e513fcdf956e8f65ba9267b1e278cb8afca733bd0b0f8456bc8fe6d2c3a62"),
+						},
+						OutboundTx: transfers.StringTxHash{
+							NetworkName: "CASPER-TESTNET",
+							Hash:        common.HexToHash("7e4b5e5419c26c224c4654fddf127e597fa9c966f9e41a4ae0b5702b3bd24abc"),
+						},
+						CreatedAt: time.Now().UTC().AddDate(0, 0, -1),
+					},
+				},
+				NextCursor: transfers.Cursor{ID: 17}.String(),
+			}, nil
+		},
 		bridgeInSignatureImpl: func(ctx context.Context, req transfers.BridgeInSignatureRequest) (transfers.BridgeInSignatureResponse, error) {
 			return transfers.BridgeInSignatureResponse{}, nil
 		},
 		cancelSignatureImpl: func(ctx context.Context, req transfers.CancelSignatureRequest) (transfers.CancelSignatureResponse, error) {
 			return transfers.CancelSignatureResponse{}, nil
 		},
+		watchImpl: func(ctx context.Context, req transfers.WatchRequest, updates chan<- transfers.Transfer) error {
+			amount := new(big.Int)
+			amount, ok := amount.SetString("1000000000000000000", 10)
+			if !ok {
+				return nil
+			}
+
+			statuses := []transfers.Status{transfers.StatusConfirming, transfers.StatusFinished}
+			for i, status := range statuses {
+				if status == req.LastStatus {
+					statuses = statuses[i+1:]
+					break
+				}
+			}
+
+			for _, status := range statuses {
+				transfer := transfers.Transfer{
+					ID:     17,
+					Amount: *amount,
+					Sender: networks.Address{
+						NetworkName: "GOERLI",
+						Address:     "0xB7F14E1C560Fc97b08F1327329D59F6db5FD2009",
+					},
+					Recipient: networks.Address{
+						NetworkName: "CASPER-TESTNET",
+						Address:     "account-hash-3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23",
+					},
+					Status: status,
+					TriggeringTx: transfers.StringTxHash{
+						NetworkName: "GOERLI",
+						Hash:        common.HexToHash("0x879e513fcdf956e8f65ba9267b1e278cb8afca733bd0b0f8456bc8fe6d2c3a62"),
+					},
+					CreatedAt: time.Now().UTC().AddDate(0, 0, -1),
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case updates <- transfer:
+				}
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -223,8 +314,10 @@ type transfersMock struct {
 	infoImpl              func(ctx context.Context, txHash string) ([]transfers.Transfer, error)
 	cancelImpl            func(ctx context.Context, id transfers.ID, signature, pubKey []byte) error
 	historyImpl           func(ctx context.Context, offset, limit uint64, signature, pubKey []byte, networkID uint32) (transfers.Page, error)
+	historyByCursorImpl   func(ctx context.Context, req transfers.HistoryRequest) (transfers.CursorPage, error)
 	bridgeInSignatureImpl func(ctx context.Context, req transfers.BridgeInSignatureRequest) (transfers.BridgeInSignatureResponse, error)
 	cancelSignatureImpl   func(ctx context.Context, req transfers.CancelSignatureRequest) (transfers.CancelSignatureResponse, error)
+	watchImpl             func(ctx context.Context, req transfers.WatchRequest, updates chan<- transfers.Transfer) error
 }
 
 // Estimate returns approximate information about transfer fee and time.
@@ -266,6 +359,15 @@ func (transfersMock *transfersMock) SetHistory(impl func(ctx context.Context, of
 	transfersMock.historyImpl = impl
 }
 
+// HistoryByCursor returns filtered list of transfers, which is paginated by cursor.
+func (transfersMock *transfersMock) HistoryByCursor(ctx context.Context, req transfers.HistoryRequest) (transfers.CursorPage, error) {
+	return transfersMock.historyByCursorImpl(ctx, req)
+}
+
+func (transfersMock *transfersMock) SetHistoryByCursor(impl func(ctx context.Context, req transfers.HistoryRequest) (transfers.CursorPage, error)) {
+	transfersMock.historyByCursorImpl = impl
+}
+
 // BridgeInSignature returns signature for user to send bridgeIn transaction.
 func (transfersMock *transfersMock) BridgeInSignature(ctx context.Context, req transfers.BridgeInSignatureRequest) (transfers.BridgeInSignatureResponse, error) {
 	return transfersMock.bridgeInSignatureImpl(ctx, req)
@@ -284,22 +386,232 @@ func (transfersMock *transfersMock) SetCancelSignature(impl func(ctx context.Con
 	transfersMock.cancelSignatureImpl = impl
 }
 
+// Watch sends transfer to updates each time its status changes, until status becomes final or context is cancelled.
+func (transfersMock *transfersMock) Watch(ctx context.Context, req transfers.WatchRequest, updates chan<- transfers.Transfer) error {
+	return transfersMock.watchImpl(ctx, req, updates)
+}
+
+func (transfersMock *transfersMock) SetWatch(impl func(ctx context.Context, req transfers.WatchRequest, updates chan<- transfers.Transfer) error) {
+	transfersMock.watchImpl = impl
+}
+
+// Webhooks provides access to the webhooks.Bridge rpc methods.
+func (rpc *MockCommunication) Webhooks() webhooks.Bridge {
+	return &webhooksMock{
+		createEndpointImpl: func(ctx context.Context, req webhooks.CreateEndpointRequest) (webhooks.Endpoint, error) {
+			return webhooks.Endpoint{
+				ID:        1,
+				Owner:     req.Owner,
+				URL:       req.URL,
+				Secret:    "8b2f9c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9",
+				Statuses:  req.Statuses,
+				CreatedAt: time.Now().UTC(),
+			}, nil
+		},
+		listEndpointsImpl: func(ctx context.Context, owner string) ([]webhooks.Endpoint, error) {
+			return []webhooks.Endpoint{
+				{
+					ID:        1,
+					Owner:     owner,
+					URL:       "https://example.com/webhooks",
+					Statuses:  []transfers.Status{transfers.StatusFinished},
+					CreatedAt: time.Now().UTC().AddDate(0, 0, -1),
+				},
+			}, nil
+		},
+		deleteEndpointImpl: func(ctx context.Context, owner string, id webhooks.EndpointID) error {
+			return nil
+		},
+		listDeliveriesImpl: func(ctx context.Context, req webhooks.DeliveriesRequest) ([]webhooks.Delivery, error) {
+			deliveredAt := time.Now().UTC()
+			return []webhooks.Delivery{
+				{
+					ID:             1,
+					EndpointID:     req.EndpointID,
+					TransferID:     17,
+					Status:         transfers.StatusFinished,
+					PreviousStatus: transfers.StatusConfirming,
+					State:          webhooks.DeliveryStateDelivered,
+					Attempts:       1,
+					NextAttemptAt:  deliveredAt,
+					CreatedAt:      deliveredAt,
+					DeliveredAt:    &deliveredAt,
+				},
+			}, nil
+		},
+		replayDeliveriesImpl: func(ctx context.Context, req webhooks.ReplayRequest) (uint64, error) {
+			return 1, nil
+		},
+	}
+}
+
+// ensures that webhooksMock implements webhooks.Bridge.
+var _ webhooks.Bridge = (*webhooksMock)(nil)
+
+// webhooksMock provides access to the webhooks.Bridge.
+type webhooksMock struct {
+	createEndpointImpl   func(ctx context.Context, req webhooks.CreateEndpointRequest) (webhooks.Endpoint, error)
+	listEndpointsImpl    func(ctx context.Context, owner string) ([]webhooks.Endpoint, error)
+	deleteEndpointImpl   func(ctx context.Context, owner string, id webhooks.EndpointID) error
+	listDeliveriesImpl   func(ctx context.Context, req webhooks.DeliveriesRequest) ([]webhooks.Delivery, error)
+	replayDeliveriesImpl func(ctx context.Context, req webhooks.ReplayRequest) (uint64, error)
+}
+
+// CreateEndpoint registers endpoint of the integrator.
+func (webhooksMock *webhooksMock) CreateEndpoint(ctx context.Context, req webhooks.CreateEndpointRequest) (webhooks.Endpoint, error) {
+	return webhooksMock.createEndpointImpl(ctx, req)
+}
+
+// SetCreateEndpoint sets CreateEndpoint mock implementation.
+func (webhooksMock *webhooksMock) SetCreateEndpoint(impl func(ctx context.Context, req webhooks.CreateEndpointRequest) (webhooks.Endpoint, error)) {
+	webhooksMock.createEndpointImpl = impl
+}
+
+// ListEndpoints returns endpoints of the integrator.
+func (webhooksMock *webhooksMock) ListEndpoints(ctx context.Context, owner string) ([]webhooks.Endpoint, error) {
+	return webhooksMock.listEndpointsImpl(ctx, owner)
+}
+
+// SetListEndpoints sets ListEndpoints mock implementation.
+func (webhooksMock *webhooksMock) SetListEndpoints(impl func(ctx context.Context, owner string) ([]webhooks.Endpoint, error)) {
+	webhooksMock.listEndpointsImpl = impl
+}
+
+// DeleteEndpoint deletes endpoint of the integrator.
+func (webhooksMock *webhooksMock) DeleteEndpoint(ctx context.Context, owner string, id webhooks.EndpointID) error {
+	return webhooksMock.deleteEndpointImpl(ctx, owner, id)
+}
+
+// SetDeleteEndpoint sets DeleteEndpoint mock implementation.
+func (webhooksMock *webhooksMock) SetDeleteEndpoint(impl func(ctx context.Context, owner string, id webhooks.EndpointID) error) {
+	webhooksMock.deleteEndpointImpl = impl
+}
+
+// ListDeliveries returns the newest deliveries of the integrator endpoint.
+func (webhooksMock *webhooksMock) ListDeliveries(ctx context.Context, req webhooks.DeliveriesRequest) ([]webhooks.Delivery, error) {
+	return webhooksMock.listDeliveriesImpl(ctx, req)
+}
+
+// SetListDeliveries sets ListDeliveries mock implementation.
+func (webhooksMock *webhooksMock) SetListDeliveries(impl func(ctx context.Context, req webhooks.DeliveriesRequest) ([]webhooks.Delivery, error)) {
+	webhooksMock.listDeliveriesImpl = impl
+}
+
+// ReplayDeliveries schedules deliveries of the integrator endpoint to be sent again.
+func (webhooksMock *webhooksMock) ReplayDeliveries(ctx context.Context, req webhooks.ReplayRequest) (uint64, error) {
+	return webhooksMock.replayDeliveriesImpl(ctx, req)
+}
+
+// SetReplayDeliveries sets ReplayDeliveries mock implementation.
+func (webhooksMock *webhooksMock) SetReplayDeliveries(impl func(ctx context.Context, req webhooks.ReplayRequest) (uint64, error)) {
+	webhooksMock.replayDeliveriesImpl = impl
+}
+
+// Interventions provides access to the interventions.Bridge rpc methods.
+func (rpc *MockCommunication) Interventions() interventions.Bridge {
+	return &interventionsMock{
+		interveneImpl: func(ctx context.Context, req interventions.Request) (interventions.Entry, error) {
+			return interventions.Entry{
+				ID:         1,
+				TransferID: req.TransferID,
+				Kind:       req.Kind,
+				Operator:   req.Operator,
+				Reason:     req.Reason,
+				Change:     req.Note,
+				Before:     interventions.State{Status: transfers.StatusConfirming},
+				After:      interventions.State{Status: transfers.StatusConfirming},
+				CreatedAt:  time.Now().UTC(),
+			}, nil
+		},
+		auditLogImpl: func(ctx context.Context, transferID transfers.ID) ([]interventions.Entry, error) {
+			return []interventions.Entry{}, nil
+		},
+	}
+}
+
+// ensures that interventionsMock implements interventions.Bridge.
+var _ interventions.Bridge = (*interventionsMock)(nil)
+
+// interventionsMock provides access to the interventions.Bridge.
+type interventionsMock struct {
+	interveneImpl func(ctx context.Context, req interventions.Request) (interventions.Entry, error)
+	auditLogImpl  func(ctx context.Context, transferID transfers.ID) ([]interventions.Entry, error)
+}
+
+// Intervene performs operator intervention into the transfer and returns its audit log entry.
+func (interventionsMock *interventionsMock) Intervene(ctx context.Context, req interventions.Request) (interventions.Entry, error) {
+	return interventionsMock.interveneImpl(ctx, req)
+}
+
+// SetIntervene sets Intervene mock implementation.
+func (interventionsMock *interventionsMock) SetIntervene(impl func(ctx context.Context, req interventions.Request) (interventions.Entry, error)) {
+	interventionsMock.interveneImpl = impl
+}
+
+// AuditLog returns audit log of the transfer from the oldest entry to the newest one.
+func (interventionsMock *interventionsMock) AuditLog(ctx context.Context, transferID transfers.ID) ([]interventions.Entry, error) {
+	return interventionsMock.auditLogImpl(ctx, transferID)
+}
+
+// SetAuditLog sets AuditLog mock implementation.
+func (interventionsMock *interventionsMock) SetAuditLog(impl func(ctx context.Context, transferID transfers.ID) ([]interventions.Entry, error)) {
+	interventionsMock.auditLogImpl = impl
+}
+
+// Rescan provides access to the rescan.Bridge rpc methods.
+func (rpc *MockCommunication) Rescan() rescan.Bridge {
+	return &rescanMock{
+		rescanImpl: func(ctx context.Context, req rescan.Request) (rescan.Report, error) {
+			return rescan.Report{
+				Processed: []rescan.Event{},
+				Known:     []rescan.Event{},
+			}, nil
+		},
+	}
+}
+
+// ensures that rescanMock implements rescan.Bridge.
+var _ rescan.Bridge = (*rescanMock)(nil)
+
+// rescanMock provides access to the rescan.Bridge.
+type rescanMock struct {
+	rescanImpl func(ctx context.Context, req rescan.Request) (rescan.Report, error)
+}
+
+// Rescan processes bridge events of the range of blocks again, events which are already known are skipped.
+func (rescanMock *rescanMock) Rescan(ctx context.Context, req rescan.Request) (rescan.Report, error) {
+	return rescanMock.rescanImpl(ctx, req)
+}
+
+// SetRescan sets Rescan mock implementation.
+func (rescanMock *rescanMock) SetRescan(impl func(ctx context.Context, req rescan.Request) (rescan.Report, error)) {
+	rescanMock.rescanImpl = impl
+}
+
 // Signer  provides access to the bridge.Signer rpc methods.
 func (rpc *MockCommunication) Signer() bridge.Signer {
 	return &signerMock{
 		signImpl: func(ctx context.Context, networkType networks.Type, data []byte, dataType signer.Type) ([]byte, error) {
 			return []byte{}, nil
 		},
+		signWithKeyImpl: func(ctx context.Context, networkType networks.Type, publicKey networks.PublicKey, data []byte) ([]byte, error) {
+			return []byte{}, nil
+		},
 		publicKeyImpl: func(ctx context.Context, networkType networks.Type) (networks.PublicKey, error) {
 			return []byte{}, nil
 		},
+		publicKeysImpl: func(ctx context.Context, networkType networks.Type) ([]networks.PublicKey, error) {
+			return []networks.PublicKey{}, nil
+		},
 	}
 }
 
 // signerMock provides access to the bridge.Signer.
 type signerMock struct {
-	signImpl      func(ctx context.Context, networkType networks.Type, data []byte, dataType signer.Type) ([]byte, error)
-	publicKeyImpl func(ctx context.Context, networkType networks.Type) (networks.PublicKey, error)
+	signImpl        func(ctx context.Context, networkType networks.Type, data []byte, dataType signer.Type) ([]byte, error)
+	signWithKeyImpl func(ctx context.Context, networkType networks.Type, publicKey networks.PublicKey, data []byte) ([]byte, error)
+	publicKeyImpl   func(ctx context.Context, networkType networks.Type) (networks.PublicKey, error)
+	publicKeysImpl  func(ctx context.Context, networkType networks.Type) ([]networks.PublicKey, error)
 }
 
 // Sign returns signed data for specific network.
@@ -307,11 +619,21 @@ func (signerMock *signerMock) Sign(ctx context.Context, networkType networks.Typ
 	return signerMock.signImpl(ctx, networkType, data, dataType)
 }
 
+// SignWithKey returns data signed with the transaction key of the specified public key for specific network.
+func (signerMock *signerMock) SignWithKey(ctx context.Context, networkType networks.Type, publicKey networks.PublicKey, data []byte) ([]byte, error) {
+	return signerMock.signWithKeyImpl(ctx, networkType, publicKey, data)
+}
+
 // PublicKey returns public key for specific network.
 func (signerMock *signerMock) PublicKey(ctx context.Context, networkType networks.Type) (networks.PublicKey, error) {
 	return signerMock.publicKeyImpl(ctx, networkType)
 }
 
+// PublicKeys returns public keys of all transaction keys for specific network.
+func (signerMock *signerMock) PublicKeys(ctx context.Context, networkType networks.Type) ([]networks.PublicKey, error) {
+	return signerMock.publicKeysImpl(ctx, networkType)
+}
+
 // Connector provides access to the bridge.Connector rpc methods.
 func (rpc *MockCommunication) Connector(ctx context.Context) bridge.Connector {
 	return &ConnectorMock{
@@ -349,6 +671,18 @@ func (rpc *MockCommunication) Connector(ctx context.Context) bridge.Connector {
 		cancelSignatureImpl: func(ctx context.Context, req chains.CancelSignatureRequest) (chains.CancelSignatureResponse, error) {
 			return chains.CancelSignatureResponse{}, nil
 		},
+		tokenSupplyImpl: func(ctx context.Context, token []byte) (chains.TokenSupply, error) {
+			return chains.TokenSupply{BridgeBalance: big.NewInt(0), TotalSupply: big.NewInt(0)}, nil
+		},
+		setPausedImpl: func(ctx context.Context, paused bool) ([]byte, error) {
+			return []byte{}, nil
+		},
+		eventRangeImpl: func(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error) {
+			return []chains.EventVariant{}, nil
+		},
+		transactionStatusImpl: func(ctx context.Context, txHash []byte) (chains.TxStatus, error) {
+			return chains.TxStatusNotFound, nil
+		},
 		addEventSubscriberImpl: func() bridge.EventSubscriber {
 			return bridge.EventSubscriber{}
 		},
@@ -369,6 +703,10 @@ type ConnectorMock struct {
 	estimateTransferImpl      func(ctx context.Context, req transfers.EstimateTransfer) (chains.Estimation, error)
 	bridgeInSignatureImpl     func(ctx context.Context, req bridge.BridgeInSignatureRequest) (bridge.BridgeInSignatureResponse, error)
 	cancelSignatureImpl       func(ctx context.Context, req chains.CancelSignatureRequest) (chains.CancelSignatureResponse, error)
+	tokenSupplyImpl           func(ctx context.Context, token []byte) (chains.TokenSupply, error)
+	setPausedImpl             func(ctx context.Context, paused bool) ([]byte, error)
+	eventRangeImpl            func(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error)
+	transactionStatusImpl     func(ctx context.Context, txHash []byte) (chains.TxStatus, error)
 	addEventSubscriberImpl    func() bridge.EventSubscriber
 	removeEventSubscriberImpl func(id uuid.UUID)
 	notifyImpl                func(ctx context.Context, event chains.EventVariant)
@@ -444,6 +782,46 @@ func (connectorMock *ConnectorMock) SetCancelSignature(impl func(ctx context.Con
 	connectorMock.cancelSignatureImpl = impl
 }
 
+// TokenSupply returns balance of the bridge contract and total supply of the token.
+func (connectorMock *ConnectorMock) TokenSupply(ctx context.Context, token []byte) (chains.TokenSupply, error) {
+	return connectorMock.tokenSupplyImpl(ctx, token)
+}
+
+// SetTokenSupply sets the mock implementation for TokenSupply.
+func (connectorMock *ConnectorMock) SetTokenSupply(impl func(ctx context.Context, token []byte) (chains.TokenSupply, error)) {
+	connectorMock.tokenSupplyImpl = impl
+}
+
+// SetPaused pauses or unpauses bridge contract and returns hash of the sent transaction.
+func (connectorMock *ConnectorMock) SetPaused(ctx context.Context, paused bool) ([]byte, error) {
+	return connectorMock.setPausedImpl(ctx, paused)
+}
+
+// SetSetPaused sets the mock implementation for SetPaused.
+func (connectorMock *ConnectorMock) SetSetPaused(impl func(ctx context.Context, paused bool) ([]byte, error)) {
+	connectorMock.setPausedImpl = impl
+}
+
+// EventRange returns historical bridge events of the bounded range of blocks.
+func (connectorMock *ConnectorMock) EventRange(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error) {
+	return connectorMock.eventRangeImpl(ctx, fromBlock, toBlock)
+}
+
+// SetEventRange sets the mock implementation for EventRange.
+func (connectorMock *ConnectorMock) SetEventRange(impl func(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error)) {
+	connectorMock.eventRangeImpl = impl
+}
+
+// TransactionStatus returns status of the transaction on chain.
+func (connectorMock *ConnectorMock) TransactionStatus(ctx context.Context, txHash []byte) (chains.TxStatus, error) {
+	return connectorMock.transactionStatusImpl(ctx, txHash)
+}
+
+// SetTransactionStatus sets the mock implementation for TransactionStatus.
+func (connectorMock *ConnectorMock) SetTransactionStatus(impl func(ctx context.Context, txHash []byte) (chains.TxStatus, error)) {
+	connectorMock.transactionStatusImpl = impl
+}
+
 // AddEventSubscriber adds subscriber to event publisher.
 func (connectorMock *ConnectorMock) AddEventSubscriber() bridge.EventSubscriber {
 	return connectorMock.addEventSubscriberImpl()
@@ -26,6 +26,7 @@ func (bridgeRPC *bridgeRPC) Sign(ctx context.Context, req chains.SignRequest) ([
 		NetworkId: networkspb.NetworkType(networks.NetworkTypeToNetworkID[req.NetworkId]),
 		Data:      req.Data,
 		DataType:  signerpb.DataType(signerpb.DataType_value[req.DataType.String()]),
+		PublicKey: req.PublicKey,
 	}
 	singResponse, err := bridgeRPC.client.Sign(ctx, &in)
 	if err != nil {
@@ -47,3 +48,16 @@ func (bridgeRPC *bridgeRPC) PublicKey(ctx context.Context, networkId networks.Ty
 
 	return grpcResponse.PublicKey, nil
 }
+
+// PublicKeys returns public keys of all transaction keys for specific network.
+func (bridgeRPC *bridgeRPC) PublicKeys(ctx context.Context, networkType networks.Type) ([][]byte, error) {
+	in := signerpb.PublicKeyRequest{
+		NetworkId: networkspb.NetworkType(networks.NetworkTypeToNetworkID[networkType]),
+	}
+	grpcResponse, err := bridgeRPC.client.PublicKeys(ctx, &in)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpcResponse.PublicKeys, nil
+}
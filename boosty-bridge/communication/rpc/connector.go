@@ -5,6 +5,8 @@ package rpc
 
 import (
 	"context"
+	"math/big"
+	"strings"
 	"sync"
 
 	"github.com/google/uuid"
@@ -201,6 +203,75 @@ func (connectorRPC *connectorRPC) CancelSignature(ctx context.Context, req chain
 	}, nil
 }
 
+// TokenSupply returns balance of the bridge contract and total supply of the token.
+func (connectorRPC *connectorRPC) TokenSupply(ctx context.Context, token []byte) (chains.TokenSupply, error) {
+	supply, err := connectorRPC.client.TokenSupply(ctx, &connectorpb.TokenSupplyRequest{
+		Token: &connectorpb.Address{
+			Address: token,
+		},
+	})
+	if err != nil {
+		return chains.TokenSupply{}, Error.Wrap(err)
+	}
+
+	bridgeBalance, ok := new(big.Int).SetString(supply.GetBridgeBalance(), 10)
+	if !ok {
+		return chains.TokenSupply{}, Error.New("invalid bridge balance %s", supply.GetBridgeBalance())
+	}
+
+	totalSupply, ok := new(big.Int).SetString(supply.GetTotalSupply(), 10)
+	if !ok {
+		return chains.TokenSupply{}, Error.New("invalid total supply %s", supply.GetTotalSupply())
+	}
+
+	return chains.TokenSupply{
+		BridgeBalance: bridgeBalance,
+		TotalSupply:   totalSupply,
+	}, nil
+}
+
+// SetPaused pauses or unpauses bridge contract and returns hash of the sent transaction.
+func (connectorRPC *connectorRPC) SetPaused(ctx context.Context, paused bool) ([]byte, error) {
+	resp, err := connectorRPC.client.SetPaused(ctx, &connectorpb.SetPausedRequest{Paused: paused})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return resp.GetTxhash(), nil
+}
+
+// EventRange returns historical bridge events of the bounded range of blocks.
+func (connectorRPC *connectorRPC) EventRange(ctx context.Context, fromBlock, toBlock uint64) ([]chains.EventVariant, error) {
+	resp, err := connectorRPC.client.EventRange(ctx, &connectorpb.EventRangeRequest{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	events := make([]chains.EventVariant, 0, len(resp.GetEvents()))
+	for _, pbEvent := range resp.GetEvents() {
+		events = append(events, toEventVariant(pbEvent))
+	}
+
+	return events, nil
+}
+
+// TransactionStatus returns status of the transaction on chain.
+func (connectorRPC *connectorRPC) TransactionStatus(ctx context.Context, txHash []byte) (chains.TxStatus, error) {
+	resp, err := connectorRPC.client.TransactionStatus(ctx, &connectorpb.TransactionStatusRequest{Txhash: txHash})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	if resp.GetStatus() == connectorpb.TransactionStatusResponse_STATUS_UNSPECIFIED {
+		return "", Error.New("transaction status is not specified")
+	}
+
+	return chains.TxStatus(strings.TrimPrefix(resp.GetStatus().String(), "STATUS_")), nil
+}
+
 // AddEventSubscriber adds subscriber to event publisher.
 func (connectorRPC *connectorRPC) AddEventSubscriber() bridge.EventSubscriber {
 	subscriber := bridge.EventSubscriber{
@@ -268,7 +339,10 @@ func toEventVariant(pbEvent *connectorpb.Event) chains.EventVariant {
 					Hash:        pbEvent.GetFundsIn().GetTx().GetHash(),
 					BlockNumber: pbEvent.GetFundsIn().GetTx().GetBlocknumber(),
 					Sender:      pbEvent.GetFundsIn().GetTx().GetSender(),
+					LogIndex:    pbEvent.GetFundsIn().GetTx().GetLogIndex(),
 				},
+				Nonce:   pbEvent.GetFundsIn().GetNonce(),
+				Removed: pbEvent.GetFundsIn().GetRemoved(),
 			},
 		}
 	}
@@ -288,7 +362,18 @@ func toEventVariant(pbEvent *connectorpb.Event) chains.EventVariant {
 					Hash:        pbEvent.GetFundsOut().GetTx().GetHash(),
 					BlockNumber: pbEvent.GetFundsOut().GetTx().GetBlocknumber(),
 					Sender:      pbEvent.GetFundsOut().GetTx().GetSender(),
+					LogIndex:    pbEvent.GetFundsOut().GetTx().GetLogIndex(),
 				},
+				TransactionID: pbEvent.GetFundsOut().GetTransactionId(),
+			},
+		}
+	}
+
+	if pbEvent.GetProgress() != nil {
+		eventVariant = chains.EventVariant{
+			Type: chains.EventTypeProgress,
+			EventProgress: chains.EventProgress{
+				BlockNumber: pbEvent.GetProgress().GetBlockNumber(),
 			},
 		}
 	}
@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bridgepb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/gateway-bridge"
+	interventionspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/interventions"
+
+	"tricorn/bridge/interventions"
+	"tricorn/bridge/transactions"
+	"tricorn/bridge/transfers"
+	"tricorn/communication"
+)
+
+// ensures that interventionsRPC implements interventions.Bridge.
+var _ interventions.Bridge = (*interventionsRPC)(nil)
+
+// interventionsRPC provides access to the interventions.Bridge.
+type interventionsRPC struct {
+	isConnected bool
+	client      bridgepb.GatewayBridgeClient
+}
+
+// Intervene performs operator intervention into the transfer and returns its audit log entry.
+func (interventionsRPC *interventionsRPC) Intervene(ctx context.Context, req interventions.Request) (interventions.Entry, error) {
+	if !interventionsRPC.isConnected {
+		return interventions.Entry{}, communication.ErrNotConnected
+	}
+
+	pbEntry, err := interventionsRPC.client.Intervene(ctx, &interventionspb.InterveneRequest{
+		TransferId:  uint64(req.TransferID),
+		Kind:        string(req.Kind),
+		Operator:    req.Operator,
+		Reason:      req.Reason,
+		TxHash:      req.TxHash,
+		BlockNumber: req.BlockNumber,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return interventions.Entry{}, convertInterventionsError(err)
+	}
+
+	return convertFromPbAuditEntry(pbEntry), nil
+}
+
+// AuditLog returns audit log of the transfer from the oldest entry to the newest one.
+func (interventionsRPC *interventionsRPC) AuditLog(ctx context.Context, transferID transfers.ID) ([]interventions.Entry, error) {
+	if !interventionsRPC.isConnected {
+		return nil, communication.ErrNotConnected
+	}
+
+	resp, err := interventionsRPC.client.AuditLog(ctx, &interventionspb.AuditLogRequest{TransferId: uint64(transferID)})
+	if err != nil {
+		return nil, convertInterventionsError(err)
+	}
+
+	entries := make([]interventions.Entry, 0, len(resp.GetEntries()))
+	for _, pbEntry := range resp.GetEntries() {
+		entries = append(entries, convertFromPbAuditEntry(pbEntry))
+	}
+
+	return entries, nil
+}
+
+// convertFromPbAuditEntry converts interventionspb.AuditEntry to interventions.Entry.
+func convertFromPbAuditEntry(pbEntry *interventionspb.AuditEntry) interventions.Entry {
+	return interventions.Entry{
+		ID:         interventions.EntryID(pbEntry.GetId()),
+		TransferID: transfers.ID(pbEntry.GetTransferId()),
+		Kind:       interventions.Kind(pbEntry.GetKind()),
+		Operator:   pbEntry.GetOperator(),
+		Reason:     pbEntry.GetReason(),
+		Change:     pbEntry.GetChange(),
+		Before:     convertFromPbTransferState(pbEntry.GetBefore()),
+		After:      convertFromPbTransferState(pbEntry.GetAfter()),
+		CreatedAt:  pbEntry.GetCreatedAt().AsTime(),
+	}
+}
+
+// convertFromPbTransferState converts interventionspb.TransferState to interventions.State.
+func convertFromPbTransferState(pbState *interventionspb.TransferState) interventions.State {
+	return interventions.State{
+		Status:     convertFromPbStatus(pbState.GetStatus()),
+		OutboundTx: transactions.ID(pbState.GetOutboundTx()),
+	}
+}
+
+// convertInterventionsError converts grpc status error to error of the interventions domain.
+func convertInterventionsError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return interventions.ErrInvalidRequest.Wrap(err)
+	case codes.FailedPrecondition:
+		return interventions.ErrNotAllowed.Wrap(err)
+	case codes.NotFound:
+		return interventions.ErrTransferNotFound.Wrap(err)
+	default:
+		return Error.Wrap(err)
+	}
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bridgepb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/gateway-bridge"
+	networkspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/networks"
+
+	"tricorn/bridge/rescan"
+	"tricorn/bridge/transfers"
+	"tricorn/communication"
+)
+
+// ensures that rescanRPC implements rescan.Bridge.
+var _ rescan.Bridge = (*rescanRPC)(nil)
+
+// rescanRPC provides access to the rescan.Bridge.
+type rescanRPC struct {
+	isConnected bool
+	client      bridgepb.GatewayBridgeClient
+}
+
+// Rescan processes bridge events of the range of blocks again, events which are already known are skipped.
+func (rescanRPC *rescanRPC) Rescan(ctx context.Context, req rescan.Request) (rescan.Report, error) {
+	if !rescanRPC.isConnected {
+		return rescan.Report{}, communication.ErrNotConnected
+	}
+
+	resp, err := rescanRPC.client.Rescan(ctx, &networkspb.RescanRequest{
+		NetworkId: uint32(req.NetworkID),
+		FromBlock: req.FromBlock,
+		ToBlock:   req.ToBlock,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return rescan.Report{}, rescan.ErrRescan.Wrap(err)
+		}
+		return rescan.Report{}, Error.Wrap(err)
+	}
+
+	return rescan.Report{
+		Processed: convertFromPbRescanEvents(resp.GetProcessed()),
+		Known:     convertFromPbRescanEvents(resp.GetKnown()),
+	}, nil
+}
+
+// convertFromPbRescanEvents converts protobuf rescan events to domain ones.
+func convertFromPbRescanEvents(pbEvents []*networkspb.RescanEvent) []rescan.Event {
+	events := make([]rescan.Event, 0, len(pbEvents))
+	for _, pbEvent := range pbEvents {
+		events = append(events, rescan.Event{
+			Kind:        transfers.EventKind(pbEvent.GetKind()),
+			TxHash:      pbEvent.GetTxHash(),
+			LogIndex:    pbEvent.GetLogIndex(),
+			BlockNumber: pbEvent.GetBlockNumber(),
+		})
+	}
+
+	return events
+}
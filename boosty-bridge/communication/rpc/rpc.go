@@ -19,8 +19,11 @@ import (
 	gatewaybridgepb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/gateway-bridge"
 
 	"tricorn/bridge"
+	"tricorn/bridge/interventions"
 	"tricorn/bridge/networks"
+	"tricorn/bridge/rescan"
 	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
 	"tricorn/chains"
 	"tricorn/communication"
 	"tricorn/internal/logger"
@@ -85,6 +88,30 @@ func (rpc *rpc) Transfers() transfers.Bridge {
 	}
 }
 
+// Webhooks provides access to the webhooks.Bridge rpc methods.
+func (rpc *rpc) Webhooks() webhooks.Bridge {
+	return &webhooksRPC{
+		client:      gatewaybridgepb.NewGatewayBridgeClient(rpc.connWithServer),
+		isConnected: rpc.isConnected,
+	}
+}
+
+// Interventions provides access to the interventions.Bridge rpc methods.
+func (rpc *rpc) Interventions() interventions.Bridge {
+	return &interventionsRPC{
+		client:      gatewaybridgepb.NewGatewayBridgeClient(rpc.connWithServer),
+		isConnected: rpc.isConnected,
+	}
+}
+
+// Rescan provides access to the rescan.Bridge rpc methods.
+func (rpc *rpc) Rescan() rescan.Bridge {
+	return &rescanRPC{
+		client:      gatewaybridgepb.NewGatewayBridgeClient(rpc.connWithServer),
+		isConnected: rpc.isConnected,
+	}
+}
+
 // Bridge provides access to the chains.Bridge rpc methods.
 func (rpc *rpc) Bridge() chains.Bridge {
 	return &bridgeRPC{
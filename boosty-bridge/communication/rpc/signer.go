@@ -39,6 +39,26 @@ func (signerRPC *signerRPC) Sign(ctx context.Context, networkType networks.Type,
 	return resp.GetSignature(), nil
 }
 
+// SignWithKey returns data signed with the transaction key of the specified public key in specific network.
+func (signerRPC *signerRPC) SignWithKey(ctx context.Context, networkType networks.Type, publicKey networks.PublicKey, data []byte) ([]byte, error) {
+	pbNetworkType, err := networksToProto(networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := signerRPC.client.Sign(ctx, &signerpb.SignRequest{
+		NetworkId: pbNetworkType,
+		Data:      data,
+		DataType:  signerpb.DataType_DT_TRANSACTION,
+		PublicKey: publicKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSignature(), nil
+}
+
 // PublicKey returns public key in specific network.
 func (signerRPC *signerRPC) PublicKey(ctx context.Context, networkType networks.Type) (networks.PublicKey, error) {
 	pbNetworkType, err := networksToProto(networkType)
@@ -56,6 +76,28 @@ func (signerRPC *signerRPC) PublicKey(ctx context.Context, networkType networks.
 	return resp.GetPublicKey(), nil
 }
 
+// PublicKeys returns public keys of all transaction keys in specific network.
+func (signerRPC *signerRPC) PublicKeys(ctx context.Context, networkType networks.Type) ([]networks.PublicKey, error) {
+	pbNetworkType, err := networksToProto(networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := signerRPC.client.PublicKeys(ctx, &signerpb.PublicKeyRequest{
+		NetworkId: pbNetworkType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]networks.PublicKey, 0, len(resp.GetPublicKeys()))
+	for _, publicKey := range resp.GetPublicKeys() {
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, nil
+}
+
 // networksToProto casts internal network type to proto one.
 func networksToProto(networkType networks.Type) (networkspb.NetworkType, error) {
 	switch networkType {
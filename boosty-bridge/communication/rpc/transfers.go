@@ -2,15 +2,22 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"io"
 	"math/big"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	bridgepb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/gateway-bridge"
 	transferspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/transfers"
 
 	"tricorn/bridge/networks"
+	"tricorn/bridge/pause"
+	"tricorn/bridge/screening"
 	"tricorn/bridge/transfers"
 	"tricorn/communication"
 )
@@ -68,42 +75,9 @@ func (transfersRPC *transfersRPC) Info(ctx context.Context, txHash string) ([]tr
 
 	txTransfers := make([]transfers.Transfer, 0, len(pbTransfers.GetStatuses()))
 	for _, pbTransfer := range pbTransfers.GetStatuses() {
-		amount := new(big.Int)
-		amount, ok := amount.SetString(pbTransfer.GetAmount(), 10)
-		if !ok {
-			return nil, Error.New("could not convert amount to big.Int")
-		}
-		transfer := transfers.Transfer{
-			ID:     transfers.ID(pbTransfer.GetId()),
-			Amount: *amount,
-			Sender: networks.Address{
-				NetworkName: pbTransfer.GetSender().GetNetworkName(),
-				Address:     pbTransfer.GetSender().GetAddress(),
-			},
-			Recipient: networks.Address{
-				NetworkName: pbTransfer.GetRecipient().GetNetworkName(),
-				Address:     pbTransfer.GetRecipient().GetAddress(),
-			},
-			TriggeringTx: transfers.StringTxHash{
-				NetworkName: pbTransfer.GetTriggeringTx().GetNetworkName(),
-				Hash:        common.HexToHash(pbTransfer.GetTriggeringTx().GetHash()),
-			},
-			OutboundTx: transfers.StringTxHash{
-				NetworkName: pbTransfer.GetOutboundTx().GetNetworkName(),
-				Hash:        common.HexToHash(pbTransfer.GetOutboundTx().GetHash()),
-			},
-			CreatedAt: pbTransfer.GetCreatedAt().AsTime(),
-		}
-
-		switch pbTransfer.GetStatus() {
-		case transferspb.TransferResponse_STATUS_CONFIRMING:
-			transfer.Status = transfers.StatusConfirming
-		case transferspb.TransferResponse_STATUS_CANCELLED:
-			transfer.Status = transfers.StatusCancelled
-		case transferspb.TransferResponse_STATUS_FINISHED:
-			transfer.Status = transfers.StatusFinished
-		case transferspb.TransferResponse_STATUS_UNSPECIFIED:
-			transfer.Status = ""
+		transfer, err := convertFromPbTransfer(pbTransfer)
+		if err != nil {
+			return nil, err
 		}
 
 		txTransfers = append(txTransfers, transfer)
@@ -131,33 +105,12 @@ func (transfersRPC *transfersRPC) History(ctx context.Context, offset, limit uin
 
 	var history []transfers.Transfer
 	for _, transferPb := range transferHistoryResponse.GetStatuses() {
-		amount := new(big.Int)
-		amount, ok := amount.SetString(transferPb.GetAmount(), 10)
-		if !ok {
-			return transfers.Page{}, Error.New("could not convert amount to big.Int")
+		transfer, err := convertFromPbTransfer(transferPb)
+		if err != nil {
+			return transfers.Page{}, err
 		}
-		history = append(history, transfers.Transfer{
-			ID:     transfers.ID(transferPb.GetId()),
-			Amount: *amount,
-			Sender: networks.Address{
-				NetworkName: transferPb.GetSender().GetNetworkName(),
-				Address:     transferPb.GetSender().GetAddress(),
-			},
-			Recipient: networks.Address{
-				NetworkName: transferPb.GetRecipient().GetNetworkName(),
-				Address:     transferPb.GetRecipient().GetAddress(),
-			},
-			Status: transfers.Status(transferPb.Status),
-			TriggeringTx: transfers.StringTxHash{
-				NetworkName: transferPb.GetTriggeringTx().GetNetworkName(),
-				Hash:        common.HexToHash(transferPb.GetTriggeringTx().GetHash()),
-			},
-			OutboundTx: transfers.StringTxHash{
-				NetworkName: transferPb.GetOutboundTx().GetNetworkName(),
-				Hash:        common.HexToHash(transferPb.GetOutboundTx().GetHash()),
-			},
-			CreatedAt: transferPb.GetCreatedAt().AsTime(),
-		})
+
+		history = append(history, transfer)
 	}
 
 	page := transfers.Page{
@@ -170,6 +123,115 @@ func (transfersRPC *transfersRPC) History(ctx context.Context, offset, limit uin
 	return page, nil
 }
 
+// HistoryByCursor returns filtered list of transfers, which is paginated by cursor.
+func (transfersRPC *transfersRPC) HistoryByCursor(ctx context.Context, req transfers.HistoryRequest) (transfers.CursorPage, error) {
+	if !transfersRPC.isConnected {
+		return transfers.CursorPage{}, communication.ErrNotConnected
+	}
+
+	historyRequest := &transferspb.TransferHistoryRequest{
+		Limit:         req.Limit,
+		UserSignature: req.Signature,
+		NetworkId:     req.NetworkID,
+		PublicKey:     req.PublicKey,
+		Cursor:        &req.Cursor,
+		TokenIds:      make([]uint32, 0, len(req.Filter.TokenIDs)),
+	}
+	for _, status := range req.Filter.Statuses {
+		historyRequest.Statuses = append(historyRequest.Statuses, convertToPbStatus(status))
+	}
+	for _, networkID := range req.Filter.SenderNetworks {
+		historyRequest.SenderNetworkIds = append(historyRequest.SenderNetworkIds, uint32(networkID))
+	}
+	for _, networkID := range req.Filter.RecipientNetworks {
+		historyRequest.RecipientNetworkIds = append(historyRequest.RecipientNetworkIds, uint32(networkID))
+	}
+	for _, tokenID := range req.Filter.TokenIDs {
+		historyRequest.TokenIds = append(historyRequest.TokenIds, uint32(tokenID))
+	}
+	if !req.Filter.CreatedFrom.IsZero() {
+		historyRequest.CreatedFrom = timestamppb.New(req.Filter.CreatedFrom)
+	}
+	if !req.Filter.CreatedTo.IsZero() {
+		historyRequest.CreatedTo = timestamppb.New(req.Filter.CreatedTo)
+	}
+	if req.Sort == transfers.SortOldest {
+		historyRequest.Sort = transferspb.TransferHistoryRequest_SORT_OLDEST
+	}
+
+	transferHistoryResponse, err := transfersRPC.client.TransferHistory(ctx, historyRequest)
+	if err != nil {
+		return transfers.CursorPage{}, Error.Wrap(err)
+	}
+
+	page := transfers.CursorPage{
+		Transfers:  make([]transfers.Transfer, 0, len(transferHistoryResponse.GetStatuses())),
+		NextCursor: transferHistoryResponse.GetNextCursor(),
+	}
+	for _, transferPb := range transferHistoryResponse.GetStatuses() {
+		transfer, err := convertFromPbTransfer(transferPb)
+		if err != nil {
+			return transfers.CursorPage{}, err
+		}
+
+		page.Transfers = append(page.Transfers, transfer)
+	}
+
+	return page, nil
+}
+
+// Watch sends transfer to updates each time its status changes, until status becomes final or context is cancelled.
+func (transfersRPC *transfersRPC) Watch(ctx context.Context, req transfers.WatchRequest, updates chan<- transfers.Transfer) error {
+	if !transfersRPC.isConnected {
+		return communication.ErrNotConnected
+	}
+
+	watchRequest := &transferspb.WatchTransferRequest{
+		TransferId: uint64(req.TransferID),
+		LastStatus: convertToPbStatus(req.LastStatus),
+	}
+	if req.TxHash != "" {
+		watchRequest.TxHash = &transferspb.StringTxHash{
+			NetworkName: req.NetworkName,
+			Hash:        req.TxHash,
+		}
+	}
+
+	stream, err := transfersRPC.client.WatchTransfer(ctx, watchRequest)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				return transfers.ErrInvalidWatchRequest.Wrap(err)
+			case codes.NotFound:
+				return transfers.ErrTransferNotFound.Wrap(err)
+			default:
+				return Error.Wrap(err)
+			}
+		}
+
+		transfer, err := convertFromPbTransfer(resp.GetTransfer())
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case updates <- transfer:
+		}
+	}
+}
+
 // BridgeInSignature returns signature for user to send bridgeIn transaction.
 func (transfersRPC *transfersRPC) BridgeInSignature(ctx context.Context, req transfers.BridgeInSignatureRequest) (transfers.BridgeInSignatureResponse, error) {
 	if !transfersRPC.isConnected {
@@ -201,6 +263,12 @@ func (transfersRPC *transfersRPC) BridgeInSignature(ctx context.Context, req tra
 		Nonce:     signatureResponse.GetNonce(),
 		Signature: signatureResponse.GetSignature(),
 	}
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return response, pause.ErrPaused.Wrap(err)
+	case codes.PermissionDenied:
+		return response, screening.ErrDenied.Wrap(err)
+	}
 
 	return response, Error.Wrap(err)
 }
@@ -233,3 +301,62 @@ func (transfersRPC *transfersRPC) CancelSignature(ctx context.Context, req trans
 
 	return response, nil
 }
+
+// convertFromPbTransfer converts transferspb.TransferResponse_Transfer to transfers.Transfer.
+func convertFromPbTransfer(pbTransfer *transferspb.TransferResponse_Transfer) (transfers.Transfer, error) {
+	amount := new(big.Int)
+	amount, ok := amount.SetString(pbTransfer.GetAmount(), 10)
+	if !ok {
+		return transfers.Transfer{}, Error.New("could not convert amount to big.Int")
+	}
+
+	transfer := transfers.Transfer{
+		ID:     transfers.ID(pbTransfer.GetId()),
+		Amount: *amount,
+		Sender: networks.Address{
+			NetworkName: pbTransfer.GetSender().GetNetworkName(),
+			Address:     pbTransfer.GetSender().GetAddress(),
+		},
+		Recipient: networks.Address{
+			NetworkName: pbTransfer.GetRecipient().GetNetworkName(),
+			Address:     pbTransfer.GetRecipient().GetAddress(),
+		},
+		TriggeringTx: transfers.StringTxHash{
+			NetworkName: pbTransfer.GetTriggeringTx().GetNetworkName(),
+			Hash:        common.HexToHash(pbTransfer.GetTriggeringTx().GetHash()),
+		},
+		OutboundTx: transfers.StringTxHash{
+			NetworkName: pbTransfer.GetOutboundTx().GetNetworkName(),
+			Hash:        common.HexToHash(pbTransfer.GetOutboundTx().GetHash()),
+		},
+		Status:    convertFromPbStatus(pbTransfer.GetStatus()),
+		CreatedAt: pbTransfer.GetCreatedAt().AsTime(),
+	}
+
+	return transfer, nil
+}
+
+// convertFromPbStatus converts transferspb.TransferResponse_Status to transfers.Status.
+func convertFromPbStatus(pbStatus transferspb.TransferResponse_Status) transfers.Status {
+	switch pbStatus {
+	case transferspb.TransferResponse_STATUS_CONFIRMING:
+		return transfers.StatusConfirming
+	case transferspb.TransferResponse_STATUS_CANCELLED:
+		return transfers.StatusCancelled
+	case transferspb.TransferResponse_STATUS_FINISHED:
+		return transfers.StatusFinished
+	case transferspb.TransferResponse_STATUS_WAITING:
+		return transfers.StatusWaiting
+	case transferspb.TransferResponse_STATUS_EXPIRED:
+		return transfers.StatusExpired
+	case transferspb.TransferResponse_STATUS_HELD:
+		return transfers.StatusHeld
+	default:
+		return ""
+	}
+}
+
+// convertToPbStatus converts transfers.Status to transferspb.TransferResponse_Status.
+func convertToPbStatus(status transfers.Status) transferspb.TransferResponse_Status {
+	return transferspb.TransferResponse_Status(transferspb.TransferResponse_Status_value["STATUS_"+string(status)])
+}
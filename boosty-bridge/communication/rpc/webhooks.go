@@ -0,0 +1,179 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bridgepb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/gateway-bridge"
+	transferspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/transfers"
+	webhookspb "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/webhooks"
+
+	"tricorn/bridge/transfers"
+	"tricorn/bridge/webhooks"
+	"tricorn/communication"
+)
+
+// deliveryStatePrefix is the first part of webhookspb.WebhookDelivery_State names.
+const deliveryStatePrefix = "STATE_"
+
+// ensures that webhooksRPC implements webhooks.Bridge.
+var _ webhooks.Bridge = (*webhooksRPC)(nil)
+
+// webhooksRPC provides access to the webhooks.Bridge.
+type webhooksRPC struct {
+	isConnected bool
+	client      bridgepb.GatewayBridgeClient
+}
+
+// CreateEndpoint registers endpoint of the integrator. Generated signing secret is returned only by this method.
+func (webhooksRPC *webhooksRPC) CreateEndpoint(ctx context.Context, req webhooks.CreateEndpointRequest) (webhooks.Endpoint, error) {
+	if !webhooksRPC.isConnected {
+		return webhooks.Endpoint{}, communication.ErrNotConnected
+	}
+
+	statuses := make([]transferspb.TransferResponse_Status, 0, len(req.Statuses))
+	for _, transferStatus := range req.Statuses {
+		statuses = append(statuses, convertToPbStatus(transferStatus))
+	}
+
+	pbEndpoint, err := webhooksRPC.client.CreateWebhookEndpoint(ctx, &webhookspb.CreateWebhookEndpointRequest{
+		Owner:    req.Owner,
+		Url:      req.URL,
+		Statuses: statuses,
+	})
+	if err != nil {
+		return webhooks.Endpoint{}, convertWebhooksError(err)
+	}
+
+	endpoint := convertFromPbWebhookEndpoint(pbEndpoint)
+	endpoint.Owner = req.Owner
+	return endpoint, nil
+}
+
+// ListEndpoints returns endpoints of the integrator.
+func (webhooksRPC *webhooksRPC) ListEndpoints(ctx context.Context, owner string) ([]webhooks.Endpoint, error) {
+	if !webhooksRPC.isConnected {
+		return nil, communication.ErrNotConnected
+	}
+
+	resp, err := webhooksRPC.client.ListWebhookEndpoints(ctx, &webhookspb.ListWebhookEndpointsRequest{Owner: owner})
+	if err != nil {
+		return nil, convertWebhooksError(err)
+	}
+
+	endpoints := make([]webhooks.Endpoint, 0, len(resp.GetEndpoints()))
+	for _, pbEndpoint := range resp.GetEndpoints() {
+		endpoint := convertFromPbWebhookEndpoint(pbEndpoint)
+		endpoint.Owner = owner
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints, nil
+}
+
+// DeleteEndpoint deletes endpoint of the integrator together with its deliveries.
+func (webhooksRPC *webhooksRPC) DeleteEndpoint(ctx context.Context, owner string, id webhooks.EndpointID) error {
+	if !webhooksRPC.isConnected {
+		return communication.ErrNotConnected
+	}
+
+	_, err := webhooksRPC.client.DeleteWebhookEndpoint(ctx, &webhookspb.DeleteWebhookEndpointRequest{
+		Owner:      owner,
+		EndpointId: uint64(id),
+	})
+	if err != nil {
+		return convertWebhooksError(err)
+	}
+
+	return nil
+}
+
+// ListDeliveries returns the newest deliveries of the integrator endpoint.
+func (webhooksRPC *webhooksRPC) ListDeliveries(ctx context.Context, req webhooks.DeliveriesRequest) ([]webhooks.Delivery, error) {
+	if !webhooksRPC.isConnected {
+		return nil, communication.ErrNotConnected
+	}
+
+	resp, err := webhooksRPC.client.ListWebhookDeliveries(ctx, &webhookspb.ListWebhookDeliveriesRequest{
+		Owner:      req.Owner,
+		EndpointId: uint64(req.EndpointID),
+		State:      webhookspb.WebhookDelivery_State(webhookspb.WebhookDelivery_State_value[deliveryStatePrefix+string(req.State)]),
+		Limit:      req.Limit,
+	})
+	if err != nil {
+		return nil, convertWebhooksError(err)
+	}
+
+	deliveries := make([]webhooks.Delivery, 0, len(resp.GetDeliveries()))
+	for _, pbDelivery := range resp.GetDeliveries() {
+		delivery := webhooks.Delivery{
+			ID:             webhooks.DeliveryID(pbDelivery.GetId()),
+			EndpointID:     webhooks.EndpointID(pbDelivery.GetEndpointId()),
+			TransferID:     transfers.ID(pbDelivery.GetTransferId()),
+			Status:         convertFromPbStatus(pbDelivery.GetStatus()),
+			PreviousStatus: convertFromPbStatus(pbDelivery.GetPreviousStatus()),
+			State:          webhooks.DeliveryState(strings.TrimPrefix(pbDelivery.GetState().String(), deliveryStatePrefix)),
+			Attempts:       pbDelivery.GetAttempts(),
+			NextAttemptAt:  pbDelivery.GetNextAttemptAt().AsTime(),
+			LastError:      pbDelivery.GetLastError(),
+			CreatedAt:      pbDelivery.GetCreatedAt().AsTime(),
+		}
+		if pbDelivery.GetDeliveredAt() != nil {
+			deliveredAt := pbDelivery.GetDeliveredAt().AsTime()
+			delivery.DeliveredAt = &deliveredAt
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// ReplayDeliveries schedules deliveries of the integrator endpoint to be sent again and returns their amount.
+func (webhooksRPC *webhooksRPC) ReplayDeliveries(ctx context.Context, req webhooks.ReplayRequest) (uint64, error) {
+	if !webhooksRPC.isConnected {
+		return 0, communication.ErrNotConnected
+	}
+
+	resp, err := webhooksRPC.client.ReplayWebhookDeliveries(ctx, &webhookspb.ReplayWebhookDeliveriesRequest{
+		Owner:      req.Owner,
+		EndpointId: uint64(req.EndpointID),
+		DeliveryId: uint64(req.DeliveryID),
+	})
+	if err != nil {
+		return 0, convertWebhooksError(err)
+	}
+
+	return resp.GetReplayed(), nil
+}
+
+// convertFromPbWebhookEndpoint converts webhookspb.WebhookEndpoint to webhooks.Endpoint.
+func convertFromPbWebhookEndpoint(pbEndpoint *webhookspb.WebhookEndpoint) webhooks.Endpoint {
+	statuses := make([]transfers.Status, 0, len(pbEndpoint.GetStatuses()))
+	for _, pbStatus := range pbEndpoint.GetStatuses() {
+		statuses = append(statuses, convertFromPbStatus(pbStatus))
+	}
+
+	return webhooks.Endpoint{
+		ID:        webhooks.EndpointID(pbEndpoint.GetId()),
+		URL:       pbEndpoint.GetUrl(),
+		Secret:    pbEndpoint.GetSecret(),
+		Statuses:  statuses,
+		CreatedAt: pbEndpoint.GetCreatedAt().AsTime(),
+	}
+}
+
+// convertWebhooksError converts grpc status error to error of the webhooks domain.
+func convertWebhooksError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return webhooks.ErrInvalidEndpoint.Wrap(err)
+	case codes.NotFound:
+		return webhooks.ErrEndpointNotFound.Wrap(err)
+	default:
+		return Error.Wrap(err)
+	}
+}
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/bridge/networks"
+)
+
+// ErrAdmin indicates that there was an error during bridge contract administration.
+var ErrAdmin = errs.Class("contract admin")
+
+// ErrUnsupported indicates that operation is not supported by the contract of the network.
+var ErrUnsupported = errs.Class("unsupported operation")
+
+// Kind defines administrative operation of the bridge contract.
+type Kind string
+
+const (
+	// KindPause pauses bridge contract.
+	KindPause Kind = "pause"
+	// KindUnpause unpauses bridge contract.
+	KindUnpause Kind = "unpause"
+	// KindSetStableCommissionPercent sets stable commission percent of the bridge contract.
+	KindSetStableCommissionPercent Kind = "set-stable-commission-percent"
+	// KindWithdrawCommission withdraws collected commission of the token from the bridge contract.
+	KindWithdrawCommission Kind = "withdraw-commission"
+	// KindTransferOwnership transfers ownership of the bridge contract.
+	KindTransferOwnership Kind = "transfer-ownership"
+	// KindSetSigner sets public key which bridge contract verifies signatures with.
+	KindSetSigner Kind = "set-signer"
+)
+
+// Operation describes administrative operation and its arguments.
+type Operation struct {
+	Kind    Kind     `json:"kind"`
+	Percent *big.Int `json:"percent,omitempty"`
+	Token   string   `json:"token,omitempty"`
+	Amount  *big.Int `json:"amount,omitempty"`
+	Owner   string   `json:"owner,omitempty"`
+	Signer  string   `json:"signer,omitempty"`
+}
+
+// Validate checks that operation has all arguments it needs.
+func (operation Operation) Validate() error {
+	switch operation.Kind {
+	case KindPause, KindUnpause:
+		return nil
+	case KindSetStableCommissionPercent:
+		if operation.Percent == nil || operation.Percent.Sign() < 0 {
+			return ErrAdmin.New("commission percent should not be negative")
+		}
+	case KindWithdrawCommission:
+		if operation.Token == "" {
+			return ErrAdmin.New("token is required")
+		}
+		if operation.Amount == nil || operation.Amount.Sign() <= 0 {
+			return ErrAdmin.New("amount should be positive")
+		}
+	case KindTransferOwnership:
+		if operation.Owner == "" {
+			return ErrAdmin.New("new owner is required")
+		}
+	case KindSetSigner:
+		if operation.Signer == "" {
+			return ErrAdmin.New("signer is required")
+		}
+	default:
+		return ErrAdmin.New("unknown operation %q", operation.Kind)
+	}
+
+	return nil
+}
+
+// Result describes outcome of the operation. Dry run keeps unsigned transaction or deploy,
+// executed operation keeps hash of the confirmed transaction or deploy and its block.
+type Result struct {
+	Network   networks.Name   `json:"network"`
+	Operation Operation       `json:"operation"`
+	Unsigned  json.RawMessage `json:"unsigned,omitempty"`
+	Hash      string          `json:"hash,omitempty"`
+	Block     string          `json:"block,omitempty"`
+}
+
+// Contract performs administrative operations on the bridge contract of the network.
+type Contract interface {
+	// Execute signs operation, sends it to the network and waits for its confirmation,
+	// dry run returns unsigned transaction without sending it.
+	Execute(ctx context.Context, operation Operation, dryRun bool) (Result, error)
+}
@@ -0,0 +1,203 @@
+package admin_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/casper-ecosystem/casper-golang-sdk/keypair"
+	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"tricorn/bridge/networks"
+	"tricorn/chains/casper"
+	"tricorn/internal/contracts/admin"
+	"tricorn/internal/contracts/evm/bridge"
+	"tricorn/pkg/casper-sdk/mock"
+)
+
+// simulatedChainID is a chain id of go-ethereum simulated backend.
+const simulatedChainID = 1337
+
+// committingBackend is a simulated backend, which mines every sent transaction.
+type committingBackend struct {
+	*backends.SimulatedBackend
+}
+
+func (backend committingBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := backend.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	backend.Commit()
+	return nil
+}
+
+func TestOperationValidate(t *testing.T) {
+	valid := []admin.Operation{
+		{Kind: admin.KindPause},
+		{Kind: admin.KindUnpause},
+		{Kind: admin.KindSetStableCommissionPercent, Percent: big.NewInt(0)},
+		{Kind: admin.KindWithdrawCommission, Token: "0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69", Amount: big.NewInt(1)},
+		{Kind: admin.KindTransferOwnership, Owner: "0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69"},
+		{Kind: admin.KindSetSigner, Signer: "key"},
+	}
+	for _, operation := range valid {
+		require.NoError(t, operation.Validate(), operation.Kind)
+	}
+
+	invalid := []admin.Operation{
+		{Kind: "unknown"},
+		{Kind: admin.KindSetStableCommissionPercent},
+		{Kind: admin.KindSetStableCommissionPercent, Percent: big.NewInt(-1)},
+		{Kind: admin.KindWithdrawCommission, Amount: big.NewInt(1)},
+		{Kind: admin.KindWithdrawCommission, Token: "0x0E26df2BaaFBC976a104EE3cbcf1B467ff1b7a69", Amount: big.NewInt(0)},
+		{Kind: admin.KindTransferOwnership},
+		{Kind: admin.KindSetSigner},
+	}
+	for _, operation := range invalid {
+		require.Error(t, operation.Validate(), operation.Kind)
+	}
+}
+
+func TestEVMContract(t *testing.T) {
+	ctx := context.Background()
+
+	ownerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+
+	backend := committingBackend{SimulatedBackend: backends.NewSimulatedBackend(core.GenesisAlloc{
+		owner: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+	}, 30_000_000)}
+	t.Cleanup(func() { _ = backend.Close() })
+
+	deployer, err := bind.NewKeyedTransactorWithChainID(ownerKey, big.NewInt(simulatedChainID))
+	require.NoError(t, err)
+	address, _, instance, err := bridge.DeployBridge(deployer, backend, owner)
+	require.NoError(t, err)
+
+	contract, err := admin.NewEVMContract(networks.NameGoerli, backend, address, big.NewInt(simulatedChainID), owner, func(data []byte) ([]byte, error) {
+		return crypto.Sign(data, ownerKey)
+	})
+	require.NoError(t, err)
+
+	t.Run("dry run", func(t *testing.T) {
+		result, err := contract.Execute(ctx, admin.Operation{Kind: admin.KindPause}, true)
+		require.NoError(t, err)
+		require.NotEmpty(t, result.Unsigned)
+		require.Empty(t, result.Hash)
+
+		paused, err := instance.Paused(&bind.CallOpts{})
+		require.NoError(t, err)
+		require.False(t, paused)
+	})
+
+	t.Run("pause", func(t *testing.T) {
+		result, err := contract.Execute(ctx, admin.Operation{Kind: admin.KindPause}, false)
+		require.NoError(t, err)
+		require.Empty(t, result.Unsigned)
+		require.NotEmpty(t, result.Hash)
+		require.NotEmpty(t, result.Block)
+
+		paused, err := instance.Paused(&bind.CallOpts{})
+		require.NoError(t, err)
+		require.True(t, paused)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := contract.Execute(ctx, admin.Operation{Kind: admin.KindSetSigner, Signer: "key"}, false)
+		require.True(t, admin.ErrUnsupported.Has(err))
+	})
+
+	t.Run("reverted", func(t *testing.T) {
+		_, err := contract.Execute(ctx, admin.Operation{Kind: admin.KindTransferOwnership, Owner: common.Address{}.Hex()}, true)
+		require.Error(t, err)
+	})
+}
+
+func TestCasperContract(t *testing.T) {
+	ctx := context.Background()
+
+	config := admin.CasperContractConfig{
+		Network:                   networks.NameCasperTest,
+		StandardPayment:           2500000000,
+		BridgeContractPackageHash: "9299f58df67c2eff01e97f362996d35ab5393167e58c58360b1721cce95a7bbc",
+		PollInterval:              time.Millisecond,
+	}
+	publicKey := keypair.PublicKey{Tag: keypair.KeyTagEd25519, PubKeyData: make([]byte, 32)}
+	sign := func(data []byte) ([]byte, error) {
+		return make([]byte, 64), nil
+	}
+
+	_, err := admin.NewCasperContract(config, mock.New(), keypair.PublicKey{Tag: 3, PubKeyData: make([]byte, 33)}, sign)
+	require.Error(t, err)
+
+	contract, err := admin.NewCasperContract(config, mock.New(), publicKey, sign)
+	require.NoError(t, err)
+
+	t.Run("dry run", func(t *testing.T) {
+		result, err := contract.Execute(ctx, admin.Operation{Kind: admin.KindSetSigner, Signer: "key"}, true)
+		require.NoError(t, err)
+		require.True(t, strings.Contains(string(result.Unsigned), "set_signer"))
+		require.True(t, strings.Contains(string(result.Unsigned), `"approvals":[]`))
+		require.Empty(t, result.Hash)
+	})
+
+	t.Run("set stable commission percent", func(t *testing.T) {
+		result, err := contract.Execute(ctx, admin.Operation{Kind: admin.KindSetStableCommissionPercent, Percent: big.NewInt(2)}, false)
+		require.NoError(t, err)
+		require.Empty(t, result.Unsigned)
+		require.NotEmpty(t, result.Hash)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := contract.Execute(ctx, admin.Operation{Kind: admin.KindPause}, false)
+		require.True(t, admin.ErrUnsupported.Has(err))
+	})
+
+	t.Run("secp256k1 key", func(t *testing.T) {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		secpPublicKey := keypair.PublicKey{Tag: keypair.KeyTagSecp256k1, PubKeyData: crypto.CompressPubkey(&privateKey.PublicKey)}
+		signSecp := func(data []byte) ([]byte, error) {
+			return crypto.Sign(data, privateKey)
+		}
+
+		casperClient := &recordingCasper{Casper: mock.New()}
+		contract, err := admin.NewCasperContract(config, casperClient, secpPublicKey, signSecp)
+		require.NoError(t, err)
+
+		_, err = contract.Execute(ctx, admin.Operation{Kind: admin.KindSetSigner, Signer: "key"}, false)
+		require.NoError(t, err)
+
+		require.Len(t, casperClient.deploy.Approvals, 1)
+		approval := casperClient.deploy.Approvals[0]
+		require.Equal(t, keypair.KeyTagSecp256k1, approval.Signature.Tag)
+		require.Len(t, approval.Signature.SignatureData, 64)
+		require.True(t, secp256k1.PubKey(secpPublicKey.PubKeyData).VerifySignature(casperClient.deploy.Hash, approval.Signature.SignatureData))
+	})
+}
+
+// recordingCasper is a casper client, which records the last deploy it puts.
+type recordingCasper struct {
+	casper.Casper
+	deploy sdk.Deploy
+}
+
+// PutDeploy records deploy.
+func (client *recordingCasper) PutDeploy(deploy sdk.Deploy) (string, error) {
+	client.deploy = deploy
+	return client.Casper.PutDeploy(deploy)
+}
@@ -0,0 +1,141 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/casper-ecosystem/casper-golang-sdk/keypair"
+	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
+
+	"tricorn/bridge/networks"
+	casper_chain "tricorn/chains/casper"
+	"tricorn/internal/contracts/casper"
+)
+
+// ensures that CasperContract implements Contract.
+var _ Contract = (*CasperContract)(nil)
+
+// CasperContractConfig describes values needed to build deploys of the casper bridge contract.
+type CasperContractConfig struct {
+	Network                   networks.Name
+	StandardPayment           int64
+	BridgeContractPackageHash string
+	PollInterval              time.Duration
+}
+
+// CasperContract performs administrative operations on the bridge contract of casper network.
+type CasperContract struct {
+	config    CasperContractConfig
+	casper    casper_chain.Casper
+	transfer  *casper.Transfer
+	publicKey keypair.PublicKey
+}
+
+// NewCasperContract is constructor for CasperContract, deploys are signed by ed25519 or secp256k1 key of the public key.
+func NewCasperContract(config CasperContractConfig, casperClient casper_chain.Casper, publicKey keypair.PublicKey, sign func([]byte) ([]byte, error)) (*CasperContract, error) {
+	if publicKey.Tag != keypair.KeyTagEd25519 && publicKey.Tag != keypair.KeyTagSecp256k1 {
+		return nil, ErrAdmin.New("unsupported public key tag %d", publicKey.Tag)
+	}
+
+	return &CasperContract{
+		config:    config,
+		casper:    casperClient,
+		transfer:  casper.NewTransfer(casperClient, sign),
+		publicKey: publicKey,
+	}, nil
+}
+
+// Execute signs operation, sends it to the network and waits for its confirmation,
+// dry run returns unsigned deploy without sending it.
+func (contract *CasperContract) Execute(ctx context.Context, operation Operation, dryRun bool) (Result, error) {
+	if err := operation.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	deploy, err := contract.deploy(operation)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{
+		Network:   contract.config.Network,
+		Operation: operation,
+	}
+
+	if dryRun {
+		result.Unsigned, err = json.Marshal(deploy)
+		return result, ErrAdmin.Wrap(err)
+	}
+
+	result.Hash, err = contract.transfer.SendDeploy(ctx, deploy, contract.publicKey)
+	if err != nil {
+		return result, ErrAdmin.Wrap(err)
+	}
+
+	execution, err := contract.waitExecuted(ctx, result.Hash)
+	if err != nil {
+		return result, err
+	}
+
+	if execution.ErrorMessage != "" {
+		return result, ErrAdmin.New("deploy %s is failed: %s", result.Hash, execution.ErrorMessage)
+	}
+
+	result.Block = execution.BlockHash
+	return result, nil
+}
+
+// deploy builds unsigned deploy of the operation.
+func (contract *CasperContract) deploy(operation Operation) (*sdk.Deploy, error) {
+	var (
+		deploy *sdk.Deploy
+		err    error
+	)
+
+	switch operation.Kind {
+	case KindSetStableCommissionPercent:
+		deploy, err = casper.NewSetStableCommissionPercentDeploy(casper.SetStableCommissionPercentRequest{
+			PublicKey:                   contract.publicKey,
+			ChainName:                   string(contract.config.Network),
+			StandardPaymentForBridgeOut: contract.config.StandardPayment,
+			BridgeContractPackageHash:   contract.config.BridgeContractPackageHash,
+			CommissionPercent:           operation.Percent,
+		})
+	case KindSetSigner:
+		deploy, err = casper.NewSetSignerDeploy(casper.SetSignerRequest{
+			PublicKey:                   contract.publicKey,
+			ChainName:                   string(contract.config.Network),
+			StandardPaymentForBridgeOut: contract.config.StandardPayment,
+			BridgeContractPackageHash:   contract.config.BridgeContractPackageHash,
+			Value:                       operation.Signer,
+		})
+	default:
+		return nil, ErrUnsupported.New("%s on %s", operation.Kind, contract.config.Network)
+	}
+
+	return deploy, ErrAdmin.Wrap(err)
+}
+
+// waitExecuted polls casper node until deploy is executed or context is done.
+func (contract *CasperContract) waitExecuted(ctx context.Context, hash string) (casper_chain.DeployExecution, error) {
+	ticker := time.NewTicker(contract.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		execution, err := contract.casper.GetDeployExecution(ctx, hash)
+		if err != nil {
+			return casper_chain.DeployExecution{}, ErrAdmin.Wrap(err)
+		}
+
+		if execution.Executed {
+			return execution, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return casper_chain.DeployExecution{}, ErrAdmin.Wrap(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"tricorn/bridge/networks"
+	"tricorn/internal/contracts/evm"
+	"tricorn/internal/contracts/evm/bridge"
+	"tricorn/signer"
+)
+
+// ensures that EVMContract implements Contract.
+var _ Contract = (*EVMContract)(nil)
+
+// EVMBackend exposes access to the evm node methods needed to send and confirm transactions.
+type EVMBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// EVMContract performs administrative operations on the bridge contract of evm network.
+type EVMContract struct {
+	network  networks.Name
+	backend  EVMBackend
+	instance *bridge.Bridge
+	chainID  *big.Int
+	from     common.Address
+
+	sign func([]byte) ([]byte, error)
+}
+
+// NewEVMContract is constructor for EVMContract.
+func NewEVMContract(network networks.Name, backend EVMBackend, contractAddress common.Address, chainID *big.Int, from common.Address, sign func([]byte) ([]byte, error)) (*EVMContract, error) {
+	instance, err := bridge.NewBridge(contractAddress, backend)
+	if err != nil {
+		return nil, ErrAdmin.Wrap(err)
+	}
+
+	return &EVMContract{
+		network:  network,
+		backend:  backend,
+		instance: instance,
+		chainID:  chainID,
+		from:     from,
+		sign:     sign,
+	}, nil
+}
+
+// Execute signs operation, sends it to the network and waits for its confirmation,
+// dry run returns unsigned transaction without sending it.
+func (contract *EVMContract) Execute(ctx context.Context, operation Operation, dryRun bool) (Result, error) {
+	if err := operation.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	opts, err := evm.NewKeyedTransactorWithChainID(ctx, contract.from, contract.chainID, func(data []byte, _ signer.Type) ([]byte, error) {
+		return contract.sign(data)
+	})
+	if err != nil {
+		return Result{}, ErrAdmin.Wrap(err)
+	}
+
+	if dryRun {
+		opts.NoSend = true
+		opts.Signer = func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		}
+	}
+
+	tx, err := contract.transact(opts, operation)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{
+		Network:   contract.network,
+		Operation: operation,
+	}
+
+	if dryRun {
+		result.Unsigned, err = tx.MarshalJSON()
+		return result, ErrAdmin.Wrap(err)
+	}
+
+	result.Hash = tx.Hash().Hex()
+	receipt, err := bind.WaitMined(ctx, contract.backend, tx)
+	if err != nil {
+		return result, ErrAdmin.Wrap(err)
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return result, ErrAdmin.New("transaction %s is reverted", result.Hash)
+	}
+
+	result.Block = receipt.BlockNumber.String()
+	return result, nil
+}
+
+// transact calls contract method of the operation.
+func (contract *EVMContract) transact(opts *bind.TransactOpts, operation Operation) (*types.Transaction, error) {
+	var (
+		tx  *types.Transaction
+		err error
+	)
+
+	switch operation.Kind {
+	case KindPause:
+		tx, err = contract.instance.Pause(opts)
+	case KindUnpause:
+		tx, err = contract.instance.Unpause(opts)
+	case KindSetStableCommissionPercent:
+		tx, err = contract.instance.SetStableCommissionPercent(opts, operation.Percent)
+	case KindWithdrawCommission:
+		if !common.IsHexAddress(operation.Token) {
+			return nil, ErrAdmin.New("invalid token address %s", operation.Token)
+		}
+		tx, err = contract.instance.WithdrawCommission(opts, common.HexToAddress(operation.Token), operation.Amount)
+	case KindTransferOwnership:
+		if !common.IsHexAddress(operation.Owner) {
+			return nil, ErrAdmin.New("invalid owner address %s", operation.Owner)
+		}
+		tx, err = contract.instance.TransferOwnership(opts, common.HexToAddress(operation.Owner))
+	default:
+		return nil, ErrUnsupported.New("%s on %s", operation.Kind, contract.network)
+	}
+
+	return tx, ErrAdmin.Wrap(err)
+}
@@ -14,6 +14,7 @@ import (
 
 	"tricorn/bridge/networks"
 	casper_chain "tricorn/chains/casper"
+	signature_lib "tricorn/pkg/signature"
 )
 
 // Transfer describes sign func to sign transaction and casper client to send transaction.
@@ -240,16 +241,23 @@ type SetSignerRequest struct {
 
 // SetSigner sets public key in contract to verify signature.
 func (t *Transfer) SetSigner(ctx context.Context, req SetSignerRequest) (string, error) {
-	deployParams := sdk.NewDeployParams(req.PublicKey, strings.ToLower(req.ChainName), nil, 0)
-	payment := sdk.StandardPayment(big.NewInt(req.StandardPaymentForBridgeOut))
+	deploy, err := NewSetSignerDeploy(req)
+	if err != nil {
+		return "", err
+	}
 
+	return t.SendDeploy(ctx, deploy, req.PublicKey)
+}
+
+// NewSetSignerDeploy builds unsigned deploy which calls setSigner method.
+func NewSetSignerDeploy(req SetSignerRequest) (*sdk.Deploy, error) {
 	value := types.CLValue{
 		Type:   types.CLTypeString,
 		String: &req.Value,
 	}
 	valueBytes, err := serialization.Marshal(value)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	args := map[string]sdk.Value{
@@ -260,40 +268,8 @@ func (t *Transfer) SetSigner(ctx context.Context, req SetSignerRequest) (string,
 		},
 	}
 	keyOrder := []string{"signer"}
-	runtimeArgs := sdk.NewRunTimeArgs(args, keyOrder)
-
-	contractHexBytes, err := hex.DecodeString(req.BridgeContractPackageHash)
-	if err != nil {
-		return "", err
-	}
-
-	var contractHashBytes [32]byte
-	copy(contractHashBytes[:], contractHexBytes)
-	session := sdk.NewStoredContractByHash(contractHashBytes, "set_signer", *runtimeArgs)
-
-	deploy := sdk.MakeDeploy(deployParams, payment, session)
-
-	signedTx, err := t.sign(deploy.Hash)
-	if err != nil {
-		return "", err
-	}
-
-	signatureKeypair := keypair.Signature{
-		Tag:           keypair.KeyTagEd25519,
-		SignatureData: signedTx,
-	}
-	approval := sdk.Approval{
-		Signer:    req.PublicKey,
-		Signature: signatureKeypair,
-	}
-	deploy.Approvals = append(deploy.Approvals, approval)
-
-	hash, err := t.casper.PutDeploy(*deploy)
-	if err != nil {
-		return "", err
-	}
 
-	return hash, nil
+	return newContractDeploy(req.PublicKey, req.ChainName, req.StandardPaymentForBridgeOut, req.BridgeContractPackageHash, "set_signer", args, keyOrder)
 }
 
 // SetStableCommissionPercentRequest describes values to calls setStableCommissionPercent method.
@@ -307,16 +283,23 @@ type SetStableCommissionPercentRequest struct {
 
 // SetStableCommissionPercent sets commission percent in contract.
 func (t *Transfer) SetStableCommissionPercent(ctx context.Context, req SetStableCommissionPercentRequest) (string, error) {
-	deployParams := sdk.NewDeployParams(req.PublicKey, strings.ToLower(req.ChainName), nil, 0)
-	payment := sdk.StandardPayment(big.NewInt(req.StandardPaymentForBridgeOut))
+	deploy, err := NewSetStableCommissionPercentDeploy(req)
+	if err != nil {
+		return "", err
+	}
 
+	return t.SendDeploy(ctx, deploy, req.PublicKey)
+}
+
+// NewSetStableCommissionPercentDeploy builds unsigned deploy which calls setStableCommissionPercent method.
+func NewSetStableCommissionPercentDeploy(req SetStableCommissionPercentRequest) (*sdk.Deploy, error) {
 	commissionPercent := types.CLValue{
 		Type: types.CLTypeU256,
 		U256: req.CommissionPercent,
 	}
 	commissionPercentBytes, err := serialization.Marshal(commissionPercent)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	args := map[string]sdk.Value{
@@ -327,40 +310,42 @@ func (t *Transfer) SetStableCommissionPercent(ctx context.Context, req SetStable
 		},
 	}
 	keyOrder := []string{"stable_commission_percent"}
-	runtimeArgs := sdk.NewRunTimeArgs(args, keyOrder)
-
-	contractHexBytes, err := hex.DecodeString(req.BridgeContractPackageHash)
-	if err != nil {
-		return "", err
-	}
-
-	var contractHashBytes [32]byte
-	copy(contractHashBytes[:], contractHexBytes)
-	session := sdk.NewStoredContractByHash(contractHashBytes, "set_stable_commission_percent", *runtimeArgs)
 
-	deploy := sdk.MakeDeploy(deployParams, payment, session)
+	return newContractDeploy(req.PublicKey, req.ChainName, req.StandardPaymentForBridgeOut, req.BridgeContractPackageHash, "set_stable_commission_percent", args, keyOrder)
+}
 
-	signedTx, err := t.sign(deploy.Hash)
+// SendDeploy signs deploy by the key of the public key and puts it to the casper network.
+func (t *Transfer) SendDeploy(ctx context.Context, deploy *sdk.Deploy, publicKey keypair.PublicKey) (string, error) {
+	signedTx, err := t.sign(signature_lib.CasperDeployDigest(publicKey.Tag, deploy.Hash))
 	if err != nil {
 		return "", err
 	}
 
-	signatureKeypair := keypair.Signature{
-		Tag:           keypair.KeyTagEd25519,
-		SignatureData: signedTx,
-	}
 	approval := sdk.Approval{
-		Signer:    req.PublicKey,
-		Signature: signatureKeypair,
+		Signer:    publicKey,
+		Signature: signature_lib.CasperDeploySignature(publicKey.Tag, signedTx),
 	}
 	deploy.Approvals = append(deploy.Approvals, approval)
 
-	hash, err := t.casper.PutDeploy(*deploy)
+	return t.casper.PutDeploy(*deploy)
+}
+
+// newContractDeploy builds unsigned deploy which calls entry point of the contract with specified package hash.
+func newContractDeploy(publicKey keypair.PublicKey, chainName string, standardPayment int64, contractPackageHash string, entryPoint string, args map[string]sdk.Value, keyOrder []string) (*sdk.Deploy, error) {
+	deployParams := sdk.NewDeployParams(publicKey, strings.ToLower(chainName), nil, 0)
+	payment := sdk.StandardPayment(big.NewInt(standardPayment))
+	runtimeArgs := sdk.NewRunTimeArgs(args, keyOrder)
+
+	contractHexBytes, err := hex.DecodeString(contractPackageHash)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return hash, nil
+	var contractHashBytes [32]byte
+	copy(contractHashBytes[:], contractHexBytes)
+	session := sdk.NewStoredContractByHash(contractHashBytes, entryPoint, *runtimeArgs)
+
+	return sdk.MakeDeploy(deployParams, payment, session), nil
 }
 
 // BridgeOutRequest describes values to initiate outbound bridge transaction.
@@ -36,7 +36,7 @@ func TestCasper_BridgeIn(t *testing.T) {
 	)
 
 	ctx := context.Background()
-	casperClient := client.New(casperNodeAddress)
+	casperClient := client.New(casperNodeAddress, client.FetcherConfig{})
 
 	privateKeyECDSA, err := crypto.HexToECDSA(privateKeySecp256k1ForSignature)
 	require.NoError(t, err)
@@ -121,7 +121,7 @@ func TestCasper_SetSigner(t *testing.T) {
 	)
 
 	ctx := context.Background()
-	casperClient := client.New(casperNodeAddress)
+	casperClient := client.New(casperNodeAddress, client.FetcherConfig{})
 
 	privateKeyForTransferSigningBytes, err := hex.DecodeString(privateKeyEd25519ForTransaction)
 	require.NoError(t, err)
@@ -161,7 +161,7 @@ func TestCasper_SetStableCommissionPercent(t *testing.T) {
 	)
 
 	ctx := context.Background()
-	casperClient := client.New(casperNodeAddress)
+	casperClient := client.New(casperNodeAddress, client.FetcherConfig{})
 
 	privateKeyForTransferSigningBytes, err := hex.DecodeString(privateKeyEd25519ForTransaction)
 	require.NoError(t, err)
@@ -200,7 +200,7 @@ func TestCasper_BridgeOut(t *testing.T) {
 	)
 
 	ctx := context.Background()
-	casperClient := client.New(casperNodeAddress)
+	casperClient := client.New(casperNodeAddress, client.FetcherConfig{})
 
 	privateKeyForTransferSigningBytes, err := hex.DecodeString(privateKeyEd25519ForTransaction)
 	require.NoError(t, err)
@@ -249,7 +249,7 @@ func TestCasperTransferOut(t *testing.T) {
 	)
 
 	ctx := context.Background()
-	casperClient := client.New(casperNodeAddress)
+	casperClient := client.New(casperNodeAddress, client.FetcherConfig{})
 
 	privateKeyECDSA, err := crypto.HexToECDSA(privateKeySecp256k1ForSignature)
 	require.NoError(t, err)
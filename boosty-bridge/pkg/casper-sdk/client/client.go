@@ -5,6 +5,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -18,6 +19,9 @@ import (
 	"tricorn/chains/casper"
 )
 
+// noSuchDeployCode is a code of the rpc error returned by node, which knows nothing about the deploy.
+const noSuchDeployCode = -32000
+
 // ensures that rpcClient implement casper.Casper.
 var _ casper.Casper = (*rpcClient)(nil)
 
@@ -26,14 +30,18 @@ type rpcClient struct {
 	client *sdk.RpcClient
 
 	rpcNodeAddress string
+	fetcher        FetcherConfig
+	limiter        *rateLimiter
 }
 
 // New is constructor for rpcClient.
-func New(rpcNodeAddress string) casper.Casper {
+func New(rpcNodeAddress string, fetcher FetcherConfig) casper.Casper {
 	client := sdk.NewRpcClient(rpcNodeAddress)
 	return &rpcClient{
 		client:         client,
 		rpcNodeAddress: rpcNodeAddress,
+		fetcher:        fetcher,
+		limiter:        newRateLimiter(fetcher.RequestsPerSecond),
 	}
 }
 
@@ -43,7 +51,7 @@ type JsonPutDeployRes struct {
 
 // PutDeploy deploys a contract or sends a transaction and returns deployment hash.
 func (r *rpcClient) PutDeploy(deploy sdk.Deploy) (string, error) {
-	resp, err := r.rpcCall("account_put_deploy", map[string]interface{}{
+	resp, err := r.rpcCall(context.Background(), "account_put_deploy", map[string]interface{}{
 		"deploy": deploy,
 	})
 	if err != nil {
@@ -65,57 +73,6 @@ func (r *rpcClient) GetBlockNumberByHash(hash string) (int, error) {
 	return blockResp.Header.Height, err
 }
 
-// GetEventsByBlockNumbers returns events for range of block numbers.
-func (r *rpcClient) GetEventsByBlockNumbers(fromBlockNumber uint64, toBlockNumber uint64, bridgeEventsHash string) ([]casper.Event, error) {
-	events := make([]casper.Event, 0)
-
-	for blockNumber := fromBlockNumber; blockNumber <= toBlockNumber; blockNumber++ {
-		blockResp, err := r.client.GetBlockByHeight(blockNumber)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, hash := range blockResp.Body.DeployHashes {
-			deploy, err := r.getDeploy(hash)
-			if err != nil {
-				return nil, err
-			}
-
-			for i, executionResult := range deploy.ExecutionResults {
-				for _, transform := range executionResult.Result.Success.Effect.Transforms {
-					if transform.Key != bridgeEventsHash {
-						continue
-					}
-
-					event := casper.Event{
-						DeployProcessed: casper.DeployProcessed{
-							DeployHash: deploy.Deploy.Hash,
-							Account:    deploy.Deploy.Header.Account,
-							BlockHash:  deploy.ExecutionResults[i].BlockHash,
-							ExecutionResult: casper.ExecutionResult{
-								Success: casper.Success{
-									Effect: casper.Effect{
-										Transforms: []casper.Transform{
-											{
-												Key:       transform.Key,
-												Transform: transform.Transform,
-											},
-										},
-									},
-								},
-							},
-						},
-					}
-
-					events = append(events, event)
-				}
-			}
-		}
-	}
-
-	return events, nil
-}
-
 // GetCurrentBlockNumber returns current block number.
 func (r *rpcClient) GetCurrentBlockNumber() (uint64, error) {
 	blockResp, err := r.client.GetLatestBlock()
@@ -135,7 +92,7 @@ func (r *rpcClient) GetStateItem(stateRootHash, key string, path []string) (Stor
 	if len(path) > 0 {
 		params["path"] = path
 	}
-	resp, err := r.rpcCall("state_get_item", params)
+	resp, err := r.rpcCall(context.Background(), "state_get_item", params)
 	if err != nil {
 		return StoredValueResult{}, err
 	}
@@ -149,10 +106,37 @@ func (r *rpcClient) GetStateItem(stateRootHash, key string, path []string) (Stor
 	return result, nil
 }
 
-func (r *rpcClient) getDeploy(hash string) (DeployResult, error) {
+// GetDeployExecution returns execution result of the deploy with specified hash.
+func (r *rpcClient) GetDeployExecution(ctx context.Context, hash string) (casper.DeployExecution, error) {
+	deploy, err := r.getDeploy(ctx, hash)
+	if err != nil {
+		var rpcErr *RPCError
+		if errors.As(err, &rpcErr) && rpcErr.Code == noSuchDeployCode {
+			return casper.DeployExecution{}, casper.ErrDeployNotFound.New("%s", hash)
+		}
+		return casper.DeployExecution{}, err
+	}
+
+	if len(deploy.ExecutionResults) == 0 {
+		return casper.DeployExecution{}, nil
+	}
+
+	executionResult := deploy.ExecutionResults[0]
+	execution := casper.DeployExecution{
+		Executed:  true,
+		BlockHash: executionResult.BlockHash,
+	}
+	if executionResult.Result.ErrorMessage != nil {
+		execution.ErrorMessage = *executionResult.Result.ErrorMessage
+	}
+
+	return execution, nil
+}
+
+func (r *rpcClient) getDeploy(ctx context.Context, hash string) (DeployResult, error) {
 	var result DeployResult
 
-	resp, err := r.rpcCall("info_get_deploy", map[string]string{
+	resp, err := r.rpcCall(ctx, "info_get_deploy", map[string]string{
 		"deploy_hash": hash,
 	})
 	if err != nil {
@@ -164,9 +148,13 @@ func (r *rpcClient) getDeploy(hash string) (DeployResult, error) {
 	return result, err
 }
 
-func (r *rpcClient) rpcCall(method string, params interface{}) (_ sdk.RpcResponse, err error) {
+func (r *rpcClient) rpcCall(ctx context.Context, method string, params interface{}) (_ sdk.RpcResponse, err error) {
 	var rpcResponse sdk.RpcResponse
 
+	if err = r.limiter.wait(ctx); err != nil {
+		return sdk.RpcResponse{}, err
+	}
+
 	body, err := json.Marshal(sdk.RpcRequest{
 		Version: "2.0",
 		Method:  method,
@@ -176,7 +164,13 @@ func (r *rpcClient) rpcCall(method string, params interface{}) (_ sdk.RpcRespons
 		return sdk.RpcResponse{}, errors.Wrap(err, "failed to marshal json")
 	}
 
-	resp, err := http.Post(r.rpcNodeAddress, "application/json", bytes.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.rpcNodeAddress, bytes.NewReader(body))
+	if err != nil {
+		return sdk.RpcResponse{}, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return sdk.RpcResponse{}, fmt.Errorf("failed to make request: %v", err)
 	}
@@ -200,7 +194,7 @@ func (r *rpcClient) rpcCall(method string, params interface{}) (_ sdk.RpcRespons
 	}
 
 	if rpcResponse.Error != nil {
-		return rpcResponse, fmt.Errorf("rpc call failed, code - %d, message - %s", rpcResponse.Error.Code, rpcResponse.Error.Message)
+		return rpcResponse, &RPCError{Code: rpcResponse.Error.Code, Message: rpcResponse.Error.Message}
 	}
 
 	return rpcResponse, nil
@@ -0,0 +1,238 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
+	"golang.org/x/sync/errgroup"
+
+	"tricorn/chains/casper"
+)
+
+// defaultRetryDelay defines delay before the first retry of failed request if delay is not configured.
+const defaultRetryDelay = 500 * time.Millisecond
+
+// FetcherConfig defines how blocks are fetched while events are read from range of blocks.
+type FetcherConfig struct {
+	// Workers is a number of blocks fetched concurrently.
+	Workers int
+	// RequestsPerSecond limits rate of requests to the node, zero means no limit.
+	RequestsPerSecond float64
+	// Retries is a number of times request is repeated after transient error, e.g. connection or node overload.
+	Retries int
+	// RetryDelay is a delay before the first retry, it is doubled for every next retry.
+	RetryDelay time.Duration
+}
+
+// RPCError is an error returned by node for the request itself, so repeating the request does not help.
+type RPCError struct {
+	Code    int
+	Message string
+}
+
+// Error returns error message.
+func (err *RPCError) Error() string {
+	return fmt.Sprintf("rpc call failed, code - %d, message - %s", err.Code, err.Message)
+}
+
+// rateLimiter spreads requests evenly, so no more than configured number of requests is sent per second.
+type rateLimiter struct {
+	interval time.Duration
+
+	mutex sync.Mutex
+	next  time.Time
+}
+
+// newRateLimiter is a constructor for rateLimiter, nil is returned if rate is not limited.
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+}
+
+// wait blocks until the next request is allowed.
+func (limiter *rateLimiter) wait(ctx context.Context) error {
+	if limiter == nil {
+		return nil
+	}
+
+	limiter.mutex.Lock()
+	now := time.Now()
+	if limiter.next.Before(now) {
+		limiter.next = now
+	}
+	delay := limiter.next.Sub(now)
+	limiter.next = limiter.next.Add(limiter.interval)
+	limiter.mutex.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retry calls fn until it succeeds, fails with RPCError or configured number of retries is exceeded.
+func (r *rpcClient) retry(ctx context.Context, fn func() error) error {
+	delay := r.fetcher.RetryDelay
+	if delay == 0 {
+		delay = defaultRetryDelay
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		var rpcErr *RPCError
+		if errors.As(err, &rpcErr) || attempt >= r.fetcher.Retries {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// GetEventsByBlockNumbers returns events for range of block numbers. Blocks are fetched concurrently
+// by configured number of workers, events are returned in order of blocks and deploys in them.
+func (r *rpcClient) GetEventsByBlockNumbers(fromBlockNumber uint64, toBlockNumber uint64, bridgeEventsHash string) ([]casper.Event, error) {
+	if fromBlockNumber > toBlockNumber {
+		return []casper.Event{}, nil
+	}
+
+	workers := r.fetcher.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	group, ctx := errgroup.WithContext(context.Background())
+	group.SetLimit(workers)
+
+	blocksEvents := make([][]casper.Event, toBlockNumber-fromBlockNumber+1)
+	for blockNumber := fromBlockNumber; blockNumber <= toBlockNumber; blockNumber++ {
+		blockNumber := blockNumber
+		group.Go(func() (err error) {
+			blocksEvents[blockNumber-fromBlockNumber], err = r.getBlockEvents(ctx, blockNumber, bridgeEventsHash)
+			return err
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	events := make([]casper.Event, 0)
+	for _, blockEvents := range blocksEvents {
+		events = append(events, blockEvents...)
+	}
+
+	return events, nil
+}
+
+// getBlockEvents returns events of deploys of the block, which emitted bridge events.
+func (r *rpcClient) getBlockEvents(ctx context.Context, blockNumber uint64, bridgeEventsHash string) ([]casper.Event, error) {
+	var block sdk.BlockResponse
+	err := r.retry(ctx, func() (err error) {
+		block, err = r.getBlock(ctx, blockNumber)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]casper.Event, 0)
+	for _, hash := range block.Body.DeployHashes {
+		var deploy DeployResult
+		err := r.retry(ctx, func() (err error) {
+			deploy, err = r.getDeploy(ctx, hash)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i, executionResult := range deploy.ExecutionResults {
+			// deploy is returned with all its transforms, so the position of each bridge event in the deploy is preserved.
+			transforms := make([]casper.Transform, 0, len(executionResult.Result.Success.Effect.Transforms))
+			hasBridgeEvents := false
+			for _, transform := range executionResult.Result.Success.Effect.Transforms {
+				if transform.Key == bridgeEventsHash {
+					hasBridgeEvents = true
+				}
+
+				transforms = append(transforms, casper.Transform{
+					Key:       transform.Key,
+					Transform: transform.Transform,
+				})
+			}
+
+			if !hasBridgeEvents {
+				continue
+			}
+
+			event := casper.Event{
+				DeployProcessed: casper.DeployProcessed{
+					DeployHash: deploy.Deploy.Hash,
+					Account:    deploy.Deploy.Header.Account,
+					BlockHash:  deploy.ExecutionResults[i].BlockHash,
+					ExecutionResult: casper.ExecutionResult{
+						Success: casper.Success{
+							Effect: casper.Effect{
+								Transforms: transforms,
+							},
+						},
+					},
+				},
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// getBlock returns block by its height.
+func (r *rpcClient) getBlock(ctx context.Context, blockNumber uint64) (sdk.BlockResponse, error) {
+	resp, err := r.rpcCall(ctx, "chain_get_block", map[string]interface{}{
+		"block_identifier": map[string]interface{}{"Height": blockNumber},
+	})
+	if err != nil {
+		return sdk.BlockResponse{}, err
+	}
+
+	var result struct {
+		Block sdk.BlockResponse `json:"block"`
+	}
+	if err = json.Unmarshal(resp.Result, &result); err != nil {
+		return sdk.BlockResponse{}, fmt.Errorf("failed to get block: %w", err)
+	}
+
+	return result.Block, nil
+}
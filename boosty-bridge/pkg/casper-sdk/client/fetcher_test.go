@@ -0,0 +1,101 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package client_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/pkg/casper-sdk/client"
+	"tricorn/pkg/multinode"
+)
+
+func TestFetcher(t *testing.T) {
+	// nodeWithDeploys returns node, every block of which up to height contains deploy with bridge event.
+	nodeWithDeploys := func(height int) *fakeNode {
+		node := newFakeNode(height)
+		for block := 1; block <= height; block++ {
+			node.addDeploy(block, fmt.Sprintf("d%d", block), fmt.Sprintf("bridge in %d", block))
+		}
+
+		return node
+	}
+
+	t.Run("Events are delivered in order", func(t *testing.T) {
+		node := nodeWithDeploys(40)
+		node.delay = 5 * time.Millisecond
+		casperClient := runFakeNodesWithFetcher(t, multinode.Config{}, client.FetcherConfig{Workers: 8}, node)
+
+		events, err := casperClient.GetEventsByBlockNumbers(1, 40, bridgeEventsHash)
+		require.NoError(t, err)
+		require.Len(t, events, 40)
+		for i, event := range events {
+			assert.Equal(t, fmt.Sprintf("d%d", i+1), event.DeployProcessed.DeployHash)
+			assert.Equal(t, blockHash(i+1), event.DeployProcessed.BlockHash)
+		}
+
+		node.mutex.Lock()
+		defer node.mutex.Unlock()
+		assert.Greater(t, node.maxInflight, 1)
+		assert.LessOrEqual(t, node.maxInflight, 8)
+	})
+
+	t.Run("Transient errors are retried", func(t *testing.T) {
+		node := nodeWithDeploys(10)
+		node.overloaded[5] = 2
+		casperClient := runFakeNodesWithFetcher(t, multinode.Config{}, client.FetcherConfig{Workers: 4, Retries: 3, RetryDelay: time.Millisecond}, node)
+
+		events, err := casperClient.GetEventsByBlockNumbers(1, 10, bridgeEventsHash)
+		require.NoError(t, err)
+		require.Len(t, events, 10)
+		assert.Equal(t, "d5", events[4].DeployProcessed.DeployHash)
+
+		node.mutex.Lock()
+		defer node.mutex.Unlock()
+		assert.Equal(t, 3, node.blockCalls[5])
+	})
+
+	t.Run("Negative retries are exceeded", func(t *testing.T) {
+		node := nodeWithDeploys(10)
+		node.overloaded[5] = 3
+		casperClient := runFakeNodesWithFetcher(t, multinode.Config{}, client.FetcherConfig{Workers: 4, Retries: 2, RetryDelay: time.Millisecond}, node)
+
+		_, err := casperClient.GetEventsByBlockNumbers(1, 10, bridgeEventsHash)
+		require.Error(t, err)
+	})
+
+	t.Run("Negative rpc errors are not retried", func(t *testing.T) {
+		node := nodeWithDeploys(10)
+		node.missing[7] = true
+		casperClient := runFakeNodesWithFetcher(t, multinode.Config{}, client.FetcherConfig{Workers: 4, Retries: 3, RetryDelay: time.Millisecond}, node)
+
+		_, err := casperClient.GetEventsByBlockNumbers(1, 10, bridgeEventsHash)
+		require.Error(t, err)
+
+		var rpcErr *client.RPCError
+		require.True(t, errors.As(err, &rpcErr))
+		assert.Equal(t, -32001, rpcErr.Code)
+
+		node.mutex.Lock()
+		defer node.mutex.Unlock()
+		assert.Equal(t, 1, node.blockCalls[7])
+	})
+
+	t.Run("Requests are rate limited", func(t *testing.T) {
+		node := newFakeNode(20)
+		casperClient := runFakeNodesWithFetcher(t, multinode.Config{}, client.FetcherConfig{Workers: 8, RequestsPerSecond: 100}, node)
+
+		start := time.Now()
+		events, err := casperClient.GetEventsByBlockNumbers(1, 20, bridgeEventsHash)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+		// the first request is sent immediately, every next one waits for 10ms.
+		assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package client
+
+import (
+	"context"
+
+	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
+	"github.com/zeebo/errs"
+
+	"tricorn/chains"
+	"tricorn/chains/casper"
+	"tricorn/pkg/multinode"
+)
+
+// ensures that multiClient implement casper.Casper.
+var _ casper.Casper = (*multiClient)(nil)
+
+// multiClient is an implementation of casper.Casper, which spreads calls between several rpc nodes.
+// Calls fail over to the next healthy node, block height and bridge events are read from quorum of nodes
+// if it is configured.
+type multiClient struct {
+	clients []casper.Casper
+	pool    *multinode.Pool
+}
+
+// NewMulti is a constructor for client of rpc nodes with specified addresses, the first node is the primary one.
+func NewMulti(rpcNodeAddresses []string, config multinode.Config, fetcher FetcherConfig) casper.Casper {
+	clients := make([]casper.Casper, 0, len(rpcNodeAddresses))
+	for _, address := range rpcNodeAddresses {
+		clients = append(clients, New(address, fetcher))
+	}
+
+	return NewMultiClient(clients, config)
+}
+
+// NewMultiClient is a constructor for client of several nodes, the first client is the primary one.
+func NewMultiClient(clients []casper.Casper, config multinode.Config) casper.Casper {
+	return &multiClient{
+		clients: clients,
+		pool: multinode.New(config, len(clients), func(ctx context.Context, node int) (uint64, error) {
+			return clients[node].GetCurrentBlockNumber()
+		}),
+	}
+}
+
+// PutDeploy deploys a contract or sends a transaction and returns deployment hash.
+func (m *multiClient) PutDeploy(deploy sdk.Deploy) (hash string, err error) {
+	err = m.pool.Do(context.Background(), func(node int) (err error) {
+		hash, err = m.clients[node].PutDeploy(deploy)
+		return err
+	})
+
+	return hash, err
+}
+
+// GetBlockNumberByHash returns block number by block hash.
+func (m *multiClient) GetBlockNumberByHash(hash string) (number int, err error) {
+	err = m.pool.Do(context.Background(), func(node int) (err error) {
+		number, err = m.clients[node].GetBlockNumberByHash(hash)
+		return err
+	})
+
+	return number, err
+}
+
+// GetEventsByBlockNumbers returns events for range of block numbers, which are returned by quorum of nodes.
+func (m *multiClient) GetEventsByBlockNumbers(fromBlockNumber uint64, toBlockNumber uint64, bridgeEventsHash string) ([]casper.Event, error) {
+	events, err := m.pool.Quorum(context.Background(), func(node int) (interface{}, error) {
+		return m.clients[node].GetEventsByBlockNumbers(fromBlockNumber, toBlockNumber, bridgeEventsHash)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events.([]casper.Event), nil
+}
+
+// GetTokenSupply returns balance of the contract holder and total supply of the cep-18 token with specified package hash.
+func (m *multiClient) GetTokenSupply(ctx context.Context, tokenPackageHash []byte, holder []byte) (supply chains.TokenSupply, err error) {
+	err = m.pool.Do(ctx, func(node int) (err error) {
+		supply, err = m.clients[node].GetTokenSupply(ctx, tokenPackageHash, holder)
+		return err
+	})
+
+	return supply, err
+}
+
+// GetDeployExecution returns execution result of the deploy with specified hash. Deploy may not have reached all nodes
+// yet, so it is not found only if every node answers so.
+func (m *multiClient) GetDeployExecution(ctx context.Context, hash string) (casper.DeployExecution, error) {
+	var group errs.Group
+	for _, client := range m.clients {
+		execution, err := client.GetDeployExecution(ctx, hash)
+		if err == nil {
+			return execution, nil
+		}
+		if !casper.ErrDeployNotFound.Has(err) {
+			group.Add(err)
+		}
+	}
+
+	if err := group.Err(); err != nil {
+		return casper.DeployExecution{}, err
+	}
+
+	return casper.DeployExecution{}, casper.ErrDeployNotFound.New("%s", hash)
+}
+
+// GetCurrentBlockNumber returns the latest block number reached by quorum of nodes.
+func (m *multiClient) GetCurrentBlockNumber() (uint64, error) {
+	return m.pool.Height(context.Background())
+}
@@ -0,0 +1,261 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package client_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/chains/casper"
+	"tricorn/pkg/casper-sdk/client"
+	"tricorn/pkg/multinode"
+)
+
+// bridgeEventsHash is a key of bridge contract events.
+const bridgeEventsHash = "uref-0a24ef5b6e8b3c9b2b0b4a6e3d1f8a7c6b5a49382716a5b4c3d2e1f0a9b8c7d6-007"
+
+// fakeNode is a fake casper json-rpc node, which serves blocks with deploys.
+type fakeNode struct {
+	mutex   sync.Mutex
+	height  int
+	blocks  map[int][]string
+	deploys map[string]interface{}
+	down    bool
+	calls   map[string]int
+
+	// delay is a time node takes to respond, inflight and maxInflight track concurrent requests.
+	delay       time.Duration
+	inflight    int
+	maxInflight int
+	// blockCalls is a number of requests of every block.
+	blockCalls map[int]int
+	// overloaded is a number of requests of the block, which fail with transient error.
+	overloaded map[int]int
+	// missing are blocks, requests of which fail with rpc error.
+	missing map[int]bool
+}
+
+func newFakeNode(height int) *fakeNode {
+	return &fakeNode{
+		height:     height,
+		blocks:     make(map[int][]string),
+		deploys:    make(map[string]interface{}),
+		calls:      make(map[string]int),
+		blockCalls: make(map[int]int),
+		overloaded: make(map[int]int),
+		missing:    make(map[int]bool),
+	}
+}
+
+// addDeploy adds deploy, which emitted bridge event with specified value, to the block.
+func (node *fakeNode) addDeploy(height int, deployHash string, value string) {
+	node.blocks[height] = append(node.blocks[height], deployHash)
+	node.deploys[deployHash] = map[string]interface{}{
+		"deploy": map[string]interface{}{
+			"hash":   deployHash,
+			"header": map[string]interface{}{"account": "01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c"},
+		},
+		"execution_results": []interface{}{map[string]interface{}{
+			"block_hash": blockHash(height),
+			"result": map[string]interface{}{"success": map[string]interface{}{"effect": map[string]interface{}{
+				"transforms": []interface{}{map[string]interface{}{"key": bridgeEventsHash, "transform": value}},
+			}}},
+		}},
+	}
+}
+
+func blockHash(height int) string {
+	return fmt.Sprintf("%064x", height)
+}
+
+func (node *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params struct {
+			BlockIdentifier struct {
+				Hash   string `json:"Hash"`
+				Height int    `json:"Height"`
+			} `json:"block_identifier"`
+			DeployHash string `json:"deploy_hash"`
+		} `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	node.mutex.Lock()
+	node.inflight++
+	if node.inflight > node.maxInflight {
+		node.maxInflight = node.inflight
+	}
+	delay := node.delay
+	node.mutex.Unlock()
+
+	time.Sleep(delay)
+
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	node.inflight--
+
+	node.calls[req.Method]++
+	if node.down {
+		http.Error(w, "node is down", http.StatusBadGateway)
+		return
+	}
+
+	var result interface{}
+	switch req.Method {
+	case "chain_get_block":
+		height := req.Params.BlockIdentifier.Height
+		if hash := req.Params.BlockIdentifier.Hash; hash != "" {
+			_, _ = fmt.Sscanf(hash, "%x", &height)
+		}
+		if height == 0 {
+			height = node.height
+		}
+
+		node.blockCalls[height]++
+		if node.overloaded[height] > 0 {
+			node.overloaded[height]--
+			http.Error(w, "too many requests", http.StatusServiceUnavailable)
+			return
+		}
+		if node.missing[height] {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID,
+				"error": map[string]interface{}{"code": -32001, "message": "block not known"}})
+			return
+		}
+
+		result = map[string]interface{}{"block": map[string]interface{}{
+			"hash":   blockHash(height),
+			"header": map[string]interface{}{"height": height},
+			"body":   map[string]interface{}{"deploy_hashes": node.blocks[height]},
+		}}
+	case "info_get_deploy":
+		result = node.deploys[req.Params.DeployHash]
+	case "account_put_deploy":
+		result = map[string]interface{}{"deploy_hash": "d1"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+// callsOf returns number of calls of the method.
+func (node *fakeNode) callsOf(method string) int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	return node.calls[method]
+}
+
+// runFakeNodes runs fake nodes and returns client of them.
+func runFakeNodes(t *testing.T, config multinode.Config, nodes ...*fakeNode) casper.Casper {
+	return runFakeNodesWithFetcher(t, config, client.FetcherConfig{}, nodes...)
+}
+
+// runFakeNodesWithFetcher runs fake nodes and returns client of them, which fetches blocks with specified config.
+func runFakeNodesWithFetcher(t *testing.T, config multinode.Config, fetcher client.FetcherConfig, nodes ...*fakeNode) casper.Casper {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		server := httptest.NewServer(node)
+		t.Cleanup(server.Close)
+		addresses = append(addresses, server.URL)
+	}
+
+	return client.NewMulti(addresses, config, fetcher)
+}
+
+func TestMultiClient(t *testing.T) {
+	honestNode := func() *fakeNode {
+		node := newFakeNode(100)
+		node.addDeploy(90, "aa", "bridge in 10")
+		return node
+	}
+	// malicious provider injects fake deposit into the block.
+	maliciousNode := func() *fakeNode {
+		node := honestNode()
+		node.addDeploy(90, "ff", "bridge in 1000000")
+		return node
+	}
+
+	t.Run("Failover to the next node", func(t *testing.T) {
+		primary := newFakeNode(100)
+		fallback := newFakeNode(100)
+		casperClient := runFakeNodes(t, multinode.Config{}, primary, fallback)
+
+		_, err := casperClient.GetCurrentBlockNumber()
+		require.NoError(t, err)
+
+		primary.mutex.Lock()
+		primary.down = true
+		primary.mutex.Unlock()
+
+		hash, err := casperClient.PutDeploy(sdk.Deploy{})
+		require.NoError(t, err)
+		assert.Equal(t, "d1", hash)
+		assert.Equal(t, 1, fallback.callsOf("account_put_deploy"))
+	})
+
+	t.Run("Lagging node is not preferred", func(t *testing.T) {
+		lagging := newFakeNode(10)
+		casperClient := runFakeNodes(t, multinode.Config{MaxLag: 5}, lagging, newFakeNode(100))
+
+		height, err := casperClient.GetCurrentBlockNumber()
+		require.NoError(t, err)
+		assert.EqualValues(t, 100, height)
+
+		number, err := casperClient.GetBlockNumberByHash(blockHash(42))
+		require.NoError(t, err)
+		assert.Equal(t, 42, number)
+		assert.Equal(t, 1, lagging.callsOf("chain_get_block"))
+	})
+
+	t.Run("Quorum events", func(t *testing.T) {
+		malicious := maliciousNode()
+		casperClient := runFakeNodes(t, multinode.Config{Quorum: 2}, malicious, honestNode(), honestNode())
+
+		events, err := casperClient.GetEventsByBlockNumbers(88, 92, bridgeEventsHash)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "aa", events[0].DeployProcessed.DeployHash)
+		assert.Equal(t, blockHash(90), events[0].DeployProcessed.BlockHash)
+
+		// node, which returned different events, is not trusted anymore.
+		calls := malicious.callsOf("account_put_deploy")
+		_, err = casperClient.PutDeploy(sdk.Deploy{})
+		require.NoError(t, err)
+		assert.Equal(t, calls, malicious.callsOf("account_put_deploy"))
+	})
+
+	t.Run("Negative no quorum", func(t *testing.T) {
+		down := honestNode()
+		down.down = true
+		casperClient := runFakeNodes(t, multinode.Config{Quorum: 2}, maliciousNode(), honestNode(), down)
+
+		_, err := casperClient.GetEventsByBlockNumbers(88, 92, bridgeEventsHash)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, multinode.ErrNoQuorum))
+	})
+
+	t.Run("Quorum block height", func(t *testing.T) {
+		casperClient := runFakeNodes(t, multinode.Config{Quorum: 2}, newFakeNode(100), newFakeNode(50), newFakeNode(98))
+
+		height, err := casperClient.GetCurrentBlockNumber()
+		require.NoError(t, err)
+		assert.EqualValues(t, 98, height)
+	})
+}
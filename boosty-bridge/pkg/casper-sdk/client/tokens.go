@@ -0,0 +1,146 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"tricorn/chains"
+)
+
+const (
+	// hashKeyPrefix is a prefix of formatted hash key.
+	hashKeyPrefix = "hash-"
+	// contractHashPrefix is a prefix of contract hash in the contract package versions.
+	contractHashPrefix = "contract-"
+	// hashKeyTag is a tag of serialized Key::Hash, balances of contracts in cep-18 tokens are keyed by it.
+	hashKeyTag byte = 1
+	// balancesDictionary is a name of cep-18 dictionary which keeps balances of token holders.
+	balancesDictionary = "balances"
+	// totalSupplyKey is a name of cep-18 named key which keeps total supply of token.
+	totalSupplyKey = "total_supply"
+)
+
+// GetTokenSupply returns balance of the contract holder and total supply of the cep-18 token with specified package hash.
+func (r *rpcClient) GetTokenSupply(ctx context.Context, tokenPackageHash []byte, holder []byte) (chains.TokenSupply, error) {
+	stateRootHash, err := r.getStateRootHash(ctx)
+	if err != nil {
+		return chains.TokenSupply{}, err
+	}
+
+	tokenContractHash, err := r.getLatestContractHash(ctx, stateRootHash, tokenPackageHash)
+	if err != nil {
+		return chains.TokenSupply{}, err
+	}
+
+	totalSupply, err := r.getCLValue(ctx, "state_get_item", map[string]interface{}{
+		"state_root_hash": stateRootHash,
+		"key":             tokenContractHash,
+		"path":            []string{totalSupplyKey},
+	})
+	if err != nil {
+		return chains.TokenSupply{}, err
+	}
+
+	holderKey := append([]byte{hashKeyTag}, holder...)
+	balance, err := r.getCLValue(ctx, "state_get_dictionary_item", map[string]interface{}{
+		"state_root_hash": stateRootHash,
+		"dictionary_identifier": map[string]interface{}{
+			"ContractNamedKey": map[string]string{
+				"key":                 tokenContractHash,
+				"dictionary_name":     balancesDictionary,
+				"dictionary_item_key": base64.StdEncoding.EncodeToString(holderKey),
+			},
+		},
+	})
+	if err != nil {
+		return chains.TokenSupply{}, err
+	}
+
+	return chains.TokenSupply{
+		BridgeBalance: balance,
+		TotalSupply:   totalSupply,
+	}, nil
+}
+
+// getStateRootHash returns state root hash of the latest block.
+func (r *rpcClient) getStateRootHash(ctx context.Context) (string, error) {
+	resp, err := r.rpcCall(ctx, "chain_get_state_root_hash", map[string]interface{}{})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		StateRootHash string `json:"state_root_hash"`
+	}
+	if err = json.Unmarshal(resp.Result, &result); err != nil {
+		return "", fmt.Errorf("failed to get result: %w", err)
+	}
+
+	return result.StateRootHash, nil
+}
+
+// getLatestContractHash returns formatted hash of the latest version of contract in the package.
+func (r *rpcClient) getLatestContractHash(ctx context.Context, stateRootHash string, packageHash []byte) (string, error) {
+	resp, err := r.rpcCall(ctx, "state_get_item", map[string]interface{}{
+		"state_root_hash": stateRootHash,
+		"key":             hashKeyPrefix + hex.EncodeToString(packageHash),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		StoredValue struct {
+			ContractPackage struct {
+				Versions []struct {
+					ContractHash string `json:"contract_hash"`
+				} `json:"versions"`
+			} `json:"ContractPackage"`
+		} `json:"stored_value"`
+	}
+	if err = json.Unmarshal(resp.Result, &result); err != nil {
+		return "", fmt.Errorf("failed to get result: %w", err)
+	}
+
+	versions := result.StoredValue.ContractPackage.Versions
+	if len(versions) == 0 {
+		return "", fmt.Errorf("contract package %x has no versions", packageHash)
+	}
+
+	return hashKeyPrefix + strings.TrimPrefix(versions[len(versions)-1].ContractHash, contractHashPrefix), nil
+}
+
+// getCLValue returns numeric value stored in the global state.
+func (r *rpcClient) getCLValue(ctx context.Context, method string, params interface{}) (*big.Int, error) {
+	resp, err := r.rpcCall(ctx, method, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		StoredValue struct {
+			CLValue struct {
+				Parsed json.RawMessage `json:"parsed"`
+			} `json:"CLValue"`
+		} `json:"stored_value"`
+	}
+	if err = json.Unmarshal(resp.Result, &result); err != nil {
+		return nil, fmt.Errorf("failed to get result: %w", err)
+	}
+
+	parsed := strings.Trim(string(result.StoredValue.CLValue.Parsed), `"`)
+	value, ok := new(big.Int).SetString(parsed, 10)
+	if !ok {
+		return nil, fmt.Errorf("stored value %s is not a number", parsed)
+	}
+
+	return value, nil
+}
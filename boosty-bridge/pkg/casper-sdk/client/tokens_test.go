@@ -0,0 +1,93 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package client_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/pkg/casper-sdk/client"
+)
+
+func TestGetTokenSupply(t *testing.T) {
+	tokenPackageHash, err := hex.DecodeString("9060c0820b5156b1620c8e3344d17f9fad5108f5dc2672f2308439e84363c88e")
+	require.NoError(t, err)
+	bridgeHash, err := hex.DecodeString("3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23")
+	require.NoError(t, err)
+
+	const tokenContractHash = "b8a9d6f4c5e3b1a2d4c6e8f0a1b3c5d7e9f1a3b5c7d9e1f3a5b7c9d1e3f5a7b9"
+	balanceKey := base64.StdEncoding.EncodeToString(append([]byte{1}, bridgeHash...))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params struct {
+				StateRootHash        string   `json:"state_root_hash"`
+				Key                  string   `json:"key"`
+				Path                 []string `json:"path"`
+				DictionaryIdentifier struct {
+					ContractNamedKey struct {
+						Key               string `json:"key"`
+						DictionaryName    string `json:"dictionary_name"`
+						DictionaryItemKey string `json:"dictionary_item_key"`
+					} `json:"ContractNamedKey"`
+				} `json:"dictionary_identifier"`
+			} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var result interface{}
+		switch {
+		case req.Method == "chain_get_state_root_hash":
+			result = map[string]interface{}{"state_root_hash": "root"}
+		case req.Method == "state_get_item" && req.Params.Key == "hash-"+hex.EncodeToString(tokenPackageHash):
+			result = map[string]interface{}{"stored_value": map[string]interface{}{"ContractPackage": map[string]interface{}{
+				"versions": []interface{}{
+					map[string]interface{}{"contract_hash": "contract-00"},
+					map[string]interface{}{"contract_hash": "contract-" + tokenContractHash},
+				},
+			}}}
+		case req.Method == "state_get_item" && req.Params.Key == "hash-"+tokenContractHash && len(req.Params.Path) == 1 &&
+			req.Params.Path[0] == "total_supply" && req.Params.StateRootHash == "root":
+			result = map[string]interface{}{"stored_value": map[string]interface{}{"CLValue": map[string]interface{}{"parsed": "1000000"}}}
+		case req.Method == "state_get_dictionary_item" && req.Params.DictionaryIdentifier.ContractNamedKey.Key == "hash-"+tokenContractHash &&
+			req.Params.DictionaryIdentifier.ContractNamedKey.DictionaryName == "balances" &&
+			req.Params.DictionaryIdentifier.ContractNamedKey.DictionaryItemKey == balanceKey:
+			result = map[string]interface{}{"stored_value": map[string]interface{}{"CLValue": map[string]interface{}{"parsed": "2500"}}}
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID,
+				"error": map[string]interface{}{"code": -32003, "message": "value not found"}})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	defer server.Close()
+
+	casperClient := client.New(server.URL, client.FetcherConfig{})
+
+	t.Run("supply", func(t *testing.T) {
+		supply, err := casperClient.GetTokenSupply(context.Background(), tokenPackageHash, bridgeHash)
+		require.NoError(t, err)
+		assert.Equal(t, "2500", supply.BridgeBalance.String())
+		assert.Equal(t, "1000000", supply.TotalSupply.String())
+	})
+
+	t.Run("unknown holder", func(t *testing.T) {
+		_, err := casperClient.GetTokenSupply(context.Background(), tokenPackageHash, make([]byte, 32))
+		require.Error(t, err)
+	})
+}
@@ -4,8 +4,12 @@
 package mock
 
 import (
+	"context"
+	"math/big"
+
 	"github.com/casper-ecosystem/casper-golang-sdk/sdk"
 
+	"tricorn/chains"
 	"tricorn/chains/casper"
 )
 
@@ -36,3 +40,13 @@ func (c *MockRpcClient) GetEventsByBlockNumbers(fromBlockNumber uint64, toBlockN
 func (c *MockRpcClient) GetCurrentBlockNumber() (uint64, error) {
 	return 0, nil
 }
+
+// GetTokenSupply returns balance of the contract holder and total supply of the cep-18 token with specified package hash.
+func (c *MockRpcClient) GetTokenSupply(ctx context.Context, tokenPackageHash []byte, holder []byte) (chains.TokenSupply, error) {
+	return chains.TokenSupply{BridgeBalance: big.NewInt(0), TotalSupply: big.NewInt(0)}, nil
+}
+
+// GetDeployExecution returns execution result of the deploy with specified hash.
+func (c *MockRpcClient) GetDeployExecution(ctx context.Context, hash string) (casper.DeployExecution, error) {
+	return casper.DeployExecution{Executed: true}, nil
+}
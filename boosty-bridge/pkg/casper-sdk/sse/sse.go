@@ -0,0 +1,285 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package sse
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default sse client error class.
+var Error = errs.Class("sse client")
+
+const (
+	// defaultReconnectDelay defines delay before the first reconnection attempt.
+	defaultReconnectDelay = time.Second
+	// defaultMaxReconnectDelay defines upper bound of the delay between reconnection attempts.
+	defaultMaxReconnectDelay = 30 * time.Second
+	// defaultHeartbeatTimeout defines how long stream may be silent before it is considered stalled.
+	defaultHeartbeatTimeout = time.Minute
+	// startFromParam defines query parameter which asks casper node to replay events starting from the specified id.
+	startFromParam = "start_from"
+)
+
+// Config defines configurable values for sse client.
+type Config struct {
+	// Address is an url of the casper node event stream.
+	Address string
+	// ReconnectDelay is a delay before the first reconnection attempt, it doubles after each failed attempt.
+	ReconnectDelay time.Duration
+	// MaxReconnectDelay is an upper bound of the delay between reconnection attempts.
+	MaxReconnectDelay time.Duration
+	// HeartbeatTimeout is a maximum period without any data or keep-alive comment, after which stream is reconnected.
+	HeartbeatTimeout time.Duration
+}
+
+// Event describes single server sent event.
+type Event struct {
+	// ID is an id of the event, valid only if HasID is set.
+	ID    uint64
+	HasID bool
+	Data  []byte
+}
+
+// Handler processes events received from the stream.
+type Handler interface {
+	// Event is called for every event with data.
+	Event(ctx context.Context, event Event) error
+	// Gap is called when some events were missed and the stream could not be resumed from the last event id,
+	// so missed events have to be read in some other way.
+	Gap(ctx context.Context) error
+}
+
+// Client reads casper node event stream, reconnects when connection is lost and resumes from the last received event id.
+type Client struct {
+	config Config
+	http   *http.Client
+
+	lastID    uint64
+	hasLastID bool
+}
+
+// New is a constructor for sse Client.
+func New(config Config) *Client {
+	if config.ReconnectDelay == 0 {
+		config.ReconnectDelay = defaultReconnectDelay
+	}
+	if config.MaxReconnectDelay < config.ReconnectDelay {
+		config.MaxReconnectDelay = defaultMaxReconnectDelay
+		if config.MaxReconnectDelay < config.ReconnectDelay {
+			config.MaxReconnectDelay = config.ReconnectDelay
+		}
+	}
+	if config.HeartbeatTimeout == 0 {
+		config.HeartbeatTimeout = defaultHeartbeatTimeout
+	}
+
+	return &Client{
+		config: config,
+		http: &http.Client{
+			Transport: &http.Transport{
+				DisableCompression: true,
+			},
+		},
+	}
+}
+
+// Stream reads events and passes them to handler until context is cancelled or handler returns an error.
+func (client *Client) Stream(ctx context.Context, handler Handler) error {
+	delay := client.config.ReconnectDelay
+	resuming := false
+
+	for {
+		received, err := client.stream(ctx, handler, resuming)
+		if err != nil {
+			var handlerErr *handlerError
+			if errors.As(err, &handlerErr) {
+				return Error.Wrap(handlerErr.err)
+			}
+		}
+
+		if received {
+			delay = client.config.ReconnectDelay
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > client.config.MaxReconnectDelay {
+			delay = client.config.MaxReconnectDelay
+		}
+		resuming = true
+	}
+}
+
+// handlerError wraps errors returned by handler, which stop the stream instead of reconnection.
+type handlerError struct {
+	err error
+}
+
+// Error returns error message.
+func (err *handlerError) Error() string {
+	return err.err.Error()
+}
+
+// stream reads single connection until it is closed, stalled or context is cancelled.
+// Returns whether at least one event was received.
+func (client *Client) stream(ctx context.Context, handler Handler, resuming bool) (received bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	address, err := client.address()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := client.http.Do(req)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, resp.Body.Close())
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, Error.New("unexpected status code %d", resp.StatusCode)
+	}
+
+	// events between disconnection and the first event of new connection may be missed if there is nothing to resume from.
+	if resuming && !client.hasLastID {
+		if err = handler.Gap(ctx); err != nil {
+			return false, &handlerError{err: err}
+		}
+	}
+
+	lines := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		reader := bufio.NewReader(resp.Body)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if err != nil {
+				readErr <- err
+				return
+			}
+
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTimer(client.config.HeartbeatTimeout)
+	defer heartbeat.Stop()
+
+	var event Event
+	for {
+		select {
+		case <-ctx.Done():
+			return received, nil
+		case err := <-readErr:
+			return received, Error.Wrap(err)
+		case <-heartbeat.C:
+			return received, Error.New("no heartbeat for %s", client.config.HeartbeatTimeout)
+		case line := <-lines:
+			if !heartbeat.Stop() {
+				<-heartbeat.C
+			}
+			heartbeat.Reset(client.config.HeartbeatTimeout)
+
+			line = bytes.TrimRight(line, "\r\n")
+			if len(line) != 0 {
+				parseLine(&event, line)
+				continue
+			}
+
+			// empty line dispatches accumulated event.
+			if event.HasID {
+				// node replays events from start_from only while they are kept in its buffer, otherwise ids jump.
+				gap := client.hasLastID && event.ID != client.lastID+1
+				client.lastID, client.hasLastID = event.ID, true
+
+				if gap {
+					if err := handler.Gap(ctx); err != nil {
+						return received, &handlerError{err: err}
+					}
+				}
+			}
+
+			if len(event.Data) != 0 {
+				received = true
+				if err := handler.Event(ctx, event); err != nil {
+					return received, &handlerError{err: err}
+				}
+			}
+
+			event = Event{}
+		}
+	}
+}
+
+// address returns stream address, which asks node to replay events after the last received one.
+func (client *Client) address() (string, error) {
+	if !client.hasLastID {
+		return client.config.Address, nil
+	}
+
+	address, err := url.Parse(client.config.Address)
+	if err != nil {
+		return "", err
+	}
+
+	query := address.Query()
+	query.Set(startFromParam, strconv.FormatUint(client.lastID+1, 10))
+	address.RawQuery = query.Encode()
+
+	return address.String(), nil
+}
+
+// parseLine applies single stream line to the event, comments are used by node as keep-alive and are ignored.
+func parseLine(event *Event, line []byte) {
+	if line[0] == ':' {
+		return
+	}
+
+	field, value := line, []byte{}
+	if index := bytes.IndexByte(line, ':'); index >= 0 {
+		field, value = line[:index], line[index+1:]
+		value = bytes.TrimPrefix(value, []byte(" "))
+	}
+
+	switch string(field) {
+	case "id":
+		id, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return
+		}
+		event.ID, event.HasID = id, true
+	case "data":
+		if len(event.Data) != 0 {
+			event.Data = append(event.Data, '\n')
+		}
+		event.Data = append(event.Data, value...)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package sse_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/pkg/casper-sdk/sse"
+)
+
+// connection describes behaviour of fake node for one stream connection.
+type connection func(w http.ResponseWriter, r *http.Request, send func(frame string))
+
+// fakeNode is a fake casper node event stream, which serves connections in order.
+type fakeNode struct {
+	mutex       sync.Mutex
+	connections []connection
+	startFrom   []string
+}
+
+func (node *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	node.mutex.Lock()
+	index := len(node.startFrom)
+	node.startFrom = append(node.startFrom, r.URL.Query().Get("start_from"))
+	node.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	send := func(frame string) {
+		_, _ = fmt.Fprint(w, frame)
+		w.(http.Flusher).Flush()
+	}
+
+	if index >= len(node.connections) {
+		<-r.Context().Done()
+		return
+	}
+
+	node.connections[index](w, r, send)
+}
+
+func (node *fakeNode) requests() []string {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	return append([]string{}, node.startFrom...)
+}
+
+// handler collects events and gaps received from the stream.
+type handler struct {
+	events chan sse.Event
+	gaps   chan struct{}
+}
+
+func newHandler() *handler {
+	return &handler{
+		events: make(chan sse.Event, 100),
+		gaps:   make(chan struct{}, 100),
+	}
+}
+
+func (h *handler) Event(ctx context.Context, event sse.Event) error {
+	h.events <- event
+	return nil
+}
+
+func (h *handler) Gap(ctx context.Context) error {
+	h.gaps <- struct{}{}
+	return nil
+}
+
+func (h *handler) receive(t *testing.T) sse.Event {
+	select {
+	case event := <-h.events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not received")
+		return sse.Event{}
+	}
+}
+
+func event(id int, data string) string {
+	return fmt.Sprintf("data:%s\nid:%d\n\n", data, id)
+}
+
+func runStream(t *testing.T, node *fakeNode, config sse.Config, test func(h *handler)) {
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config.Address = server.URL + "/events/main"
+	if config.ReconnectDelay == 0 {
+		config.ReconnectDelay = 10 * time.Millisecond
+	}
+	client := sse.New(config)
+
+	h := newHandler()
+	done := make(chan error)
+	go func() {
+		done <- client.Stream(ctx, h)
+	}()
+
+	test(h)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestStream(t *testing.T) {
+	t.Run("Frames", func(t *testing.T) {
+		node := &fakeNode{connections: []connection{
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				send("data:{\"ApiVersion\":\"1.4.3\"}\n\n")
+				send(":\n\n")
+				send("data: {\"first\":\r\ndata: 1}\r\nid: 7\r\n\r\n")
+				<-r.Context().Done()
+			},
+		}}
+
+		runStream(t, node, sse.Config{}, func(h *handler) {
+			apiVersion := h.receive(t)
+			assert.False(t, apiVersion.HasID)
+			assert.Equal(t, `{"ApiVersion":"1.4.3"}`, string(apiVersion.Data))
+
+			multiline := h.receive(t)
+			assert.True(t, multiline.HasID)
+			assert.EqualValues(t, 7, multiline.ID)
+			assert.Equal(t, "{\"first\":\n1}", string(multiline.Data))
+		})
+	})
+
+	t.Run("Resume from last event id", func(t *testing.T) {
+		node := &fakeNode{connections: []connection{
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				send(event(0, "a") + event(1, "b") + event(2, "c"))
+			},
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				send(event(3, "d") + event(4, "e"))
+				<-r.Context().Done()
+			},
+		}}
+
+		runStream(t, node, sse.Config{}, func(h *handler) {
+			for id := 0; id < 5; id++ {
+				received := h.receive(t)
+				assert.EqualValues(t, id, received.ID)
+			}
+			assert.Empty(t, h.gaps)
+		})
+
+		requests := node.requests()
+		require.GreaterOrEqual(t, len(requests), 2)
+		assert.Equal(t, "", requests[0])
+		assert.Equal(t, "3", requests[1])
+	})
+
+	t.Run("Gap after resume", func(t *testing.T) {
+		node := &fakeNode{connections: []connection{
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				send(event(0, "a") + event(1, "b"))
+			},
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				// node does not keep requested events anymore and streams from the oldest it has.
+				send(event(7, "h") + event(8, "i"))
+				<-r.Context().Done()
+			},
+		}}
+
+		runStream(t, node, sse.Config{}, func(h *handler) {
+			for _, id := range []uint64{0, 1, 7, 8} {
+				assert.Equal(t, id, h.receive(t).ID)
+			}
+			assert.Len(t, h.gaps, 1)
+		})
+	})
+
+	t.Run("Gap without event id to resume from", func(t *testing.T) {
+		node := &fakeNode{connections: []connection{
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {},
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				send(event(0, "a"))
+				<-r.Context().Done()
+			},
+		}}
+
+		runStream(t, node, sse.Config{}, func(h *handler) {
+			assert.EqualValues(t, 0, h.receive(t).ID)
+			assert.Len(t, h.gaps, 1)
+		})
+	})
+
+	t.Run("Reconnect on missed heartbeat", func(t *testing.T) {
+		heartbeatTimeout := 200 * time.Millisecond
+		pinging := 3 * heartbeatTimeout
+		node := &fakeNode{connections: []connection{
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				send(event(0, "a"))
+				for start := time.Now(); time.Since(start) < pinging; {
+					send(":\n")
+					time.Sleep(heartbeatTimeout / 4)
+				}
+				// node stalls without closing connection.
+				<-r.Context().Done()
+			},
+			func(w http.ResponseWriter, r *http.Request, send func(string)) {
+				send(event(1, "b"))
+				<-r.Context().Done()
+			},
+		}}
+
+		runStream(t, node, sse.Config{HeartbeatTimeout: heartbeatTimeout}, func(h *handler) {
+			start := time.Now()
+			assert.EqualValues(t, 0, h.receive(t).ID)
+			assert.EqualValues(t, 1, h.receive(t).ID)
+			assert.GreaterOrEqual(t, time.Since(start), pinging)
+			assert.Empty(t, h.gaps)
+		})
+
+		requests := node.requests()
+		require.GreaterOrEqual(t, len(requests), 2)
+		assert.Equal(t, "1", requests[1])
+	})
+}
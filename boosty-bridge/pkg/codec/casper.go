@@ -0,0 +1,172 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package codec
+
+import (
+	"encoding/hex"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// CasperHashLength defines length in bytes of account hash, contract hash and deploy/block hash in casper networks.
+	CasperHashLength = 32
+	// CasperED25519PublicKeyLength defines length in bytes of tagged ED25519 public key in casper networks.
+	CasperED25519PublicKeyLength = 33
+	// CasperSECP256K1PublicKeyLength defines length in bytes of tagged SECP256K1 public key in casper networks.
+	CasperSECP256K1PublicKeyLength = 34
+	// CasperSignatureLength defines length in bytes of signature in casper networks.
+	CasperSignatureLength = 64
+)
+
+const (
+	// casperAccountHashPrefix defines prefix of formatted account hash.
+	casperAccountHashPrefix = "account-hash-"
+	// casperContractHashPrefix defines prefix of formatted contract hash.
+	casperContractHashPrefix = "hash-"
+)
+
+const (
+	// casperTagED25519 defines tag of ED25519 public key.
+	casperTagED25519 byte = 1
+	// casperTagSECP256K1 defines tag of SECP256K1 public key.
+	casperTagSECP256K1 byte = 2
+)
+
+// Casper is a codec of Casper networks. Accounts are addressed either by tagged public key (01 or 02 prefixed hex)
+// or by account hash (account-hash- prefixed hex), contracts by contract hash (hash- prefixed hex), deploys and blocks
+// by plain hex hash. Signatures are 0x prefixed hex as they are passed to bridge contracts as byte arrays.
+var Casper Codec = casperCodec{}
+
+// casperCodec implements Codec for Casper networks.
+type casperCodec struct{}
+
+// ParseAddress parses tagged public key or account-hash- prefixed account hash.
+func (casperCodec) ParseAddress(address string) ([]byte, error) {
+	if accountHash, ok := trimPrefix(address, casperAccountHashPrefix); ok {
+		return decodePlainHex("casper account hash", accountHash, CasperHashLength)
+	}
+
+	return parseCasperPublicKey("casper address", address)
+}
+
+// FormatAddress formats tagged public key or account hash.
+func (casperCodec) FormatAddress(address []byte) (string, error) {
+	if len(address) == CasperHashLength {
+		return casperAccountHashPrefix + hex.EncodeToString(address), nil
+	}
+
+	return formatCasperPublicKey("casper address", address)
+}
+
+// ParseContract parses contract hash, which is prefixed with hash- or is plain hex.
+func (casperCodec) ParseContract(contract string) ([]byte, error) {
+	contract, _ = trimPrefix(contract, casperContractHashPrefix)
+	return decodePlainHex("casper contract hash", contract, CasperHashLength)
+}
+
+// FormatContract formats contract hash with hash- prefix.
+func (casperCodec) FormatContract(contract []byte) (string, error) {
+	if err := checkLength("casper contract hash", contract, CasperHashLength); err != nil {
+		return "", err
+	}
+
+	return casperContractHashPrefix + hex.EncodeToString(contract), nil
+}
+
+// ParseHash parses deploy or block hash.
+func (casperCodec) ParseHash(hash string) ([]byte, error) {
+	return decodePlainHex("casper hash", hash, CasperHashLength)
+}
+
+// FormatHash formats deploy or block hash.
+func (casperCodec) FormatHash(hash []byte) (string, error) {
+	if err := checkLength("casper hash", hash, CasperHashLength); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash), nil
+}
+
+// ParseSignature parses signature, which is optionally prefixed with 0x. Secp256k1 signature could have recovery id.
+func (casperCodec) ParseSignature(signature string) ([]byte, error) {
+	return decodeHex("casper signature", signature, CasperSignatureLength, CasperSignatureLength+1)
+}
+
+// FormatSignature formats signature as 0x prefixed hex.
+func (casperCodec) FormatSignature(signature []byte) (string, error) {
+	if err := checkLength("casper signature", signature, CasperSignatureLength, CasperSignatureLength+1); err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(signature), nil
+}
+
+// ParsePublicKey parses tagged public key.
+func (casperCodec) ParsePublicKey(publicKey string) ([]byte, error) {
+	return parseCasperPublicKey("casper public key", publicKey)
+}
+
+// FormatPublicKey formats tagged public key.
+func (casperCodec) FormatPublicKey(publicKey []byte) (string, error) {
+	return formatCasperPublicKey("casper public key", publicKey)
+}
+
+// CasperAccountHash returns account hash of the casper address, which is either tagged public key or account hash.
+func CasperAccountHash(address []byte) ([]byte, error) {
+	if len(address) == CasperHashLength {
+		return address, nil
+	}
+
+	if err := checkCasperPublicKey("casper address", address); err != nil {
+		return nil, err
+	}
+
+	algorithm := "ed25519"
+	if address[0] == casperTagSECP256K1 {
+		algorithm = "secp256k1"
+	}
+
+	// account hash is blake2b hash of lowercase algorithm name, zero separator and public key without tag.
+	buffer := append([]byte(algorithm), 0)
+	buffer = append(buffer, address[1:]...)
+	accountHash := blake2b.Sum256(buffer)
+
+	return accountHash[:], nil
+}
+
+// parseCasperPublicKey parses public key prefixed with the tag of its algorithm.
+func parseCasperPublicKey(kind string, s string) ([]byte, error) {
+	publicKey, err := decodePlainHex(kind, s, CasperED25519PublicKeyLength, CasperSECP256K1PublicKeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkCasperPublicKey(kind, publicKey); err != nil {
+		return nil, err
+	}
+
+	return publicKey, nil
+}
+
+// formatCasperPublicKey formats public key prefixed with the tag of its algorithm.
+func formatCasperPublicKey(kind string, publicKey []byte) (string, error) {
+	if err := checkCasperPublicKey(kind, publicKey); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(publicKey), nil
+}
+
+// checkCasperPublicKey checks that tag of the public key matches its length.
+func checkCasperPublicKey(kind string, publicKey []byte) error {
+	switch {
+	case len(publicKey) == CasperED25519PublicKeyLength && publicKey[0] == casperTagED25519:
+		return nil
+	case len(publicKey) == CasperSECP256K1PublicKeyLength && publicKey[0] == casperTagSECP256K1:
+		return nil
+	default:
+		return Error.New("invalid %s: unexpected length %d or tag", kind, len(publicKey))
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package codec
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"tricorn/pkg/hexutils"
+)
+
+// Error is the default codec error class.
+var Error = errs.Class("codec")
+
+// Codec parses and formats addresses, hashes, signatures and public keys of networks of the same type.
+// Parse functions accept only unambiguous representations of the value, format functions return the canonical one,
+// so formatted value is always parsed back to the same bytes.
+type Codec interface {
+	// ParseAddress parses address of the account, which sends or receives tokens.
+	ParseAddress(address string) ([]byte, error)
+	// FormatAddress formats address of the account.
+	FormatAddress(address []byte) (string, error)
+	// ParseContract parses address of the smart contract.
+	ParseContract(contract string) ([]byte, error)
+	// FormatContract formats address of the smart contract.
+	FormatContract(contract []byte) (string, error)
+	// ParseHash parses transaction or block hash.
+	ParseHash(hash string) ([]byte, error)
+	// FormatHash formats transaction or block hash.
+	FormatHash(hash []byte) (string, error)
+	// ParseSignature parses signature.
+	ParseSignature(signature string) ([]byte, error)
+	// FormatSignature formats signature.
+	FormatSignature(signature []byte) (string, error)
+	// ParsePublicKey parses public key of the account.
+	ParsePublicKey(publicKey string) ([]byte, error)
+	// FormatPublicKey formats public key of the account.
+	FormatPublicKey(publicKey []byte) (string, error)
+}
+
+// Name defines list of possible codecs.
+type Name string
+
+const (
+	// NameEVM describes codec of EVM compatible networks.
+	NameEVM Name = "EVM"
+	// NameCasper describes codec of Casper networks.
+	NameCasper Name = "CASPER"
+	// NameSolana describes codec of Solana networks.
+	NameSolana Name = "SOLANA"
+)
+
+// codecs describes codec by its name.
+var codecs = map[Name]Codec{
+	NameEVM:    EVM,
+	NameCasper: Casper,
+	NameSolana: Solana,
+}
+
+// ByName returns codec by its name.
+func ByName(name Name) (Codec, bool) {
+	codec, ok := codecs[name]
+	return codec, ok
+}
+
+// Validate validates supported codec name.
+func (name Name) Validate() error {
+	if _, ok := codecs[name]; ok {
+		return nil
+	}
+
+	return Error.New("unsupported codec %q", name)
+}
+
+// decodeHex decodes hex string, which is optionally prefixed with 0x, into value of one of given lengths in bytes.
+func decodeHex(kind string, s string, lengths ...int) ([]byte, error) {
+	if hexutils.Has0xPrefix(s) {
+		s = s[2:]
+	}
+
+	return decodePlainHex(kind, s, lengths...)
+}
+
+// decodePlainHex decodes hex string without any prefix into value of one of given lengths in bytes.
+func decodePlainHex(kind string, s string, lengths ...int) ([]byte, error) {
+	if !hexutils.IsHex(s) || hexutils.Has0xPrefix(s) {
+		return nil, Error.New("invalid %s %q: not a hex string", kind, s)
+	}
+
+	value, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, Error.New("invalid %s %q: %v", kind, s, err)
+	}
+
+	if err = checkLength(kind, value, lengths...); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// checkLength checks that value has one of given lengths in bytes.
+func checkLength(kind string, value []byte, lengths ...int) error {
+	for _, length := range lengths {
+		if len(value) == length {
+			return nil
+		}
+	}
+
+	return Error.New("invalid %s: unexpected length %d", kind, len(value))
+}
+
+// trimPrefix removes prefix from the string and reports whether it was present.
+func trimPrefix(s, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+
+	return s[len(prefix):], true
+}
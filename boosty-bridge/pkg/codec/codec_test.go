@@ -0,0 +1,254 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package codec_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tricorn/pkg/codec"
+)
+
+func TestAddress(t *testing.T) {
+	tests := []struct {
+		codec     codec.Codec
+		address   string
+		formatted string
+		valid     bool
+	}{
+		{
+			codec:     codec.EVM,
+			address:   "0x3095F955Da700b96215CFfC9Bc64AB2e69eB7DAB",
+			formatted: "0x3095F955Da700b96215CFfC9Bc64AB2e69eB7DAB",
+			valid:     true,
+		},
+		{
+			codec:     codec.EVM,
+			address:   "e5bfc49e60a62ab039189d14b148abeb80403460",
+			formatted: "0xe5bfc49E60a62AB039189D14b148ABEb80403460",
+			valid:     true,
+		},
+		{
+			codec:     codec.EVM,
+			address:   "0x00a5bfc49e60a62ab039189d14b148abeb804034",
+			formatted: "0x00A5bFc49E60a62AB039189D14B148aBeb804034",
+			valid:     true,
+		},
+		{
+			codec:   codec.EVM,
+			address: "0x3095f955Da700b96215CFfC9Bc64AB2e69eB7DAB",
+			valid:   false,
+		},
+		{
+			codec:   codec.EVM,
+			address: "0x3095F955Da700b96215CFfC9Bc64AB2e69eB7DAB_invalid",
+			valid:   false,
+		},
+		{
+			codec:   codec.EVM,
+			address: "0x003095F955Da700b96215CFfC9Bc64AB2e69eB7DAB",
+			valid:   false,
+		},
+		{
+			codec:     codec.Casper,
+			address:   "01783c4d47a3030add05472a685b20c2f8ec2fe64b309b601347be0968167c0d67",
+			formatted: "01783c4d47a3030add05472a685b20c2f8ec2fe64b309b601347be0968167c0d67",
+			valid:     true,
+		},
+		{
+			codec:     codec.Casper,
+			address:   "account-hash-3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23",
+			formatted: "account-hash-3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23",
+			valid:     true,
+		},
+		{
+			codec:   codec.Casper,
+			address: "3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23",
+			valid:   false,
+		},
+		{
+			codec:   codec.Casper,
+			address: "02783c4d47a3030add05472a685b20c2f8ec2fe64b309b601347be0968167c0d67",
+			valid:   false,
+		},
+		{
+			codec:   codec.Casper,
+			address: "01783c4d47a3030add05472a685b20c2f8ec2fe64b309b601347be0968167c0d67_invalid",
+			valid:   false,
+		},
+		{
+			codec:     codec.Solana,
+			address:   "JARehRjGUkkEShpjzfuV4ERJS25j8XhamL776FAktNGm",
+			formatted: "JARehRjGUkkEShpjzfuV4ERJS25j8XhamL776FAktNGm",
+			valid:     true,
+		},
+		{
+			codec:   codec.Solana,
+			address: "JARehRjGUkkEShpjzfuV4ERJS25j8XhamL776FAktNG_invalid",
+			valid:   false,
+		},
+	}
+
+	for _, test := range tests {
+		address, err := test.codec.ParseAddress(test.address)
+		if !test.valid {
+			assert.Error(t, err, test.address)
+			assert.True(t, codec.Error.Has(err))
+			continue
+		}
+		require.NoError(t, err, test.address)
+
+		formatted, err := test.codec.FormatAddress(address)
+		require.NoError(t, err)
+		assert.Equal(t, test.formatted, formatted)
+
+		parsed, err := test.codec.ParseAddress(formatted)
+		require.NoError(t, err)
+		assert.Equal(t, address, parsed)
+	}
+}
+
+func TestContract(t *testing.T) {
+	address, err := codec.Casper.ParseContract("3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23")
+	require.NoError(t, err)
+
+	contract, err := codec.Casper.FormatContract(address)
+	require.NoError(t, err)
+	assert.Equal(t, "hash-3c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23", contract)
+
+	parsed, err := codec.Casper.ParseContract(contract)
+	require.NoError(t, err)
+	assert.Equal(t, address, parsed)
+
+	_, err = codec.Casper.ParseContract("hash-013c0c1847d1c410338ab9b4ee0919c181cf26085997ff9c797e8a1ae5b02ddf23")
+	require.Error(t, err)
+
+	_, err = codec.EVM.FormatContract(address)
+	require.Error(t, err)
+}
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		codec     codec.Codec
+		hash      string
+		formatted string
+		valid     bool
+	}{
+		{
+			codec:     codec.EVM,
+			hash:      "0x1468a0193e6123ee5977417b008296f98e0c78bb24d362384f6fd4e1c3094885",
+			formatted: "0x1468a0193e6123ee5977417b008296f98e0c78bb24d362384f6fd4e1c3094885",
+			valid:     true,
+		},
+		{
+			codec:     codec.EVM,
+			hash:      "bf4d685afb739d609924b9c316c841a6a4d996e86b363b5cfb4386c9554144a6",
+			formatted: "0xbf4d685afb739d609924b9c316c841a6a4d996e86b363b5cfb4386c9554144a6",
+			valid:     true,
+		},
+		{
+			codec: codec.EVM,
+			hash:  "0x1468a0193e6123ee5977417b008296f98e0c78bb24d362384f6fd4e1c3094885_invalid",
+			valid: false,
+		},
+		{
+			codec:     codec.Casper,
+			hash:      "7b734c6851cd1e5839bfa52f0467db36351f73f58396734a43bd8b91d4e0e109",
+			formatted: "7b734c6851cd1e5839bfa52f0467db36351f73f58396734a43bd8b91d4e0e109",
+			valid:     true,
+		},
+		{
+			codec: codec.Casper,
+			hash:  "0x7b734c6851cd1e5839bfa52f0467db36351f73f58396734a43bd8b91d4e0e109",
+			valid: false,
+		},
+		{
+			codec: codec.Casper,
+			hash:  "7b734c6851cd1e5839bfa52f0467db36351f73f58396734a43bd8b91d4e0e109_invalid",
+			valid: false,
+		},
+		{
+			codec:     codec.Solana,
+			hash:      "4DFmkJaV6e71be9JfGfMZtd4eTjomKZE29ZNn8kV1UUkSFotYHTTq1H58fRkpjENN3xojCSXNdURHzgNVxHYZN2f",
+			formatted: "4DFmkJaV6e71be9JfGfMZtd4eTjomKZE29ZNn8kV1UUkSFotYHTTq1H58fRkpjENN3xojCSXNdURHzgNVxHYZN2f",
+			valid:     true,
+		},
+		{
+			codec: codec.Solana,
+			hash:  "4DFmkJaV6e71be9JfGfMZtd4eTjomKZE29ZNn8kV1UUkSFotYHTTq1H58fRkpjENN3xojCSXNdURHzgNVxHYZN2f_invalid",
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		hash, err := test.codec.ParseHash(test.hash)
+		if !test.valid {
+			assert.Error(t, err, test.hash)
+			continue
+		}
+		require.NoError(t, err, test.hash)
+
+		formatted, err := test.codec.FormatHash(hash)
+		require.NoError(t, err)
+		assert.Equal(t, test.formatted, formatted)
+	}
+}
+
+func TestSignature(t *testing.T) {
+	evmSignature := "0xd29bb47954dc2c0d67778507d9a96852bd0da75dce2337009fcce23a6dedb5625ad5541523ac3c2959c0d31b60b62b980a3c778fd903cedf9f17a99ba9d2152e1b"
+
+	for _, networkCodec := range []codec.Codec{codec.EVM, codec.Casper} {
+		signature, err := networkCodec.ParseSignature(evmSignature[2:])
+		require.NoError(t, err)
+		require.Len(t, signature, 65)
+
+		formatted, err := networkCodec.FormatSignature(signature)
+		require.NoError(t, err)
+		assert.Equal(t, evmSignature, formatted)
+
+		_, err = networkCodec.ParseSignature(evmSignature[:len(evmSignature)-4])
+		require.Error(t, err)
+	}
+}
+
+func TestCasperAccountHash(t *testing.T) {
+	tests := []struct {
+		address     string
+		accountHash string
+	}{
+		{
+			address:     "0203c1253298f0617081edb618917c4109466b6cb734bae4bbb9b716b4c957f26e57",
+			accountHash: "463a154e75e6a5ba06e372e21f4ec12a6d6d89685286b160da4ab98d5a557fc4",
+		},
+		{
+			address:     "01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c",
+			accountHash: "131b3843c4e5a2526229d158c253c5217adbfe6007a5b800be488e37a0ae9d58",
+		},
+		{
+			address:     "account-hash-131b3843c4e5a2526229d158c253c5217adbfe6007a5b800be488e37a0ae9d58",
+			accountHash: "131b3843c4e5a2526229d158c253c5217adbfe6007a5b800be488e37a0ae9d58",
+		},
+	}
+
+	for _, test := range tests {
+		address, err := codec.Casper.ParseAddress(test.address)
+		require.NoError(t, err)
+
+		accountHash, err := codec.CasperAccountHash(address)
+		require.NoError(t, err)
+		assert.Equal(t, test.accountHash, hex.EncodeToString(accountHash))
+	}
+}
+
+func TestByName(t *testing.T) {
+	networkCodec, ok := codec.ByName(codec.NameCasper)
+	require.True(t, ok)
+	assert.Equal(t, codec.Casper, networkCodec)
+
+	assert.NoError(t, codec.NameSolana.Validate())
+	assert.Error(t, codec.Name("HEX").Validate())
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package codec
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// EVMAddressLength defines length in bytes of account and contract address in evm networks.
+	EVMAddressLength = common.AddressLength
+	// EVMHashLength defines length in bytes of transaction and block hash in evm networks.
+	EVMHashLength = common.HashLength
+	// EVMSignatureLength defines length in bytes of signature with recovery id in evm networks.
+	EVMSignatureLength = 65
+)
+
+// EVM is a codec of EVM compatible networks. Values are hex encoded with optional 0x prefix, addresses
+// in mixed case have to match EIP-55 checksum. Formatted values are 0x prefixed, addresses are checksummed.
+var EVM Codec = evmCodec{}
+
+// evmCodec implements Codec for EVM compatible networks.
+type evmCodec struct{}
+
+// ParseAddress parses account address.
+func (evmCodec) ParseAddress(address string) ([]byte, error) {
+	return parseEVMAddress("evm address", address)
+}
+
+// FormatAddress formats account address with EIP-55 checksum.
+func (evmCodec) FormatAddress(address []byte) (string, error) {
+	return formatEVMAddress("evm address", address)
+}
+
+// ParseContract parses contract address.
+func (evmCodec) ParseContract(contract string) ([]byte, error) {
+	return parseEVMAddress("evm contract", contract)
+}
+
+// FormatContract formats contract address with EIP-55 checksum.
+func (evmCodec) FormatContract(contract []byte) (string, error) {
+	return formatEVMAddress("evm contract", contract)
+}
+
+// ParseHash parses transaction or block hash.
+func (evmCodec) ParseHash(hash string) ([]byte, error) {
+	return decodeHex("evm hash", hash, EVMHashLength)
+}
+
+// FormatHash formats transaction or block hash.
+func (evmCodec) FormatHash(hash []byte) (string, error) {
+	return formatEVMHex("evm hash", hash, EVMHashLength)
+}
+
+// ParseSignature parses signature, recovery id is optional.
+func (evmCodec) ParseSignature(signature string) ([]byte, error) {
+	return decodeHex("evm signature", signature, EVMSignatureLength-1, EVMSignatureLength)
+}
+
+// FormatSignature formats signature.
+func (evmCodec) FormatSignature(signature []byte) (string, error) {
+	return formatEVMHex("evm signature", signature, EVMSignatureLength-1, EVMSignatureLength)
+}
+
+// ParsePublicKey parses compressed or uncompressed secp256k1 public key.
+func (evmCodec) ParsePublicKey(publicKey string) ([]byte, error) {
+	return decodeHex("evm public key", publicKey, 33, 65)
+}
+
+// FormatPublicKey formats compressed or uncompressed secp256k1 public key.
+func (evmCodec) FormatPublicKey(publicKey []byte) (string, error) {
+	return formatEVMHex("evm public key", publicKey, 33, 65)
+}
+
+// parseEVMAddress parses address, checksum is validated if address is in mixed case.
+func parseEVMAddress(kind string, s string) ([]byte, error) {
+	address, err := decodeHex(kind, s, EVMAddressLength)
+	if err != nil {
+		return nil, err
+	}
+
+	if s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"); s != strings.ToLower(s) && s != strings.ToUpper(s) {
+		if checksummed := common.BytesToAddress(address).Hex(); checksummed[2:] != s {
+			return nil, Error.New("invalid %s %q: checksum mismatch, expected %s", kind, s, checksummed)
+		}
+	}
+
+	return address, nil
+}
+
+// formatEVMAddress formats address with EIP-55 checksum.
+func formatEVMAddress(kind string, address []byte) (string, error) {
+	if err := checkLength(kind, address, EVMAddressLength); err != nil {
+		return "", err
+	}
+
+	return common.BytesToAddress(address).Hex(), nil
+}
+
+// formatEVMHex formats value of one of given lengths as 0x prefixed hex.
+func formatEVMHex(kind string, value []byte, lengths ...int) (string, error) {
+	if err := checkLength(kind, value, lengths...); err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(value), nil
+}
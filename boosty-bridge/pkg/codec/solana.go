@@ -0,0 +1,96 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package codec
+
+import (
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// SolanaKeyLength defines length in bytes of account, program and public key in solana networks.
+	SolanaKeyLength = 32
+	// SolanaSignatureLength defines length in bytes of signature in solana networks, which is transaction hash as well.
+	SolanaSignatureLength = 64
+	// SolanaHashLength defines length in bytes of block hash in solana networks.
+	SolanaHashLength = 32
+)
+
+// Solana is a codec of Solana networks. All values are base58 encoded, transactions are identified by their signatures.
+var Solana Codec = solanaCodec{}
+
+// solanaCodec implements Codec for Solana networks.
+type solanaCodec struct{}
+
+// ParseAddress parses account key.
+func (solanaCodec) ParseAddress(address string) ([]byte, error) {
+	return decodeBase58("solana address", address, SolanaKeyLength)
+}
+
+// FormatAddress formats account key.
+func (solanaCodec) FormatAddress(address []byte) (string, error) {
+	return encodeBase58("solana address", address, SolanaKeyLength)
+}
+
+// ParseContract parses program key.
+func (solanaCodec) ParseContract(contract string) ([]byte, error) {
+	return decodeBase58("solana program", contract, SolanaKeyLength)
+}
+
+// FormatContract formats program key.
+func (solanaCodec) FormatContract(contract []byte) (string, error) {
+	return encodeBase58("solana program", contract, SolanaKeyLength)
+}
+
+// ParseHash parses transaction signature or block hash.
+func (solanaCodec) ParseHash(hash string) ([]byte, error) {
+	return decodeBase58("solana hash", hash, SolanaSignatureLength, SolanaHashLength)
+}
+
+// FormatHash formats transaction signature or block hash.
+func (solanaCodec) FormatHash(hash []byte) (string, error) {
+	return encodeBase58("solana hash", hash, SolanaSignatureLength, SolanaHashLength)
+}
+
+// ParseSignature parses signature.
+func (solanaCodec) ParseSignature(signature string) ([]byte, error) {
+	return decodeBase58("solana signature", signature, SolanaSignatureLength)
+}
+
+// FormatSignature formats signature.
+func (solanaCodec) FormatSignature(signature []byte) (string, error) {
+	return encodeBase58("solana signature", signature, SolanaSignatureLength)
+}
+
+// ParsePublicKey parses public key.
+func (solanaCodec) ParsePublicKey(publicKey string) ([]byte, error) {
+	return decodeBase58("solana public key", publicKey, SolanaKeyLength)
+}
+
+// FormatPublicKey formats public key.
+func (solanaCodec) FormatPublicKey(publicKey []byte) (string, error) {
+	return encodeBase58("solana public key", publicKey, SolanaKeyLength)
+}
+
+// decodeBase58 decodes base58 string into value of one of given lengths in bytes.
+func decodeBase58(kind string, s string, lengths ...int) ([]byte, error) {
+	value, err := base58.Decode(s)
+	if err != nil {
+		return nil, Error.New("invalid %s %q: %v", kind, s, err)
+	}
+
+	if err = checkLength(kind, value, lengths...); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// encodeBase58 encodes value of one of given lengths in bytes to base58 string.
+func encodeBase58(kind string, value []byte, lengths ...int) (string, error) {
+	if err := checkLength(kind, value, lengths...); err != nil {
+		return "", err
+	}
+
+	return base58.Encode(value), nil
+}
@@ -22,6 +22,7 @@ import (
 	"tricorn/communication/rpc"
 	"tricorn/internal/eventparsing"
 	"tricorn/internal/logger/zaplog"
+	signature_lib "tricorn/pkg/signature"
 )
 
 // ErrContract indicates that there was an error in the contract package.
@@ -143,9 +144,9 @@ func BridgeInWithoutSignature(ctx context.Context, req BridgeInRequestWithoutSig
 		return resp, ErrContract.Wrap(err)
 	}
 
-	publicKey := keypair.PublicKey{
-		Tag:        keypair.KeyTagEd25519,
-		PubKeyData: respPubKey,
+	publicKey, err := signature_lib.ParseCasperPublicKey(respPubKey)
+	if err != nil {
+		return resp, ErrContract.Wrap(err)
 	}
 
 	deployParams := sdk.NewDeployParams(publicKey, strings.ToLower(req.ChainName), nil, 0)
@@ -234,29 +235,19 @@ func BridgeInWithoutSignature(ctx context.Context, req BridgeInRequestWithoutSig
 
 	deploy := sdk.MakeDeploy(deployParams, payment, session)
 
-	data, err := json.Marshal(*deploy)
-	if err != nil {
-		return resp, ErrContract.Wrap(err)
-	}
-
 	reqSign := chains.SignRequest{
 		NetworkId: networks.TypeCasper,
-		Data:      data,
+		Data:      signature_lib.CasperDeployDigest(publicKey.Tag, deploy.Hash),
 	}
 
-	signature, err := bridge.Bridge().Sign(ctx, reqSign)
+	deploySignature, err := bridge.Bridge().Sign(ctx, reqSign)
 	if err != nil {
 		return resp, ErrContract.Wrap(err)
 	}
 
-	signatureKeypair := keypair.Signature{
-		Tag:           keypair.KeyTagEd25519,
-		SignatureData: signature,
-	}
-
 	approval := sdk.Approval{
 		Signer:    publicKey,
-		Signature: signatureKeypair,
+		Signature: signature_lib.CasperDeploySignature(publicKey.Tag, deploySignature),
 	}
 
 	deploy.Approvals = append(deploy.Approvals, approval)
@@ -0,0 +1,340 @@
+// Copyright (C) 2022 Creditor Corp. Group.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrNoQuorum indicates that not enough nodes returned the same result.
+var ErrNoQuorum = errors.New("nodes do not agree on the result")
+
+// Error is the default multinode error class.
+var Error = errs.Class("multinode")
+
+// defaultHealthCheckInterval defines how often nodes are checked if interval is not configured.
+const defaultHealthCheckInterval = 15 * time.Second
+
+// Config defines configurable values of nodes pool.
+type Config struct {
+	// Quorum is a number of nodes, which have to return the same result of quorum reads.
+	// Values lower than 2 mean that result of any healthy node is trusted.
+	Quorum int
+	// MaxLag is a number of blocks node may lag behind the highest node before it is considered unhealthy,
+	// zero disables lag check.
+	MaxLag uint64
+	// HealthCheckInterval defines how often node heights are checked.
+	HealthCheckInterval time.Duration
+}
+
+// HeightFunc returns the latest block number of the node with specified index.
+type HeightFunc func(ctx context.Context, node int) (uint64, error)
+
+// Pool keeps track of nodes health, calls healthy nodes with failover and compares results of several nodes.
+// Nodes are identified by their indexes, the first node is the primary one.
+type Pool struct {
+	config Config
+	height HeightFunc
+
+	mutex     sync.Mutex
+	healthy   []bool
+	checkedAt time.Time
+}
+
+// New is a constructor for nodes Pool. All nodes are considered healthy until they are checked.
+func New(config Config, nodes int, height HeightFunc) *Pool {
+	if config.HealthCheckInterval == 0 {
+		config.HealthCheckInterval = defaultHealthCheckInterval
+	}
+
+	healthy := make([]bool, nodes)
+	for node := range healthy {
+		healthy[node] = true
+	}
+
+	return &Pool{
+		config:  config,
+		height:  height,
+		healthy: healthy,
+	}
+}
+
+// Healthy returns indexes of nodes, which are considered healthy.
+func (pool *Pool) Healthy() []int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	nodes := make([]int, 0, len(pool.healthy))
+	for node, healthy := range pool.healthy {
+		if healthy {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// Check requests heights of all nodes. Nodes, which fail or lag behind the highest one, are marked as unhealthy.
+func (pool *Pool) Check(ctx context.Context) {
+	_, _ = pool.heights(ctx)
+}
+
+// Do calls fn for nodes in order of preference until it succeeds, healthy nodes are called first.
+// Nodes which failed are marked as unhealthy until the next health check.
+func (pool *Pool) Do(ctx context.Context, fn func(node int) error) error {
+	pool.checkIfDue(ctx)
+
+	var group errs.Group
+	for _, node := range pool.order() {
+		err := fn(node)
+		if err == nil {
+			return nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return permanent.err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		pool.setHealthy(node, false)
+		group.Add(err)
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// Quorum calls fn for all nodes concurrently and returns result, which is returned by configured quorum of nodes.
+// Results are compared by their json representation. Nodes, which failed or returned different result,
+// are marked as unhealthy. If quorum is not configured, result of the first successful node is returned.
+func (pool *Pool) Quorum(ctx context.Context, fn func(node int) (interface{}, error)) (interface{}, error) {
+	if pool.config.Quorum < 2 {
+		var result interface{}
+		err := pool.Do(ctx, func(node int) (err error) {
+			result, err = fn(node)
+			return err
+		})
+
+		return result, err
+	}
+
+	pool.checkIfDue(ctx)
+
+	type response struct {
+		result  interface{}
+		encoded []byte
+		err     error
+	}
+
+	responses := make([]response, pool.nodes())
+	var wg sync.WaitGroup
+	for node := range responses {
+		wg.Add(1)
+		go func(node int) {
+			defer wg.Done()
+
+			result, err := fn(node)
+			if err == nil {
+				responses[node].encoded, err = json.Marshal(result)
+			}
+			responses[node].result, responses[node].err = result, err
+		}(node)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// votes contains number of nodes, which returned the same result as node with the index.
+	votes := make([]int, len(responses))
+	best := -1
+	var group errs.Group
+	for node, resp := range responses {
+		if resp.err != nil {
+			group.Add(resp.err)
+			continue
+		}
+
+		for other := range responses {
+			if responses[other].err == nil && bytes.Equal(resp.encoded, responses[other].encoded) {
+				votes[node]++
+			}
+		}
+
+		if best < 0 || votes[node] > votes[best] {
+			best = node
+		}
+	}
+
+	if best < 0 || votes[best] < pool.config.Quorum {
+		votesNumber := 0
+		if best >= 0 {
+			votesNumber = votes[best]
+		}
+
+		return nil, Error.Wrap(errs.Combine(
+			fmt.Errorf("%w: %d of %d nodes agree, %d required", ErrNoQuorum, votesNumber, len(responses), pool.config.Quorum),
+			group.Err(),
+		))
+	}
+
+	for node, resp := range responses {
+		pool.setHealthy(node, resp.err == nil && bytes.Equal(resp.encoded, responses[best].encoded))
+	}
+
+	return responses[best].result, nil
+}
+
+// Height returns the latest block number reached by quorum of nodes, so quorum reads of blocks up to it succeed
+// unless nodes disagree. If quorum is not configured, height of the first healthy node is returned.
+func (pool *Pool) Height(ctx context.Context) (uint64, error) {
+	if pool.config.Quorum < 2 {
+		var height uint64
+		err := pool.Do(ctx, func(node int) (err error) {
+			height, err = pool.height(ctx, node)
+			return err
+		})
+
+		return height, err
+	}
+
+	heights, err := pool.heights(ctx)
+	if len(heights) < pool.config.Quorum {
+		return 0, Error.Wrap(errs.Combine(
+			fmt.Errorf("%w: %d of %d nodes respond, %d required", ErrNoQuorum, len(heights), pool.nodes(), pool.config.Quorum),
+			err,
+		))
+	}
+
+	return heights[pool.config.Quorum-1], nil
+}
+
+// heights requests heights of all nodes, updates their health and returns heights of responded nodes
+// in descending order.
+func (pool *Pool) heights(ctx context.Context) ([]uint64, error) {
+	heights := make([]uint64, pool.nodes())
+	failures := make([]error, len(heights))
+
+	var wg sync.WaitGroup
+	for node := range heights {
+		wg.Add(1)
+		go func(node int) {
+			defer wg.Done()
+			heights[node], failures[node] = pool.height(ctx, node)
+		}(node)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for node, height := range heights {
+		if failures[node] == nil && height > highest {
+			highest = height
+		}
+	}
+
+	var group errs.Group
+	responded := make([]uint64, 0, len(heights))
+
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	for node, height := range heights {
+		if failures[node] != nil {
+			group.Add(failures[node])
+			pool.healthy[node] = false
+			continue
+		}
+
+		responded = append(responded, height)
+		pool.healthy[node] = pool.config.MaxLag == 0 || highest-height <= pool.config.MaxLag
+	}
+	pool.checkedAt = time.Now()
+
+	sort.Slice(responded, func(i, j int) bool { return responded[i] > responded[j] })
+
+	return responded, group.Err()
+}
+
+// checkIfDue checks nodes health if health check interval has passed since the last check.
+func (pool *Pool) checkIfDue(ctx context.Context) {
+	pool.mutex.Lock()
+	due := time.Since(pool.checkedAt) >= pool.config.HealthCheckInterval
+	pool.mutex.Unlock()
+
+	if due {
+		pool.Check(ctx)
+	}
+}
+
+// order returns indexes of all nodes, healthy nodes go first, so unhealthy ones are called only as the last resort.
+func (pool *Pool) order() []int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	nodes := make([]int, 0, len(pool.healthy))
+	for node, healthy := range pool.healthy {
+		if healthy {
+			nodes = append(nodes, node)
+		}
+	}
+	for node, healthy := range pool.healthy {
+		if !healthy {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// setHealthy updates health of the node.
+func (pool *Pool) setHealthy(node int, healthy bool) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	pool.healthy[node] = healthy
+}
+
+// nodes returns number of nodes in the pool.
+func (pool *Pool) nodes() int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	return len(pool.healthy)
+}
+
+// permanentError wraps errors, which are caused by the request itself, so other nodes would return them as well.
+type permanentError struct {
+	err error
+}
+
+// Error returns error message.
+func (err *permanentError) Error() string {
+	return err.err.Error()
+}
+
+// Unwrap returns wrapped error.
+func (err *permanentError) Unwrap() error {
+	return err.err
+}
+
+// Permanent marks error as caused by the request itself, so Do returns it without calling other nodes.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
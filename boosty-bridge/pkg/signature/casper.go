@@ -5,8 +5,10 @@ package signature
 
 import (
 	"crypto/ed25519"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"fmt"
 
 	"github.com/casper-ecosystem/casper-golang-sdk/keypair"
 	casper_ed25519 "github.com/casper-ecosystem/casper-golang-sdk/keypair/ed25519"
@@ -21,6 +23,13 @@ var (
 	ErrInvalidPublicKeyAlgorithm = errors.New("public key created using unsupported algorithm")
 )
 
+const (
+	// ed25519PublicKeySize defines size of ed25519 public key without tag.
+	ed25519PublicKeySize = 32
+	// secp256k1PublicKeySize defines size of compressed secp256k1 public key without tag.
+	secp256k1PublicKeySize = 33
+)
+
 // casperMessage defines prefix in messages in Casper network.
 const casperMessage = "Casper Message:\n"
 
@@ -102,6 +111,52 @@ func verifySECP256K1(publicKeyBytes []byte, msg string, signature []byte) bool {
 	return publicKey.VerifySignature(msgData, signature)
 }
 
+// ParseCasperPublicKey parses casper public key, which is prefixed with the tag of the key algorithm.
+func ParseCasperPublicKey(taggedPublicKey []byte) (keypair.PublicKey, error) {
+	algorithm, err := getPublicKeyAlgorithm(taggedPublicKey)
+	if err != nil {
+		return keypair.PublicKey{}, err
+	}
+
+	size := ed25519PublicKeySize
+	if algorithm == algorithmSECP256K1 {
+		size = secp256k1PublicKeySize
+	}
+
+	if len(taggedPublicKey)-1 != size {
+		return keypair.PublicKey{}, fmt.Errorf("invalid public key length %d", len(taggedPublicKey)-1)
+	}
+
+	return keypair.PublicKey{
+		Tag:        keypair.KeyTag(taggedPublicKey[0]),
+		PubKeyData: taggedPublicKey[1:],
+	}, nil
+}
+
+// CasperDeployDigest returns data which is signed by the key of specified algorithm to approve deploy,
+// casper verifies secp256k1 signatures of sha256 digest of the deploy hash.
+func CasperDeployDigest(tag keypair.KeyTag, deployHash []byte) []byte {
+	if tag != keypair.KeyTagSecp256k1 {
+		return deployHash
+	}
+
+	hash := sha256.Sum256(deployHash)
+	return hash[:]
+}
+
+// CasperDeploySignature builds approval signature of the key of specified algorithm,
+// recovery id is removed from secp256k1 signature.
+func CasperDeploySignature(tag keypair.KeyTag, signature []byte) keypair.Signature {
+	if tag == keypair.KeyTagSecp256k1 {
+		signature = WithoutV(signature)
+	}
+
+	return keypair.Signature{
+		Tag:           tag,
+		SignatureData: signature,
+	}
+}
+
 // WithoutV removes fragment V from the signature.
 func WithoutV(signature []byte) []byte {
 	if len(signature) == 64 {
@@ -7,10 +7,12 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/casper-ecosystem/casper-golang-sdk/keypair"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
-	"tricorn/bridge/networks"
 	"tricorn/pkg/signature"
 )
 
@@ -66,7 +68,7 @@ func TestAccountHashByPublicKey(t *testing.T) {
 		accountHash, err := signature.PublicKeyToAccountHash(publicKey)
 		require.NoError(t, err)
 
-		actual, err := networks.StringToBytes(networks.IDCasperTest, accountHash)
+		actual, err := hex.DecodeString(accountHash)
 		require.NoError(t, err)
 
 		expected, err := hex.DecodeString("463a154e75e6a5ba06e372e21f4ec12a6d6d89685286b160da4ab98d5a557fc4")
@@ -83,7 +85,7 @@ func TestAccountHashByPublicKey(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, accountHash)
 
-		actual, err := networks.StringToBytes(networks.IDCasperTest, accountHash)
+		actual, err := hex.DecodeString(accountHash)
 		require.NoError(t, err)
 
 		expected, err := hex.DecodeString("131b3843c4e5a2526229d158c253c5217adbfe6007a5b800be488e37a0ae9d58")
@@ -92,3 +94,74 @@ func TestAccountHashByPublicKey(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 }
+
+func TestParseCasperPublicKey(t *testing.T) {
+	t.Run("secp256k1 public key", func(t *testing.T) {
+		taggedPublicKey, err := hex.DecodeString("0203c1253298f0617081edb618917c4109466b6cb734bae4bbb9b716b4c957f26e57")
+		require.NoError(t, err)
+
+		publicKey, err := signature.ParseCasperPublicKey(taggedPublicKey)
+		require.NoError(t, err)
+		assert.Equal(t, keypair.KeyTagSecp256k1, publicKey.Tag)
+		assert.Equal(t, taggedPublicKey[1:], publicKey.PubKeyData)
+	})
+
+	t.Run("ed25519 public key", func(t *testing.T) {
+		taggedPublicKey, err := hex.DecodeString("01eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
+		require.NoError(t, err)
+
+		publicKey, err := signature.ParseCasperPublicKey(taggedPublicKey)
+		require.NoError(t, err)
+		assert.Equal(t, keypair.KeyTagEd25519, publicKey.Tag)
+		assert.Equal(t, taggedPublicKey[1:], publicKey.PubKeyData)
+	})
+
+	t.Run("length does not match tag", func(t *testing.T) {
+		taggedPublicKey, err := hex.DecodeString("02eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
+		require.NoError(t, err)
+
+		_, err = signature.ParseCasperPublicKey(taggedPublicKey)
+		require.Error(t, err)
+	})
+
+	t.Run("untagged public key", func(t *testing.T) {
+		taggedPublicKey, err := hex.DecodeString("eb6db16548f388fe35b542bccb2ba58284c99cb53d3fc8e8c596c7be1ba2146c")
+		require.NoError(t, err)
+
+		_, err = signature.ParseCasperPublicKey(taggedPublicKey)
+		require.Equal(t, signature.ErrInvalidPublicKeyAlgorithm, err)
+	})
+}
+
+func TestCasperDeploySignature(t *testing.T) {
+	deployHash, err := hex.DecodeString("131b3843c4e5a2526229d158c253c5217adbfe6007a5b800be488e37a0ae9d58")
+	require.NoError(t, err)
+
+	t.Run("secp256k1", func(t *testing.T) {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		taggedPublicKey := append([]byte{byte(keypair.KeyTagSecp256k1)}, crypto.CompressPubkey(&privateKey.PublicKey)...)
+		publicKey, err := signature.ParseCasperPublicKey(taggedPublicKey)
+		require.NoError(t, err)
+
+		digest := signature.CasperDeployDigest(publicKey.Tag, deployHash)
+		assert.NotEqual(t, deployHash, digest)
+
+		recoverable, err := crypto.Sign(digest, privateKey)
+		require.NoError(t, err)
+
+		deploySignature := signature.CasperDeploySignature(publicKey.Tag, recoverable)
+		assert.Equal(t, keypair.KeyTagSecp256k1, deploySignature.Tag)
+		assert.Len(t, deploySignature.SignatureData, 64)
+		assert.True(t, secp256k1.PubKey(publicKey.PubKeyData).VerifySignature(deployHash, deploySignature.SignatureData))
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		assert.Equal(t, deployHash, signature.CasperDeployDigest(keypair.KeyTagEd25519, deployHash))
+
+		deploySignature := signature.CasperDeploySignature(keypair.KeyTagEd25519, make([]byte, 64))
+		assert.Equal(t, keypair.KeyTagEd25519, deploySignature.Tag)
+		assert.Len(t, deploySignature.SignatureData, 64)
+	})
+}
@@ -46,8 +46,18 @@ func (db *database) CreateSchema(ctx context.Context) error {
             network_type VARCHAR NOT NULL,
             private_key  VARCHAR NOT NULL,
 			type         VARCHAR NOT NULL,
-			PRIMARY KEY(network_type, type)
-        );`
+			key_index    INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY(network_type, type, key_index)
+        );
+        ALTER TABLE private_keys ADD COLUMN IF NOT EXISTS key_index INTEGER NOT NULL DEFAULT 0;
+        DO $$
+        BEGIN
+            IF (SELECT COUNT(*) FROM information_schema.key_column_usage
+                WHERE table_name = 'private_keys' AND constraint_name = 'private_keys_pkey') = 2 THEN
+                ALTER TABLE private_keys DROP CONSTRAINT private_keys_pkey;
+                ALTER TABLE private_keys ADD PRIMARY KEY (network_type, type, key_index);
+            END IF;
+        END $$;`
 
 	_, err := db.conn.ExecContext(ctx, createTableQuery)
 	return Error.Wrap(err)
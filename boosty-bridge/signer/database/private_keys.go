@@ -26,15 +26,15 @@ type privateKeysDB struct {
 
 // Create inserts private key to database.
 func (privateKeysDB *privateKeysDB) Create(ctx context.Context, privateKey signer.PrivateKey) error {
-	query := "INSERT INTO private_keys(network_type,private_key,type) VALUES($1,$2,$3)"
-	_, err := privateKeysDB.conn.ExecContext(ctx, query, privateKey.NetworkType, privateKey.Key, privateKey.Type)
+	query := "INSERT INTO private_keys(network_type,private_key,type,key_index) VALUES($1,$2,$3,$4)"
+	_, err := privateKeysDB.conn.ExecContext(ctx, query, privateKey.NetworkType, privateKey.Key, privateKey.Type, privateKey.Index)
 	return ErrPrivateKeys.Wrap(err)
 }
 
-// Get returns private key by network type from database.
+// Get returns default private key by network type from database.
 func (privateKeysDB *privateKeysDB) Get(ctx context.Context, networkType networks.Type, keyType signer.Type) (string, error) {
 	var privateKey string
-	query := "SELECT private_key FROM private_keys WHERE network_type = $1 AND type = $2"
+	query := "SELECT private_key FROM private_keys WHERE network_type = $1 AND type = $2 ORDER BY key_index LIMIT 1"
 	row := privateKeysDB.conn.QueryRowContext(ctx, query, networkType, keyType)
 
 	if err := row.Scan(&privateKey); err != nil {
@@ -48,10 +48,41 @@ func (privateKeysDB *privateKeysDB) Get(ctx context.Context, networkType network
 	return privateKey, nil
 }
 
+// List returns all private keys of the type by network type from database, ordered by key index.
+func (privateKeysDB *privateKeysDB) List(ctx context.Context, networkType networks.Type, keyType signer.Type) (_ []string, err error) {
+	query := "SELECT private_key FROM private_keys WHERE network_type = $1 AND type = $2 ORDER BY key_index"
+	rows, err := privateKeysDB.conn.QueryContext(ctx, query, networkType, keyType)
+	if err != nil {
+		return nil, ErrPrivateKeys.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	var privateKeys []string
+	for rows.Next() {
+		var privateKey string
+		if err = rows.Scan(&privateKey); err != nil {
+			return nil, ErrPrivateKeys.Wrap(err)
+		}
+
+		privateKeys = append(privateKeys, privateKey)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, ErrPrivateKeys.Wrap(err)
+	}
+
+	if len(privateKeys) == 0 {
+		return nil, signer.ErrNoPrivateKey
+	}
+
+	return privateKeys, nil
+}
+
 // Update updates private key in database.
 func (privateKeysDB *privateKeysDB) Update(ctx context.Context, privateKey signer.PrivateKey) error {
-	query := "UPDATE private_keys SET private_key = $1 WHERE network_type = $2 AND type = $3"
-	result, err := privateKeysDB.conn.ExecContext(ctx, query, privateKey.Key, privateKey.NetworkType, privateKey.Type)
+	query := "UPDATE private_keys SET private_key = $1 WHERE network_type = $2 AND type = $3 AND key_index = $4"
+	result, err := privateKeysDB.conn.ExecContext(ctx, query, privateKey.Key, privateKey.NetworkType, privateKey.Type, privateKey.Index)
 	if err != nil {
 		return ErrPrivateKeys.Wrap(err)
 	}
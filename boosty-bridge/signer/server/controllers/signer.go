@@ -57,7 +57,11 @@ func (s *Signer) Sign(ctx context.Context, req *signerpb.SignRequest) (*signerpb
 		return &resp, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
 	}
 
-	resp.Signature, err = s.signer.Sign(ctx, networkType, req.Data, signer.Type(req.GetDataType().String()))
+	if len(req.GetPublicKey()) != 0 {
+		resp.Signature, err = s.signer.SignWithKey(ctx, networkType, req.GetPublicKey(), req.Data)
+	} else {
+		resp.Signature, err = s.signer.Sign(ctx, networkType, req.Data, signer.Type(req.GetDataType().String()))
+	}
 	if err != nil {
 		if errors.Is(err, signer.ErrNoPrivateKey) {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -93,3 +97,30 @@ func (s *Signer) PublicKey(ctx context.Context, req *signerpb.PublicKeyRequest)
 
 	return &resp, nil
 }
+
+// PublicKeys returns public keys of all transaction keys for specific network.
+func (s *Signer) PublicKeys(ctx context.Context, req *signerpb.PublicKeyRequest) (*signerpb.PublicKeysResponse, error) {
+	networkType := networks.NetworkIDToNetworkType[networks.TypeID(req.NetworkId)]
+	if err := networkType.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	}
+
+	publicKeys, err := s.signer.PublicKeys(ctx, networkType)
+	if err != nil {
+		if errors.Is(err, signer.ErrNoPrivateKey) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		s.log.Error(fmt.Sprintf("couldn't get public keys for %s network", networkType), err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := signerpb.PublicKeysResponse{
+		PublicKeys: make([][]byte, 0, len(publicKeys)),
+	}
+	for _, publicKey := range publicKeys {
+		resp.PublicKeys = append(resp.PublicKeys, publicKey)
+	}
+
+	return &resp, nil
+}
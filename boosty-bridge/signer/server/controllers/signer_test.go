@@ -33,7 +33,7 @@ func TestSigner(t *testing.T) {
 	signatureSolana := "e951b4182b1572e8ff73dfde2216573930da2a9883349d6421cc21838aa8880a139737503d7bd54923c4c4f150ad9a6297f6826552175efb698c1afc4c9b4e0a"
 
 	publicKeyEVM := "2807d9de22f235ccc562969628cda551d437afb799d3f8f3baaccbe8ea9379f6344d890efb763c07d503d89d05a1669d15c44e396110a18fde1fc435afb3ad82"
-	publicKeyCasper := "d90cdb7e06d2f2e6a5a1e1999f4e0447003a941c6a039b7749e24a85052863ea"
+	publicKeyCasper := "01d90cdb7e06d2f2e6a5a1e1999f4e0447003a941c6a039b7749e24a85052863ea"
 	publicKeySolana := "AxHgjH2Hh6tPUqKAoCG92aKPmdv8z4iQjPihKAtFeYdh"
 
 	privateKeyEVM := signer.PrivateKey{
@@ -4,12 +4,13 @@
 package signer
 
 import (
+	"bytes"
 	"context"
 	"crypto/ed25519"
 	"encoding/hex"
 	"errors"
-	"fmt"
 
+	"github.com/casper-ecosystem/casper-golang-sdk/keypair"
 	casper_ed25519 "github.com/casper-ecosystem/casper-golang-sdk/keypair/ed25519"
 	"github.com/ethereum/go-ethereum/crypto"
 	solana_types "github.com/portto/solana-go-sdk/types"
@@ -42,13 +43,39 @@ func NewService(config Config, keyStore KeyStore) *Service {
 
 // Sign creates and returns signature from data.
 func (s *Service) Sign(ctx context.Context, networkType networks.Type, data []byte, dataType Type) ([]byte, error) {
-	var signature []byte
-
 	privateKeyHex, err := s.keyStore.Get(ctx, networkType, dataType)
 	if err != nil {
-		return signature, ErrSigner.Wrap(err)
+		return nil, ErrSigner.Wrap(err)
+	}
+
+	return sign(networkType, privateKeyHex, data)
+}
+
+// SignWithKey creates signature from data with the transaction key of the specified public key.
+func (s *Service) SignWithKey(ctx context.Context, networkType networks.Type, publicKey networks.PublicKey, data []byte) ([]byte, error) {
+	privateKeys, err := s.keyStore.List(ctx, networkType, TypeDTTransaction)
+	if err != nil {
+		return nil, ErrSigner.Wrap(err)
+	}
+
+	for _, privateKey := range privateKeys {
+		keyPublicKey, err := derivePublicKey(networkType, privateKey)
+		if err != nil {
+			return nil, ErrSigner.Wrap(err)
+		}
+
+		if bytes.Equal(keyPublicKey, publicKey) {
+			return sign(networkType, privateKey, data)
+		}
 	}
 
+	return nil, ErrSigner.Wrap(ErrNoPrivateKey)
+}
+
+// sign creates signature from data with private key of specific network.
+func sign(networkType networks.Type, privateKeyHex string, data []byte) ([]byte, error) {
+	var signature []byte
+
 	switch networkType {
 	case networks.TypeEVM:
 		privateKeyECDSA, err := crypto.HexToECDSA(privateKeyHex)
@@ -66,8 +93,13 @@ func (s *Service) Sign(ctx context.Context, networkType networks.Type, data []by
 			return signature, ErrSigner.Wrap(err)
 		}
 
-		switch {
-		case len(privateKeyBytes) == ed25519.PrivateKeySize:
+		keyTag, err := CasperKeyTag(privateKeyBytes)
+		if err != nil {
+			return signature, err
+		}
+
+		switch keyTag {
+		case keypair.KeyTagEd25519:
 			publicKey := make([]byte, PublicKeySize)
 			copy(publicKey, privateKeyBytes[PublicKeySize:])
 
@@ -76,7 +108,7 @@ func (s *Service) Sign(ctx context.Context, networkType networks.Type, data []by
 			casperSignature := pair.Sign(data)
 
 			signature = casperSignature.SignatureData
-		case len(privateKeyBytes) == Secp256k1PrivateKeyLength:
+		case keypair.KeyTagSecp256k1:
 			privateKeyECDSA, err := crypto.HexToECDSA(privateKeyHex)
 			if err != nil {
 				return signature, ErrSigner.Wrap(err)
@@ -86,8 +118,6 @@ func (s *Service) Sign(ctx context.Context, networkType networks.Type, data []by
 			if err != nil {
 				return signature, ErrSigner.Wrap(err)
 			}
-		default:
-			return signature, fmt.Errorf("invalid private key length: %d", len(privateKeyBytes))
 		}
 	case networks.TypeSolana:
 		account, err := solana_types.AccountFromHex(privateKeyHex)
@@ -104,14 +134,40 @@ func (s *Service) Sign(ctx context.Context, networkType networks.Type, data []by
 }
 
 // PublicKey returns public key for specific network.
+// Casper public key is returned with the tag of the key algorithm as the first byte.
 func (s *Service) PublicKey(ctx context.Context, networkType networks.Type) ([]byte, error) {
-	var publicKey []byte
-
 	privateKey, err := s.keyStore.Get(ctx, networkType, TypeDTTransaction)
 	if err != nil {
-		return publicKey, err
+		return nil, err
 	}
 
+	return derivePublicKey(networkType, privateKey)
+}
+
+// PublicKeys returns public keys of all transaction keys for specific network, default key goes first.
+func (s *Service) PublicKeys(ctx context.Context, networkType networks.Type) ([]networks.PublicKey, error) {
+	privateKeys, err := s.keyStore.List(ctx, networkType, TypeDTTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]networks.PublicKey, 0, len(privateKeys))
+	for _, privateKey := range privateKeys {
+		publicKey, err := derivePublicKey(networkType, privateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, nil
+}
+
+// derivePublicKey returns public key of the private key for specific network.
+func derivePublicKey(networkType networks.Type, privateKey string) ([]byte, error) {
+	var publicKey []byte
+
 	switch networkType {
 	case networks.TypeEVM:
 		privateKeyECDSA, err := crypto.HexToECDSA(privateKey)
@@ -122,12 +178,34 @@ func (s *Service) PublicKey(ctx context.Context, networkType networks.Type) ([]b
 		publicKey = append(publicKey, privateKeyECDSA.PublicKey.X.Bytes()...)
 		publicKey = append(publicKey, privateKeyECDSA.PublicKey.Y.Bytes()...)
 	case networks.TypeCasper:
-		privateKeyHex, err := hex.DecodeString(privateKey)
+		privateKeyBytes, err := hex.DecodeString(privateKey)
+		if err != nil {
+			return publicKey, err
+		}
+
+		keyTag, err := CasperKeyTag(privateKeyBytes)
 		if err != nil {
 			return publicKey, err
 		}
 
-		publicKey = ed25519.PrivateKey(privateKeyHex).Public().(ed25519.PublicKey)
+		// casper public keys are prefixed with the tag of the algorithm, so connector knows how to use them.
+		taggedPublicKey := keypair.PublicKey{Tag: keyTag}
+		switch keyTag {
+		case keypair.KeyTagEd25519:
+			taggedPublicKey.PubKeyData = ed25519.PrivateKey(privateKeyBytes).Public().(ed25519.PublicKey)
+		case keypair.KeyTagSecp256k1:
+			privateKeyECDSA, err := crypto.ToECDSA(privateKeyBytes)
+			if err != nil {
+				return publicKey, err
+			}
+
+			taggedPublicKey.PubKeyData = crypto.CompressPubkey(&privateKeyECDSA.PublicKey)
+		}
+
+		publicKey, err = taggedPublicKey.ToBytes()
+		if err != nil {
+			return publicKey, err
+		}
 	case networks.TypeSolana:
 		account, err := solana_types.AccountFromHex(privateKey)
 		if err != nil {
@@ -141,3 +219,15 @@ func (s *Service) PublicKey(ctx context.Context, networkType networks.Type) ([]b
 
 	return publicKey, nil
 }
+
+// CasperKeyTag returns tag of the algorithm casper private key belongs to, algorithm is defined by the key length.
+func CasperKeyTag(privateKey []byte) (keypair.KeyTag, error) {
+	switch len(privateKey) {
+	case ed25519.PrivateKeySize:
+		return keypair.KeyTagEd25519, nil
+	case Secp256k1PrivateKeyLength:
+		return keypair.KeyTagSecp256k1, nil
+	default:
+		return 0, ErrSigner.New("invalid private key length: %d", len(privateKey))
+	}
+}
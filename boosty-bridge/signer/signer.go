@@ -40,8 +40,10 @@ type DB interface {
 type KeyStore interface {
 	// Create inserts private key to database.
 	Create(ctx context.Context, privateKey PrivateKey) error
-	// Get returns private key by network type from database.
+	// Get returns default private key by network type from database.
 	Get(ctx context.Context, networkType networks.Type, keyType Type) (string, error)
+	// List returns all private keys of the type by network type from database, default key goes first.
+	List(ctx context.Context, networkType networks.Type, keyType Type) ([]string, error)
 	// Update updates private key in database.
 	Update(ctx context.Context, privateKey PrivateKey) error
 }
@@ -52,10 +54,12 @@ type Config struct {
 }
 
 // PrivateKey contains private key for specific network.
+// Several keys of the same type are ordered by index, key with the lowest index is the default one.
 type PrivateKey struct {
 	NetworkType networks.Type
 	Key         string
 	Type        Type
+	Index       int
 }
 
 // Type defines list of possible private key types.
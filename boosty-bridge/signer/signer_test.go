@@ -43,6 +43,30 @@ func TestPrivateKeysDB(t *testing.T) {
 			require.True(t, errors.Is(err, signer.ErrNoPrivateKey))
 		})
 
+		t.Run("Create additional key", func(t *testing.T) {
+			additional := privateKey
+			additional.Key = "additional_private_key"
+			additional.Index = 1
+			err := repository.Create(ctx, additional)
+			require.NoError(t, err)
+
+			value, err := repository.Get(ctx, privateKey.NetworkType, signer.TypeDTTransaction)
+			require.NoError(t, err)
+			assert.Equal(t, privateKey.Key, value)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			values, err := repository.List(ctx, privateKey.NetworkType, signer.TypeDTTransaction)
+			require.NoError(t, err)
+			assert.Equal(t, []string{privateKey.Key, "additional_private_key"}, values)
+		})
+
+		t.Run("Negative List", func(t *testing.T) {
+			_, err := repository.List(ctx, networks.TypeCasper, signer.TypeDTTransaction)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, signer.ErrNoPrivateKey))
+		})
+
 		t.Run("Update", func(t *testing.T) {
 			privateKey.Key = "new_private_key"
 			err := repository.Update(ctx, privateKey)
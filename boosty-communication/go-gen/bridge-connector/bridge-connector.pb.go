@@ -35,8 +35,8 @@ var file_bridge_connector_bridge_connector_proto_rawDesc = []byte{
 	0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
 	0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x90,
-	0x04, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x07,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc1,
+	0x06, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x07,
 	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
 	0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
@@ -69,14 +69,33 @@ var file_bridge_connector_bridge_connector_proto_rawDesc = []byte{
 	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x20, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
 	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x6a, 0x5a, 0x68, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f,
-	0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2d,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3b, 0x70, 0x62, 0x5f, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x48, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x12, 0x1b, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x19, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f,
+	0x72, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x2e,
+	0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x69, 0x63,
+	0x6f, 0x72, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x72,
+	0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x6a, 0x5a, 0x68, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x70,
+	0x65, 0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x6f,
+	0x6f, 0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3b, 0x70, 0x62, 0x5f, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_bridge_connector_bridge_connector_proto_goTypes = []interface{}{
@@ -86,13 +105,21 @@ var file_bridge_connector_bridge_connector_proto_goTypes = []interface{}{
 	(*transfers.EstimateTransferRequest)(nil),           // 3: tricorn.EstimateTransferRequest
 	(*transfers.BridgeInSignatureWithNonceRequest)(nil), // 4: tricorn.BridgeInSignatureWithNonceRequest
 	(*transfers.CancelSignatureRequest)(nil),            // 5: tricorn.CancelSignatureRequest
-	(*networks.Network)(nil),                            // 6: tricorn.Network
-	(*connector.ConnectorTokens)(nil),                   // 7: tricorn.ConnectorTokens
-	(*connector.Event)(nil),                             // 8: tricorn.Event
-	(*connector.TokenOutResponse)(nil),                  // 9: tricorn.TokenOutResponse
-	(*transfers.EstimateTransferResponse)(nil),          // 10: tricorn.EstimateTransferResponse
-	(*transfers.BridgeInSignatureResponse)(nil),         // 11: tricorn.BridgeInSignatureResponse
-	(*transfers.CancelSignatureResponse)(nil),           // 12: tricorn.CancelSignatureResponse
+	(*connector.TokenSupplyRequest)(nil),                // 6: tricorn.TokenSupplyRequest
+	(*connector.SetPausedRequest)(nil),                  // 7: tricorn.SetPausedRequest
+	(*connector.EventRangeRequest)(nil),                 // 8: tricorn.EventRangeRequest
+	(*connector.TransactionStatusRequest)(nil),          // 9: tricorn.TransactionStatusRequest
+	(*networks.Network)(nil),                            // 10: tricorn.Network
+	(*connector.ConnectorTokens)(nil),                   // 11: tricorn.ConnectorTokens
+	(*connector.Event)(nil),                             // 12: tricorn.Event
+	(*connector.TokenOutResponse)(nil),                  // 13: tricorn.TokenOutResponse
+	(*transfers.EstimateTransferResponse)(nil),          // 14: tricorn.EstimateTransferResponse
+	(*transfers.BridgeInSignatureResponse)(nil),         // 15: tricorn.BridgeInSignatureResponse
+	(*transfers.CancelSignatureResponse)(nil),           // 16: tricorn.CancelSignatureResponse
+	(*connector.TokenSupplyResponse)(nil),               // 17: tricorn.TokenSupplyResponse
+	(*connector.SetPausedResponse)(nil),                 // 18: tricorn.SetPausedResponse
+	(*connector.EventRangeResponse)(nil),                // 19: tricorn.EventRangeResponse
+	(*connector.TransactionStatusResponse)(nil),         // 20: tricorn.TransactionStatusResponse
 }
 var file_bridge_connector_bridge_connector_proto_depIdxs = []int32{
 	0,  // 0: tricorn.Connector.Network:input_type -> google.protobuf.Empty
@@ -102,15 +129,23 @@ var file_bridge_connector_bridge_connector_proto_depIdxs = []int32{
 	3,  // 4: tricorn.Connector.EstimateTransfer:input_type -> tricorn.EstimateTransferRequest
 	4,  // 5: tricorn.Connector.BridgeInSignature:input_type -> tricorn.BridgeInSignatureWithNonceRequest
 	5,  // 6: tricorn.Connector.CancelSignature:input_type -> tricorn.CancelSignatureRequest
-	6,  // 7: tricorn.Connector.Network:output_type -> tricorn.Network
-	7,  // 8: tricorn.Connector.KnownTokens:output_type -> tricorn.ConnectorTokens
-	8,  // 9: tricorn.Connector.EventStream:output_type -> tricorn.Event
-	9,  // 10: tricorn.Connector.BridgeOut:output_type -> tricorn.TokenOutResponse
-	10, // 11: tricorn.Connector.EstimateTransfer:output_type -> tricorn.EstimateTransferResponse
-	11, // 12: tricorn.Connector.BridgeInSignature:output_type -> tricorn.BridgeInSignatureResponse
-	12, // 13: tricorn.Connector.CancelSignature:output_type -> tricorn.CancelSignatureResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	6,  // 7: tricorn.Connector.TokenSupply:input_type -> tricorn.TokenSupplyRequest
+	7,  // 8: tricorn.Connector.SetPaused:input_type -> tricorn.SetPausedRequest
+	8,  // 9: tricorn.Connector.EventRange:input_type -> tricorn.EventRangeRequest
+	9,  // 10: tricorn.Connector.TransactionStatus:input_type -> tricorn.TransactionStatusRequest
+	10, // 11: tricorn.Connector.Network:output_type -> tricorn.Network
+	11, // 12: tricorn.Connector.KnownTokens:output_type -> tricorn.ConnectorTokens
+	12, // 13: tricorn.Connector.EventStream:output_type -> tricorn.Event
+	13, // 14: tricorn.Connector.BridgeOut:output_type -> tricorn.TokenOutResponse
+	14, // 15: tricorn.Connector.EstimateTransfer:output_type -> tricorn.EstimateTransferResponse
+	15, // 16: tricorn.Connector.BridgeInSignature:output_type -> tricorn.BridgeInSignatureResponse
+	16, // 17: tricorn.Connector.CancelSignature:output_type -> tricorn.CancelSignatureResponse
+	17, // 18: tricorn.Connector.TokenSupply:output_type -> tricorn.TokenSupplyResponse
+	18, // 19: tricorn.Connector.SetPaused:output_type -> tricorn.SetPausedResponse
+	19, // 20: tricorn.Connector.EventRange:output_type -> tricorn.EventRangeResponse
+	20, // 21: tricorn.Connector.TransactionStatus:output_type -> tricorn.TransactionStatusResponse
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
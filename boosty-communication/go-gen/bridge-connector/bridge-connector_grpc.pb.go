@@ -40,6 +40,14 @@ type ConnectorClient interface {
 	BridgeInSignature(ctx context.Context, in *transfers.BridgeInSignatureWithNonceRequest, opts ...grpc.CallOption) (*transfers.BridgeInSignatureResponse, error)
 	// Return signature for user to return funds.
 	CancelSignature(ctx context.Context, in *transfers.CancelSignatureRequest, opts ...grpc.CallOption) (*transfers.CancelSignatureResponse, error)
+	// Return balance of the bridge contract and total supply of the token.
+	TokenSupply(ctx context.Context, in *connector.TokenSupplyRequest, opts ...grpc.CallOption) (*connector.TokenSupplyResponse, error)
+	// Pause or unpause bridge contract on chain, returns hash of the sent transaction.
+	SetPaused(ctx context.Context, in *connector.SetPausedRequest, opts ...grpc.CallOption) (*connector.SetPausedResponse, error)
+	// Return historical bridge events of the bounded range of blocks.
+	EventRange(ctx context.Context, in *connector.EventRangeRequest, opts ...grpc.CallOption) (*connector.EventRangeResponse, error)
+	// Return status of the transaction sent by the bridge, so bridge out is not sent again while it may be paid.
+	TransactionStatus(ctx context.Context, in *connector.TransactionStatusRequest, opts ...grpc.CallOption) (*connector.TransactionStatusResponse, error)
 }
 
 type connectorClient struct {
@@ -136,6 +144,42 @@ func (c *connectorClient) CancelSignature(ctx context.Context, in *transfers.Can
 	return out, nil
 }
 
+func (c *connectorClient) TokenSupply(ctx context.Context, in *connector.TokenSupplyRequest, opts ...grpc.CallOption) (*connector.TokenSupplyResponse, error) {
+	out := new(connector.TokenSupplyResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.Connector/TokenSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectorClient) SetPaused(ctx context.Context, in *connector.SetPausedRequest, opts ...grpc.CallOption) (*connector.SetPausedResponse, error) {
+	out := new(connector.SetPausedResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.Connector/SetPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectorClient) EventRange(ctx context.Context, in *connector.EventRangeRequest, opts ...grpc.CallOption) (*connector.EventRangeResponse, error) {
+	out := new(connector.EventRangeResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.Connector/EventRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectorClient) TransactionStatus(ctx context.Context, in *connector.TransactionStatusRequest, opts ...grpc.CallOption) (*connector.TransactionStatusResponse, error) {
+	out := new(connector.TransactionStatusResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.Connector/TransactionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConnectorServer is the server API for Connector service.
 // All implementations should embed UnimplementedConnectorServer
 // for forward compatibility
@@ -154,6 +198,14 @@ type ConnectorServer interface {
 	BridgeInSignature(context.Context, *transfers.BridgeInSignatureWithNonceRequest) (*transfers.BridgeInSignatureResponse, error)
 	// Return signature for user to return funds.
 	CancelSignature(context.Context, *transfers.CancelSignatureRequest) (*transfers.CancelSignatureResponse, error)
+	// Return balance of the bridge contract and total supply of the token.
+	TokenSupply(context.Context, *connector.TokenSupplyRequest) (*connector.TokenSupplyResponse, error)
+	// Pause or unpause bridge contract on chain, returns hash of the sent transaction.
+	SetPaused(context.Context, *connector.SetPausedRequest) (*connector.SetPausedResponse, error)
+	// Return historical bridge events of the bounded range of blocks.
+	EventRange(context.Context, *connector.EventRangeRequest) (*connector.EventRangeResponse, error)
+	// Return status of the transaction sent by the bridge, so bridge out is not sent again while it may be paid.
+	TransactionStatus(context.Context, *connector.TransactionStatusRequest) (*connector.TransactionStatusResponse, error)
 }
 
 // UnimplementedConnectorServer should be embedded to have forward compatible implementations.
@@ -181,6 +233,18 @@ func (UnimplementedConnectorServer) BridgeInSignature(context.Context, *transfer
 func (UnimplementedConnectorServer) CancelSignature(context.Context, *transfers.CancelSignatureRequest) (*transfers.CancelSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSignature not implemented")
 }
+func (UnimplementedConnectorServer) TokenSupply(context.Context, *connector.TokenSupplyRequest) (*connector.TokenSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenSupply not implemented")
+}
+func (UnimplementedConnectorServer) SetPaused(context.Context, *connector.SetPausedRequest) (*connector.SetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+func (UnimplementedConnectorServer) EventRange(context.Context, *connector.EventRangeRequest) (*connector.EventRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventRange not implemented")
+}
+func (UnimplementedConnectorServer) TransactionStatus(context.Context, *connector.TransactionStatusRequest) (*connector.TransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionStatus not implemented")
+}
 
 // UnsafeConnectorServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ConnectorServer will
@@ -322,6 +386,78 @@ func _Connector_CancelSignature_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Connector_TokenSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(connector.TokenSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorServer).TokenSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.Connector/TokenSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorServer).TokenSupply(ctx, req.(*connector.TokenSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Connector_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(connector.SetPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.Connector/SetPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorServer).SetPaused(ctx, req.(*connector.SetPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Connector_EventRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(connector.EventRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorServer).EventRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.Connector/EventRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorServer).EventRange(ctx, req.(*connector.EventRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Connector_TransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(connector.TransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorServer).TransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.Connector/TransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorServer).TransactionStatus(ctx, req.(*connector.TransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Connector_ServiceDesc is the grpc.ServiceDesc for Connector service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -353,6 +489,22 @@ var Connector_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSignature",
 			Handler:    _Connector_CancelSignature_Handler,
 		},
+		{
+			MethodName: "TokenSupply",
+			Handler:    _Connector_TokenSupply_Handler,
+		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Connector_SetPaused_Handler,
+		},
+		{
+			MethodName: "EventRange",
+			Handler:    _Connector_EventRange_Handler,
+		},
+		{
+			MethodName: "TransactionStatus",
+			Handler:    _Connector_TransactionStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -27,6 +27,8 @@ type BridgeSignerClient interface {
 	Sign(ctx context.Context, in *signer.SignRequest, opts ...grpc.CallOption) (*signer.Signature, error)
 	// Return public key for specific network.
 	PublicKey(ctx context.Context, in *signer.PublicKeyRequest, opts ...grpc.CallOption) (*signer.PublicKeyResponse, error)
+	// Return public keys of all transaction keys for specific network.
+	PublicKeys(ctx context.Context, in *signer.PublicKeyRequest, opts ...grpc.CallOption) (*signer.PublicKeysResponse, error)
 }
 
 type bridgeSignerClient struct {
@@ -55,6 +57,15 @@ func (c *bridgeSignerClient) PublicKey(ctx context.Context, in *signer.PublicKey
 	return out, nil
 }
 
+func (c *bridgeSignerClient) PublicKeys(ctx context.Context, in *signer.PublicKeyRequest, opts ...grpc.CallOption) (*signer.PublicKeysResponse, error) {
+	out := new(signer.PublicKeysResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.BridgeSigner/PublicKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BridgeSignerServer is the server API for BridgeSigner service.
 // All implementations should embed UnimplementedBridgeSignerServer
 // for forward compatibility
@@ -63,6 +74,8 @@ type BridgeSignerServer interface {
 	Sign(context.Context, *signer.SignRequest) (*signer.Signature, error)
 	// Return public key for specific network.
 	PublicKey(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeyResponse, error)
+	// Return public keys of all transaction keys for specific network.
+	PublicKeys(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeysResponse, error)
 }
 
 // UnimplementedBridgeSignerServer should be embedded to have forward compatible implementations.
@@ -75,6 +88,9 @@ func (UnimplementedBridgeSignerServer) Sign(context.Context, *signer.SignRequest
 func (UnimplementedBridgeSignerServer) PublicKey(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublicKey not implemented")
 }
+func (UnimplementedBridgeSignerServer) PublicKeys(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublicKeys not implemented")
+}
 
 // UnsafeBridgeSignerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BridgeSignerServer will
@@ -123,6 +139,24 @@ func _BridgeSigner_PublicKey_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeSigner_PublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(signer.PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeSignerServer).PublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.BridgeSigner/PublicKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeSignerServer).PublicKeys(ctx, req.(*signer.PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BridgeSigner_ServiceDesc is the grpc.ServiceDesc for BridgeSigner service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -138,6 +172,10 @@ var BridgeSigner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublicKey",
 			Handler:    _BridgeSigner_PublicKey_Handler,
 		},
+		{
+			MethodName: "PublicKeys",
+			Handler:    _BridgeSigner_PublicKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bridge-signer/bridge-signer.proto",
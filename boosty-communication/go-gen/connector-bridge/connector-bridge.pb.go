@@ -27,7 +27,7 @@ var file_connector_bridge_connector_bridge_proto_rawDesc = []byte{
 	0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x62, 0x72, 0x69,
 	0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x74, 0x72, 0x69, 0x63, 0x6f,
 	0x72, 0x6e, 0x1a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x87, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xcd, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e,
 	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x53,
 	0x69, 0x67, 0x6e, 0x12, 0x14, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x53, 0x69,
 	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x72, 0x69, 0x63,
@@ -36,29 +36,36 @@ var file_connector_bridge_connector_bridge_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e,
 	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x6a, 0x5a, 0x68, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f,
-	0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x3b, 0x70, 0x62, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x44, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x19, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x69,
+	0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6a, 0x5a, 0x68, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x73,
+	0x2f, 0x63, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x3b,
+	0x70, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_connector_bridge_connector_bridge_proto_goTypes = []interface{}{
-	(*signer.SignRequest)(nil),       // 0: tricorn.SignRequest
-	(*signer.PublicKeyRequest)(nil),  // 1: tricorn.PublicKeyRequest
-	(*signer.Signature)(nil),         // 2: tricorn.Signature
-	(*signer.PublicKeyResponse)(nil), // 3: tricorn.PublicKeyResponse
+	(*signer.SignRequest)(nil),        // 0: tricorn.SignRequest
+	(*signer.PublicKeyRequest)(nil),   // 1: tricorn.PublicKeyRequest
+	(*signer.Signature)(nil),          // 2: tricorn.Signature
+	(*signer.PublicKeyResponse)(nil),  // 3: tricorn.PublicKeyResponse
+	(*signer.PublicKeysResponse)(nil), // 4: tricorn.PublicKeysResponse
 }
 var file_connector_bridge_connector_bridge_proto_depIdxs = []int32{
 	0, // 0: tricorn.ConnectorBridge.Sign:input_type -> tricorn.SignRequest
 	1, // 1: tricorn.ConnectorBridge.PublicKey:input_type -> tricorn.PublicKeyRequest
-	2, // 2: tricorn.ConnectorBridge.Sign:output_type -> tricorn.Signature
-	3, // 3: tricorn.ConnectorBridge.PublicKey:output_type -> tricorn.PublicKeyResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	1, // 2: tricorn.ConnectorBridge.PublicKeys:input_type -> tricorn.PublicKeyRequest
+	2, // 3: tricorn.ConnectorBridge.Sign:output_type -> tricorn.Signature
+	3, // 4: tricorn.ConnectorBridge.PublicKey:output_type -> tricorn.PublicKeyResponse
+	4, // 5: tricorn.ConnectorBridge.PublicKeys:output_type -> tricorn.PublicKeysResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -27,6 +27,8 @@ type ConnectorBridgeClient interface {
 	Sign(ctx context.Context, in *signer.SignRequest, opts ...grpc.CallOption) (*signer.Signature, error)
 	// Return public key for specific network.
 	PublicKey(ctx context.Context, in *signer.PublicKeyRequest, opts ...grpc.CallOption) (*signer.PublicKeyResponse, error)
+	// Return public keys of all transaction keys for specific network.
+	PublicKeys(ctx context.Context, in *signer.PublicKeyRequest, opts ...grpc.CallOption) (*signer.PublicKeysResponse, error)
 }
 
 type connectorBridgeClient struct {
@@ -55,6 +57,15 @@ func (c *connectorBridgeClient) PublicKey(ctx context.Context, in *signer.Public
 	return out, nil
 }
 
+func (c *connectorBridgeClient) PublicKeys(ctx context.Context, in *signer.PublicKeyRequest, opts ...grpc.CallOption) (*signer.PublicKeysResponse, error) {
+	out := new(signer.PublicKeysResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.ConnectorBridge/PublicKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConnectorBridgeServer is the server API for ConnectorBridge service.
 // All implementations should embed UnimplementedConnectorBridgeServer
 // for forward compatibility
@@ -63,6 +74,8 @@ type ConnectorBridgeServer interface {
 	Sign(context.Context, *signer.SignRequest) (*signer.Signature, error)
 	// Return public key for specific network.
 	PublicKey(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeyResponse, error)
+	// Return public keys of all transaction keys for specific network.
+	PublicKeys(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeysResponse, error)
 }
 
 // UnimplementedConnectorBridgeServer should be embedded to have forward compatible implementations.
@@ -75,6 +88,9 @@ func (UnimplementedConnectorBridgeServer) Sign(context.Context, *signer.SignRequ
 func (UnimplementedConnectorBridgeServer) PublicKey(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublicKey not implemented")
 }
+func (UnimplementedConnectorBridgeServer) PublicKeys(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublicKeys not implemented")
+}
 
 // UnsafeConnectorBridgeServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ConnectorBridgeServer will
@@ -123,6 +139,24 @@ func _ConnectorBridge_PublicKey_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConnectorBridge_PublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(signer.PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorBridgeServer).PublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.ConnectorBridge/PublicKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorBridgeServer).PublicKeys(ctx, req.(*signer.PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConnectorBridge_ServiceDesc is the grpc.ServiceDesc for ConnectorBridge service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -138,6 +172,10 @@ var ConnectorBridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublicKey",
 			Handler:    _ConnectorBridge_PublicKey_Handler,
 		},
+		{
+			MethodName: "PublicKeys",
+			Handler:    _ConnectorBridge_PublicKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connector-bridge/connector-bridge.proto",
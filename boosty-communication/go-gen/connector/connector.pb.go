@@ -21,6 +21,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TransactionStatusResponse_Status int32
+
+const (
+	TransactionStatusResponse_STATUS_UNSPECIFIED TransactionStatusResponse_Status = 0
+	TransactionStatusResponse_STATUS_NOT_FOUND   TransactionStatusResponse_Status = 1
+	TransactionStatusResponse_STATUS_PENDING     TransactionStatusResponse_Status = 2
+	TransactionStatusResponse_STATUS_SUCCEEDED   TransactionStatusResponse_Status = 3
+	TransactionStatusResponse_STATUS_FAILED      TransactionStatusResponse_Status = 4
+)
+
+// Enum value maps for TransactionStatusResponse_Status.
+var (
+	TransactionStatusResponse_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_NOT_FOUND",
+		2: "STATUS_PENDING",
+		3: "STATUS_SUCCEEDED",
+		4: "STATUS_FAILED",
+	}
+	TransactionStatusResponse_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_NOT_FOUND":   1,
+		"STATUS_PENDING":     2,
+		"STATUS_SUCCEEDED":   3,
+		"STATUS_FAILED":      4,
+	}
+)
+
+func (x TransactionStatusResponse_Status) Enum() *TransactionStatusResponse_Status {
+	p := new(TransactionStatusResponse_Status)
+	*p = x
+	return p
+}
+
+func (x TransactionStatusResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatusResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_connector_connector_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionStatusResponse_Status) Type() protoreflect.EnumType {
+	return &file_connector_connector_proto_enumTypes[0]
+}
+
+func (x TransactionStatusResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatusResponse_Status.Descriptor instead.
+func (TransactionStatusResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{18, 0}
+}
+
 type Address struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -170,6 +225,7 @@ type Event struct {
 	// Types that are assignable to Variant:
 	//	*Event_FundsIn
 	//	*Event_FundsOut
+	//	*Event_Progress
 	Variant isEvent_Variant `protobuf_oneof:"variant"`
 }
 
@@ -226,6 +282,13 @@ func (x *Event) GetFundsOut() *EventFundsOut {
 	return nil
 }
 
+func (x *Event) GetProgress() *EventProgress {
+	if x, ok := x.GetVariant().(*Event_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
 type isEvent_Variant interface {
 	isEvent_Variant()
 }
@@ -238,20 +301,28 @@ type Event_FundsOut struct {
 	FundsOut *EventFundsOut `protobuf:"bytes,2,opt,name=funds_out,json=fundsOut,proto3,oneof"`
 }
 
+type Event_Progress struct {
+	Progress *EventProgress `protobuf:"bytes,3,opt,name=progress,proto3,oneof"`
+}
+
 func (*Event_FundsIn) isEvent_Variant() {}
 
 func (*Event_FundsOut) isEvent_Variant() {}
 
+func (*Event_Progress) isEvent_Variant() {}
+
 type EventFundsIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From   *Address                        `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To     *transfers.StringNetworkAddress `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Amount string                          `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Token  *Address                        `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	Tx     *TransactionInfo                `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+	From    *Address                        `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      *transfers.StringNetworkAddress `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount  string                          `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Token   *Address                        `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Tx      *TransactionInfo                `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+	Nonce   uint64                          `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Removed bool                            `protobuf:"varint,7,opt,name=removed,proto3" json:"removed,omitempty"`
 }
 
 func (x *EventFundsIn) Reset() {
@@ -321,16 +392,31 @@ func (x *EventFundsIn) GetTx() *TransactionInfo {
 	return nil
 }
 
+func (x *EventFundsIn) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *EventFundsIn) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
 type EventFundsOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	To     *Address                        `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
-	From   *transfers.StringNetworkAddress `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	Amount string                          `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Token  *Address                        `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	Tx     *TransactionInfo                `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+	To            *Address                        `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	From          *transfers.StringNetworkAddress `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Amount        string                          `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Token         *Address                        `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Tx            *TransactionInfo                `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+	TransactionId uint64                          `protobuf:"varint,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
 func (x *EventFundsOut) Reset() {
@@ -400,6 +486,13 @@ func (x *EventFundsOut) GetTx() *TransactionInfo {
 	return nil
 }
 
+func (x *EventFundsOut) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
 type TransactionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -408,6 +501,7 @@ type TransactionInfo struct {
 	Hash        []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Blocknumber uint64 `protobuf:"varint,2,opt,name=blocknumber,proto3" json:"blocknumber,omitempty"`
 	Sender      []byte `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	LogIndex    uint64 `protobuf:"varint,4,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
 }
 
 func (x *TransactionInfo) Reset() {
@@ -463,6 +557,13 @@ func (x *TransactionInfo) GetSender() []byte {
 	return nil
 }
 
+func (x *TransactionInfo) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
 type ConnectorTokens struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -636,6 +737,445 @@ func (x *TokenOutResponse) GetTxhash() []byte {
 	return nil
 }
 
+type EventProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *EventProgress) Reset() {
+	*x = EventProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventProgress) ProtoMessage() {}
+
+func (x *EventProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventProgress.ProtoReflect.Descriptor instead.
+func (*EventProgress) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventProgress) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type TokenSupplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token *Address `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *TokenSupplyRequest) Reset() {
+	*x = TokenSupplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenSupplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSupplyRequest) ProtoMessage() {}
+
+func (x *TokenSupplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenSupplyRequest.ProtoReflect.Descriptor instead.
+func (*TokenSupplyRequest) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenSupplyRequest) GetToken() *Address {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type TokenSupplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeBalance string `protobuf:"bytes,1,opt,name=bridge_balance,json=bridgeBalance,proto3" json:"bridge_balance,omitempty"`
+	TotalSupply   string `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+}
+
+func (x *TokenSupplyResponse) Reset() {
+	*x = TokenSupplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenSupplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSupplyResponse) ProtoMessage() {}
+
+func (x *TokenSupplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenSupplyResponse.ProtoReflect.Descriptor instead.
+func (*TokenSupplyResponse) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TokenSupplyResponse) GetBridgeBalance() string {
+	if x != nil {
+		return x.BridgeBalance
+	}
+	return ""
+}
+
+func (x *TokenSupplyResponse) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+type SetPausedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *SetPausedRequest) Reset() {
+	*x = SetPausedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPausedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPausedRequest) ProtoMessage() {}
+
+func (x *SetPausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPausedRequest.ProtoReflect.Descriptor instead.
+func (*SetPausedRequest) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetPausedRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type SetPausedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txhash []byte `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
+}
+
+func (x *SetPausedResponse) Reset() {
+	*x = SetPausedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPausedResponse) ProtoMessage() {}
+
+func (x *SetPausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPausedResponse.ProtoReflect.Descriptor instead.
+func (*SetPausedResponse) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetPausedResponse) GetTxhash() []byte {
+	if x != nil {
+		return x.Txhash
+	}
+	return nil
+}
+
+type EventRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromBlock uint64 `protobuf:"varint,1,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock   uint64 `protobuf:"varint,2,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+}
+
+func (x *EventRangeRequest) Reset() {
+	*x = EventRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRangeRequest) ProtoMessage() {}
+
+func (x *EventRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRangeRequest.ProtoReflect.Descriptor instead.
+func (*EventRangeRequest) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EventRangeRequest) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *EventRangeRequest) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+type EventRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *EventRangeResponse) Reset() {
+	*x = EventRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRangeResponse) ProtoMessage() {}
+
+func (x *EventRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRangeResponse.ProtoReflect.Descriptor instead.
+func (*EventRangeResponse) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EventRangeResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TransactionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txhash []byte `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
+}
+
+func (x *TransactionStatusRequest) Reset() {
+	*x = TransactionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatusRequest) ProtoMessage() {}
+
+func (x *TransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransactionStatusRequest) GetTxhash() []byte {
+	if x != nil {
+		return x.Txhash
+	}
+	return nil
+}
+
+type TransactionStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status TransactionStatusResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=tricorn.TransactionStatusResponse_Status" json:"status,omitempty"`
+}
+
+func (x *TransactionStatusResponse) Reset() {
+	*x = TransactionStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connector_connector_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatusResponse) ProtoMessage() {}
+
+func (x *TransactionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connector_connector_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatusResponse.ProtoReflect.Descriptor instead.
+func (*TransactionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_connector_connector_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TransactionStatusResponse) GetStatus() TransactionStatusResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatusResponse_STATUS_UNSPECIFIED
+}
+
 type ConnectorTokens_ConnectorToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -648,7 +1188,7 @@ type ConnectorTokens_ConnectorToken struct {
 func (x *ConnectorTokens_ConnectorToken) Reset() {
 	*x = ConnectorTokens_ConnectorToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connector_connector_proto_msgTypes[10]
+		mi := &file_connector_connector_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -661,7 +1201,7 @@ func (x *ConnectorTokens_ConnectorToken) String() string {
 func (*ConnectorTokens_ConnectorToken) ProtoMessage() {}
 
 func (x *ConnectorTokens_ConnectorToken) ProtoReflect() protoreflect.Message {
-	mi := &file_connector_connector_proto_msgTypes[10]
+	mi := &file_connector_connector_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,79 +1247,134 @@ var file_connector_connector_proto_rawDesc = []byte{
 	0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
 	0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x0a, 0x05, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x66,
-	0x75, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f,
-	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x69, 0x63,
-	0x6f, 0x72, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x75,
-	0x74, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x42, 0x09, 0x0a,
-	0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72,
-	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
-	0x2d, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x72,
-	0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28,
-	0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x69,
-	0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x02, 0x74, 0x78, 0x22, 0xce, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x31, 0x0a, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x69,
-	0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x28, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72,
-	0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x02, 0x74, 0x78, 0x22, 0x5f, 0x0a, 0x0f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x3f,
-	0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a,
-	0x4c, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xcd, 0x01,
-	0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f,
-	0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xb3, 0x01, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x48, 0x00, 0x52, 0x07,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x73,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x69,
+	0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x4f,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x34,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22,
+	0xfd, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x49, 0x6e,
+	0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x02, 0x74, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
+	0xf5, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
 	0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x02, 0x74, 0x6f, 0x12, 0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x74, 0x6f, 0x12, 0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x69,
 	0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x2a, 0x0a,
-	0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x06, 0x74, 0x78, 0x68, 0x61, 0x73, 0x68, 0x42, 0x5c, 0x5a, 0x5a, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61,
-	0x62, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d,
-	0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e,
-	0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3b, 0x70, 0x62, 0x5f, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x02, 0x74, 0x78,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x67,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x72, 0x69, 0x63,
+	0x6f, 0x72, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x4c, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f,
+	0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x31,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x78, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78,
+	0x68, 0x61, 0x73, 0x68, 0x22, 0x32, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x4d, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x3c, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x32, 0x0a,
+	0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0xd3, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x73, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x42, 0x5c, 0x5a, 0x5a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x73,
+	0x2f, 0x63, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3b, 0x70, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -794,42 +1389,57 @@ func file_connector_connector_proto_rawDescGZIP() []byte {
 	return file_connector_connector_proto_rawDescData
 }
 
-var file_connector_connector_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_connector_connector_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_connector_connector_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_connector_connector_proto_goTypes = []interface{}{
-	(*Address)(nil),                        // 0: tricorn.Address
-	(*StringAddress)(nil),                  // 1: tricorn.StringAddress
-	(*EventsRequest)(nil),                  // 2: tricorn.EventsRequest
-	(*Event)(nil),                          // 3: tricorn.Event
-	(*EventFundsIn)(nil),                   // 4: tricorn.EventFundsIn
-	(*EventFundsOut)(nil),                  // 5: tricorn.EventFundsOut
-	(*TransactionInfo)(nil),                // 6: tricorn.TransactionInfo
-	(*ConnectorTokens)(nil),                // 7: tricorn.ConnectorTokens
-	(*TokenOutRequest)(nil),                // 8: tricorn.TokenOutRequest
-	(*TokenOutResponse)(nil),               // 9: tricorn.TokenOutResponse
-	(*ConnectorTokens_ConnectorToken)(nil), // 10: tricorn.ConnectorTokens.ConnectorToken
-	(*transfers.StringNetworkAddress)(nil), // 11: tricorn.StringNetworkAddress
+	(TransactionStatusResponse_Status)(0),  // 0: tricorn.TransactionStatusResponse.Status
+	(*Address)(nil),                        // 1: tricorn.Address
+	(*StringAddress)(nil),                  // 2: tricorn.StringAddress
+	(*EventsRequest)(nil),                  // 3: tricorn.EventsRequest
+	(*Event)(nil),                          // 4: tricorn.Event
+	(*EventFundsIn)(nil),                   // 5: tricorn.EventFundsIn
+	(*EventFundsOut)(nil),                  // 6: tricorn.EventFundsOut
+	(*TransactionInfo)(nil),                // 7: tricorn.TransactionInfo
+	(*ConnectorTokens)(nil),                // 8: tricorn.ConnectorTokens
+	(*TokenOutRequest)(nil),                // 9: tricorn.TokenOutRequest
+	(*TokenOutResponse)(nil),               // 10: tricorn.TokenOutResponse
+	(*EventProgress)(nil),                  // 11: tricorn.EventProgress
+	(*TokenSupplyRequest)(nil),             // 12: tricorn.TokenSupplyRequest
+	(*TokenSupplyResponse)(nil),            // 13: tricorn.TokenSupplyResponse
+	(*SetPausedRequest)(nil),               // 14: tricorn.SetPausedRequest
+	(*SetPausedResponse)(nil),              // 15: tricorn.SetPausedResponse
+	(*EventRangeRequest)(nil),              // 16: tricorn.EventRangeRequest
+	(*EventRangeResponse)(nil),             // 17: tricorn.EventRangeResponse
+	(*TransactionStatusRequest)(nil),       // 18: tricorn.TransactionStatusRequest
+	(*TransactionStatusResponse)(nil),      // 19: tricorn.TransactionStatusResponse
+	(*ConnectorTokens_ConnectorToken)(nil), // 20: tricorn.ConnectorTokens.ConnectorToken
+	(*transfers.StringNetworkAddress)(nil), // 21: tricorn.StringNetworkAddress
 }
 var file_connector_connector_proto_depIdxs = []int32{
-	4,  // 0: tricorn.Event.funds_in:type_name -> tricorn.EventFundsIn
-	5,  // 1: tricorn.Event.funds_out:type_name -> tricorn.EventFundsOut
-	0,  // 2: tricorn.EventFundsIn.from:type_name -> tricorn.Address
-	11, // 3: tricorn.EventFundsIn.to:type_name -> tricorn.StringNetworkAddress
-	0,  // 4: tricorn.EventFundsIn.token:type_name -> tricorn.Address
-	6,  // 5: tricorn.EventFundsIn.tx:type_name -> tricorn.TransactionInfo
-	0,  // 6: tricorn.EventFundsOut.to:type_name -> tricorn.Address
-	11, // 7: tricorn.EventFundsOut.from:type_name -> tricorn.StringNetworkAddress
-	0,  // 8: tricorn.EventFundsOut.token:type_name -> tricorn.Address
-	6,  // 9: tricorn.EventFundsOut.tx:type_name -> tricorn.TransactionInfo
-	10, // 10: tricorn.ConnectorTokens.tokens:type_name -> tricorn.ConnectorTokens.ConnectorToken
-	0,  // 11: tricorn.TokenOutRequest.token:type_name -> tricorn.Address
-	0,  // 12: tricorn.TokenOutRequest.to:type_name -> tricorn.Address
-	11, // 13: tricorn.TokenOutRequest.from:type_name -> tricorn.StringNetworkAddress
-	0,  // 14: tricorn.ConnectorTokens.ConnectorToken.address:type_name -> tricorn.Address
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	5,  // 0: tricorn.Event.funds_in:type_name -> tricorn.EventFundsIn
+	6,  // 1: tricorn.Event.funds_out:type_name -> tricorn.EventFundsOut
+	11, // 2: tricorn.Event.progress:type_name -> tricorn.EventProgress
+	1,  // 3: tricorn.EventFundsIn.from:type_name -> tricorn.Address
+	21, // 4: tricorn.EventFundsIn.to:type_name -> tricorn.StringNetworkAddress
+	1,  // 5: tricorn.EventFundsIn.token:type_name -> tricorn.Address
+	7,  // 6: tricorn.EventFundsIn.tx:type_name -> tricorn.TransactionInfo
+	1,  // 7: tricorn.EventFundsOut.to:type_name -> tricorn.Address
+	21, // 8: tricorn.EventFundsOut.from:type_name -> tricorn.StringNetworkAddress
+	1,  // 9: tricorn.EventFundsOut.token:type_name -> tricorn.Address
+	7,  // 10: tricorn.EventFundsOut.tx:type_name -> tricorn.TransactionInfo
+	20, // 11: tricorn.ConnectorTokens.tokens:type_name -> tricorn.ConnectorTokens.ConnectorToken
+	1,  // 12: tricorn.TokenOutRequest.token:type_name -> tricorn.Address
+	1,  // 13: tricorn.TokenOutRequest.to:type_name -> tricorn.Address
+	21, // 14: tricorn.TokenOutRequest.from:type_name -> tricorn.StringNetworkAddress
+	1,  // 15: tricorn.TokenSupplyRequest.token:type_name -> tricorn.Address
+	4,  // 16: tricorn.EventRangeResponse.events:type_name -> tricorn.Event
+	0,  // 17: tricorn.TransactionStatusResponse.status:type_name -> tricorn.TransactionStatusResponse.Status
+	1,  // 18: tricorn.ConnectorTokens.ConnectorToken.address:type_name -> tricorn.Address
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_connector_connector_proto_init() }
@@ -959,6 +1569,114 @@ func file_connector_connector_proto_init() {
 			}
 		}
 		file_connector_connector_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenSupplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenSupplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPausedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPausedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connector_connector_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectorTokens_ConnectorToken); i {
 			case 0:
 				return &v.state
@@ -975,19 +1693,21 @@ func file_connector_connector_proto_init() {
 	file_connector_connector_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*Event_FundsIn)(nil),
 		(*Event_FundsOut)(nil),
+		(*Event_Progress)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connector_connector_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_connector_connector_proto_goTypes,
 		DependencyIndexes: file_connector_connector_proto_depIdxs,
+		EnumInfos:         file_connector_connector_proto_enumTypes,
 		MessageInfos:      file_connector_connector_proto_msgTypes,
 	}.Build()
 	File_connector_connector_proto = out.File
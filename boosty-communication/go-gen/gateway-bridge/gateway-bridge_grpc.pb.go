@@ -8,8 +8,10 @@ package pb_gateway_bridge
 
 import (
 	context "context"
+	interventions "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/interventions"
 	networks "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/networks"
 	transfers "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/transfers"
+	webhooks "github.com/BoostyLabs/casper-eth-bridge/boosty-communication/go-gen/webhooks"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -39,6 +41,24 @@ type GatewayBridgeClient interface {
 	TransferHistory(ctx context.Context, in *transfers.TransferHistoryRequest, opts ...grpc.CallOption) (*transfers.TransferHistoryResponse, error)
 	// Return signature for user to send bridgeIn transaction.
 	BridgeInSignature(ctx context.Context, in *transfers.BridgeInSignatureRequest, opts ...grpc.CallOption) (*transfers.BridgeInSignatureResponse, error)
+	// Stream status changes of transfer until it is finished, cancelled or expired.
+	WatchTransfer(ctx context.Context, in *transfers.WatchTransferRequest, opts ...grpc.CallOption) (GatewayBridge_WatchTransferClient, error)
+	// Register webhook endpoint of the integrator.
+	CreateWebhookEndpoint(ctx context.Context, in *webhooks.CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*webhooks.WebhookEndpoint, error)
+	// Return webhook endpoints of the integrator.
+	ListWebhookEndpoints(ctx context.Context, in *webhooks.ListWebhookEndpointsRequest, opts ...grpc.CallOption) (*webhooks.ListWebhookEndpointsResponse, error)
+	// Delete webhook endpoint of the integrator together with its deliveries.
+	DeleteWebhookEndpoint(ctx context.Context, in *webhooks.DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Return the newest deliveries of the integrator webhook endpoint.
+	ListWebhookDeliveries(ctx context.Context, in *webhooks.ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*webhooks.ListWebhookDeliveriesResponse, error)
+	// Schedule deliveries of the integrator webhook endpoint to be sent again.
+	ReplayWebhookDeliveries(ctx context.Context, in *webhooks.ReplayWebhookDeliveriesRequest, opts ...grpc.CallOption) (*webhooks.ReplayWebhookDeliveriesResponse, error)
+	// Process bridge events of the range of blocks again, events which are already known are skipped.
+	Rescan(ctx context.Context, in *networks.RescanRequest, opts ...grpc.CallOption) (*networks.RescanResponse, error)
+	// Intervene into the transfer by operator, intervention is recorded to the audit log.
+	Intervene(ctx context.Context, in *interventions.InterveneRequest, opts ...grpc.CallOption) (*interventions.AuditEntry, error)
+	// Return audit log of the transfer from the oldest entry to the newest one.
+	AuditLog(ctx context.Context, in *interventions.AuditLogRequest, opts ...grpc.CallOption) (*interventions.AuditLogResponse, error)
 }
 
 type gatewayBridgeClient struct {
@@ -112,6 +132,110 @@ func (c *gatewayBridgeClient) BridgeInSignature(ctx context.Context, in *transfe
 	return out, nil
 }
 
+func (c *gatewayBridgeClient) WatchTransfer(ctx context.Context, in *transfers.WatchTransferRequest, opts ...grpc.CallOption) (GatewayBridge_WatchTransferClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GatewayBridge_ServiceDesc.Streams[0], "/tricorn.GatewayBridge/WatchTransfer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayBridgeWatchTransferClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GatewayBridge_WatchTransferClient interface {
+	Recv() (*transfers.WatchTransferResponse, error)
+	grpc.ClientStream
+}
+
+type gatewayBridgeWatchTransferClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayBridgeWatchTransferClient) Recv() (*transfers.WatchTransferResponse, error) {
+	m := new(transfers.WatchTransferResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayBridgeClient) CreateWebhookEndpoint(ctx context.Context, in *webhooks.CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*webhooks.WebhookEndpoint, error) {
+	out := new(webhooks.WebhookEndpoint)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/CreateWebhookEndpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayBridgeClient) ListWebhookEndpoints(ctx context.Context, in *webhooks.ListWebhookEndpointsRequest, opts ...grpc.CallOption) (*webhooks.ListWebhookEndpointsResponse, error) {
+	out := new(webhooks.ListWebhookEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/ListWebhookEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayBridgeClient) DeleteWebhookEndpoint(ctx context.Context, in *webhooks.DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/DeleteWebhookEndpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayBridgeClient) ListWebhookDeliveries(ctx context.Context, in *webhooks.ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*webhooks.ListWebhookDeliveriesResponse, error) {
+	out := new(webhooks.ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayBridgeClient) ReplayWebhookDeliveries(ctx context.Context, in *webhooks.ReplayWebhookDeliveriesRequest, opts ...grpc.CallOption) (*webhooks.ReplayWebhookDeliveriesResponse, error) {
+	out := new(webhooks.ReplayWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/ReplayWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayBridgeClient) Rescan(ctx context.Context, in *networks.RescanRequest, opts ...grpc.CallOption) (*networks.RescanResponse, error) {
+	out := new(networks.RescanResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/Rescan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayBridgeClient) Intervene(ctx context.Context, in *interventions.InterveneRequest, opts ...grpc.CallOption) (*interventions.AuditEntry, error) {
+	out := new(interventions.AuditEntry)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/Intervene", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayBridgeClient) AuditLog(ctx context.Context, in *interventions.AuditLogRequest, opts ...grpc.CallOption) (*interventions.AuditLogResponse, error) {
+	out := new(interventions.AuditLogResponse)
+	err := c.cc.Invoke(ctx, "/tricorn.GatewayBridge/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayBridgeServer is the server API for GatewayBridge service.
 // All implementations should embed UnimplementedGatewayBridgeServer
 // for forward compatibility
@@ -130,6 +254,24 @@ type GatewayBridgeServer interface {
 	TransferHistory(context.Context, *transfers.TransferHistoryRequest) (*transfers.TransferHistoryResponse, error)
 	// Return signature for user to send bridgeIn transaction.
 	BridgeInSignature(context.Context, *transfers.BridgeInSignatureRequest) (*transfers.BridgeInSignatureResponse, error)
+	// Stream status changes of transfer until it is finished, cancelled or expired.
+	WatchTransfer(*transfers.WatchTransferRequest, GatewayBridge_WatchTransferServer) error
+	// Register webhook endpoint of the integrator.
+	CreateWebhookEndpoint(context.Context, *webhooks.CreateWebhookEndpointRequest) (*webhooks.WebhookEndpoint, error)
+	// Return webhook endpoints of the integrator.
+	ListWebhookEndpoints(context.Context, *webhooks.ListWebhookEndpointsRequest) (*webhooks.ListWebhookEndpointsResponse, error)
+	// Delete webhook endpoint of the integrator together with its deliveries.
+	DeleteWebhookEndpoint(context.Context, *webhooks.DeleteWebhookEndpointRequest) (*emptypb.Empty, error)
+	// Return the newest deliveries of the integrator webhook endpoint.
+	ListWebhookDeliveries(context.Context, *webhooks.ListWebhookDeliveriesRequest) (*webhooks.ListWebhookDeliveriesResponse, error)
+	// Schedule deliveries of the integrator webhook endpoint to be sent again.
+	ReplayWebhookDeliveries(context.Context, *webhooks.ReplayWebhookDeliveriesRequest) (*webhooks.ReplayWebhookDeliveriesResponse, error)
+	// Process bridge events of the range of blocks again, events which are already known are skipped.
+	Rescan(context.Context, *networks.RescanRequest) (*networks.RescanResponse, error)
+	// Intervene into the transfer by operator, intervention is recorded to the audit log.
+	Intervene(context.Context, *interventions.InterveneRequest) (*interventions.AuditEntry, error)
+	// Return audit log of the transfer from the oldest entry to the newest one.
+	AuditLog(context.Context, *interventions.AuditLogRequest) (*interventions.AuditLogResponse, error)
 }
 
 // UnimplementedGatewayBridgeServer should be embedded to have forward compatible implementations.
@@ -157,6 +299,33 @@ func (UnimplementedGatewayBridgeServer) TransferHistory(context.Context, *transf
 func (UnimplementedGatewayBridgeServer) BridgeInSignature(context.Context, *transfers.BridgeInSignatureRequest) (*transfers.BridgeInSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeInSignature not implemented")
 }
+func (UnimplementedGatewayBridgeServer) WatchTransfer(*transfers.WatchTransferRequest, GatewayBridge_WatchTransferServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTransfer not implemented")
+}
+func (UnimplementedGatewayBridgeServer) CreateWebhookEndpoint(context.Context, *webhooks.CreateWebhookEndpointRequest) (*webhooks.WebhookEndpoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookEndpoint not implemented")
+}
+func (UnimplementedGatewayBridgeServer) ListWebhookEndpoints(context.Context, *webhooks.ListWebhookEndpointsRequest) (*webhooks.ListWebhookEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookEndpoints not implemented")
+}
+func (UnimplementedGatewayBridgeServer) DeleteWebhookEndpoint(context.Context, *webhooks.DeleteWebhookEndpointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookEndpoint not implemented")
+}
+func (UnimplementedGatewayBridgeServer) ListWebhookDeliveries(context.Context, *webhooks.ListWebhookDeliveriesRequest) (*webhooks.ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedGatewayBridgeServer) ReplayWebhookDeliveries(context.Context, *webhooks.ReplayWebhookDeliveriesRequest) (*webhooks.ReplayWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDeliveries not implemented")
+}
+func (UnimplementedGatewayBridgeServer) Rescan(context.Context, *networks.RescanRequest) (*networks.RescanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rescan not implemented")
+}
+func (UnimplementedGatewayBridgeServer) Intervene(context.Context, *interventions.InterveneRequest) (*interventions.AuditEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Intervene not implemented")
+}
+func (UnimplementedGatewayBridgeServer) AuditLog(context.Context, *interventions.AuditLogRequest) (*interventions.AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
 
 // UnsafeGatewayBridgeServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to GatewayBridgeServer will
@@ -295,6 +464,171 @@ func _GatewayBridge_BridgeInSignature_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayBridge_WatchTransfer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(transfers.WatchTransferRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayBridgeServer).WatchTransfer(m, &gatewayBridgeWatchTransferServer{stream})
+}
+
+type GatewayBridge_WatchTransferServer interface {
+	Send(*transfers.WatchTransferResponse) error
+	grpc.ServerStream
+}
+
+type gatewayBridgeWatchTransferServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayBridgeWatchTransferServer) Send(m *transfers.WatchTransferResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GatewayBridge_CreateWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(webhooks.CreateWebhookEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).CreateWebhookEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/CreateWebhookEndpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).CreateWebhookEndpoint(ctx, req.(*webhooks.CreateWebhookEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayBridge_ListWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(webhooks.ListWebhookEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).ListWebhookEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/ListWebhookEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).ListWebhookEndpoints(ctx, req.(*webhooks.ListWebhookEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayBridge_DeleteWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(webhooks.DeleteWebhookEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).DeleteWebhookEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/DeleteWebhookEndpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).DeleteWebhookEndpoint(ctx, req.(*webhooks.DeleteWebhookEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayBridge_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(webhooks.ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).ListWebhookDeliveries(ctx, req.(*webhooks.ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayBridge_ReplayWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(webhooks.ReplayWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).ReplayWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/ReplayWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).ReplayWebhookDeliveries(ctx, req.(*webhooks.ReplayWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayBridge_Rescan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(networks.RescanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).Rescan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/Rescan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).Rescan(ctx, req.(*networks.RescanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayBridge_Intervene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(interventions.InterveneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).Intervene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/Intervene",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).Intervene(ctx, req.(*interventions.InterveneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayBridge_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(interventions.AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayBridgeServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tricorn.GatewayBridge/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayBridgeServer).AuditLog(ctx, req.(*interventions.AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GatewayBridge_ServiceDesc is the grpc.ServiceDesc for GatewayBridge service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -330,7 +664,45 @@ var GatewayBridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeInSignature",
 			Handler:    _GatewayBridge_BridgeInSignature_Handler,
 		},
+		{
+			MethodName: "CreateWebhookEndpoint",
+			Handler:    _GatewayBridge_CreateWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "ListWebhookEndpoints",
+			Handler:    _GatewayBridge_ListWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookEndpoint",
+			Handler:    _GatewayBridge_DeleteWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _GatewayBridge_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDeliveries",
+			Handler:    _GatewayBridge_ReplayWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "Rescan",
+			Handler:    _GatewayBridge_Rescan_Handler,
+		},
+		{
+			MethodName: "Intervene",
+			Handler:    _GatewayBridge_Intervene_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _GatewayBridge_AuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTransfer",
+			Handler:       _GatewayBridge_WatchTransfer_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway-bridge/gateway-bridge.proto",
 }
@@ -313,6 +313,195 @@ func (x *TokensResponse) GetTokens() []*TokensResponse_Token {
 	return nil
 }
 
+type RescanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock   uint64 `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+}
+
+func (x *RescanRequest) Reset() {
+	*x = RescanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_networks_networks_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanRequest) ProtoMessage() {}
+
+func (x *RescanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_networks_networks_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanRequest.ProtoReflect.Descriptor instead.
+func (*RescanRequest) Descriptor() ([]byte, []int) {
+	return file_networks_networks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RescanRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *RescanRequest) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *RescanRequest) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+type RescanEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind        string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	TxHash      []byte `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex    uint64 `protobuf:"varint,3,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *RescanEvent) Reset() {
+	*x = RescanEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_networks_networks_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanEvent) ProtoMessage() {}
+
+func (x *RescanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_networks_networks_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanEvent.ProtoReflect.Descriptor instead.
+func (*RescanEvent) Descriptor() ([]byte, []int) {
+	return file_networks_networks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RescanEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RescanEvent) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *RescanEvent) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *RescanEvent) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type RescanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Processed []*RescanEvent `protobuf:"bytes,1,rep,name=processed,proto3" json:"processed,omitempty"`
+	Known     []*RescanEvent `protobuf:"bytes,2,rep,name=known,proto3" json:"known,omitempty"`
+}
+
+func (x *RescanResponse) Reset() {
+	*x = RescanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_networks_networks_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanResponse) ProtoMessage() {}
+
+func (x *RescanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_networks_networks_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanResponse.ProtoReflect.Descriptor instead.
+func (*RescanResponse) Descriptor() ([]byte, []int) {
+	return file_networks_networks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RescanResponse) GetProcessed() []*RescanEvent {
+	if x != nil {
+		return x.Processed
+	}
+	return nil
+}
+
+func (x *RescanResponse) GetKnown() []*RescanEvent {
+	if x != nil {
+		return x.Known
+	}
+	return nil
+}
+
 type TokensResponse_TokenAddress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -326,7 +515,7 @@ type TokensResponse_TokenAddress struct {
 func (x *TokensResponse_TokenAddress) Reset() {
 	*x = TokensResponse_TokenAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_networks_networks_proto_msgTypes[4]
+		mi := &file_networks_networks_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +528,7 @@ func (x *TokensResponse_TokenAddress) String() string {
 func (*TokensResponse_TokenAddress) ProtoMessage() {}
 
 func (x *TokensResponse_TokenAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_networks_networks_proto_msgTypes[4]
+	mi := &file_networks_networks_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +579,7 @@ type TokensResponse_Token struct {
 func (x *TokensResponse_Token) Reset() {
 	*x = TokensResponse_Token{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_networks_networks_proto_msgTypes[5]
+		mi := &file_networks_networks_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -403,7 +592,7 @@ func (x *TokensResponse_Token) String() string {
 func (*TokensResponse_Token) ProtoMessage() {}
 
 func (x *TokensResponse_Token) ProtoReflect() protoreflect.Message {
-	mi := &file_networks_networks_proto_msgTypes[5]
+	mi := &file_networks_networks_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,17 +686,38 @@ var file_networks_networks_proto_rawDesc = []byte{
 	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72,
 	0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2a, 0x37, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x54, 0x5f, 0x45, 0x56,
-	0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x53, 0x50, 0x45, 0x52,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x4c, 0x41, 0x4e, 0x41, 0x10,
-	0x02, 0x42, 0x5a, 0x5a, 0x58, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f,
-	0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x73, 0x3b, 0x70, 0x62, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x63,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x7a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x70,
+	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x63, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x69, 0x63, 0x6f, 0x72, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x63, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x2a, 0x37, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x4e, 0x54, 0x5f, 0x45, 0x56, 0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
+	0x54, 0x5f, 0x43, 0x41, 0x53, 0x50, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x54,
+	0x5f, 0x53, 0x4f, 0x4c, 0x41, 0x4e, 0x41, 0x10, 0x02, 0x42, 0x5a, 0x5a, 0x58, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x4c, 0x61,
+	0x62, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x65, 0x6e,
+	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x3b, 0x70, 0x62, 0x5f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -523,26 +733,31 @@ func file_networks_networks_proto_rawDescGZIP() []byte {
 }
 
 var file_networks_networks_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_networks_networks_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_networks_networks_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_networks_networks_proto_goTypes = []interface{}{
 	(NetworkType)(0),                    // 0: tricorn.NetworkType
 	(*Network)(nil),                     // 1: tricorn.Network
 	(*ConnectedNetworksResponse)(nil),   // 2: tricorn.ConnectedNetworksResponse
 	(*SupportedTokensRequest)(nil),      // 3: tricorn.SupportedTokensRequest
 	(*TokensResponse)(nil),              // 4: tricorn.TokensResponse
-	(*TokensResponse_TokenAddress)(nil), // 5: tricorn.TokensResponse.TokenAddress
-	(*TokensResponse_Token)(nil),        // 6: tricorn.TokensResponse.Token
+	(*RescanRequest)(nil),               // 5: tricorn.RescanRequest
+	(*RescanEvent)(nil),                 // 6: tricorn.RescanEvent
+	(*RescanResponse)(nil),              // 7: tricorn.RescanResponse
+	(*TokensResponse_TokenAddress)(nil), // 8: tricorn.TokensResponse.TokenAddress
+	(*TokensResponse_Token)(nil),        // 9: tricorn.TokensResponse.Token
 }
 var file_networks_networks_proto_depIdxs = []int32{
 	0, // 0: tricorn.Network.type:type_name -> tricorn.NetworkType
 	1, // 1: tricorn.ConnectedNetworksResponse.networks:type_name -> tricorn.Network
-	6, // 2: tricorn.TokensResponse.tokens:type_name -> tricorn.TokensResponse.Token
-	5, // 3: tricorn.TokensResponse.Token.addresses:type_name -> tricorn.TokensResponse.TokenAddress
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	9, // 2: tricorn.TokensResponse.tokens:type_name -> tricorn.TokensResponse.Token
+	6, // 3: tricorn.RescanResponse.processed:type_name -> tricorn.RescanEvent
+	6, // 4: tricorn.RescanResponse.known:type_name -> tricorn.RescanEvent
+	8, // 5: tricorn.TokensResponse.Token.addresses:type_name -> tricorn.TokensResponse.TokenAddress
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_networks_networks_proto_init() }
@@ -600,7 +815,7 @@ func file_networks_networks_proto_init() {
 			}
 		}
 		file_networks_networks_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensResponse_TokenAddress); i {
+			switch v := v.(*RescanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -612,6 +827,42 @@ func file_networks_networks_proto_init() {
 			}
 		}
 		file_networks_networks_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_networks_networks_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_networks_networks_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensResponse_TokenAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_networks_networks_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TokensResponse_Token); i {
 			case 0:
 				return &v.state
@@ -630,7 +881,7 @@ func file_networks_networks_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_networks_networks_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},